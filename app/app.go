@@ -155,6 +155,7 @@ import (
 	"github.com/CoreumFoundation/coreum/v6/x/deterministicgas"
 	deterministicgastypes "github.com/CoreumFoundation/coreum/v6/x/deterministicgas/types"
 	"github.com/CoreumFoundation/coreum/v6/x/dex"
+	dexindexer "github.com/CoreumFoundation/coreum/v6/x/dex/indexer"
 	dexkeeper "github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
 	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
 	"github.com/CoreumFoundation/coreum/v6/x/feemodel"
@@ -200,6 +201,8 @@ var (
 		wasmtypes.ModuleName:           {authtypes.Burner},
 		assetfttypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		assetnfttypes.ModuleName:       {authtypes.Burner},
+		dextypes.ModuleName:            nil,
+		dextypes.RewardPoolModuleName:  nil,
 		// the line is required by the nft module to have the module account stored in the account keeper
 		nft.ModuleName: {},
 	}
@@ -273,6 +276,9 @@ type App struct {
 	DelayKeeper        delaykeeper.Keeper
 	DEXKeeper          dexkeeper.Keeper
 
+	// DEXIndexer is the node-local DEX indexer, nil if the indexer is disabled.
+	DEXIndexer *dexindexer.Indexer
+
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
@@ -744,7 +750,10 @@ func New(
 		wasmkeeper.WithQueryPlugins(wasmcustomhandler.NewCoreumQueryHandler(
 			assetftkeeper.NewQueryService(app.AssetFTKeeper, app.BankKeeper),
 			assetnftkeeper.NewQueryService(app.AssetNFTKeeper),
-			app.NFTKeeper, app.GRPCQueryRouter(), appCodec,
+			app.NFTKeeper,
+			// the dex keeper is created after the wasm keeper, so the pointer is passed
+			dexkeeper.NewQueryService(&app.DEXKeeper),
+			app.GRPCQueryRouter(), appCodec,
 		)),
 	}
 
@@ -779,6 +788,7 @@ func New(
 
 	// IBC transfer stack contains (from top to bottom):
 	// - wibctransfer
+	// - wibctransfer DEX
 	// - packetforward
 	// - ibchooks
 	// - ibctransfer
@@ -791,6 +801,13 @@ func New(
 		0,
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 	)
+	// the dex keeper is created after the IBC router, so the pointer is passed
+	ibcTransferStack = wibctransfer.NewDEXMiddleware(
+		ibcTransferStack,
+		&app.DEXKeeper,
+		app.BankKeeper,
+		app.TransferKeeper,
+	)
 	ibcTransferStack = wibctransfer.NewPurposeMiddleware(ibcTransferStack)
 
 	// Create ICAHost Stack
@@ -816,6 +833,8 @@ func New(
 		authkeeper.NewQueryServer(app.AccountKeeper),
 		app.AssetFTKeeper,
 		app.DelayKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	if err := delayRouter.RegisterHandler(
@@ -824,6 +843,12 @@ func New(
 	); err != nil {
 		panic(err)
 	}
+	if err := delayRouter.RegisterHandler(
+		&dextypes.CancelDeadManSwitchOrders{},
+		dexkeeper.NewDelayDeadManSwitchHandler(app.DEXKeeper),
+	); err != nil {
+		panic(err)
+	}
 
 	/****  Module Options ****/
 
@@ -1082,6 +1107,22 @@ func New(
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
+	dexIndexerConfig, err := dexindexer.ReadConfig(appOpts)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to read dex indexer config"))
+	}
+	if dexIndexerConfig.Enable {
+		dexIndexerDB, err := dbm.NewDB("dex_indexer", server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(errors.Wrap(err, "failed to open dex indexer db"))
+		}
+		app.DEXIndexer = dexindexer.New(dexIndexerConfig, dexIndexerDB, appCodec, logger)
+		app.SetStreamingManager(storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{app.DEXIndexer},
+		})
+	}
+	dextypes.RegisterIndexerServer(app.GRPCQueryRouter(), dexindexer.NewQueryService(app.DEXIndexer))
+
 	reflectionSvc, err := runtimeservices.NewReflectionService()
 	if err != nil {
 		panic(err)
@@ -1245,6 +1286,16 @@ func New(
 	return app
 }
 
+// Close closes the app and the resources opened by it.
+func (app *App) Close() error {
+	if app.DEXIndexer != nil {
+		if err := app.DEXIndexer.Close(); err != nil {
+			return err
+		}
+	}
+	return app.BaseApp.Close()
+}
+
 // Name returns the name of the App.
 func (app *App) Name() string { return app.BaseApp.Name() }
 
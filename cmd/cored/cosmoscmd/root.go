@@ -47,6 +47,7 @@ import (
 	"github.com/CoreumFoundation/coreum/v6/app"
 	coreumclient "github.com/CoreumFoundation/coreum/v6/pkg/client"
 	"github.com/CoreumFoundation/coreum/v6/pkg/config"
+	dexindexer "github.com/CoreumFoundation/coreum/v6/x/dex/indexer"
 )
 
 const ledgerAppName = "Coreum"
@@ -188,7 +189,8 @@ func initAppConfig() (string, interface{}) {
 
 	type CustomAppConfig struct {
 		serverconfig.Config
-		WASM WASMConfig
+		WASM       WASMConfig
+		DEXIndexer dexindexer.Config
 	}
 
 	defaultWasmNodeConfig := wasmtypes.DefaultNodeConfig()
@@ -198,6 +200,7 @@ func initAppConfig() (string, interface{}) {
 			QueryGasLimit:   defaultWasmNodeConfig.SmartQueryGasLimit,
 			MemoryCacheSize: defaultWasmNodeConfig.MemoryCacheSize,
 		},
+		DEXIndexer: dexindexer.DefaultConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -207,7 +210,7 @@ query_gas_limit = {{ .WASM.QueryGasLimit }}
 # This defines the memory size for Wasm modules that we can keep cached to speed-up instantiation
 # The value is in MiB not bytes
 memory_cache_size = {{ .WASM.MemoryCacheSize }}
-`
+` + dexindexer.DefaultConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }
@@ -89,7 +89,26 @@ func (k Keeper) DEXExecuteActions(ctx sdk.Context, actions types.DEXActions) err
 	)
 }
 
-// DEXDecreaseLimits decreases the DEX limits.
+// DEXIncreaseLimits increases the DEX limits, the zero expected to receive coin is ignored.
+func (k Keeper) DEXIncreaseLimits(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	lockedCoins sdk.Coins, expectedToReceiveCoin sdk.Coin,
+) error {
+	for _, coin := range lockedCoins {
+		if err := k.DEXIncreaseLocked(ctx, addr, coin); err != nil {
+			return err
+		}
+	}
+
+	if expectedToReceiveCoin.IsZero() {
+		return nil
+	}
+
+	return k.DEXIncreaseExpectedToReceive(ctx, addr, expectedToReceiveCoin)
+}
+
+// DEXDecreaseLimits decreases the DEX limits, the zero expected to receive coin is ignored.
 func (k Keeper) DEXDecreaseLimits(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
@@ -101,6 +120,10 @@ func (k Keeper) DEXDecreaseLimits(
 		}
 	}
 
+	if expectedToReceiveCoin.IsZero() {
+		return nil
+	}
+
 	return k.DEXDecreaseExpectedToReceive(ctx, addr, expectedToReceiveCoin)
 }
 
@@ -404,6 +427,21 @@ func (k Keeper) ValidateDEXCancelOrdersByDenomIsAllowed(ctx sdk.Context, addr sd
 	return nil
 }
 
+// ValidateDEXOrderBookUpdateIsAllowed validates whether the address is allowed to manage the DEX order books of the
+// denom.
+func (k Keeper) ValidateDEXOrderBookUpdateIsAllowed(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	def, err := k.getDefinitionOrNil(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if def == nil || !def.HasAdminPrivileges(addr) {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "only admin is able to manage order books of denom %s", denom)
+	}
+
+	return nil
+}
+
 func (k Keeper) dexCheckExpectedToSpend(
 	ctx sdk.Context,
 	order types.DEXOrder,
@@ -49,6 +49,8 @@ const (
 	GrantBaseGas                     = 25000
 	DEXUpdateWhitelistedDenomBaseGas = 10_000
 	DEXWhitelistedPerDenomGas        = 10_000
+	DEXBatchCancelOrdersPerOrderGas  = 35_000
+	DEXCancelAllOrdersPerOrderGas    = 40_000
 )
 
 type (
@@ -114,7 +116,11 @@ func DefaultConfig() Config {
 		MsgToMsgURL(&assetnfttypes.MsgRemoveFromClassWhitelist{}): constantGasFunc(3_500),
 
 		// dex
-		MsgToMsgURL(&dextypes.MsgCancelOrder{}): constantGasFunc(35_000),
+		MsgToMsgURL(&dextypes.MsgCancelOrder{}):       constantGasFunc(35_000),
+		MsgToMsgURL(&dextypes.MsgBatchCancelOrders{}): dexBatchCancelOrdersGasFunc(DEXBatchCancelOrdersPerOrderGas),
+		MsgToMsgURL(&dextypes.MsgCancelAllOrders{}):   dexCancelAllOrdersGasFunc(DEXCancelAllOrdersPerOrderGas),
+		MsgToMsgURL(&dextypes.MsgSetDeadManSwitch{}):  constantGasFunc(15_000),
+		MsgToMsgURL(&dextypes.MsgHeartbeat{}):         constantGasFunc(15_000),
 
 		// authz
 		MsgToMsgURL(&authz.MsgGrant{}):  authzMsgGrantGasFunc(GrantBaseGas, storeConfig.WriteCostPerByte),
@@ -230,7 +236,17 @@ func DefaultConfig() Config {
 			// dex
 			&dextypes.MsgUpdateParams{},
 			&dextypes.MsgPlaceOrder{},
+			&dextypes.MsgBatchPlaceOrders{},
+			&dextypes.MsgReplaceOrder{},
 			&dextypes.MsgCancelOrdersByDenom{},
+			&dextypes.MsgCreateOrderBook{},
+			&dextypes.MsgUpdateOrderBook{},
+			&dextypes.MsgUpdateOrderBookStatus{},
+			&dextypes.MsgSwapExactIn{},
+			&dextypes.MsgSwapExactOut{},
+			&dextypes.MsgFundRewardProgram{},
+			&dextypes.MsgClaimRewards{},
+			&dextypes.MsgSetOrderQuota{},
 
 			// distribution
 			&distributiontypes.MsgUpdateParams{},       // This is non-deterministic because all the gov proposals are non-deterministic anyway
@@ -498,6 +514,28 @@ func updateDEXWhitelistedDenomsGasFunc(
 	}
 }
 
+func dexBatchCancelOrdersGasFunc(dexBatchCancelOrdersPerOrderGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*dextypes.MsgBatchCancelOrders)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{len(m.IDs), 1})) * dexBatchCancelOrdersPerOrderGas, true
+	}
+}
+
+func dexCancelAllOrdersGasFunc(dexCancelAllOrdersPerOrderGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*dextypes.MsgCancelAllOrders)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]uint32{m.Limit, 1})) * dexCancelAllOrdersPerOrderGas, true
+	}
+}
+
 func reportUnknownMessageMetric(msgURL MsgURL) {
 	metrics.IncrCounterWithLabels([]string{"deterministic_gas_unknown_message"}, 1, []metrics.Label{
 		{Name: "msg_name", Value: string(msgURL)},
@@ -24,6 +24,7 @@ import (
 	assetnfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/v6/x/deterministicgas"
 	"github.com/CoreumFoundation/coreum/v6/x/deterministicgas/types"
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
 // To access private variable from github.com/cosmos/gogoproto we link it to local variable.
@@ -107,10 +108,10 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 	// To make sure we do not increase/decrease deterministic and extension types accidentally,
 	// we assert length to be equal to exact number, so each change requires
 	// explicit adjustment of tests.
-	assert.Equal(t, 85, nondeterministicMsgCount)
-	assert.Equal(t, 68, deterministicMsgCount)
+	assert.Equal(t, 95, nondeterministicMsgCount)
+	assert.Equal(t, 72, deterministicMsgCount)
 	assert.Equal(t, 12, extensionMsgCount)
-	assert.Equal(t, 141, nonExtensionMsgCount)
+	assert.Equal(t, 155, nonExtensionMsgCount)
 }
 
 func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
@@ -121,6 +122,8 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 		assetFTIssue                 = 70000
 		bankSendPerCoinGas           = deterministicgas.BankSendPerCoinGas
 		bankMultiSendPerOperationGas = deterministicgas.BankMultiSendPerOperationsGas
+		dexBatchCancelPerOrderGas    = deterministicgas.DEXBatchCancelOrdersPerOrderGas
+		dexCancelAllPerOrderGas      = deterministicgas.DEXCancelAllOrdersPerOrderGas
 	)
 
 	cfg := deterministicgas.DefaultConfig()
@@ -214,6 +217,30 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 			expectedGas:             5 * bankMultiSendPerOperationGas,
 			expectedIsDeterministic: true,
 		},
+		{
+			name: "dex.MsgBatchPlaceOrders",
+			msg: &dextypes.MsgBatchPlaceOrders{
+				Orders: make([]dextypes.BatchOrder, 3),
+			},
+			expectedGas:             0,
+			expectedIsDeterministic: false,
+		},
+		{
+			name: "dex.MsgBatchCancelOrders: 4 orders",
+			msg: &dextypes.MsgBatchCancelOrders{
+				IDs: []string{"id1", "id2", "id3", "id4"},
+			},
+			expectedGas:             4 * dexBatchCancelPerOrderGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "dex.MsgCancelAllOrders: limit 5",
+			msg: &dextypes.MsgCancelAllOrders{
+				Limit: 5,
+			},
+			expectedGas:             5 * dexCancelAllPerOrderGas,
+			expectedIsDeterministic: true,
+		},
 		{
 			name: "authz.MsgExec: 1 bank.MsgSend & 1 wasm.MsgExecuteContract",
 			msg: lo.ToPtr(
@@ -95,6 +95,8 @@ func main() {
 		NFTMsgMintCost                   uint64
 		DEXUpdateWhitelistedDenomBaseGas uint64
 		DEXWhitelistedPerDenomGas        uint64
+		DEXBatchCancelOrdersPerOrderGas  uint64
+		DEXCancelAllOrdersPerOrderGas    uint64
 
 		DetermMsgsSpecialCases []deterministicgas.MsgURL
 		DetermMsgs             []determMsg
@@ -120,6 +122,8 @@ func main() {
 		NFTMsgMintCost:                   deterministicgas.NFTMintBaseGas,
 		DEXWhitelistedPerDenomGas:        deterministicgas.DEXWhitelistedPerDenomGas,
 		DEXUpdateWhitelistedDenomBaseGas: deterministicgas.DEXUpdateWhitelistedDenomBaseGas,
+		DEXBatchCancelOrdersPerOrderGas:  deterministicgas.DEXBatchCancelOrdersPerOrderGas,
+		DEXCancelAllOrdersPerOrderGas:    deterministicgas.DEXCancelAllOrdersPerOrderGas,
 
 		DetermMsgsSpecialCases: determSpeicialCaseMsgURLs,
 		DetermMsgs:             determMsgs,
@@ -2,18 +2,31 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
 	"github.com/CoreumFoundation/coreum/v6/pkg/config/constant"
 	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
+const (
+	// DepthLimitFlag is order book depth limit flag.
+	DepthLimitFlag = "limit"
+	// CreatorFlag is order creator flag.
+	CreatorFlag = "creator"
+	// EndTimeFlag is TWAP window end time flag.
+	EndTimeFlag = "end-time"
+)
+
 // GetQueryCmd returns the cli query commands for the module.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,10 +40,19 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryOrder())
 	cmd.AddCommand(CmdQueryOrders())
+	cmd.AddCommand(CmdQueryTriggerOrders())
 	cmd.AddCommand(CmdQueryOrderBooks())
 	cmd.AddCommand(CmdQueryOrderBookParams())
 	cmd.AddCommand(CmdQueryOrderBookOrders())
+	cmd.AddCommand(CmdQueryOrderBookDepth())
+	cmd.AddCommand(CmdQuerySimulateOrder())
 	cmd.AddCommand(CmdQueryAccountDenomOrdersCount())
+	cmd.AddCommand(CmdQueryTWAP())
+	cmd.AddCommand(CmdQueryDeadManSwitch())
+	cmd.AddCommand(CmdQueryAccountTradingVolume())
+	cmd.AddCommand(CmdQueryRewardPrograms())
+	cmd.AddCommand(CmdQueryAccountRewards())
+	cmd.AddCommand(CmdQueryOrderQuota())
 
 	return cmd
 }
@@ -150,6 +172,48 @@ $ %[1]s query %s orders %s
 	return cmd
 }
 
+// CmdQueryTriggerOrders returns the QueryTriggerOrders cobra command.
+func CmdQueryTriggerOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger-orders [creator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query trigger orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query not yet activated trigger orders.
+
+Example:
+$ %[1]s query %s trigger-orders %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TriggerOrders(cmd.Context(), &types.QueryTriggerOrdersRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trigger-orders")
+
+	return cmd
+}
+
 // CmdQueryOrderBooks returns the QueryOrderBooks cobra command.
 func CmdQueryOrderBooks() *cobra.Command {
 	cmd := &cobra.Command{
@@ -276,6 +340,142 @@ $ %[1]s query %s order-book-orders denom1 denom2 buy
 	return cmd
 }
 
+// CmdQueryOrderBookDepth returns the QueryOrderBookDepth cobra command.
+func CmdQueryOrderBookDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book-depth [base_denom] [quote_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query order book depth",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query order book price levels aggregated by price, the best bid, the best ask and the spread.
+
+Example:
+$ %[1]s query %s order-book-depth denom1 denom2 --%s=10
+`,
+				version.AppName, types.ModuleName, DepthLimitFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			limit, err := cmd.Flags().GetUint32(DepthLimitFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			res, err := queryClient.OrderBookDepth(cmd.Context(), &types.QueryOrderBookDepthRequest{
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+				Limit:      limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(
+		DepthLimitFlag,
+		types.DefaultOrderBookDepthLimit,
+		fmt.Sprintf("Max number of price levels of each side, max %d", types.MaxOrderBookDepthLimit),
+	)
+
+	return cmd
+}
+
+// CmdQuerySimulateOrder returns the QuerySimulateOrder cobra command.
+func CmdQuerySimulateOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-order [type] [base_denom] [quote_denom] [quantity] [side] --price 123e-2 --time-in-force=TIME_IN_FORCE_IOC --creator [creator]", //nolint:lll // string example
+		Args:  cobra.ExactArgs(5),
+		Short: "Simulate order matching without placing the order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate order matching without placing the order.
+
+Example:
+$ %[1]s query %s simulate-order ORDER_TYPE_MARKET denom1 denom2 1000 SIDE_BUY --time-in-force=TIME_IN_FORCE_IOC
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			orderType, ok := types.OrderType_value[args[0]]
+			if !ok {
+				return errors.Errorf("unknown type '%s'", args[0])
+			}
+
+			quantity, ok := sdkmath.NewIntFromString(args[3])
+			if !ok {
+				return errors.New("quantity is ivalid or too big")
+			}
+
+			side, ok := types.Side_value[args[4]]
+			if !ok {
+				return errors.Errorf("unknown side '%s'", args[4])
+			}
+
+			priceStr, err := cmd.Flags().GetString(PriceFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			var price *types.Price
+			if priceStr != "" {
+				priceV, err := types.NewPriceFromString(priceStr)
+				if err != nil {
+					return errors.Wrap(err, "invalid price")
+				}
+				price = &priceV
+			}
+
+			timeInForceString, err := cmd.Flags().GetString(TimeInForce)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			timeInForce, ok := types.TimeInForce_value[timeInForceString]
+			if !ok {
+				return errors.Errorf("unknown time in force '%s'", timeInForceString)
+			}
+
+			creator, err := cmd.Flags().GetString(CreatorFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			res, err := queryClient.SimulateOrder(cmd.Context(), &types.QuerySimulateOrderRequest{
+				Creator:     creator,
+				Type:        types.OrderType(orderType),
+				BaseDenom:   args[1],
+				QuoteDenom:  args[2],
+				Price:       price,
+				Quantity:    quantity,
+				Side:        types.Side(side),
+				TimeInForce: types.TimeInForce(timeInForce),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(PriceFlag, "", "Order price.")
+	cmd.Flags().String(TimeInForce, types.TIME_IN_FORCE_UNSPECIFIED.String(), "Time in force.")
+	cmd.Flags().String(
+		CreatorFlag, "", "Order creator, the market order spendable balance isn't limited if it's not set.",
+	)
+
+	return cmd
+}
+
 // CmdQueryAccountDenomOrdersCount returns the QueryAccountDenomOrdersCount cobra command.
 func CmdQueryAccountDenomOrdersCount() *cobra.Command {
 	cmd := &cobra.Command{
@@ -311,3 +511,238 @@ $ %[1]s query %s account-denom-orders-count %s denom1
 
 	return cmd
 }
+
+// CmdQueryTWAP returns the QueryTWAP cobra command.
+func CmdQueryTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [base_denom] [quote_denom] [start_time]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query time-weighted average price of the order book",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query time-weighted average price of the order book from the start time (unix seconds)
+to the end time or the current block time.
+
+Example:
+$ %[1]s query %s twap denom1 denom2 1700000000 --%s=1700003600
+`,
+				version.AppName, types.ModuleName, EndTimeFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid start time")
+			}
+			endTimeNum, err := cmd.Flags().GetInt64(EndTimeFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			var endTime *time.Time
+			if endTimeNum != 0 {
+				endTime = lo.ToPtr(time.Unix(endTimeNum, 0))
+			}
+
+			res, err := queryClient.TWAP(cmd.Context(), &types.QueryTWAPRequest{
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+				StartTime:  time.Unix(startTime, 0),
+				EndTime:    endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(EndTimeFlag, 0, "TWAP window end time (unix seconds), the current block time is used if not set.")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDeadManSwitch returns the QueryDeadManSwitch cobra command.
+func CmdQueryDeadManSwitch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dead-man-switch [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query dead man's switch of the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query dead man's switch of the account.
+
+Example:
+$ %[1]s query %s dead-man-switch %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeadManSwitch(cmd.Context(), &types.QueryDeadManSwitchRequest{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryAccountTradingVolume returns the QueryAccountTradingVolume cobra command.
+func CmdQueryAccountTradingVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-trading-volume [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query trading volume and volume tier of the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query trading volume of the account within the trading volume window and its volume tier.
+
+Example:
+$ %[1]s query %s account-trading-volume %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountTradingVolume(cmd.Context(), &types.QueryAccountTradingVolumeRequest{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRewardPrograms returns the QueryRewardPrograms cobra command.
+func CmdQueryRewardPrograms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-programs",
+		Args:  cobra.NoArgs,
+		Short: "Query reward programs of the order books",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query reward programs of the order books.
+
+Example:
+$ %[1]s query %s reward-programs
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardPrograms(cmd.Context(), &types.QueryRewardProgramsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reward-programs")
+
+	return cmd
+}
+
+// CmdQueryAccountRewards returns the QueryAccountRewards cobra command.
+func CmdQueryAccountRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-rewards [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query rewards accrued by the account in the reward programs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query points and rewards accrued by the account in the reward programs of the order books.
+
+Example:
+$ %[1]s query %s account-rewards %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountRewards(cmd.Context(), &types.QueryAccountRewardsRequest{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryOrderQuota returns the QueryOrderQuota cobra command.
+func CmdQueryOrderQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-quota [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query order quota of the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query max orders per denom of the account, the additional orders per denom with the reserve locked for
+them, and the highest number of the account orders per denom.
+
+Example:
+$ %[1]s query %s order-quota %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OrderQuota(cmd.Context(), &types.QueryOrderQuotaRequest{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
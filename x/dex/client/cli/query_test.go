@@ -24,7 +24,12 @@ func TestQueryParams(t *testing.T) {
 
 	var resp types.QueryParamsResponse
 	coreumclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryParams(), []string{}, &resp)
-	requireT.Equal(types.DefaultParams(), resp.Params)
+	expectedParams := types.DefaultParams()
+	// the empty list is decoded from JSON as the empty slice
+	expectedParams.OrderBookFeeRates = []types.OrderBookFeeRates{}
+	expectedParams.VolumeTiers = []types.VolumeTier{}
+	expectedParams.SwapRouteDenoms = []string{}
+	requireT.Equal(expectedParams, resp.Params)
 }
 
 func TestQueryOrderBookParams(t *testing.T) {
@@ -128,6 +133,54 @@ func TestCmdQueryOrderBooksAndOrders(t *testing.T) {
 	requireT.ElementsMatch([]types.Order{
 		order1,
 	}, orderBookOrdersRes.Orders)
+
+	// check order book depth
+	var orderBookDepthRes types.QueryOrderBookDepthResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryOrderBookDepth(), []string{denom1, denom2, "--limit=5"}, &orderBookDepthRes,
+	)
+	requireT.Equal([]types.PriceLevel{
+		{
+			Price:                 *order1.Price,
+			RemainingBaseQuantity: order1.Quantity,
+			OrdersCount:           1,
+		},
+	}, orderBookDepthRes.Asks)
+	requireT.Empty(orderBookDepthRes.Bids)
+	requireT.Equal(order1.Price.String(), orderBookDepthRes.BestAsk.String())
+	requireT.Nil(orderBookDepthRes.Spread)
+
+	// check order simulation
+	var simulateOrderRes types.QuerySimulateOrderResponse
+	coreumclitestutil.ExecQueryCmd(
+		t,
+		ctx,
+		cli.CmdQuerySimulateOrder(),
+		[]string{
+			types.ORDER_TYPE_MARKET.String(), denom1, denom2, order1.Quantity.String(), types.SIDE_BUY.String(),
+			"--time-in-force=" + types.TIME_IN_FORCE_IOC.String(),
+		},
+		&simulateOrderRes,
+	)
+	requireT.True(simulateOrderRes.Filled)
+	requireT.Equal(order1.Quantity.String(), simulateOrderRes.ExecutedBaseQuantity.String())
+	requireT.Equal(order1.Price.String(), simulateOrderRes.AveragePrice.String())
+}
+
+func TestCmdQueryAccountTradingVolume(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	var resp types.QueryAccountTradingVolumeResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryAccountTradingVolume(), []string{validator1Address(testNetwork).String()}, &resp,
+	)
+	requireT.True(resp.Volume.IsZero())
+	requireT.Zero(resp.Tier)
+	requireT.Equal(types.DefaultParams().MaxOrdersPerDenom, resp.MaxOrdersPerDenom)
+	requireT.True(resp.FeeDiscount.IsZero())
 }
 
 func TestCmdQueryAccountDenomOrdersCount(t *testing.T) {
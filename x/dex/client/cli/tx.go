@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
@@ -27,8 +30,42 @@ const (
 	GoodTilBlockHeightFlag = "good-til-block-height"
 	// GoodTilBlockTimeFlag is good til block time flag.
 	GoodTilBlockTimeFlag = "good-til-block-time"
+	// GoodTilBlocksFlag is good til blocks flag.
+	GoodTilBlocksFlag = "good-til-blocks"
+	// GoodTilDurationFlag is good til duration flag.
+	GoodTilDurationFlag = "good-til-duration"
 	// TimeInForce is time-in-force flag.
 	TimeInForce = "time-in-force"
+	// QuantityFlag is quantity flag.
+	QuantityFlag = "quantity"
+	// TriggerPriceFlag is trigger price flag.
+	TriggerPriceFlag = "trigger-price"
+	// TriggerConditionFlag is trigger condition flag.
+	TriggerConditionFlag = "trigger-condition"
+	// BatchModeFlag is batch mode flag.
+	BatchModeFlag = "mode"
+	// DisplayQuantityFlag is display quantity flag.
+	DisplayQuantityFlag = "display-quantity"
+	// AllOrNoneFlag is all-or-none flag.
+	AllOrNoneFlag = "all-or-none"
+	// PriceTickFlag is price tick flag.
+	PriceTickFlag = "price-tick"
+	// QuantityStepFlag is quantity step flag.
+	QuantityStepFlag = "quantity-step"
+	// MinQuantityFlag is min quantity flag.
+	MinQuantityFlag = "min-quantity"
+	// BaseDenomFlag is base denom flag.
+	BaseDenomFlag = "base-denom"
+	// QuoteDenomFlag is quote denom flag.
+	QuoteDenomFlag = "quote-denom"
+	// SideFlag is side flag.
+	SideFlag = "side"
+	// MinPriceFlag is min price flag.
+	MinPriceFlag = "min-price"
+	// MaxPriceFlag is max price flag.
+	MaxPriceFlag = "max-price"
+	// RouteFlag is swap route flag.
+	RouteFlag = "route"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -43,8 +80,22 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdPlaceOrder(),
+		CmdReplaceOrder(),
 		CmdCancelOrder(),
 		CmdCancelOrdersByDenom(),
+		CmdBatchPlaceOrders(),
+		CmdBatchCancelOrders(),
+		CmdCancelAllOrders(),
+		CmdSetDeadManSwitch(),
+		CmdHeartbeat(),
+		CmdCreateOrderBook(),
+		CmdUpdateOrderBook(),
+		CmdUpdateOrderBookStatus(),
+		CmdSwapExactIn(),
+		CmdSwapExactOut(),
+		CmdFundRewardProgram(),
+		CmdClaimRewards(),
+		CmdSetOrderQuota(),
 	)
 
 	return cmd
@@ -61,7 +112,7 @@ func CmdPlaceOrder() *cobra.Command {
 	availableSides := lo.Values(types.Side_name)
 	sort.Strings(availableTimeInForces)
 	cmd := &cobra.Command{
-		Use:   "place-order [type (" + strings.Join(availableOrderTypes, ",") + ")] [id] [base_denom] [quote_denom] [quantity] [side (" + strings.Join(availableSides, ",") + ")] --price 123e-2 --time-in-force=" + strings.Join(availableTimeInForces, ",") + " --good-til-block-height=123 --good-til-block-time=1727124446 --from [sender]", //nolint:lll // string example
+		Use:   "place-order [type (" + strings.Join(availableOrderTypes, ",") + ")] [id] [base_denom] [quote_denom] [quantity] [side (" + strings.Join(availableSides, ",") + ")] --price 123e-2 --time-in-force=" + strings.Join(availableTimeInForces, ",") + " --good-til-block-height=123 --good-til-block-time=1727124446 --good-til-blocks=10 --good-til-duration=1h --trigger-price 11e-1 --trigger-condition=TRIGGER_CONDITION_PRICE_LTE --from [sender]", //nolint:lll // string example
 		Args:  cobra.ExactArgs(6),
 		Short: "Place new order",
 		Long: strings.TrimSpace(
@@ -126,6 +177,20 @@ $ %s tx %s place-order ORDER_TYPE_LIMIT "my-order-id1" denom1 denom2 1000 SIDE_S
 				goodTilBlockTime = lo.ToPtr(time.Unix(goodTilBlockTimeNum, 0))
 			}
 
+			goodTilBlocks, err := cmd.Flags().GetUint64(GoodTilBlocksFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			goodTilDurationValue, err := cmd.Flags().GetDuration(GoodTilDurationFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			var goodTilDuration *time.Duration
+			if goodTilDurationValue != 0 {
+				goodTilDuration = &goodTilDurationValue
+			}
+
 			timeInForceString, err := cmd.Flags().GetString(TimeInForce)
 			timeInForceInt, ok := types.TimeInForce_value[timeInForceString]
 			if !ok {
@@ -140,22 +205,50 @@ $ %s tx %s place-order ORDER_TYPE_LIMIT "my-order-id1" denom1 denom2 1000 SIDE_S
 			}
 			timeInForce := types.TimeInForce(timeInForceInt)
 
+			trigger, err := readTrigger(cmd)
+			if err != nil {
+				return err
+			}
+
+			displayQuantityStr, err := cmd.Flags().GetString(DisplayQuantityFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			var displayQuantity *sdkmath.Int
+			if displayQuantityStr != "" {
+				displayQuantityV, ok := sdkmath.NewIntFromString(displayQuantityStr)
+				if !ok {
+					return sdkerrors.Wrapf(types.ErrInvalidInput, "display quantity is invalid or too big")
+				}
+				displayQuantity = &displayQuantityV
+			}
+
+			allOrNone, err := cmd.Flags().GetBool(AllOrNoneFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
 			msg := &types.MsgPlaceOrder{
-				Sender:      sender.String(),
-				Type:        types.OrderType(orderType),
-				ID:          id,
-				BaseDenom:   baseDenom,
-				QuoteDenom:  quoteDenom,
-				Price:       price,
-				Quantity:    quantity,
-				Side:        types.Side(side),
-				TimeInForce: timeInForce,
-			}
-
-			if goodTilBlockHeight != 0 || goodTilBlockTime != nil {
+				Sender:          sender.String(),
+				Type:            types.OrderType(orderType),
+				ID:              id,
+				BaseDenom:       baseDenom,
+				QuoteDenom:      quoteDenom,
+				Price:           price,
+				Quantity:        quantity,
+				Side:            types.Side(side),
+				TimeInForce:     timeInForce,
+				Trigger:         trigger,
+				DisplayQuantity: displayQuantity,
+				AllOrNone:       allOrNone,
+			}
+
+			if goodTilBlockHeight != 0 || goodTilBlockTime != nil || goodTilBlocks != 0 || goodTilDuration != nil {
 				msg.GoodTil = &types.GoodTil{
 					GoodTilBlockHeight: goodTilBlockHeight,
 					GoodTilBlockTime:   goodTilBlockTime,
+					GoodTilBlocks:      goodTilBlocks,
+					GoodTilDuration:    goodTilDuration,
 				}
 			}
 
@@ -166,7 +259,113 @@ $ %s tx %s place-order ORDER_TYPE_LIMIT "my-order-id1" denom1 denom2 1000 SIDE_S
 	cmd.Flags().String(PriceFlag, "", "Order price.")
 	cmd.Flags().Uint64(GoodTilBlockHeightFlag, 0, "Good til block height.")
 	cmd.Flags().Int64(GoodTilBlockTimeFlag, 0, "Good til block time.")
+	cmd.Flags().Uint64(GoodTilBlocksFlag, 0, "Number of blocks the order remains active for after the placement.")
+	cmd.Flags().Duration(GoodTilDurationFlag, 0, "Duration the order remains active for after the placement.")
 	cmd.Flags().String(TimeInForce, types.TIME_IN_FORCE_UNSPECIFIED.String(), "Time in force.")
+	cmd.Flags().String(TriggerPriceFlag, "", "Price activating the trigger order.")
+	cmd.Flags().String(
+		TriggerConditionFlag, types.TRIGGER_CONDITION_UNSPECIFIED.String(), "Condition activating the trigger order.",
+	)
+	cmd.Flags().String(DisplayQuantityFlag, "", "Visible quantity of the iceberg order.")
+	cmd.Flags().Bool(AllOrNoneFlag, false, "Match the resting order only when it can be filled entirely.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readTrigger(cmd *cobra.Command) (*types.Trigger, error) {
+	triggerPriceStr, err := cmd.Flags().GetString(TriggerPriceFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if triggerPriceStr == "" {
+		return nil, nil //nolint:nilnil // nil trigger means a regular order
+	}
+	triggerPrice, err := types.NewPriceFromString(triggerPriceStr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid trigger price")
+	}
+
+	triggerConditionStr, err := cmd.Flags().GetString(TriggerConditionFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	triggerCondition, ok := types.TriggerCondition_value[triggerConditionStr]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "unknown trigger condition '%s'", triggerConditionStr)
+	}
+
+	return &types.Trigger{
+		Price:     triggerPrice,
+		Condition: types.TriggerCondition(triggerCondition),
+	}, nil
+}
+
+// CmdReplaceOrder returns ReplaceOrder cobra command.
+func CmdReplaceOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-order [id] --price 123e-2 --quantity 1000 --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace order price and/or quantity",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace order price and/or remaining quantity.
+The order keeps its time priority if only its quantity is reduced.
+
+Example:
+$ %s tx %s replace-order id1 --price 12e-1 --quantity 1000 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			id := args[0]
+
+			priceStr, err := cmd.Flags().GetString(PriceFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			var price *types.Price
+			if priceStr != "" {
+				priceV, err := types.NewPriceFromString(priceStr)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid price")
+				}
+				price = &priceV
+			}
+
+			quantityStr, err := cmd.Flags().GetString(QuantityFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			quantity := sdkmath.ZeroInt()
+			if quantityStr != "" {
+				var ok bool
+				quantity, ok = sdkmath.NewIntFromString(quantityStr)
+				if !ok {
+					return sdkerrors.Wrapf(types.ErrInvalidInput, "quantity is ivalid or too big")
+				}
+			}
+
+			msg := &types.MsgReplaceOrder{
+				Sender:   sender.String(),
+				ID:       id,
+				Price:    price,
+				Quantity: quantity,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(PriceFlag, "", "New order price.")
+	cmd.Flags().String(QuantityFlag, "", "New order remaining quantity.")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -250,3 +449,736 @@ $ %s tx %s cancel-orders-by-denom %s denom1 --from [sender]
 
 	return cmd
 }
+
+// CmdBatchPlaceOrders returns BatchPlaceOrders cobra command.
+func CmdBatchPlaceOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-place-orders [orders-file] --mode=" + types.BATCH_MODE_ALL_OR_NOTHING.String() + " --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Place multiple orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place multiple orders. The orders file is a JSON file in the format:
+{"orders":[{"type":"ORDER_TYPE_LIMIT","id":"my-order-id1","base_denom":"denom1","quote_denom":"denom2","price":"12e-1","quantity":"1000","side":"SIDE_SELL","time_in_force":"TIME_IN_FORCE_GTC"}]}
+
+Example:
+$ %s tx %s batch-place-orders orders.json --mode=%s --from [sender]`, //nolint:lll // string example
+				version.AppName, types.ModuleName, types.BATCH_MODE_BEST_EFFORT.String(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return errors.Wrapf(err, "failed to read orders file %q", args[0])
+			}
+
+			msg := &types.MsgBatchPlaceOrders{}
+			if err := clientCtx.Codec.UnmarshalJSON(contents, msg); err != nil {
+				return errors.Wrapf(err, "failed to unmarshal orders file %q", args[0])
+			}
+
+			mode, err := readBatchMode(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg.Sender = sender.String()
+			msg.Mode = mode
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addBatchModeFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdBatchCancelOrders returns BatchCancelOrders cobra command.
+func CmdBatchCancelOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-cancel-orders [id1,id2,...] --mode=" + types.BATCH_MODE_ALL_OR_NOTHING.String() + " --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel multiple orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel multiple orders.
+
+Example:
+$ %s tx %s batch-cancel-orders id1,id2 --mode=%s --from [sender]
+`,
+				version.AppName, types.ModuleName, types.BATCH_MODE_BEST_EFFORT.String(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+
+			mode, err := readBatchMode(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchCancelOrders{
+				Sender: sender.String(),
+				IDs:    strings.Split(args[0], ","),
+				Mode:   mode,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addBatchModeFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addBatchModeFlag(cmd *cobra.Command) {
+	cmd.Flags().String(
+		BatchModeFlag,
+		types.BATCH_MODE_ALL_OR_NOTHING.String(),
+		"Batch mode, "+types.BATCH_MODE_ALL_OR_NOTHING.String()+" or "+types.BATCH_MODE_BEST_EFFORT.String()+".",
+	)
+}
+
+func readBatchMode(cmd *cobra.Command) (types.BatchMode, error) {
+	modeStr, err := cmd.Flags().GetString(BatchModeFlag)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	mode, ok := types.BatchMode_value[modeStr]
+	if !ok {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidInput, "unknown batch mode '%s'", modeStr)
+	}
+
+	return types.BatchMode(mode), nil
+}
+
+// CmdCancelAllOrders returns CancelAllOrders cobra command.
+func CmdCancelAllOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all-orders [limit] --base-denom denom1 --quote-denom denom2 --side SIDE_BUY --min-price 1e-1 --max-price 2e-1 --from [sender]", //nolint:lll // string example
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel all orders matching the filters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel up to the limit orders of the sender matching the optional filters.
+The price range filter requires the base and quote denoms.
+
+Example:
+$ %s tx %s cancel-all-orders 100 --base-denom denom1 --quote-denom denom2 --side SIDE_BUY --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+
+			limit, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid limit '%s'", args[0])
+			}
+
+			baseDenom, err := cmd.Flags().GetString(BaseDenomFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			quoteDenom, err := cmd.Flags().GetString(QuoteDenomFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sideStr, err := cmd.Flags().GetString(SideFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			side, ok := types.Side_value[sideStr]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown side '%s'", sideStr)
+			}
+
+			minPrice, err := readOptionalPrice(cmd, MinPriceFlag)
+			if err != nil {
+				return err
+			}
+			maxPrice, err := readOptionalPrice(cmd, MaxPriceFlag)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelAllOrders{
+				Sender:     sender.String(),
+				BaseDenom:  baseDenom,
+				QuoteDenom: quoteDenom,
+				Side:       types.Side(side),
+				MinPrice:   minPrice,
+				MaxPrice:   maxPrice,
+				Limit:      uint32(limit),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(BaseDenomFlag, "", "Base denom of the order book to cancel the orders of.")
+	cmd.Flags().String(QuoteDenomFlag, "", "Quote denom of the order book to cancel the orders of.")
+	cmd.Flags().String(
+		SideFlag, types.SIDE_UNSPECIFIED.String(), "Side of the orders to cancel, both sides if unspecified.",
+	)
+	cmd.Flags().String(MinPriceFlag, "", "Min price (inclusive) of the orders to cancel.")
+	cmd.Flags().String(MaxPriceFlag, "", "Max price (inclusive) of the orders to cancel.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSetDeadManSwitch returns SetDeadManSwitch cobra command.
+func CmdSetDeadManSwitch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dead-man-switch [timeout_blocks] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Arm, update or disarm the dead man's switch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Arm or update the dead man's switch canceling all orders of the sender if the switch isn't
+refreshed within the timeout blocks. The switch is refreshed by the heartbeat and any order action of the sender.
+The zero timeout disarms the switch.
+
+Example:
+$ %s tx %s set-dead-man-switch 100 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			timeoutBlocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid timeout blocks '%s'", args[0])
+			}
+
+			msg := &types.MsgSetDeadManSwitch{
+				Sender:        clientCtx.GetFromAddress().String(),
+				TimeoutBlocks: timeoutBlocks,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdHeartbeat returns Heartbeat cobra command.
+func CmdHeartbeat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "heartbeat --from [sender]",
+		Args:  cobra.NoArgs,
+		Short: "Refresh the dead man's switch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Refresh the dead man's switch of the sender.
+
+Example:
+$ %s tx %s heartbeat --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgHeartbeat{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readOptionalPrice(cmd *cobra.Command, flag string) (*types.Price, error) {
+	priceStr, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if priceStr == "" {
+		return nil, nil //nolint:nilnil // nil price means no price filter
+	}
+	price, err := types.NewPriceFromString(priceStr)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "invalid %s", flag)
+	}
+
+	return &price, nil
+}
+
+// CmdCreateOrderBook returns CreateOrderBook cobra command.
+func CmdCreateOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll // breaking this down will make it look worse when printed to user screen.
+		Use:   "create-order-book [base_denom] [quote_denom] --price-tick 1e-3 --quantity-step 1000 --min-quantity 10000 --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Register the order book pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register the order book pair, optionally overriding the price tick, quantity step and min quantity
+of the order book.
+Only the governance or the base denom admin is able to register the order book.
+
+Example:
+$ %s tx %s create-order-book denom1 denom2 --price-tick 1e-3 --quantity-step 1000 --min-quantity 10000 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			priceTick, quantityStep, minQuantity, err := readOrderBookOverrides(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateOrderBook{
+				Sender:       clientCtx.GetFromAddress().String(),
+				BaseDenom:    args[0],
+				QuoteDenom:   args[1],
+				PriceTick:    priceTick,
+				QuantityStep: quantityStep,
+				MinQuantity:  minQuantity,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addOrderBookOverridesFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdUpdateOrderBook returns UpdateOrderBook cobra command.
+func CmdUpdateOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll // breaking this down will make it look worse when printed to user screen.
+		Use:   "update-order-book [base_denom] [quote_denom] --price-tick 1e-3 --quantity-step 1000 --min-quantity 10000 --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Update the price tick, quantity step and min quantity of the order book",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the price tick, quantity step and min quantity of the order book.
+The not provided values are computed from the module parameters and unified ref amounts.
+
+Example:
+$ %s tx %s update-order-book denom1 denom2 --price-tick 1e-3 --quantity-step 1000 --min-quantity 10000 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			priceTick, quantityStep, minQuantity, err := readOrderBookOverrides(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateOrderBook{
+				Sender:       clientCtx.GetFromAddress().String(),
+				BaseDenom:    args[0],
+				QuoteDenom:   args[1],
+				PriceTick:    priceTick,
+				QuantityStep: quantityStep,
+				MinQuantity:  minQuantity,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addOrderBookOverridesFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdUpdateOrderBookStatus returns UpdateOrderBookStatus cobra command.
+func CmdUpdateOrderBookStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-order-book-status [base_denom] [quote_denom] [status] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Pause, resume or delist the order book pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause, resume or delist the order book pair.
+The orders of the delisted order book pair are canceled.
+
+Example:
+$ %s tx %s update-order-book-status denom1 denom2 %s --from [sender]
+`,
+				version.AppName, types.ModuleName, types.ORDER_BOOK_STATUS_PAUSED.String(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			status, ok := types.OrderBookStatus_value[args[2]]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown order book status '%s'", args[2])
+			}
+
+			msg := &types.MsgUpdateOrderBookStatus{
+				Sender:     clientCtx.GetFromAddress().String(),
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+				Status:     types.OrderBookStatus(status),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addOrderBookOverridesFlags(cmd *cobra.Command) {
+	cmd.Flags().String(PriceTickFlag, "", "Price tick of the order book.")
+	cmd.Flags().String(QuantityStepFlag, "", "Quantity step of the order book.")
+	cmd.Flags().String(MinQuantityFlag, "", "Min quantity of the order placed to the order book.")
+}
+
+func readOrderBookOverrides(cmd *cobra.Command) (*types.Price, *sdkmath.Int, *sdkmath.Int, error) {
+	priceTickStr, err := cmd.Flags().GetString(PriceTickFlag)
+	if err != nil {
+		return nil, nil, nil, errors.WithStack(err)
+	}
+	var priceTick *types.Price
+	if priceTickStr != "" {
+		priceTickV, err := types.NewPriceFromString(priceTickStr)
+		if err != nil {
+			return nil, nil, nil, sdkerrors.Wrap(err, "invalid price tick")
+		}
+		priceTick = &priceTickV
+	}
+
+	quantityStep, err := readOptionalIntFlag(cmd, QuantityStepFlag)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	minQuantity, err := readOptionalIntFlag(cmd, MinQuantityFlag)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return priceTick, quantityStep, minQuantity, nil
+}
+
+func readOptionalIntFlag(cmd *cobra.Command, flag string) (*sdkmath.Int, error) {
+	valueStr, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if valueStr == "" {
+		return nil, nil //nolint:nilnil // nil value means the value isn't set
+	}
+	value, ok := sdkmath.NewIntFromString(valueStr)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "%s is invalid or too big", flag)
+	}
+
+	return &value, nil
+}
+
+// CmdSwapExactIn returns SwapExactIn cobra command.
+func CmdSwapExactIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-in [coin_in] [denom_out] [min_amount_out] --route [denoms] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Swap the exact input coin to the output denom through the order books",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap the exact input coin to the output denom by the market orders executed through the order
+books of the route. The route starts with the input denom and ends with the output denom, if the route isn't set it's
+computed automatically.
+
+Example:
+$ %s tx %s swap-exact-in 1000000denom1 denom3 900000 --route denom1,denom2,denom3 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			coinIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid coin in '%s'", args[0])
+			}
+			minAmountOut, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid min amount out '%s'", args[2])
+			}
+			route, err := cmd.Flags().GetStringSlice(RouteFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgSwapExactIn{
+				Sender:       clientCtx.GetFromAddress().String(),
+				CoinIn:       coinIn,
+				DenomOut:     args[1],
+				Route:        route,
+				MinAmountOut: minAmountOut,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addRouteFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSwapExactOut returns SwapExactOut cobra command.
+func CmdSwapExactOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-out [denom_in] [coin_out] [max_amount_in] --route [denoms] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Swap the input denom to the exact output coin through the order books",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap the input denom to the exact output coin by the market orders executed through the order
+books of the route. The route starts with the input denom and ends with the output denom, if the route isn't set it's
+computed automatically.
+
+Example:
+$ %s tx %s swap-exact-out denom1 1000000denom3 1100000 --route denom1,denom2,denom3 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			coinOut, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid coin out '%s'", args[1])
+			}
+			maxAmountIn, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid max amount in '%s'", args[2])
+			}
+			route, err := cmd.Flags().GetStringSlice(RouteFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgSwapExactOut{
+				Sender:      clientCtx.GetFromAddress().String(),
+				DenomIn:     args[0],
+				CoinOut:     coinOut,
+				Route:       route,
+				MaxAmountIn: maxAmountIn,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addRouteFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addRouteFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(
+		RouteFlag,
+		nil,
+		"Comma separated denoms of the swap route starting with the input denom and ending with the output denom",
+	)
+}
+
+// CmdFundRewardProgram returns FundRewardProgram cobra command.
+func CmdFundRewardProgram() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll // breaking this down will make it look worse when printed to user screen.
+		Use:   "fund-reward-program [base_denom] [quote_denom] [amount] [reward_per_epoch] [max_ticks] --min-quantity 10000 --from [sender]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Fund the reward program of the order book",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Fund the reward program of the order book and set its settings.
+Each reward epoch the reward per epoch is distributed between the makers of the orders placed within the max ticks
+from the mid price and having the remaining quantity not less than the min quantity.
+Only the governance or the base denom admin is able to fund the reward program.
+
+Example:
+$ %s tx %s fund-reward-program denom1 denom2 1000000denom3 1000 10 --min-quantity 10000 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid amount '%s'", args[2])
+			}
+			rewardPerEpoch, ok := sdkmath.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid reward per epoch '%s'", args[3])
+			}
+			maxTicks, err := strconv.ParseUint(args[4], 10, 32)
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid max ticks '%s'", args[4])
+			}
+			minQuantity, err := readOptionalIntFlag(cmd, MinQuantityFlag)
+			if err != nil {
+				return err
+			}
+			if minQuantity == nil {
+				minQuantity = lo.ToPtr(sdkmath.ZeroInt())
+			}
+
+			msg := &types.MsgFundRewardProgram{
+				Sender:         clientCtx.GetFromAddress().String(),
+				BaseDenom:      args[0],
+				QuoteDenom:     args[1],
+				Amount:         amount,
+				RewardPerEpoch: rewardPerEpoch,
+				MaxTicks:       uint32(maxTicks),
+				MinQuantity:    *minQuantity,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(MinQuantityFlag, "", "Min remaining quantity of the order qualifying for the rewards.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdClaimRewards returns ClaimRewards cobra command.
+func CmdClaimRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards --from [sender]",
+		Args:  cobra.NoArgs,
+		Short: "Claim the rewards accrued in the reward programs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the rewards accrued by the sender in the reward programs of the order books.
+
+Example:
+$ %s tx %s claim-rewards --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgClaimRewards{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSetOrderQuota returns SetOrderQuota cobra command.
+func CmdSetOrderQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-order-quota [extra_orders_per_denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the additional number of orders per denom the sender can have",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the additional number of orders per denom the sender can have. The reserve for the additional
+orders is locked, and it is released when the number is lowered, zero releases the whole reserve.
+
+Example:
+$ %s tx %s set-order-quota 100 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			extraOrdersPerDenom, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid extra orders per denom: %s", args[0])
+			}
+
+			msg := &types.MsgSetOrderQuota{
+				Sender:              clientCtx.GetFromAddress().String(),
+				ExtraOrdersPerDenom: extraOrdersPerDenom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
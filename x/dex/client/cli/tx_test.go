@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -115,6 +117,41 @@ func TestCmdPlaceOrderWithGoodTilBlockHeightAndGoodTilBlockTime(t *testing.T) {
 	})
 }
 
+func TestCmdPlaceOrderWithGoodTilBlocksAndGoodTilDuration(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	placeOrder(ctx, requireT, testNetwork, types.Order{
+		ID:          "id1",
+		Type:        types.ORDER_TYPE_LIMIT,
+		BaseDenom:   denom1,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("123e-2")),
+		Quantity:    defaultQuantity,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		GoodTil: &types.GoodTil{
+			GoodTilBlocks:   100,
+			GoodTilDuration: lo.ToPtr(time.Hour),
+		},
+	})
+
+	// the relative good til settings are converted to the absolute ones
+	var orderRes types.QueryOrderResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryOrder(), []string{validator1Address(testNetwork).String(), "id1"}, &orderRes,
+	)
+	requireT.NotNil(orderRes.Order.GoodTil)
+	requireT.Positive(orderRes.Order.GoodTil.GoodTilBlockHeight)
+	requireT.NotNil(orderRes.Order.GoodTil.GoodTilBlockTime)
+	requireT.Zero(orderRes.Order.GoodTil.GoodTilBlocks)
+	requireT.Nil(orderRes.Order.GoodTil.GoodTilDuration)
+}
+
 func TestCmdPlaceOrderWithTimeInForceGTC(t *testing.T) {
 	requireT := require.New(t)
 	testNetwork := network.New(t)
@@ -175,6 +212,45 @@ func TestCmdPlaceOrderWithTimeInForceFOK(t *testing.T) {
 	})
 }
 
+func TestCmdPlaceTriggerOrder(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	order := types.Order{
+		Creator:     validator1Address(testNetwork).String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   denom1,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("123e-2")),
+		Quantity:    defaultQuantity,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		Trigger: &types.Trigger{
+			Price:     types.MustNewPriceFromString("125e-2"),
+			Condition: types.TRIGGER_CONDITION_PRICE_LTE,
+		},
+	}
+	placeOrder(ctx, requireT, testNetwork, order)
+
+	var triggerOrdersRes types.QueryTriggerOrdersResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryTriggerOrders(), []string{order.Creator}, &triggerOrdersRes,
+	)
+	requireT.Len(triggerOrdersRes.Orders, 1)
+	requireT.Equal(order.ID, triggerOrdersRes.Orders[0].ID)
+	requireT.Equal(order.Trigger, triggerOrdersRes.Orders[0].Trigger)
+
+	// the trigger order isn't in the order book
+	var ordersRes types.QueryOrdersResponse
+	coreumclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryOrders(), []string{order.Creator}, &ordersRes)
+	requireT.Empty(ordersRes.Orders)
+}
+
 func TestCmdCancelOrder(t *testing.T) {
 	requireT := require.New(t)
 	testNetwork := network.New(t)
@@ -209,6 +285,49 @@ func TestCmdCancelOrder(t *testing.T) {
 	requireT.NoError(err)
 }
 
+func TestCmdReplaceOrder(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	order := types.Order{
+		ID:          "id1",
+		Type:        types.ORDER_TYPE_LIMIT,
+		BaseDenom:   denom1,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("123e-2")),
+		Quantity:    defaultQuantity,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+
+	placeOrder(ctx, requireT, testNetwork, order)
+
+	args := append(
+		[]string{
+			order.ID,
+			"--" + cli.PriceFlag, "124e-2",
+			"--" + cli.QuantityFlag, defaultQuantity.QuoRaw(2).String(),
+		}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdReplaceOrder(),
+		args,
+	)
+	requireT.NoError(err)
+
+	var orderRes types.QueryOrderResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryOrder(), []string{validator1Address(testNetwork).String(), order.ID}, &orderRes,
+	)
+	requireT.Equal("124e-2", orderRes.Order.Price.String())
+	requireT.Equal(defaultQuantity.QuoRaw(2).String(), orderRes.Order.RemainingBaseQuantity.String())
+}
+
 func TestCmdCancelOrdersByDenom(t *testing.T) {
 	requireT := require.New(t)
 	testNetwork := network.New(t)
@@ -251,6 +370,279 @@ func TestCmdCancelOrdersByDenom(t *testing.T) {
 	requireT.Zero(ordersRes.Count)
 }
 
+func TestCmdBatchPlaceAndCancelOrders(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	ordersFile := filepath.Join(t.TempDir(), "orders.json")
+	requireT.NoError(os.WriteFile(ordersFile, []byte(fmt.Sprintf(`{"orders":[
+{"type":"ORDER_TYPE_LIMIT","id":"id1","base_denom":"%[1]s","quote_denom":"%[2]s","price":"123e-2","quantity":"%[3]s","side":"SIDE_SELL","time_in_force":"TIME_IN_FORCE_GTC"},
+{"type":"ORDER_TYPE_LIMIT","id":"id2","base_denom":"%[1]s","quote_denom":"%[2]s","price":"124e-2","quantity":"%[3]s","side":"SIDE_SELL","time_in_force":"TIME_IN_FORCE_GTC"}
+]}`, denom1, denom2, defaultQuantity.QuoRaw(2))), 0o600)) //nolint:lll // json example
+
+	args := append(
+		[]string{
+			ordersFile,
+			"--" + cli.BatchModeFlag, types.BATCH_MODE_ALL_OR_NOTHING.String(),
+			fmt.Sprintf("--%s=%d", flags.FlagGas, 1_000_000),
+		}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdBatchPlaceOrders(),
+		args,
+	)
+	requireT.NoError(err)
+
+	var ordersRes types.QueryAccountDenomOrdersCountResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryAccountDenomOrdersCount(), []string{validator1Address(testNetwork).String(), denom1}, &ordersRes,
+	)
+	requireT.Equal(uint64(2), ordersRes.Count)
+
+	args = append(
+		[]string{
+			"id1,id2,id3",
+			"--" + cli.BatchModeFlag, types.BATCH_MODE_BEST_EFFORT.String(),
+		}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdBatchCancelOrders(),
+		args,
+	)
+	requireT.NoError(err)
+
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryAccountDenomOrdersCount(), []string{validator1Address(testNetwork).String(), denom1}, &ordersRes,
+	)
+	requireT.Zero(ordersRes.Count)
+}
+
+func TestCmdCancelAllOrders(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	order1 := types.Order{
+		ID:          "id1",
+		Type:        types.ORDER_TYPE_LIMIT,
+		BaseDenom:   denom1,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("123e-2")),
+		Quantity:    defaultQuantity.QuoRaw(2),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	order2 := order1
+	order2.ID = "id2"
+	order2.Price = lo.ToPtr(types.MustNewPriceFromString("124e-2"))
+
+	placeOrder(ctx, requireT, testNetwork, order1)
+	placeOrder(ctx, requireT, testNetwork, order2)
+
+	// cancel the orders with the price up to the first order price
+	args := append(
+		[]string{
+			"10",
+			"--" + cli.BaseDenomFlag, denom1,
+			"--" + cli.QuoteDenomFlag, denom2,
+			"--" + cli.SideFlag, types.SIDE_SELL.String(),
+			"--" + cli.MaxPriceFlag, order1.Price.String(),
+			fmt.Sprintf("--%s=%d", flags.FlagGas, 1_000_000),
+		}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdCancelAllOrders(),
+		args,
+	)
+	requireT.NoError(err)
+
+	var ordersRes types.QueryAccountDenomOrdersCountResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryAccountDenomOrdersCount(), []string{validator1Address(testNetwork).String(), denom1}, &ordersRes,
+	)
+	requireT.Equal(uint64(1), ordersRes.Count)
+
+	// cancel the remaining orders without filters
+	args = append(
+		[]string{
+			"10",
+			fmt.Sprintf("--%s=%d", flags.FlagGas, 1_000_000),
+		}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdCancelAllOrders(),
+		args,
+	)
+	requireT.NoError(err)
+
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryAccountDenomOrdersCount(), []string{validator1Address(testNetwork).String(), denom1}, &ordersRes,
+	)
+	requireT.Zero(ordersRes.Count)
+}
+
+func TestCmdSetDeadManSwitchAndHeartbeat(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	args := append([]string{"1000"}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdSetDeadManSwitch(),
+		args,
+	)
+	requireT.NoError(err)
+
+	var deadManSwitchRes types.QueryDeadManSwitchResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryDeadManSwitch(), []string{validator1Address(testNetwork).String()}, &deadManSwitchRes,
+	)
+	requireT.Equal(validator1Address(testNetwork).String(), deadManSwitchRes.DeadManSwitch.Creator)
+	requireT.Equal(uint64(1000), deadManSwitchRes.DeadManSwitch.TimeoutBlocks)
+	expirationHeight := deadManSwitchRes.DeadManSwitch.ExpirationHeight
+
+	requireT.NoError(testNetwork.WaitForNextBlock())
+
+	_, err = coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdHeartbeat(),
+		txValidator1Args(testNetwork),
+	)
+	requireT.NoError(err)
+
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryDeadManSwitch(), []string{validator1Address(testNetwork).String()}, &deadManSwitchRes,
+	)
+	requireT.Greater(deadManSwitchRes.DeadManSwitch.ExpirationHeight, expirationHeight)
+
+	// disarm the switch
+	args = append([]string{"0"}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdSetDeadManSwitch(),
+		args,
+	)
+	requireT.NoError(err)
+
+	_, err = coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdHeartbeat(),
+		txValidator1Args(testNetwork),
+	)
+	requireT.ErrorContains(err, "isn't armed")
+}
+
+func TestCmdSwapExactInAndOut(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	// the order books are empty, so the swaps are delivered but fail
+	args := append(
+		[]string{
+			sdk.NewCoin(denom1, sdkmath.NewInt(100_000)).String(),
+			denom2,
+			"90000",
+			"--" + cli.RouteFlag, denom1 + "," + denom2,
+			fmt.Sprintf("--%s=%d", flags.FlagGas, 1_000_000),
+		}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdSwapExactIn(),
+		args,
+	)
+	requireT.ErrorContains(err, "no liquidity in the order book")
+
+	args = append(
+		[]string{
+			denom1,
+			sdk.NewCoin(denom2, sdkmath.NewInt(100_000)).String(),
+			"110000",
+			fmt.Sprintf("--%s=%d", flags.FlagGas, 1_000_000),
+		}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdSwapExactOut(),
+		args,
+	)
+	requireT.ErrorContains(err, "no swap route found")
+}
+
+func TestCmdFundRewardProgramAndClaimRewards(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	args := append(
+		[]string{
+			denom1,
+			denom2,
+			sdk.NewCoin(denom1, sdkmath.NewInt(100_000)).String(),
+			"1000",
+			"10",
+			"--" + cli.MinQuantityFlag, "10000",
+		}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdFundRewardProgram(),
+		args,
+	)
+	requireT.NoError(err)
+
+	var rewardProgramsRes types.QueryRewardProgramsResponse
+	coreumclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryRewardPrograms(), []string{}, &rewardProgramsRes)
+	requireT.Equal([]types.RewardProgram{
+		{
+			BaseDenom:      denom1,
+			QuoteDenom:     denom2,
+			Pool:           sdk.NewCoin(denom1, sdkmath.NewInt(100_000)),
+			RewardPerEpoch: sdkmath.NewInt(1000),
+			MaxTicks:       10,
+			MinQuantity:    sdkmath.NewInt(10_000),
+		},
+	}, rewardProgramsRes.RewardPrograms)
+
+	var accountRewardsRes types.QueryAccountRewardsResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryAccountRewards(), []string{validator1Address(testNetwork).String()}, &accountRewardsRes,
+	)
+	requireT.Empty(accountRewardsRes.AccountRewards)
+
+	_, err = coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdClaimRewards(),
+		txValidator1Args(testNetwork),
+	)
+	requireT.ErrorContains(err, "no rewards to claim")
+}
+
 func placeOrder(
 	ctx client.Context,
 	requireT *require.Assertions,
@@ -278,6 +670,20 @@ func placeOrder(
 				"--"+cli.GoodTilBlockTimeFlag, strconv.FormatInt(order.GoodTil.GoodTilBlockTime.Unix(), 10),
 			)
 		}
+		if order.GoodTil.GoodTilBlocks > 0 {
+			args = append(args,
+				"--"+cli.GoodTilBlocksFlag, strconv.FormatUint(order.GoodTil.GoodTilBlocks, 10),
+			)
+		}
+		if order.GoodTil.GoodTilDuration != nil {
+			args = append(args, "--"+cli.GoodTilDurationFlag, order.GoodTil.GoodTilDuration.String())
+		}
+	}
+	if order.Trigger != nil {
+		args = append(args,
+			"--"+cli.TriggerPriceFlag, order.Trigger.Price.String(),
+			"--"+cli.TriggerConditionFlag, order.Trigger.Condition.String(),
+		)
 	}
 	args = append(args, txValidator1Args(testNetwork)...)
 	_, err := coreumclitestutil.ExecTxCmd(
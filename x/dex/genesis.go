@@ -79,11 +79,136 @@ func InitGenesis(
 			AccountNumber:             accNumber,
 			RemainingBaseQuantity:     order.RemainingBaseQuantity,
 			RemainingSpendableBalance: order.RemainingSpendableBalance,
+			HiddenBaseQuantity:        order.HiddenBaseQuantity,
+			AllOrNone:                 order.AllOrNone,
 		}
 		if err := dexKeeper.SaveOrderWithOrderBookRecord(ctx, order, record); err != nil {
 			panic(errors.Wrap(err, "failed to set order with order book record"))
 		}
 	}
+	for _, order := range genState.TriggerOrders {
+		creator, err := sdk.AccAddressFromBech32(order.Creator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator))
+		}
+
+		accNumber, ok := accAddressToNumberCache[order.Creator]
+		if !ok {
+			acc := accountKeeper.GetAccount(ctx, creator)
+			if acc == nil {
+				panic(errors.New("account not fond: " + creator.String()))
+			}
+			accNumber = acc.GetAccountNumber()
+			accAddressToNumberCache[order.Creator] = accNumber
+		}
+
+		if err := dexKeeper.SaveTriggerOrder(ctx, accNumber, order); err != nil {
+			panic(errors.Wrap(err, "failed to set trigger order"))
+		}
+	}
+
+	for _, deadManSwitch := range genState.DeadManSwitches {
+		creator, err := sdk.AccAddressFromBech32(deadManSwitch.Creator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", deadManSwitch.Creator))
+		}
+
+		accNumber, ok := accAddressToNumberCache[deadManSwitch.Creator]
+		if !ok {
+			acc := accountKeeper.GetAccount(ctx, creator)
+			if acc == nil {
+				panic(errors.New("account not fond: " + creator.String()))
+			}
+			accNumber = acc.GetAccountNumber()
+			accAddressToNumberCache[deadManSwitch.Creator] = accNumber
+		}
+
+		if err := dexKeeper.SaveDeadManSwitch(ctx, accNumber, deadManSwitch); err != nil {
+			panic(errors.Wrap(err, "failed to set dead man's switch"))
+		}
+	}
+
+	for _, tradingVolume := range genState.TradingVolumes {
+		acc, err := sdk.AccAddressFromBech32(tradingVolume.Account)
+		if err != nil {
+			panic(sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", tradingVolume.Account))
+		}
+
+		accNumber, ok := accAddressToNumberCache[tradingVolume.Account]
+		if !ok {
+			account := accountKeeper.GetAccount(ctx, acc)
+			if account == nil {
+				panic(errors.New("account not fond: " + acc.String()))
+			}
+			accNumber = account.GetAccountNumber()
+			accAddressToNumberCache[tradingVolume.Account] = accNumber
+		}
+
+		if err := dexKeeper.SaveTradingVolume(ctx, accNumber, tradingVolume); err != nil {
+			panic(errors.Wrap(err, "failed to set trading volume"))
+		}
+	}
+
+	for _, rewardProgram := range genState.RewardPrograms {
+		if err := dexKeeper.SaveRewardProgram(ctx, rewardProgram); err != nil {
+			panic(errors.Wrap(err, "failed to set reward program"))
+		}
+	}
+
+	for _, accountReward := range genState.AccountRewards {
+		acc, err := sdk.AccAddressFromBech32(accountReward.Account)
+		if err != nil {
+			panic(sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", accountReward.Account))
+		}
+
+		accNumber, ok := accAddressToNumberCache[accountReward.Account]
+		if !ok {
+			account := accountKeeper.GetAccount(ctx, acc)
+			if account == nil {
+				panic(errors.New("account not fond: " + acc.String()))
+			}
+			accNumber = account.GetAccountNumber()
+			accAddressToNumberCache[accountReward.Account] = accNumber
+		}
+
+		if err := dexKeeper.SaveAccountReward(ctx, accNumber, accountReward); err != nil {
+			panic(errors.Wrap(err, "failed to set account reward"))
+		}
+	}
+
+	for _, orderQuota := range genState.OrderQuotas {
+		acc, err := sdk.AccAddressFromBech32(orderQuota.Account)
+		if err != nil {
+			panic(sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", orderQuota.Account))
+		}
+
+		accNumber, ok := accAddressToNumberCache[orderQuota.Account]
+		if !ok {
+			account := accountKeeper.GetAccount(ctx, acc)
+			if account == nil {
+				panic(errors.New("account not fond: " + acc.String()))
+			}
+			accNumber = account.GetAccountNumber()
+			accAddressToNumberCache[orderQuota.Account] = accNumber
+		}
+
+		if err := dexKeeper.SaveOrderQuota(ctx, accNumber, orderQuota); err != nil {
+			panic(errors.Wrap(err, "failed to set order quota"))
+		}
+	}
+
+	for _, lastTrade := range genState.LastTrades {
+		if err := dexKeeper.SaveOrderBookLastTrade(ctx, lastTrade); err != nil {
+			panic(errors.Wrap(err, "failed to set order book last trade"))
+		}
+	}
+
+	for _, accumulator := range genState.PriceAccumulators {
+		if err := dexKeeper.SavePriceAccumulator(ctx, accumulator); err != nil {
+			panic(errors.Wrap(err, "failed to set order book price accumulator"))
+		}
+	}
+
 	if err := dexKeeper.SetOrderSequence(ctx, genState.OrderSequence); err != nil {
 		panic(errors.Wrap(err, "failed to set order sequence"))
 	}
@@ -106,6 +231,46 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(errors.Wrap(err, "failed to get orders with sequence"))
 	}
 
+	triggerOrders, _, err := k.GetAccountsTriggerOrders(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get trigger orders"))
+	}
+
+	lastTrades, _, err := k.GetOrderBooksLastTrades(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get order books last trades"))
+	}
+
+	priceAccumulators, _, err := k.GetPriceAccumulators(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get order books price accumulators"))
+	}
+
+	deadManSwitches, _, err := k.GetDeadManSwitches(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get dead man's switches"))
+	}
+
+	tradingVolumes, _, err := k.GetTradingVolumes(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get trading volumes"))
+	}
+
+	rewardPrograms, _, err := k.GetRewardPrograms(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get reward programs"))
+	}
+
+	accountRewards, _, err := k.GetAccountsRewards(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get accounts rewards"))
+	}
+
+	orderQuotas, _, err := k.GetOrderQuotas(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get order quotas"))
+	}
+
 	orderBooksWithID, _, err := k.GetOrderBooksWithID(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
 	if err != nil {
 		panic(errors.Wrap(err, "failed to get order books with ID"))
@@ -140,5 +305,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		OrderSequence:              orderSequence,
 		AccountsDenomsOrdersCounts: accountsDenomsOrdersCounts,
 		ReservedOrderIds:           reservedOrderIDs,
+		TriggerOrders:              triggerOrders,
+		LastTrades:                 lastTrades,
+		PriceAccumulators:          priceAccumulators,
+		DeadManSwitches:            deadManSwitches,
+		TradingVolumes:             tradingVolumes,
+		RewardPrograms:             rewardPrograms,
+		AccountRewards:             accountRewards,
+		OrderQuotas:                orderQuotas,
 	}
 }
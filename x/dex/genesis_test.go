@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -68,8 +69,10 @@ func TestInitAndExportGenesis(t *testing.T) {
 			{
 				ID: 2,
 				Data: types.OrderBookData{
-					BaseDenom:  denoms[1],
-					QuoteDenom: denoms[2],
+					BaseDenom:   denoms[1],
+					QuoteDenom:  denoms[2],
+					Status:      types.ORDER_BOOK_STATUS_ACTIVE,
+					MinQuantity: lo.ToPtr(sdkmath.NewInt(1)),
 				},
 			},
 			{
@@ -77,6 +80,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 				Data: types.OrderBookData{
 					BaseDenom:  denoms[2],
 					QuoteDenom: denoms[1],
+					Status:     types.ORDER_BOOK_STATUS_ACTIVE,
 				},
 			},
 		},
@@ -109,7 +113,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 				Price:                     lo.ToPtr(types.MustNewPriceFromString("3e3")),
 				Quantity:                  sdkmath.NewInt(100),
 				Side:                      types.SIDE_SELL,
-				TimeInForce:               types.TIME_IN_FORCE_GTC,
+				TimeInForce:               types.TIME_IN_FORCE_POST_ONLY,
 				RemainingBaseQuantity:     sdkmath.NewInt(90),
 				RemainingSpendableBalance: sdkmath.NewInt(90),
 				Reserve:                   prams.OrderReserve,
@@ -133,10 +137,114 @@ func TestInitAndExportGenesis(t *testing.T) {
 				Reserve:                   prams.OrderReserve,
 			},
 		},
+		TriggerOrders: []types.Order{
+			{
+				Creator:                   acc1.String(),
+				Type:                      types.ORDER_TYPE_LIMIT,
+				ID:                        "id-trigger1",
+				Sequence:                  4,
+				BaseDenom:                 denoms[0],
+				QuoteDenom:                denoms[1],
+				Price:                     lo.ToPtr(types.MustNewPriceFromString("4e-3")),
+				Quantity:                  sdkmath.NewInt(100),
+				Side:                      types.SIDE_SELL,
+				TimeInForce:               types.TIME_IN_FORCE_GTC,
+				RemainingBaseQuantity:     sdkmath.NewInt(100),
+				RemainingSpendableBalance: sdkmath.NewInt(100),
+				Reserve:                   prams.OrderReserve,
+				Trigger: &types.Trigger{
+					Price:     types.MustNewPriceFromString("5e-3"),
+					Condition: types.TRIGGER_CONDITION_PRICE_LTE,
+				},
+			},
+		},
+		LastTrades: []types.OrderBookLastTrade{
+			{
+				OrderBookID:   0,
+				Price:         types.MustNewPriceFromString("1e-2"),
+				OrderSequence: 2,
+			},
+		},
+		PriceAccumulators: []types.PriceAccumulator{
+			{
+				OrderBookID:     0,
+				Time:            time.Unix(1700000000, 0).UTC(),
+				LastPrice:       sdkmath.LegacyMustNewDecFromStr("0.01"),
+				CumulativePrice: sdkmath.LegacyMustNewDecFromStr("12.5"),
+			},
+			{
+				OrderBookID:     1,
+				Time:            time.Unix(1700000000, 0).UTC(),
+				LastPrice:       sdkmath.LegacyMustNewDecFromStr("100"),
+				CumulativePrice: sdkmath.LegacyMustNewDecFromStr("125000"),
+			},
+		},
+		DeadManSwitches: []types.DeadManSwitch{
+			{
+				Creator:          acc1.String(),
+				TimeoutBlocks:    10,
+				ExpirationHeight: 110,
+			},
+			{
+				Creator:          acc2.String(),
+				TimeoutBlocks:    100,
+				ExpirationHeight: 150,
+			},
+		},
+		TradingVolumes: []types.TradingVolume{
+			{
+				Account: acc1.String(),
+				Day:     19675,
+				Volume:  sdkmath.LegacyMustNewDecFromStr("10.5"),
+			},
+			{
+				Account: acc1.String(),
+				Day:     19676,
+				Volume:  sdkmath.LegacyMustNewDecFromStr("3"),
+			},
+			{
+				Account: acc2.String(),
+				Day:     19676,
+				Volume:  sdkmath.LegacyMustNewDecFromStr("1000"),
+			},
+		},
+		RewardPrograms: []types.RewardProgram{
+			{
+				BaseDenom:      denoms[0],
+				QuoteDenom:     denoms[1],
+				Pool:           sdk.NewInt64Coin(denoms[2], 70),
+				RewardPerEpoch: sdkmath.NewInt(10),
+				MaxTicks:       5,
+				MinQuantity:    sdkmath.NewInt(1),
+			},
+		},
+		AccountRewards: []types.AccountReward{
+			{
+				Account:    acc1.String(),
+				BaseDenom:  denoms[0],
+				QuoteDenom: denoms[1],
+				Points:     sdkmath.NewInt(300),
+				Reward:     sdk.NewInt64Coin(denoms[2], 20),
+			},
+			{
+				Account:    acc2.String(),
+				BaseDenom:  denoms[0],
+				QuoteDenom: denoms[1],
+				Points:     sdkmath.NewInt(150),
+				Reward:     sdk.NewInt64Coin(denoms[2], 0),
+			},
+		},
+		OrderQuotas: []types.OrderQuota{
+			{
+				Account:             acc2.String(),
+				ExtraOrdersPerDenom: 10,
+				Reserve:             sdk.NewInt64Coin(prams.OrderReserve.Denom, 100),
+			},
+		},
 	}
 
 	accountDenomToAccountDenomOrdersCount := make(map[string]types.AccountDenomOrdersCount, 0)
-	for _, order := range genState.Orders {
+	for _, order := range append(genState.Orders, genState.TriggerOrders...) {
 		creator := sdk.MustAccAddressFromBech32(order.Creator)
 		accNum := testApp.AccountKeeper.GetAccount(sdkCtx, creator).GetAccountNumber()
 		genState.ReservedOrderIds = append(genState.ReservedOrderIds, types.CreateReserveOrderIDKey(accNum, order.ID))
@@ -169,7 +277,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	genState.AccountsDenomsOrdersCounts = lo.Values(accountDenomToAccountDenomOrdersCount)
 
 	// the order sequence is last order sequence
-	genState.OrderSequence = 3
+	genState.OrderSequence = 4
 
 	// init the keeper
 	dex.InitGenesis(sdkCtx, dexKeeper, testApp.AccountKeeper, genState)
@@ -186,6 +294,18 @@ func TestInitAndExportGenesis(t *testing.T) {
 	requireT.Equal(genState.Params, exportedGenState.Params)
 	requireT.Equal(genState.OrderBooks, exportedGenState.OrderBooks)
 	requireT.Equal(genState.Orders, exportedGenState.Orders)
+	requireT.Equal(genState.TriggerOrders, exportedGenState.TriggerOrders)
+	requireT.Equal(genState.LastTrades, exportedGenState.LastTrades)
+	requireT.Equal(genState.PriceAccumulators, exportedGenState.PriceAccumulators)
+	requireT.Equal(genState.DeadManSwitches, exportedGenState.DeadManSwitches)
+	requireT.Equal(genState.TradingVolumes, exportedGenState.TradingVolumes)
+	requireT.Equal(genState.RewardPrograms, exportedGenState.RewardPrograms)
+	requireT.Equal(genState.AccountRewards, exportedGenState.AccountRewards)
+	requireT.Equal(genState.OrderQuotas, exportedGenState.OrderQuotas)
+
+	triggerOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, acc1, genState.TriggerOrders[0].ID)
+	requireT.NoError(err)
+	requireT.Equal(genState.TriggerOrders[0], triggerOrder)
 
 	// check that imported state is valid
 
@@ -216,7 +336,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, orderWithExisingOrderBook))
 
 	// set the expected state
-	orderWithExisingOrderBook.Sequence = 4
+	orderWithExisingOrderBook.Sequence = 5
 	orderWithExisingOrderBook.RemainingBaseQuantity = sdkmath.NewInt(10000000)
 	orderWithExisingOrderBook.RemainingSpendableBalance = sdkmath.NewInt(40000000000)
 	orderWithExisingOrderBook.Reserve = params.OrderReserve
@@ -239,7 +359,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	for _, order := range orders {
 		if order.Creator == acc2.String() && order.ID == orderWithExisingOrderBook.ID {
 			orderFound = true
-			// the `orderWithExisingOrderBook` has the sequence eq to 4 to check that next sequence from imported is used
+			// the `orderWithExisingOrderBook` has the sequence eq to 5 to check that next sequence from imported is used
 			requireT.Equal(orderWithExisingOrderBook, order)
 		}
 	}
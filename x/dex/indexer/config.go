@@ -0,0 +1,163 @@
+package indexer
+
+import (
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cast"
+)
+
+// App config keys of the indexer.
+const (
+	flagEnable           = "dex-indexer.enable"
+	flagCandleIntervals  = "dex-indexer.candle-intervals"
+	flagTradesRetention  = "dex-indexer.trades-retention"
+	flagCandlesRetention = "dex-indexer.candles-retention"
+	flagPruningInterval  = "dex-indexer.pruning-interval"
+
+	flagOrderBookSnapshotInterval = "dex-indexer.order-book-snapshot-interval"
+)
+
+// DefaultConfigTemplate is the app.toml template of the indexer config.
+const DefaultConfigTemplate = `
+[dex-indexer]
+# enable defines if the node-local DEX trades and candles indexer is enabled.
+# The indexed data isn't a part of the consensus state, so only the nodes with the enabled indexer serve it.
+enable = {{ .DEXIndexer.Enable }}
+# candle-intervals defines the intervals of the OHLCV candles built by the indexer.
+candle-intervals = [{{ range $i, $v := .DEXIndexer.CandleIntervals }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+# trades-retention defines how long the trades are kept, "0s" keeps them forever.
+trades-retention = "{{ .DEXIndexer.TradesRetention }}"
+# candles-retention defines how long the candles are kept, "0s" keeps them forever.
+candles-retention = "{{ .DEXIndexer.CandlesRetention }}"
+# pruning-interval defines the number of blocks between the pruning runs.
+pruning-interval = {{ .DEXIndexer.PruningInterval }}
+# order-book-snapshot-interval defines the number of blocks between the full order book snapshots sent to the order
+# book streams, the order book diffs are sent in the other blocks.
+order-book-snapshot-interval = {{ .DEXIndexer.OrderBookSnapshotInterval }}
+`
+
+// Config is the indexer config.
+type Config struct {
+	Enable           bool
+	CandleIntervals  []time.Duration
+	TradesRetention  time.Duration
+	CandlesRetention time.Duration
+	PruningInterval  uint64
+
+	OrderBookSnapshotInterval uint64
+}
+
+// DefaultConfig returns the default indexer config.
+func DefaultConfig() Config {
+	return Config{
+		Enable: false,
+		CandleIntervals: []time.Duration{
+			time.Minute,
+			5 * time.Minute,
+			15 * time.Minute,
+			time.Hour,
+			4 * time.Hour,
+			24 * time.Hour,
+		},
+		TradesRetention:  30 * 24 * time.Hour,
+		CandlesRetention: 0,
+		PruningInterval:  100,
+
+		OrderBookSnapshotInterval: 100,
+	}
+}
+
+// ReadConfig reads the indexer config from the app options.
+func ReadConfig(appOpts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+
+	if v := appOpts.Get(flagEnable); v != nil {
+		enable, err := cast.ToBoolE(v)
+		if err != nil {
+			return Config{}, errors.Wrapf(err, "invalid %s", flagEnable)
+		}
+		cfg.Enable = enable
+	}
+
+	if v := appOpts.Get(flagCandleIntervals); v != nil {
+		intervalsStr, err := cast.ToStringSliceE(v)
+		if err != nil {
+			return Config{}, errors.Wrapf(err, "invalid %s", flagCandleIntervals)
+		}
+		intervals := make([]time.Duration, 0, len(intervalsStr))
+		for _, intervalStr := range intervalsStr {
+			interval, err := time.ParseDuration(intervalStr)
+			if err != nil {
+				return Config{}, errors.Wrapf(err, "invalid %s", flagCandleIntervals)
+			}
+			intervals = append(intervals, interval)
+		}
+		cfg.CandleIntervals = intervals
+	}
+
+	for flag, dst := range map[string]*time.Duration{
+		flagTradesRetention:  &cfg.TradesRetention,
+		flagCandlesRetention: &cfg.CandlesRetention,
+	} {
+		if v := appOpts.Get(flag); v != nil {
+			retention, err := cast.ToDurationE(v)
+			if err != nil {
+				return Config{}, errors.Wrapf(err, "invalid %s", flag)
+			}
+			*dst = retention
+		}
+	}
+
+	if v := appOpts.Get(flagPruningInterval); v != nil {
+		pruningInterval, err := cast.ToUint64E(v)
+		if err != nil {
+			return Config{}, errors.Wrapf(err, "invalid %s", flagPruningInterval)
+		}
+		cfg.PruningInterval = pruningInterval
+	}
+
+	if v := appOpts.Get(flagOrderBookSnapshotInterval); v != nil {
+		snapshotInterval, err := cast.ToUint64E(v)
+		if err != nil {
+			return Config{}, errors.Wrapf(err, "invalid %s", flagOrderBookSnapshotInterval)
+		}
+		cfg.OrderBookSnapshotInterval = snapshotInterval
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// Validate validates the indexer config.
+func (c Config) Validate() error {
+	seen := make(map[time.Duration]struct{}, len(c.CandleIntervals))
+	for _, interval := range c.CandleIntervals {
+		if interval < time.Second || interval%time.Second != 0 {
+			return errors.Errorf("candle interval must be a positive number of seconds, got %s", interval)
+		}
+		if _, ok := seen[interval]; ok {
+			return errors.Errorf("duplicated candle interval %s", interval)
+		}
+		seen[interval] = struct{}{}
+	}
+
+	if c.TradesRetention < 0 {
+		return errors.Errorf("trades retention must not be negative, got %s", c.TradesRetention)
+	}
+	if c.CandlesRetention < 0 {
+		return errors.Errorf("candles retention must not be negative, got %s", c.CandlesRetention)
+	}
+	if c.PruningInterval == 0 {
+		return errors.New("pruning interval must be positive")
+	}
+	if c.OrderBookSnapshotInterval == 0 {
+		return errors.New("order book snapshot interval must be positive")
+	}
+
+	return nil
+}
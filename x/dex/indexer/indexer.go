@@ -0,0 +1,414 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+const (
+	blockEventModeAttributeKey = "mode"
+	blockEventModeBeginBlock   = "BeginBlock"
+)
+
+var _ storetypes.ABCIListener = &Indexer{}
+
+// Indexer is the node-local indexer of the DEX trades, OHLCV candles and order book price levels. It listens to the
+// finalized blocks, so only the events of the successfully executed transactions are indexed, and the indexed data
+// isn't a part of the consensus state.
+type Indexer struct {
+	cfg    Config
+	db     dbm.DB
+	cdc    codec.BinaryCodec
+	logger log.Logger
+
+	mu                 sync.Mutex
+	subscriptions      map[uint64]*orderBookSubscription
+	nextSubscriptionID uint64
+}
+
+// New returns a new instance of the Indexer.
+func New(cfg Config, db dbm.DB, cdc codec.BinaryCodec, logger log.Logger) *Indexer {
+	return &Indexer{
+		cfg:    cfg,
+		db:     db,
+		cdc:    cdc,
+		logger: logger.With("module", "dex-indexer"),
+
+		subscriptions: make(map[uint64]*orderBookSubscription),
+	}
+}
+
+// ListenFinalizeBlock indexes the trades and the order book changes of the finalized block, and streams the order
+// book updates to the subscribers.
+func (idx *Indexer) ListenFinalizeBlock(
+	_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock,
+) error {
+	lastIndexedHeight, err := idx.getLastIndexedHeight()
+	if err != nil {
+		return err
+	}
+	// the block might be replayed after the restart
+	if req.Height <= lastIndexedHeight {
+		return nil
+	}
+
+	events := collectBlockEvents(res)
+	tradeEvents, err := collectTradeEvents(events)
+	if err != nil {
+		return err
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	if err := idx.indexTrades(batch, req.Height, req.Time, tradeEvents); err != nil {
+		return err
+	}
+
+	orderBooksCache, err := idx.indexOrderBooks(batch, events)
+	if err != nil {
+		return err
+	}
+
+	if err := batch.Set(lastIndexedHeightKey, sdk.Uint64ToBigEndian(uint64(req.Height))); err != nil {
+		return errors.Wrap(err, "failed to set last indexed height")
+	}
+
+	if uint64(req.Height)%idx.cfg.PruningInterval == 0 {
+		if err := idx.prune(batch, req.Time); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return errors.Wrapf(err, "failed to write indexed block %d", req.Height)
+	}
+
+	if len(tradeEvents) > 0 {
+		idx.logger.Debug("Indexed DEX trades.", "height", req.Height, "count", len(tradeEvents))
+	}
+
+	return idx.publishOrderBookUpdates(req.Height, orderBooksCache)
+}
+
+// ListenCommit implements the storetypes.ABCIListener interface, the indexer doesn't use the committed state changes.
+func (idx *Indexer) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// Close closes the order book streams and the indexer DB.
+func (idx *Indexer) Close() error {
+	idx.closeSubscriptions()
+	return idx.db.Close()
+}
+
+func (idx *Indexer) indexTrades(
+	batch dbm.Batch, height int64, blockTime time.Time, tradeEvents []types.EventTrade,
+) error {
+	// the candles updated in the block are cached since the batch isn't readable
+	candles := make(map[string]types.Candle)
+	var index uint32
+	for _, evt := range tradeEvents {
+		trades, err := newIndexedTrades(evt, height, blockTime)
+		if err != nil {
+			return err
+		}
+		for _, trade := range trades {
+			pairKey, err := createPairKey(trade.BaseDenom, trade.QuoteDenom)
+			if err != nil {
+				return err
+			}
+			if err := batch.Set(store.JoinKeys(pairKeyPrefix, pairKey), storeTrue); err != nil {
+				return errors.Wrap(err, "failed to set pair")
+			}
+
+			tradeBytes, err := idx.cdc.Marshal(&trade)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal trade")
+			}
+			if err := batch.Set(createTradeKey(pairKey, blockTime, height, index), tradeBytes); err != nil {
+				return errors.Wrap(err, "failed to set trade")
+			}
+			index++
+
+			for _, interval := range idx.cfg.CandleIntervals {
+				candleKey := createCandleKey(pairKey, interval, candleOpenTime(blockTime, interval))
+				candle, ok := candles[string(candleKey)]
+				if !ok {
+					candle, ok, err = idx.getCandle(candleKey)
+					if err != nil {
+						return err
+					}
+					if !ok {
+						candle = newCandle(candleOpenTime(blockTime, interval), trade.Price)
+					}
+				}
+				candles[string(candleKey)] = addTradeToCandle(candle, trade)
+			}
+		}
+	}
+
+	for key, candle := range candles {
+		candleBytes, err := idx.cdc.Marshal(&candle)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal candle")
+		}
+		if err := batch.Set([]byte(key), candleBytes); err != nil {
+			return errors.Wrap(err, "failed to set candle")
+		}
+	}
+
+	return nil
+}
+
+func (idx *Indexer) prune(batch dbm.Batch, blockTime time.Time) error {
+	if idx.cfg.TradesRetention == 0 && idx.cfg.CandlesRetention == 0 {
+		return nil
+	}
+
+	pairKeys, err := idx.getPairKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, pairKey := range pairKeys {
+		if idx.cfg.TradesRetention > 0 {
+			start := createTradeKeyPrefix(pairKey)
+			end := store.AppendUint64ToOrderedBytes(
+				createTradeKeyPrefix(pairKey), uint64(blockTime.Add(-idx.cfg.TradesRetention).UnixNano()),
+			)
+			if err := deleteRange(idx.db, batch, start, end); err != nil {
+				return err
+			}
+		}
+
+		if idx.cfg.CandlesRetention > 0 {
+			for _, interval := range idx.cfg.CandleIntervals {
+				start := createCandleKeyPrefix(pairKey, interval)
+				end := createCandleKey(pairKey, interval, blockTime.Add(-idx.cfg.CandlesRetention))
+				if err := deleteRange(idx.db, batch, start, end); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (idx *Indexer) getLastIndexedHeight() (int64, error) {
+	bz, err := idx.db.Get(lastIndexedHeightKey)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get last indexed height")
+	}
+	if bz == nil {
+		return 0, nil
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+func (idx *Indexer) getCandle(key []byte) (types.Candle, bool, error) {
+	bz, err := idx.db.Get(key)
+	if err != nil {
+		return types.Candle{}, false, errors.Wrap(err, "failed to get candle")
+	}
+	if bz == nil {
+		return types.Candle{}, false, nil
+	}
+
+	var candle types.Candle
+	if err := idx.cdc.Unmarshal(bz, &candle); err != nil {
+		return types.Candle{}, false, errors.Wrap(err, "failed to unmarshal candle")
+	}
+
+	return candle, true, nil
+}
+
+func (idx *Indexer) getPairKeys() ([][]byte, error) {
+	iterator, err := dbm.IteratePrefix(idx.db, pairKeyPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pairs")
+	}
+	defer iterator.Close()
+
+	pairKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		pairKeys = append(pairKeys, bytes.Clone(iterator.Key()[len(pairKeyPrefix):]))
+	}
+
+	return pairKeys, iterator.Error()
+}
+
+func deleteRange(db dbm.DB, batch dbm.Batch, start, end []byte) error {
+	iterator, err := db.Iterator(start, end)
+	if err != nil {
+		return errors.Wrap(err, "failed to iterate keys to prune")
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := batch.Delete(iterator.Key()); err != nil {
+			return errors.Wrap(err, "failed to delete pruned key")
+		}
+	}
+
+	return iterator.Error()
+}
+
+// collectBlockEvents returns the events of the block in the execution order: the begin block events, the events of the
+// successful transactions and the end block events.
+func collectBlockEvents(res abci.ResponseFinalizeBlock) []abci.Event {
+	events := make([]abci.Event, 0)
+	endBlockEvents := make([]abci.Event, 0)
+	for _, evt := range res.Events {
+		mode, evt := removeEventModeAttribute(evt)
+		if mode == blockEventModeBeginBlock {
+			events = append(events, evt)
+		} else {
+			endBlockEvents = append(endBlockEvents, evt)
+		}
+	}
+	for _, txRes := range res.TxResults {
+		// the events of the failed transactions are not applied
+		if txRes.Code != 0 {
+			continue
+		}
+		events = append(events, txRes.Events...)
+	}
+
+	return append(events, endBlockEvents...)
+}
+
+// removeEventModeAttribute returns the block execution mode of the event and the event without the mode attribute,
+// since the attribute isn't the part of the typed event and can't be parsed.
+func removeEventModeAttribute(evt abci.Event) (string, abci.Event) {
+	for i, attr := range evt.Attributes {
+		if attr.Key != blockEventModeAttributeKey {
+			continue
+		}
+		attributes := make([]abci.EventAttribute, 0, len(evt.Attributes)-1)
+		attributes = append(attributes, evt.Attributes[:i]...)
+		evt.Attributes = append(attributes, evt.Attributes[i+1:]...)
+		return attr.Value, evt
+	}
+
+	return "", evt
+}
+
+func collectTradeEvents(events []abci.Event) ([]types.EventTrade, error) {
+	tradeEventName := proto.MessageName(&types.EventTrade{})
+	tradeEvents := make([]types.EventTrade, 0)
+	for _, evt := range events {
+		if evt.Type != tradeEventName {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(evt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", tradeEventName)
+		}
+		tradeEvent, ok := msg.(*types.EventTrade)
+		if !ok {
+			return nil, errors.Errorf("unexpected event type %T", msg)
+		}
+		tradeEvents = append(tradeEvents, *tradeEvent)
+	}
+
+	return tradeEvents, nil
+}
+
+// newIndexedTrades returns the trade in the maker order book, and in the opposite order book if the inverted price
+// can be represented.
+func newIndexedTrades(evt types.EventTrade, height int64, blockTime time.Time) ([]types.IndexedTrade, error) {
+	trade := types.IndexedTrade{
+		BaseDenom:     evt.BaseDenom,
+		QuoteDenom:    evt.QuoteDenom,
+		Price:         evt.Price,
+		BaseQuantity:  evt.BaseQuantity,
+		QuoteQuantity: evt.QuoteQuantity,
+		TakerSide:     evt.TakerSide,
+		Maker:         evt.Maker,
+		MakerOrderID:  evt.MakerOrderID,
+		Taker:         evt.Taker,
+		TakerOrderID:  evt.TakerOrderID,
+		Height:        height,
+		Time:          blockTime,
+	}
+
+	invertedPrice, ok := invertPrice(evt.Price)
+	if !ok {
+		return []types.IndexedTrade{trade}, nil
+	}
+
+	invertedTakerSide, err := evt.TakerSide.Opposite()
+	if err != nil {
+		return nil, err
+	}
+	invertedTrade := trade
+	invertedTrade.BaseDenom, invertedTrade.QuoteDenom = trade.QuoteDenom, trade.BaseDenom
+	invertedTrade.BaseQuantity, invertedTrade.QuoteQuantity = trade.QuoteQuantity, trade.BaseQuantity
+	invertedTrade.Price = invertedPrice
+	invertedTrade.TakerSide = invertedTakerSide
+
+	return []types.IndexedTrade{trade, invertedTrade}, nil
+}
+
+func candleOpenTime(blockTime time.Time, interval time.Duration) time.Time {
+	intervalSeconds := int64(interval / time.Second)
+	unix := blockTime.Unix()
+	return time.Unix(unix-unix%intervalSeconds, 0).UTC()
+}
+
+func newCandle(openTime time.Time, price types.Price) types.Candle {
+	return types.Candle{
+		OpenTime:    openTime,
+		Open:        price,
+		High:        price,
+		Low:         price,
+		Close:       price,
+		BaseVolume:  sdkmath.ZeroInt(),
+		QuoteVolume: sdkmath.ZeroInt(),
+	}
+}
+
+func addTradeToCandle(candle types.Candle, trade types.IndexedTrade) types.Candle {
+	if trade.Price.Rat().Cmp(candle.High.Rat()) > 0 {
+		candle.High = trade.Price
+	}
+	if trade.Price.Rat().Cmp(candle.Low.Rat()) < 0 {
+		candle.Low = trade.Price
+	}
+	candle.Close = trade.Price
+	candle.BaseVolume = candle.BaseVolume.Add(trade.BaseQuantity)
+	candle.QuoteVolume = candle.QuoteVolume.Add(trade.QuoteQuantity)
+	candle.TradesCount++
+
+	return candle
+}
+
+// invertPrice returns the inverted price rounded down to the max price precision, or false if the inverted price
+// is out of the price range.
+func invertPrice(price types.Price) (types.Price, bool) {
+	invertedPrice, err := types.NewPriceFromRat(new(big.Rat).Inv(price.Rat()))
+	if err != nil {
+		return types.Price{}, false
+	}
+
+	return invertedPrice, true
+}
@@ -0,0 +1,368 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestIndexer_TradesAndCandles(t *testing.T) {
+	ctx := context.Background()
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	cfg.CandleIntervals = []time.Duration{time.Minute, time.Hour}
+	cfg.TradesRetention = time.Hour
+	cfg.PruningInterval = 1
+
+	idx := New(cfg, dbm.NewMemDB(), codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), log.NewNopLogger())
+	qs := NewQueryService(idx)
+
+	blockTime := time.Date(2024, 1, 1, 10, 0, 30, 0, time.UTC)
+	trade1 := newTestTradeEvent(t, "2e-1", 100, 20, types.SIDE_BUY)
+	trade2 := newTestTradeEvent(t, "3e-1", 50, 15, types.SIDE_SELL)
+	failedTxTrade := newTestTradeEvent(t, "1", 1, 1, types.SIDE_SELL)
+
+	res := abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{newTypedEvent(t, &trade1)}},
+			{Code: 1, Events: []abci.Event{newTypedEvent(t, &failedTxTrade)}},
+		},
+		Events: []abci.Event{newTypedEvent(t, &trade2)},
+	}
+	req := abci.RequestFinalizeBlock{Height: 1, Time: blockTime}
+	require.NoError(t, idx.ListenFinalizeBlock(ctx, req, res))
+	// the replayed block is skipped
+	require.NoError(t, idx.ListenFinalizeBlock(ctx, req, res))
+
+	tradesRes, err := qs.Trades(ctx, &types.QueryTradesRequest{BaseDenom: "denom1", QuoteDenom: "denom2"})
+	require.NoError(t, err)
+	require.Len(t, tradesRes.Trades, 2)
+	require.Equal(t, trade1.Price.String(), tradesRes.Trades[0].Price.String())
+	require.Equal(t, trade2.Price.String(), tradesRes.Trades[1].Price.String())
+	require.Equal(t, int64(1), tradesRes.Trades[0].Height)
+	require.Equal(t, blockTime, tradesRes.Trades[0].Time)
+
+	invertedTradesRes, err := qs.Trades(ctx, &types.QueryTradesRequest{BaseDenom: "denom2", QuoteDenom: "denom1"})
+	require.NoError(t, err)
+	require.Len(t, invertedTradesRes.Trades, 2)
+	require.Equal(t, "5", invertedTradesRes.Trades[0].Price.String())
+	require.Equal(t, types.SIDE_SELL, invertedTradesRes.Trades[0].TakerSide)
+	require.Equal(t, sdkmath.NewInt(20), invertedTradesRes.Trades[0].BaseQuantity)
+	require.Equal(t, sdkmath.NewInt(100), invertedTradesRes.Trades[0].QuoteQuantity)
+
+	candlesRes, err := qs.Candles(ctx, &types.QueryCandlesRequest{
+		BaseDenom:  "denom1",
+		QuoteDenom: "denom2",
+		Interval:   time.Minute,
+	})
+	require.NoError(t, err)
+	require.Len(t, candlesRes.Candles, 1)
+	candle := candlesRes.Candles[0]
+	require.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), candle.OpenTime)
+	require.Equal(t, "2e-1", candle.Open.String())
+	require.Equal(t, "3e-1", candle.High.String())
+	require.Equal(t, "2e-1", candle.Low.String())
+	require.Equal(t, "3e-1", candle.Close.String())
+	require.Equal(t, sdkmath.NewInt(150), candle.BaseVolume)
+	require.Equal(t, sdkmath.NewInt(35), candle.QuoteVolume)
+	require.Equal(t, uint64(2), candle.TradesCount)
+
+	_, err = qs.Candles(ctx, &types.QueryCandlesRequest{
+		BaseDenom:  "denom1",
+		QuoteDenom: "denom2",
+		Interval:   5 * time.Minute,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// the next block with the trade in the same hour candle prunes the expired trades
+	trade3 := newTestTradeEvent(t, "1e-1", 10, 1, types.SIDE_BUY)
+	require.NoError(t, idx.ListenFinalizeBlock(
+		ctx,
+		abci.RequestFinalizeBlock{Height: 2, Time: blockTime.Add(59 * time.Minute)},
+		abci.ResponseFinalizeBlock{Events: []abci.Event{newTypedEvent(t, &trade3)}},
+	))
+	candlesRes, err = qs.Candles(ctx, &types.QueryCandlesRequest{
+		BaseDenom:  "denom1",
+		QuoteDenom: "denom2",
+		Interval:   time.Hour,
+	})
+	require.NoError(t, err)
+	require.Len(t, candlesRes.Candles, 1)
+	require.Equal(t, "1e-1", candlesRes.Candles[0].Low.String())
+	require.Equal(t, "1e-1", candlesRes.Candles[0].Close.String())
+	require.Equal(t, uint64(3), candlesRes.Candles[0].TradesCount)
+
+	require.NoError(t, idx.ListenFinalizeBlock(
+		ctx,
+		abci.RequestFinalizeBlock{Height: 3, Time: blockTime.Add(time.Hour + time.Second)},
+		abci.ResponseFinalizeBlock{},
+	))
+	tradesRes, err = qs.Trades(ctx, &types.QueryTradesRequest{BaseDenom: "denom1", QuoteDenom: "denom2"})
+	require.NoError(t, err)
+	require.Len(t, tradesRes.Trades, 1)
+	require.Equal(t, int64(2), tradesRes.Trades[0].Height)
+
+	// the candles are kept forever
+	candlesRes, err = qs.Candles(ctx, &types.QueryCandlesRequest{
+		BaseDenom:  "denom1",
+		QuoteDenom: "denom2",
+		Interval:   time.Minute,
+	})
+	require.NoError(t, err)
+	require.Len(t, candlesRes.Candles, 2)
+}
+
+func TestIndexer_OrderBookStream(t *testing.T) {
+	ctx := context.Background()
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	cfg.OrderBookSnapshotInterval = 3
+
+	idx := New(cfg, dbm.NewMemDB(), codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), log.NewNopLogger())
+
+	snapshot, updates, unsubscribe, err := idx.SubscribeOrderBook("denom1", "denom2")
+	require.NoError(t, err)
+	require.Equal(t, types.OrderBookUpdate{
+		BaseDenom:  "denom1",
+		QuoteDenom: "denom2",
+		Snapshot:   true,
+		Bids:       []types.PriceLevel{},
+		Asks:       []types.PriceLevel{},
+	}, snapshot)
+
+	// the orders are created
+	res := abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{
+				newTypedEvent(t, newTestOrderCreatedEvent(1, types.SIDE_SELL, "2e-1", 100)),
+				newTypedEvent(t, newTestOrderCreatedEvent(2, types.SIDE_SELL, "2e-1", 50)),
+				newTypedEvent(t, newTestOrderCreatedEvent(3, types.SIDE_SELL, "3e-1", 10)),
+				newTypedEvent(t, newTestOrderCreatedEvent(4, types.SIDE_BUY, "1e-1", 30)),
+			}},
+			{Code: 1, Events: []abci.Event{
+				newTypedEvent(t, newTestOrderCreatedEvent(5, types.SIDE_BUY, "1e-1", 30)),
+			}},
+		},
+	}
+	require.NoError(t, idx.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 1}, res))
+	require.Equal(t, types.OrderBookUpdate{
+		Height:      1,
+		OrderBookID: 1,
+		BaseDenom:   "denom1",
+		QuoteDenom:  "denom2",
+		Bids:        []types.PriceLevel{newTestPriceLevel("1e-1", 30, 1)},
+		Asks: []types.PriceLevel{
+			newTestPriceLevel("2e-1", 150, 2),
+			newTestPriceLevel("3e-1", 10, 1),
+		},
+	}, <-updates)
+
+	// the orders are reduced and closed, the begin block events are applied before the transaction events
+	beginBlockEvent := newTypedEvent(t, newTestOrderCreatedEvent(6, types.SIDE_BUY, "1e-1", 20))
+	beginBlockEvent.Attributes = append(beginBlockEvent.Attributes, abci.EventAttribute{
+		Key:   "mode",
+		Value: "BeginBlock",
+	})
+	res = abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{
+				newTypedEvent(t, &types.EventOrderReduced{
+					Sequence:            1,
+					VisibleBaseQuantity: sdkmath.NewInt(60),
+				}),
+				newTypedEvent(t, newTestOrderClosedEvent(4, types.SIDE_BUY, "1e-1")),
+				newTypedEvent(t, newTestOrderClosedEvent(6, types.SIDE_BUY, "1e-1")),
+				// the reduced order which isn't saved to the order book is ignored
+				newTypedEvent(t, &types.EventOrderReduced{
+					Sequence:            7,
+					VisibleBaseQuantity: sdkmath.NewInt(1),
+				}),
+			}},
+		},
+		Events: []abci.Event{beginBlockEvent},
+	}
+	require.NoError(t, idx.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 2}, res))
+	require.Equal(t, types.OrderBookUpdate{
+		Height:      2,
+		OrderBookID: 1,
+		BaseDenom:   "denom1",
+		QuoteDenom:  "denom2",
+		Bids:        []types.PriceLevel{newTestPriceLevel("1e-1", 0, 0)},
+		Asks:        []types.PriceLevel{newTestPriceLevel("2e-1", 110, 2)},
+	}, <-updates)
+
+	// the full snapshot is sent in the snapshot block
+	res = abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{
+				newTypedEvent(t, &types.EventOrderRefreshed{
+					PreviousSequence:      2,
+					Sequence:              8,
+					RemainingBaseQuantity: sdkmath.NewInt(40),
+					OrderBookID:           1,
+					BaseDenom:             "denom1",
+					QuoteDenom:            "denom2",
+					Side:                  types.SIDE_SELL,
+					Price:                 types.MustNewPriceFromString("2e-1"),
+				}),
+			}},
+		},
+	}
+	require.NoError(t, idx.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 3}, res))
+	expectedSnapshot := types.OrderBookUpdate{
+		Height:      3,
+		OrderBookID: 1,
+		BaseDenom:   "denom1",
+		QuoteDenom:  "denom2",
+		Snapshot:    true,
+		Bids:        []types.PriceLevel{},
+		Asks: []types.PriceLevel{
+			newTestPriceLevel("2e-1", 100, 2),
+			newTestPriceLevel("3e-1", 10, 1),
+		},
+	}
+	require.Equal(t, expectedSnapshot, <-updates)
+
+	// the new subscriber receives the current snapshot
+	snapshot, _, unsubscribe2, err := idx.SubscribeOrderBook("denom1", "denom2")
+	require.NoError(t, err)
+	require.Equal(t, expectedSnapshot, snapshot)
+	unsubscribe2()
+
+	// the unsubscribed channel is closed
+	unsubscribe()
+	_, ok := <-updates
+	require.False(t, ok)
+	require.NoError(t, idx.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 4}, res))
+}
+
+func TestIndexer_Disabled(t *testing.T) {
+	qs := NewQueryService(nil)
+	_, err := qs.Trades(context.Background(), &types.QueryTradesRequest{BaseDenom: "denom1", QuoteDenom: "denom2"})
+	require.ErrorContains(t, err, "dex indexer is disabled")
+	err = qs.StreamOrderBook(&types.StreamOrderBookRequest{BaseDenom: "denom1", QuoteDenom: "denom2"}, nil)
+	require.ErrorContains(t, err, "dex indexer is disabled")
+}
+
+func TestInvertPrice(t *testing.T) {
+	testCases := []struct {
+		price    string
+		expected string
+		ok       bool
+	}{
+		{price: "1", expected: "1", ok: true},
+		{price: "2e-1", expected: "5", ok: true},
+		{price: "4e3", expected: "25e-5", ok: true},
+		{price: "3", expected: "3333333333333333333e-19", ok: true},
+		{price: "7e-3", expected: "1428571428571428571e-16", ok: true},
+		{price: "1e-100", expected: "1e100", ok: true},
+		{price: "1e100", expected: "1e-100", ok: true},
+		{price: "3e100", ok: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.price, func(t *testing.T) {
+			inverted, ok := invertPrice(types.MustNewPriceFromString(tc.price))
+			require.Equal(t, tc.ok, ok)
+			if tc.ok {
+				require.Equal(t, tc.expected, inverted.String())
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+
+	cfg := DefaultConfig()
+	cfg.CandleIntervals = []time.Duration{time.Minute, time.Minute}
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.CandleIntervals = []time.Duration{1500 * time.Millisecond}
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.PruningInterval = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.OrderBookSnapshotInterval = 0
+	require.Error(t, cfg.Validate())
+}
+
+func newTestTradeEvent(
+	t *testing.T, price string, baseQuantity, quoteQuantity int64, takerSide types.Side,
+) types.EventTrade {
+	t.Helper()
+
+	return types.EventTrade{
+		OrderBookID:   0,
+		BaseDenom:     "denom1",
+		QuoteDenom:    "denom2",
+		Price:         types.MustNewPriceFromString(price),
+		BaseQuantity:  sdkmath.NewInt(baseQuantity),
+		QuoteQuantity: sdkmath.NewInt(quoteQuantity),
+		TakerSide:     takerSide,
+		Maker:         "maker",
+		MakerOrderID:  "maker-order",
+		Taker:         "taker",
+		TakerOrderID:  "taker-order",
+	}
+}
+
+func newTypedEvent(t *testing.T, evt proto.Message) abci.Event {
+	t.Helper()
+
+	event, err := sdk.TypedEventToEvent(evt)
+	require.NoError(t, err)
+	return abci.Event(event)
+}
+
+func newTestOrderCreatedEvent(
+	sequence uint64, side types.Side, price string, quantity int64,
+) *types.EventOrderCreated {
+	return &types.EventOrderCreated{
+		Sequence:                  sequence,
+		RemainingBaseQuantity:     sdkmath.NewInt(quantity),
+		RemainingSpendableBalance: sdkmath.NewInt(quantity),
+		OrderBookID:               1,
+		BaseDenom:                 "denom1",
+		QuoteDenom:                "denom2",
+		Side:                      side,
+		Price:                     types.MustNewPriceFromString(price),
+		VisibleBaseQuantity:       sdkmath.NewInt(quantity),
+	}
+}
+
+func newTestOrderClosedEvent(sequence uint64, side types.Side, price string) *types.EventOrderClosed {
+	return &types.EventOrderClosed{
+		Sequence:                  sequence,
+		RemainingBaseQuantity:     sdkmath.ZeroInt(),
+		RemainingSpendableBalance: sdkmath.ZeroInt(),
+		OrderBookID:               1,
+		BaseDenom:                 "denom1",
+		QuoteDenom:                "denom2",
+		Side:                      side,
+		Price:                     types.MustNewPriceFromString(price),
+	}
+}
+
+func newTestPriceLevel(price string, quantity int64, ordersCount uint64) types.PriceLevel {
+	return types.PriceLevel{
+		Price:                 types.MustNewPriceFromString(price),
+		RemainingBaseQuantity: sdkmath.NewInt(quantity),
+		OrdersCount:           ordersCount,
+	}
+}
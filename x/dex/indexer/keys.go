@@ -0,0 +1,88 @@
+package indexer
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// DB key prefixes.
+var (
+	// lastIndexedHeightKey defines the key for the last indexed block height.
+	lastIndexedHeightKey = []byte{0x01}
+	// pairKeyPrefix defines the key prefix for the indexed denom pairs.
+	pairKeyPrefix = []byte{0x02}
+	// tradeKeyPrefix defines the key prefix for the trades.
+	tradeKeyPrefix = []byte{0x03}
+	// candleKeyPrefix defines the key prefix for the candles.
+	candleKeyPrefix = []byte{0x04}
+	// orderBookOrderKeyPrefix defines the key prefix for the orders tracked in the order books.
+	orderBookOrderKeyPrefix = []byte{0x05}
+	// priceLevelKeyPrefix defines the key prefix for the order book price levels.
+	priceLevelKeyPrefix = []byte{0x06}
+	// orderBookIDKeyPrefix defines the key prefix for the order book IDs of the denom pairs.
+	orderBookIDKeyPrefix = []byte{0x07}
+	// orderBookDataKeyPrefix defines the key prefix for the denoms of the order books.
+	orderBookDataKeyPrefix = []byte{0x08}
+)
+
+// storeTrue keeps a value used by the DB to indicate that key is present.
+var storeTrue = []byte{0x01}
+
+func createPairKey(baseDenom, quoteDenom string) ([]byte, error) {
+	key, err := store.JoinKeysWithLength([]byte(baseDenom), []byte(quoteDenom))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create pair key for %s/%s", baseDenom, quoteDenom)
+	}
+
+	return key, nil
+}
+
+func createTradeKeyPrefix(pairKey []byte) []byte {
+	return store.JoinKeys(tradeKeyPrefix, pairKey)
+}
+
+func createTradeKey(pairKey []byte, blockTime time.Time, height int64, index uint32) []byte {
+	key := createTradeKeyPrefix(pairKey)
+	key = store.AppendUint64ToOrderedBytes(key, uint64(blockTime.UnixNano()))
+	key = store.AppendUint64ToOrderedBytes(key, uint64(height))
+	return store.AppendUint32ToOrderedBytes(key, index)
+}
+
+func createCandleKeyPrefix(pairKey []byte, interval time.Duration) []byte {
+	key := store.JoinKeys(candleKeyPrefix, pairKey)
+	return store.AppendUint64ToOrderedBytes(key, uint64(interval/time.Second))
+}
+
+func createCandleKey(pairKey []byte, interval time.Duration, openTime time.Time) []byte {
+	return store.AppendUint64ToOrderedBytes(createCandleKeyPrefix(pairKey, interval), uint64(openTime.Unix()))
+}
+
+func createOrderBookOrderKey(orderSequence uint64) []byte {
+	return store.AppendUint64ToOrderedBytes(store.JoinKeys(orderBookOrderKeyPrefix), orderSequence)
+}
+
+func createPriceLevelSideKeyPrefix(orderBookID uint32, side types.Side) []byte {
+	key := store.AppendUint32ToOrderedBytes(store.JoinKeys(priceLevelKeyPrefix), orderBookID)
+	return store.AppendUint8ToOrderedBytes(key, uint8(side))
+}
+
+func createPriceLevelKey(orderBookID uint32, side types.Side, price types.Price) ([]byte, error) {
+	priceKey, err := price.MarshallToOrderedBytes()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal price %s", price.String())
+	}
+
+	return store.JoinKeys(createPriceLevelSideKeyPrefix(orderBookID, side), priceKey), nil
+}
+
+func createOrderBookIDKey(pairKey []byte) []byte {
+	return store.JoinKeys(orderBookIDKeyPrefix, pairKey)
+}
+
+func createOrderBookDataKey(orderBookID uint32) []byte {
+	return store.AppendUint32ToOrderedBytes(store.JoinKeys(orderBookDataKeyPrefix), orderBookID)
+}
@@ -0,0 +1,546 @@
+package indexer
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// orderBookUpdatesBufferSize is the number of the order book updates buffered for the stream subscriber, the
+// subscriber is dropped if it doesn't keep up with the updates.
+const orderBookUpdatesBufferSize = 100
+
+var orderBookEventNames = map[string]struct{}{
+	proto.MessageName(&types.EventOrderCreated{}):       {},
+	proto.MessageName(&types.EventOrderReduced{}):       {},
+	proto.MessageName(&types.EventOrderClosed{}):        {},
+	proto.MessageName(&types.EventOrderRefreshed{}):     {},
+	proto.MessageName(&types.EventOrderReplaced{}):      {},
+	proto.MessageName(&types.EventSelfTradePrevented{}): {},
+}
+
+type orderBookSubscription struct {
+	baseDenom  string
+	quoteDenom string
+	pairKey    string
+	updates    chan types.OrderBookUpdate
+}
+
+type priceLevelRef struct {
+	orderBookID uint32
+	side        types.Side
+	price       types.Price
+}
+
+// orderBooksCache caches the order book changes of the block, since the batch isn't readable.
+type orderBooksCache struct {
+	idx *Indexer
+	// orders contains the updated orders, the nil order is deleted
+	orders map[uint64]*types.IndexedOrderBookOrder
+	// levels contains the updated price levels, the level without orders is deleted
+	levels map[string]types.PriceLevel
+	// changedLevels contains the references to the updated price levels
+	changedLevels map[string]priceLevelRef
+	// orderBooks contains the order books updated in the block
+	orderBooks map[uint32]types.OrderBookData
+}
+
+func newOrderBooksCache(idx *Indexer) *orderBooksCache {
+	return &orderBooksCache{
+		idx:           idx,
+		orders:        make(map[uint64]*types.IndexedOrderBookOrder),
+		levels:        make(map[string]types.PriceLevel),
+		changedLevels: make(map[string]priceLevelRef),
+		orderBooks:    make(map[uint32]types.OrderBookData),
+	}
+}
+
+// SubscribeOrderBook subscribes to the updates of the order book price levels. It returns the current snapshot of the
+// order book, and the channel of the next updates, which is closed if the subscriber doesn't keep up with the updates.
+func (idx *Indexer) SubscribeOrderBook(
+	baseDenom, quoteDenom string,
+) (types.OrderBookUpdate, <-chan types.OrderBookUpdate, func(), error) {
+	pairKey, err := createPairKey(baseDenom, quoteDenom)
+	if err != nil {
+		return types.OrderBookUpdate{}, nil, nil, err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	lastIndexedHeight, err := idx.getLastIndexedHeight()
+	if err != nil {
+		return types.OrderBookUpdate{}, nil, nil, err
+	}
+	snapshot, err := idx.getOrderBookSnapshot(lastIndexedHeight, baseDenom, quoteDenom)
+	if err != nil {
+		return types.OrderBookUpdate{}, nil, nil, err
+	}
+
+	id := idx.nextSubscriptionID
+	idx.nextSubscriptionID++
+	sub := &orderBookSubscription{
+		baseDenom:  baseDenom,
+		quoteDenom: quoteDenom,
+		pairKey:    string(pairKey),
+		updates:    make(chan types.OrderBookUpdate, orderBookUpdatesBufferSize),
+	}
+	idx.subscriptions[id] = sub
+
+	unsubscribe := func() {
+		idx.mu.Lock()
+		defer idx.mu.Unlock()
+
+		if _, ok := idx.subscriptions[id]; ok {
+			delete(idx.subscriptions, id)
+			close(sub.updates)
+		}
+	}
+
+	return snapshot, sub.updates, unsubscribe, nil
+}
+
+func (idx *Indexer) indexOrderBooks(batch dbm.Batch, events []abci.Event) (*orderBooksCache, error) {
+	cache := newOrderBooksCache(idx)
+	for _, evt := range events {
+		if _, ok := orderBookEventNames[evt.Type]; !ok {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(evt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", evt.Type)
+		}
+		if err := cache.applyEvent(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cache.write(batch); err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+func (idx *Indexer) publishOrderBookUpdates(height int64, cache *orderBooksCache) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if len(idx.subscriptions) == 0 {
+		return nil
+	}
+
+	// the updates are mapped by the pair key
+	updates := make(map[string]types.OrderBookUpdate)
+	if uint64(height)%idx.cfg.OrderBookSnapshotInterval == 0 {
+		for _, sub := range idx.subscriptions {
+			if _, ok := updates[sub.pairKey]; ok {
+				continue
+			}
+			snapshot, err := idx.getOrderBookSnapshot(height, sub.baseDenom, sub.quoteDenom)
+			if err != nil {
+				return err
+			}
+			updates[sub.pairKey] = snapshot
+		}
+	} else {
+		diffs, err := cache.diffs(height)
+		if err != nil {
+			return err
+		}
+		for _, diff := range diffs {
+			pairKey, err := createPairKey(diff.BaseDenom, diff.QuoteDenom)
+			if err != nil {
+				return err
+			}
+			updates[string(pairKey)] = diff
+		}
+	}
+
+	for id, sub := range idx.subscriptions {
+		update, ok := updates[sub.pairKey]
+		if !ok {
+			continue
+		}
+		select {
+		case sub.updates <- update:
+		default:
+			idx.logger.Info(
+				"Dropping slow order book stream subscriber.", "baseDenom", sub.baseDenom, "quoteDenom", sub.quoteDenom,
+			)
+			delete(idx.subscriptions, id)
+			close(sub.updates)
+		}
+	}
+
+	return nil
+}
+
+func (idx *Indexer) closeSubscriptions() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for id, sub := range idx.subscriptions {
+		delete(idx.subscriptions, id)
+		close(sub.updates)
+	}
+}
+
+func (idx *Indexer) getOrderBookSnapshot(height int64, baseDenom, quoteDenom string) (types.OrderBookUpdate, error) {
+	snapshot := types.OrderBookUpdate{
+		Height:     height,
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+		Snapshot:   true,
+		Bids:       make([]types.PriceLevel, 0),
+		Asks:       make([]types.PriceLevel, 0),
+	}
+
+	orderBookID, found, err := idx.getOrderBookID(baseDenom, quoteDenom)
+	if err != nil {
+		return types.OrderBookUpdate{}, err
+	}
+	if !found {
+		return snapshot, nil
+	}
+	snapshot.OrderBookID = orderBookID
+
+	snapshot.Bids, err = idx.getPriceLevels(orderBookID, types.SIDE_BUY)
+	if err != nil {
+		return types.OrderBookUpdate{}, err
+	}
+	snapshot.Asks, err = idx.getPriceLevels(orderBookID, types.SIDE_SELL)
+	if err != nil {
+		return types.OrderBookUpdate{}, err
+	}
+
+	return snapshot, nil
+}
+
+// getPriceLevels returns the price levels of the order book side starting from the best price.
+func (idx *Indexer) getPriceLevels(orderBookID uint32, side types.Side) ([]types.PriceLevel, error) {
+	prefix := createPriceLevelSideKeyPrefix(orderBookID, side)
+	var (
+		iterator dbm.Iterator
+		err      error
+	)
+	// the bids are sorted by price descending, and the asks are sorted by price ascending
+	if side == types.SIDE_BUY {
+		iterator, err = idx.db.ReverseIterator(prefix, storetypes.PrefixEndBytes(prefix))
+	} else {
+		iterator, err = idx.db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate price levels")
+	}
+	defer iterator.Close()
+
+	levels := make([]types.PriceLevel, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var level types.PriceLevel
+		if err := idx.cdc.Unmarshal(iterator.Value(), &level); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal price level")
+		}
+		levels = append(levels, level)
+	}
+
+	return levels, iterator.Error()
+}
+
+func (idx *Indexer) getOrderBookID(baseDenom, quoteDenom string) (uint32, bool, error) {
+	pairKey, err := createPairKey(baseDenom, quoteDenom)
+	if err != nil {
+		return 0, false, err
+	}
+	bz, err := idx.db.Get(createOrderBookIDKey(pairKey))
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to get order book ID")
+	}
+	if bz == nil {
+		return 0, false, nil
+	}
+
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(bz)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to read order book ID")
+	}
+
+	return orderBookID, true, nil
+}
+
+func (c *orderBooksCache) applyEvent(msg proto.Message) error {
+	switch evt := msg.(type) {
+	case *types.EventOrderCreated:
+		c.orderBooks[evt.OrderBookID] = types.OrderBookData{BaseDenom: evt.BaseDenom, QuoteDenom: evt.QuoteDenom}
+		return c.addOrder(evt.Sequence, types.IndexedOrderBookOrder{
+			OrderBookID:         evt.OrderBookID,
+			Side:                evt.Side,
+			Price:               evt.Price,
+			VisibleBaseQuantity: evt.VisibleBaseQuantity,
+		})
+	case *types.EventOrderReduced:
+		// the reduced taker order which isn't saved to the order book, and the maker order which is closed or
+		// refreshed in the same matching, aren't tracked
+		return c.updateOrderQuantity(evt.Sequence, evt.VisibleBaseQuantity)
+	case *types.EventOrderClosed:
+		return c.removeOrder(evt.Sequence)
+	case *types.EventOrderRefreshed:
+		if err := c.removeOrder(evt.PreviousSequence); err != nil {
+			return err
+		}
+		return c.addOrder(evt.Sequence, types.IndexedOrderBookOrder{
+			OrderBookID:         evt.OrderBookID,
+			Side:                evt.Side,
+			Price:               evt.Price,
+			VisibleBaseQuantity: evt.RemainingBaseQuantity,
+		})
+	case *types.EventOrderReplaced:
+		// the order replaced without the priority is closed and created again
+		if !evt.PriorityKept {
+			return nil
+		}
+		return c.updateOrderQuantity(evt.Sequence, evt.RemainingBaseQuantity)
+	case *types.EventSelfTradePrevented:
+		if evt.MakerCanceled || !evt.MakerVisibleBaseQuantity.IsPositive() {
+			return nil
+		}
+		return c.updateOrderQuantity(evt.MakerOrderSequence, evt.MakerVisibleBaseQuantity)
+	default:
+		return errors.Errorf("unexpected event type %T", msg)
+	}
+}
+
+func (c *orderBooksCache) addOrder(orderSequence uint64, order types.IndexedOrderBookOrder) error {
+	c.orders[orderSequence] = &order
+	return c.updateLevel(order, order.VisibleBaseQuantity, 1)
+}
+
+func (c *orderBooksCache) removeOrder(orderSequence uint64) error {
+	order, found, err := c.getOrder(orderSequence)
+	if err != nil || !found {
+		return err
+	}
+	c.orders[orderSequence] = nil
+
+	return c.updateLevel(order, order.VisibleBaseQuantity.Neg(), -1)
+}
+
+func (c *orderBooksCache) updateOrderQuantity(orderSequence uint64, visibleBaseQuantity sdkmath.Int) error {
+	order, found, err := c.getOrder(orderSequence)
+	if err != nil || !found {
+		return err
+	}
+	quantityDelta := visibleBaseQuantity.Sub(order.VisibleBaseQuantity)
+	order.VisibleBaseQuantity = visibleBaseQuantity
+	c.orders[orderSequence] = &order
+
+	return c.updateLevel(order, quantityDelta, 0)
+}
+
+func (c *orderBooksCache) getOrder(orderSequence uint64) (types.IndexedOrderBookOrder, bool, error) {
+	if order, ok := c.orders[orderSequence]; ok {
+		if order == nil {
+			return types.IndexedOrderBookOrder{}, false, nil
+		}
+		return *order, true, nil
+	}
+
+	bz, err := c.idx.db.Get(createOrderBookOrderKey(orderSequence))
+	if err != nil {
+		return types.IndexedOrderBookOrder{}, false, errors.Wrap(err, "failed to get order book order")
+	}
+	// the orders placed before the indexer is enabled aren't tracked
+	if bz == nil {
+		return types.IndexedOrderBookOrder{}, false, nil
+	}
+
+	var order types.IndexedOrderBookOrder
+	if err := c.idx.cdc.Unmarshal(bz, &order); err != nil {
+		return types.IndexedOrderBookOrder{}, false, errors.Wrap(err, "failed to unmarshal order book order")
+	}
+
+	return order, true, nil
+}
+
+func (c *orderBooksCache) updateLevel(
+	order types.IndexedOrderBookOrder,
+	quantityDelta sdkmath.Int,
+	ordersCountDelta int64,
+) error {
+	levelKey, err := createPriceLevelKey(order.OrderBookID, order.Side, order.Price)
+	if err != nil {
+		return err
+	}
+
+	level, ok := c.levels[string(levelKey)]
+	if !ok {
+		level, err = c.getLevel(levelKey, order.Price)
+		if err != nil {
+			return err
+		}
+	}
+	level.RemainingBaseQuantity = level.RemainingBaseQuantity.Add(quantityDelta)
+	level.OrdersCount = uint64(int64(level.OrdersCount) + ordersCountDelta)
+
+	c.levels[string(levelKey)] = level
+	c.changedLevels[string(levelKey)] = priceLevelRef{
+		orderBookID: order.OrderBookID,
+		side:        order.Side,
+		price:       order.Price,
+	}
+
+	return nil
+}
+
+func (c *orderBooksCache) getLevel(levelKey []byte, price types.Price) (types.PriceLevel, error) {
+	bz, err := c.idx.db.Get(levelKey)
+	if err != nil {
+		return types.PriceLevel{}, errors.Wrap(err, "failed to get price level")
+	}
+	if bz == nil {
+		return types.PriceLevel{
+			Price:                 price,
+			RemainingBaseQuantity: sdkmath.ZeroInt(),
+		}, nil
+	}
+
+	var level types.PriceLevel
+	if err := c.idx.cdc.Unmarshal(bz, &level); err != nil {
+		return types.PriceLevel{}, errors.Wrap(err, "failed to unmarshal price level")
+	}
+
+	return level, nil
+}
+
+func (c *orderBooksCache) write(batch dbm.Batch) error {
+	for orderSequence, order := range c.orders {
+		key := createOrderBookOrderKey(orderSequence)
+		if order == nil {
+			if err := batch.Delete(key); err != nil {
+				return errors.Wrap(err, "failed to delete order book order")
+			}
+			continue
+		}
+		orderBytes, err := c.idx.cdc.Marshal(order)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal order book order")
+		}
+		if err := batch.Set(key, orderBytes); err != nil {
+			return errors.Wrap(err, "failed to set order book order")
+		}
+	}
+
+	for key, level := range c.levels {
+		if level.OrdersCount == 0 {
+			if err := batch.Delete([]byte(key)); err != nil {
+				return errors.Wrap(err, "failed to delete price level")
+			}
+			continue
+		}
+		levelBytes, err := c.idx.cdc.Marshal(&level)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal price level")
+		}
+		if err := batch.Set([]byte(key), levelBytes); err != nil {
+			return errors.Wrap(err, "failed to set price level")
+		}
+	}
+
+	for orderBookID, data := range c.orderBooks {
+		pairKey, err := createPairKey(data.BaseDenom, data.QuoteDenom)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(
+			createOrderBookIDKey(pairKey), store.AppendUint32ToOrderedBytes(nil, orderBookID),
+		); err != nil {
+			return errors.Wrap(err, "failed to set order book ID")
+		}
+		dataBytes, err := c.idx.cdc.Marshal(&data)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal order book data")
+		}
+		if err := batch.Set(createOrderBookDataKey(orderBookID), dataBytes); err != nil {
+			return errors.Wrap(err, "failed to set order book data")
+		}
+	}
+
+	return nil
+}
+
+// diffs returns the changed price levels of the block grouped by the order book, the removed levels have the zero
+// quantity and orders count.
+func (c *orderBooksCache) diffs(height int64) ([]types.OrderBookUpdate, error) {
+	updates := make(map[uint32]*types.OrderBookUpdate)
+	for key, ref := range c.changedLevels {
+		update, ok := updates[ref.orderBookID]
+		if !ok {
+			data, err := c.getOrderBookData(ref.orderBookID)
+			if err != nil {
+				return nil, err
+			}
+			update = &types.OrderBookUpdate{
+				Height:      height,
+				OrderBookID: ref.orderBookID,
+				BaseDenom:   data.BaseDenom,
+				QuoteDenom:  data.QuoteDenom,
+				Bids:        make([]types.PriceLevel, 0),
+				Asks:        make([]types.PriceLevel, 0),
+			}
+			updates[ref.orderBookID] = update
+		}
+
+		level := c.levels[key]
+		if level.OrdersCount == 0 {
+			level.RemainingBaseQuantity = sdkmath.ZeroInt()
+		}
+		if ref.side == types.SIDE_BUY {
+			update.Bids = append(update.Bids, level)
+		} else {
+			update.Asks = append(update.Asks, level)
+		}
+	}
+
+	result := make([]types.OrderBookUpdate, 0, len(updates))
+	for _, update := range updates {
+		sort.Slice(update.Bids, func(i, j int) bool {
+			return update.Bids[i].Price.Rat().Cmp(update.Bids[j].Price.Rat()) > 0
+		})
+		sort.Slice(update.Asks, func(i, j int) bool {
+			return update.Asks[i].Price.Rat().Cmp(update.Asks[j].Price.Rat()) < 0
+		})
+		result = append(result, *update)
+	}
+
+	return result, nil
+}
+
+func (c *orderBooksCache) getOrderBookData(orderBookID uint32) (types.OrderBookData, error) {
+	if data, ok := c.orderBooks[orderBookID]; ok {
+		return data, nil
+	}
+
+	bz, err := c.idx.db.Get(createOrderBookDataKey(orderBookID))
+	if err != nil {
+		return types.OrderBookData{}, errors.Wrap(err, "failed to get order book data")
+	}
+	if bz == nil {
+		return types.OrderBookData{}, errors.Errorf("order book %d isn't indexed", orderBookID)
+	}
+
+	var data types.OrderBookData
+	if err := c.idx.cdc.Unmarshal(bz, &data); err != nil {
+		return types.OrderBookData{}, errors.Wrap(err, "failed to unmarshal order book data")
+	}
+
+	return data, nil
+}
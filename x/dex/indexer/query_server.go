@@ -0,0 +1,148 @@
+package indexer
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+var _ types.IndexerServer = QueryService{}
+
+// QueryService serves grpc query requests of the indexer.
+type QueryService struct {
+	indexer *Indexer
+}
+
+// NewQueryService initiates the new instance of query service. The nil indexer means that the indexer is disabled.
+func NewQueryService(indexer *Indexer) QueryService {
+	return QueryService{
+		indexer: indexer,
+	}
+}
+
+// Trades queries the executed trades of the order book.
+func (qs QueryService) Trades(
+	_ context.Context,
+	req *types.QueryTradesRequest,
+) (*types.QueryTradesResponse, error) {
+	if qs.indexer == nil {
+		return nil, errors.New("dex indexer is disabled")
+	}
+
+	pairKey, err := createPairKey(req.BaseDenom, req.QuoteDenom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	trades, pageRes, err := query.GenericFilteredPaginate(
+		qs.indexer.cdc,
+		prefix.NewStore(dbadapter.Store{DB: qs.indexer.db}, createTradeKeyPrefix(pairKey)),
+		req.Pagination,
+		func(_ []byte, record *types.IndexedTrade) (*types.IndexedTrade, error) {
+			return record, nil
+		},
+		func() *types.IndexedTrade {
+			return &types.IndexedTrade{}
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to paginate trades")
+	}
+
+	return &types.QueryTradesResponse{
+		Trades: lo.Map(trades, func(trade *types.IndexedTrade, _ int) types.IndexedTrade {
+			return *trade
+		}),
+		Pagination: pageRes,
+	}, nil
+}
+
+// Candles queries the OHLCV candles of the order book.
+func (qs QueryService) Candles(
+	_ context.Context,
+	req *types.QueryCandlesRequest,
+) (*types.QueryCandlesResponse, error) {
+	if qs.indexer == nil {
+		return nil, errors.New("dex indexer is disabled")
+	}
+
+	if !lo.Contains(qs.indexer.cfg.CandleIntervals, req.Interval) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"candle interval %s is not indexed, indexed intervals: %v",
+			req.Interval, qs.indexer.cfg.CandleIntervals,
+		)
+	}
+
+	pairKey, err := createPairKey(req.BaseDenom, req.QuoteDenom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	candles, pageRes, err := query.GenericFilteredPaginate(
+		qs.indexer.cdc,
+		prefix.NewStore(dbadapter.Store{DB: qs.indexer.db}, createCandleKeyPrefix(pairKey, req.Interval)),
+		req.Pagination,
+		func(_ []byte, record *types.Candle) (*types.Candle, error) {
+			return record, nil
+		},
+		func() *types.Candle {
+			return &types.Candle{}
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to paginate candles")
+	}
+
+	return &types.QueryCandlesResponse{
+		Candles: lo.Map(candles, func(candle *types.Candle, _ int) types.Candle {
+			return *candle
+		}),
+		Pagination: pageRes,
+	}, nil
+}
+
+// StreamOrderBook streams the price levels of the order book.
+func (qs QueryService) StreamOrderBook(
+	req *types.StreamOrderBookRequest,
+	stream types.Indexer_StreamOrderBookServer,
+) error {
+	if qs.indexer == nil {
+		return errors.New("dex indexer is disabled")
+	}
+
+	if req.BaseDenom == "" || req.QuoteDenom == "" {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "base and quote denoms must be set")
+	}
+
+	snapshot, updates, unsubscribe, err := qs.indexer.SubscribeOrderBook(req.BaseDenom, req.QuoteDenom)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+	defer unsubscribe()
+
+	if err := stream.Send(&snapshot); err != nil {
+		return errors.Wrap(err, "failed to send order book snapshot")
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return errors.New("order book stream is closed, the subscriber is too slow or the node is stopping")
+			}
+			if err := stream.Send(&update); err != nil {
+				return errors.Wrap(err, "failed to send order book update")
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// DeadManSwitchKeeper is keeper interface required for CancelDeadManSwitchOrders.
+type DeadManSwitchKeeper interface {
+	ExecuteDeadManSwitch(ctx sdk.Context, acc sdk.AccAddress, cancellation types.CancelDeadManSwitchOrders) error
+}
+
+// NewDelayDeadManSwitchHandler handles the dead man's switch orders cancellation.
+func NewDelayDeadManSwitchHandler(keeper DeadManSwitchKeeper) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.CancelDeadManSwitchOrders)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+		creator, err := sdk.AccAddressFromBech32(msg.Creator)
+		if err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid creator")
+		}
+
+		return keeper.ExecuteDeadManSwitch(ctx, creator, *msg)
+	}
+}
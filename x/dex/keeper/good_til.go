@@ -1,14 +1,61 @@
 package keeper
 
 import (
+	"math"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
 
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
 	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
+// SweepExpiredOrders cancels the orders with the reached good til block height or time at the end of the block. The
+// sweep is limited by the gas limit param, and the remaining expired orders are cancelled in the next blocks. The
+// failed cancellations are logged and their expirations are removed to not fail the block.
+func (k Keeper) SweepExpiredOrders(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	sweepCtx := ctx.WithGasMeter(gasMeter)
+	expirations := []struct {
+		keyPrefix []byte
+		until     uint64
+	}{
+		{keyPrefix: types.OrderExpirationByHeightKeyPrefix, until: uint64(ctx.BlockHeight())},
+		{keyPrefix: types.OrderExpirationByTimeKeyPrefix, until: uint64(ctx.BlockTime().Unix())},
+	}
+	for _, expiration := range expirations {
+		for {
+			if gasMeter.GasConsumed() >= params.OrderExpirationSweepGasLimit {
+				k.logger(ctx).Debug(
+					"Order expiration sweep gas limit is reached.",
+					"gasConsumed", gasMeter.GasConsumed(),
+					"gasLimit", params.OrderExpirationSweepGasLimit,
+				)
+				return nil
+			}
+			cancelled, err := k.cancelNextExpiredOrder(sweepCtx, expiration.keyPrefix, expiration.until)
+			if err != nil {
+				return err
+			}
+			if !cancelled {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 func validateGoodTil(ctx sdk.Context, order types.Order) error {
 	if order.GoodTil.GoodTilBlockHeight > 0 {
 		currentHeight := ctx.BlockHeight()
@@ -30,75 +77,198 @@ func validateGoodTil(ctx sdk.Context, order types.Order) error {
 			)
 		}
 	}
+	if order.GoodTil.GoodTilBlocks > math.MaxUint64-uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "good til blocks %d is too big", order.GoodTil.GoodTilBlocks)
+	}
 
 	return nil
 }
 
-func (k Keeper) delayGoodTilCancellation(
-	ctx sdk.Context,
-	goodTil types.GoodTil,
-	orderSequence uint64,
-	creator sdk.AccAddress,
-) error {
-	if goodTil.GoodTilBlockHeight > 0 {
-		return k.delayGoodTilBlockHeightCancellation(ctx, goodTil.GoodTilBlockHeight, orderSequence, creator)
+// resolveGoodTil converts the relative good til settings of the order to the absolute ones and limits the good til
+// block time of the order, which might be saved to the order book, by the max order lifetime.
+func resolveGoodTil(ctx sdk.Context, params types.Params, order types.Order) *types.GoodTil {
+	if order.Type != types.ORDER_TYPE_LIMIT {
+		return order.GoodTil
 	}
-	if goodTil.GoodTilBlockTime != nil {
-		return k.delayGoodTilBlockTimeCancellation(ctx, *goodTil.GoodTilBlockTime, orderSequence, creator)
+
+	var goodTil *types.GoodTil
+	if order.GoodTil != nil {
+		goodTil = &types.GoodTil{
+			GoodTilBlockHeight: order.GoodTil.GoodTilBlockHeight,
+			GoodTilBlockTime:   order.GoodTil.GoodTilBlockTime,
+		}
+		if order.GoodTil.GoodTilBlocks > 0 {
+			goodTil.GoodTilBlockHeight = uint64(ctx.BlockHeight()) + order.GoodTil.GoodTilBlocks
+		}
+		if order.GoodTil.GoodTilDuration != nil {
+			goodTil.GoodTilBlockTime = lo.ToPtr(ctx.BlockTime().Add(*order.GoodTil.GoodTilDuration))
+		}
 	}
 
-	return nil
+	if params.MaxOrderLifetime > 0 &&
+		(order.TimeInForce == types.TIME_IN_FORCE_GTC || order.TimeInForce == types.TIME_IN_FORCE_POST_ONLY) {
+		maxGoodTilBlockTime := ctx.BlockTime().Add(params.MaxOrderLifetime)
+		if goodTil == nil {
+			goodTil = &types.GoodTil{}
+		}
+		if goodTil.GoodTilBlockTime == nil || goodTil.GoodTilBlockTime.After(maxGoodTilBlockTime) {
+			goodTil.GoodTilBlockTime = &maxGoodTilBlockTime
+		}
+	}
+
+	return goodTil
 }
 
-func (k Keeper) delayGoodTilBlockHeightCancellation(
+func (k Keeper) saveOrderExpiration(
 	ctx sdk.Context,
-	height uint64,
+	goodTil types.GoodTil,
 	orderSequence uint64,
 	creator sdk.AccAddress,
 ) error {
 	k.logger(ctx).Debug(
-		"Delaying good til height cancellation.",
+		"Saving order expiration.",
 		"orderSequence", orderSequence,
-		"height", height,
+		"goodTil", goodTil.String(),
 		"creator", creator.String(),
 	)
-	if err := k.delayKeeper.ExecuteAfterBlock(
-		ctx,
-		types.BuildGoodTilBlockHeightDelayKey(orderSequence),
-		&types.CancelGoodTil{
-			Creator:       creator.String(),
-			OrderSequence: orderSequence,
-		},
-		height,
-	); err != nil {
-		return sdkerrors.Wrap(err, "failed to create good til height delayed cancellation")
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	if goodTil.GoodTilBlockHeight > 0 {
+		if err := moduleStore.Set(
+			types.CreateOrderExpirationByHeightKey(goodTil.GoodTilBlockHeight, orderSequence), creator,
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to save good til height order expiration")
+		}
+	}
+	if goodTil.GoodTilBlockTime != nil {
+		if err := moduleStore.Set(
+			types.CreateOrderExpirationByTimeKey(*goodTil.GoodTilBlockTime, orderSequence), creator,
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to save good til time order expiration")
+		}
 	}
 
 	return nil
 }
 
-func (k Keeper) delayGoodTilBlockTimeCancellation(
-	ctx sdk.Context,
-	time time.Time,
-	orderSequence uint64,
-	creator sdk.AccAddress,
-) error {
-	k.logger(ctx).Debug(
-		"Delaying good til time cancellation.",
-		"orderSequence", orderSequence,
-		"time", time,
-		"creator", creator.String(),
-	)
-	if err := k.delayKeeper.ExecuteAfter(
-		ctx,
-		types.BuildGoodTilBlockTimeDelayKey(orderSequence),
-		&types.CancelGoodTil{
-			Creator:       creator.String(),
-			OrderSequence: orderSequence,
-		},
-		time,
-	); err != nil {
-		return sdkerrors.Wrap(err, "failed to create good til time delayed cancellation")
+func (k Keeper) removeOrderExpiration(ctx sdk.Context, goodTil types.GoodTil, orderSequence uint64) error {
+	k.logger(ctx).Debug("Removing order expiration.", "orderSequence", orderSequence, "goodTil", goodTil.String())
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	if goodTil.GoodTilBlockHeight > 0 {
+		if err := moduleStore.Delete(
+			types.CreateOrderExpirationByHeightKey(goodTil.GoodTilBlockHeight, orderSequence),
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to remove good til height order expiration")
+		}
+	}
+	if goodTil.GoodTilBlockTime != nil {
+		if err := moduleStore.Delete(
+			types.CreateOrderExpirationByTimeKey(*goodTil.GoodTilBlockTime, orderSequence),
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to remove good til time order expiration")
+		}
+	}
+
+	return nil
+}
+
+// cancelNextExpiredOrder cancels the first order of the expiration index expired at or before the until value, and
+// returns false if there is no such order. The cancellation is executed in the cache context, so the failed one doesn't
+// change the state.
+func (k Keeper) cancelNextExpiredOrder(ctx sdk.Context, keyPrefix []byte, until uint64) (bool, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	expirationStore := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), keyPrefix)
+
+	iterator := expirationStore.Iterator(nil, store.AppendUint64ToOrderedBytes(nil, until+1))
+	if !iterator.Valid() {
+		return false, iterator.Close()
+	}
+	key := iterator.Key()
+	creator := sdk.AccAddress(iterator.Value())
+	if err := iterator.Close(); err != nil {
+		return false, err
+	}
+
+	// the key is removed explicitly to guarantee the sweep progress, the order cancellation removes it as well
+	expirationStore.Delete(key)
+
+	_, orderSequence, err := types.DecodeOrderExpirationKey(key)
+	if err != nil {
+		k.logger(ctx).Error("Failed to decode order expiration key.", "key", key, "err", err)
+		return true, nil
+	}
+	k.logger(ctx).Debug("Cancelling expired order.", "orderSequence", orderSequence, "creator", creator.String())
+
+	// the failed cancellation is skipped to not halt the chain by the end blocker
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.cancelOrderBySequence(cacheCtx, creator, orderSequence); err != nil {
+		k.logger(ctx).Error(
+			"Failed to cancel expired order.",
+			"orderSequence", orderSequence,
+			"creator", creator.String(),
+			"err", err,
+		)
+		return true, nil
+	}
+	writeCache()
+
+	return true, nil
+}
+
+// migrateGoodTilDelays moves the good til cancellations of the saved orders from the delay module to the order
+// expiration index.
+func (k Keeper) migrateGoodTilDelays(ctx sdk.Context) error {
+	type orderGoodTil struct {
+		orderSequence uint64
+		goodTil       types.GoodTil
+		creator       sdk.AccAddress
+	}
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OrderKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	ordersGoodTil := make([]orderGoodTil, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var orderData types.OrderData
+		if err := k.cdc.Unmarshal(iterator.Value(), &orderData); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal order data: %s", err)
+		}
+		if orderData.GoodTil == nil {
+			continue
+		}
+
+		orderSequence, _, err := store.ReadOrderedBytesToUint64(iterator.Key())
+		if err != nil {
+			return err
+		}
+		record, err := k.getOrderBookRecord(
+			ctx, orderData.OrderBookID, orderData.Side, orderData.Price, orderSequence,
+		)
+		if err != nil {
+			return err
+		}
+		creator, err := cachedAccKeeper.GetAccountAddress(ctx, record.AccountNumber)
+		if err != nil {
+			return err
+		}
+		ordersGoodTil = append(ordersGoodTil, orderGoodTil{
+			orderSequence: orderSequence,
+			goodTil:       *orderData.GoodTil,
+			creator:       creator,
+		})
+	}
+
+	// the store is updated after the iteration is finished
+	for _, order := range ordersGoodTil {
+		if err := k.removeGoodTilDelay(ctx, order.goodTil, order.orderSequence); err != nil {
+			return err
+		}
+		if err := k.saveOrderExpiration(ctx, order.goodTil, order.orderSequence, order.creator); err != nil {
+			return err
+		}
 	}
 
 	return nil
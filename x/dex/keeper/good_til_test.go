@@ -7,10 +7,12 @@ import (
 	"cosmossdk.io/log"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
 	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
@@ -343,7 +345,8 @@ func TestKeeper_GoodTil(t *testing.T) {
 				}
 			},
 			startHeight: 300,
-			endHeight:   343,
+			// the order is active in the good til block height and is removed at the end of that block
+			endHeight: 342,
 		},
 		{
 			name: "no_match_with_good_til_block_height_remove_from_max_height",
@@ -592,3 +595,225 @@ func TestKeeper_GoodTil(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_GoodTilRelativeAndMaxOrderLifetime(t *testing.T) {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Time:   blockTime,
+		Height: 100,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MaxOrderLifetime = time.Hour
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	maxGoodTilBlockTime := blockTime.Add(time.Hour)
+	tests := []struct {
+		id          string
+		goodTil     *types.GoodTil
+		wantGoodTil *types.GoodTil
+	}{
+		{
+			id:      "blocks",
+			goodTil: &types.GoodTil{GoodTilBlocks: 10},
+			wantGoodTil: &types.GoodTil{
+				GoodTilBlockHeight: 110,
+				GoodTilBlockTime:   &maxGoodTilBlockTime,
+			},
+		},
+		{
+			id:          "duration",
+			goodTil:     &types.GoodTil{GoodTilDuration: lo.ToPtr(10 * time.Minute)},
+			wantGoodTil: &types.GoodTil{GoodTilBlockTime: lo.ToPtr(blockTime.Add(10 * time.Minute))},
+		},
+		{
+			id:          "no_good_til",
+			wantGoodTil: &types.GoodTil{GoodTilBlockTime: &maxGoodTilBlockTime},
+		},
+		{
+			id:          "time_after_max_lifetime",
+			goodTil:     &types.GoodTil{GoodTilBlockTime: lo.ToPtr(blockTime.Add(2 * time.Hour))},
+			wantGoodTil: &types.GoodTil{GoodTilBlockTime: &maxGoodTilBlockTime},
+		},
+	}
+	for _, tt := range tests {
+		order := types.Order{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          tt.id,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("376e-3")),
+			Quantity:    defaultQuantityStep.MulRaw(10),
+			Side:        types.SIDE_SELL,
+			GoodTil:     tt.goodTil,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+		balance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(balance))
+		fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+
+		storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, tt.id)
+		require.NoError(t, err)
+		require.Equal(t, tt.wantGoodTil, storedOrder.GoodTil, tt.id)
+	}
+
+	// the order expired by the block height is removed
+	sdkCtx = sdkCtx.WithBlockHeight(110).WithBlockTime(blockTime.Add(time.Minute))
+	require.NoError(t, dexKeeper.SweepExpiredOrders(sdkCtx))
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, "blocks")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, "duration")
+	require.NoError(t, err)
+
+	// the order expired by the block time is removed
+	sdkCtx = sdkCtx.WithBlockHeight(111).WithBlockTime(blockTime.Add(10 * time.Minute))
+	require.NoError(t, dexKeeper.SweepExpiredOrders(sdkCtx))
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, "duration")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// the orders reached the max lifetime are removed
+	sdkCtx = sdkCtx.WithBlockHeight(112).WithBlockTime(maxGoodTilBlockTime)
+	require.NoError(t, dexKeeper.SweepExpiredOrders(sdkCtx))
+	orders, _, err := dexKeeper.GetOrders(sdkCtx, testSet.acc1, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Empty(t, orders)
+}
+
+func TestKeeper_SweepExpiredOrdersGasLimit(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Time:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Height: 100,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	// the limit is reached by the first cancellation
+	params.OrderExpirationSweepGasLimit = 1
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	for _, id := range []string{"id1", "id2", "id3"} {
+		order := types.Order{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("376e-3")),
+			Quantity:    defaultQuantityStep.MulRaw(10),
+			Side:        types.SIDE_SELL,
+			GoodTil:     &types.GoodTil{GoodTilBlockHeight: 101},
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+		balance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(balance))
+		fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	// the expired orders are removed one by one in the next blocks
+	for i := range 3 {
+		sdkCtx = sdkCtx.WithBlockHeight(int64(101 + i))
+		require.NoError(t, dexKeeper.SweepExpiredOrders(sdkCtx))
+		orders, _, err := dexKeeper.GetOrders(sdkCtx, testSet.acc1, &query.PageRequest{})
+		require.NoError(t, err)
+		require.Len(t, orders, 2-i)
+	}
+}
+
+func TestKeeper_SweepExpiredOrdersFailedCancellation(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Time:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Height: 100,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	order := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("376e-3")),
+		Quantity:    defaultQuantityStep.MulRaw(10),
+		Side:        types.SIDE_SELL,
+		GoodTil:     &types.GoodTil{GoodTilBlockHeight: 101},
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeTestOrder(t, testApp, sdkCtx, order)
+
+	// the expiration of the missing order is swept before the placed order expiration
+	missingOrderExpirationKey := types.CreateOrderExpirationByHeightKey(101, 0)
+	dexStore := sdkCtx.KVStore(testApp.GetKey(types.StoreKey))
+	dexStore.Set(missingOrderExpirationKey, testSet.acc1)
+
+	sdkCtx = sdkCtx.WithBlockHeight(101)
+	require.NoError(t, dexKeeper.SweepExpiredOrders(sdkCtx))
+	require.Nil(t, dexStore.Get(missingOrderExpirationKey))
+	_, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, order.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func TestKeeper_MigrateGoodTilDelays(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Time:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Height: 100,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	order := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("376e-3")),
+		Quantity:    defaultQuantityStep.MulRaw(10),
+		Side:        types.SIDE_SELL,
+		GoodTil:     &types.GoodTil{GoodTilBlockHeight: 110},
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	balance, err := order.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(balance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, order.ID)
+	require.NoError(t, err)
+
+	// the cancellation delayed before the migration
+	require.NoError(t, testApp.DelayKeeper.ExecuteAfterBlock(
+		sdkCtx,
+		types.BuildGoodTilBlockHeightDelayKey(storedOrder.Sequence),
+		&types.CancelGoodTil{
+			Creator:       order.Creator,
+			OrderSequence: storedOrder.Sequence,
+		},
+		110,
+	))
+
+	require.NoError(t, keeper.NewMigrator(dexKeeper).Migrate1to2(sdkCtx))
+
+	blockItems, err := testApp.DelayKeeper.ExportBlockItems(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, blockItems)
+
+	sdkCtx = sdkCtx.WithBlockHeight(110)
+	require.NoError(t, dexKeeper.SweepExpiredOrders(sdkCtx))
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, order.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -35,11 +37,39 @@ type QueryKeeper interface {
 		side types.Side,
 		pagination *query.PageRequest,
 	) ([]types.Order, *query.PageResponse, error)
+	GetOrderBookDepth(
+		ctx sdk.Context,
+		baseDenom, quoteDenom string,
+		limit uint32,
+	) (*types.QueryOrderBookDepthResponse, error)
+	SimulateOrder(
+		ctx sdk.Context,
+		req *types.QuerySimulateOrderRequest,
+	) (*types.QuerySimulateOrderResponse, error)
+	GetTWAP(
+		ctx sdk.Context,
+		baseDenom, quoteDenom string,
+		startTime time.Time,
+		endTime *time.Time,
+	) (sdkmath.LegacyDec, error)
 	GetAccountDenomOrdersCount(
 		ctx sdk.Context,
 		acc sdk.AccAddress,
 		denom string,
 	) (uint64, error)
+	GetTriggerOrders(
+		ctx sdk.Context,
+		creator sdk.AccAddress,
+		pagination *query.PageRequest,
+	) ([]types.Order, *query.PageResponse, error)
+	GetDeadManSwitch(ctx sdk.Context, acc sdk.AccAddress) (types.DeadManSwitch, error)
+	GetAccountTradingVolume(ctx sdk.Context, acc sdk.AccAddress) (*types.QueryAccountTradingVolumeResponse, error)
+	GetRewardPrograms(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.RewardProgram, *query.PageResponse, error)
+	GetAccountRewards(ctx sdk.Context, acc sdk.AccAddress) ([]types.AccountReward, error)
+	GetOrderQuota(ctx sdk.Context, acc sdk.AccAddress) (*types.QueryOrderQuotaResponse, error)
 }
 
 // QueryService serves grpc query requests for the module.
@@ -126,6 +156,22 @@ func (qs QueryService) OrderBookOrders(
 	}, nil
 }
 
+// OrderBookDepth queries order book price levels aggregated by price.
+func (qs QueryService) OrderBookDepth(
+	ctx context.Context,
+	req *types.QueryOrderBookDepthRequest,
+) (*types.QueryOrderBookDepthResponse, error) {
+	return qs.keeper.GetOrderBookDepth(sdk.UnwrapSDKContext(ctx), req.BaseDenom, req.QuoteDenom, req.Limit)
+}
+
+// SimulateOrder simulates the matching of the order without placing it.
+func (qs QueryService) SimulateOrder(
+	ctx context.Context,
+	req *types.QuerySimulateOrderRequest,
+) (*types.QuerySimulateOrderResponse, error) {
+	return qs.keeper.SimulateOrder(sdk.UnwrapSDKContext(ctx), req)
+}
+
 // Order queries order by creator and ID.
 func (qs QueryService) Order(ctx context.Context, req *types.QueryOrderRequest) (*types.QueryOrderResponse, error) {
 	creatorAddr, err := sdk.AccAddressFromBech32(req.Creator)
@@ -160,3 +206,119 @@ func (qs QueryService) AccountDenomOrdersCount(
 		Count: count,
 	}, nil
 }
+
+// TriggerOrders returns creator trigger orders.
+func (qs QueryService) TriggerOrders(
+	ctx context.Context,
+	req *types.QueryTriggerOrdersRequest,
+) (*types.QueryTriggerOrdersResponse, error) {
+	creatorAddr, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", req.Creator)
+	}
+
+	orders, pageRes, err := qs.keeper.GetTriggerOrders(sdk.UnwrapSDKContext(ctx), creatorAddr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTriggerOrdersResponse{
+		Orders:     orders,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TWAP queries the time-weighted average price of the order book.
+func (qs QueryService) TWAP(
+	ctx context.Context,
+	req *types.QueryTWAPRequest,
+) (*types.QueryTWAPResponse, error) {
+	twap, err := qs.keeper.GetTWAP(sdk.UnwrapSDKContext(ctx), req.BaseDenom, req.QuoteDenom, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{
+		TWAP: twap,
+	}, nil
+}
+
+// DeadManSwitch queries the dead man's switch of the account.
+func (qs QueryService) DeadManSwitch(
+	ctx context.Context,
+	req *types.QueryDeadManSwitchRequest,
+) (*types.QueryDeadManSwitchResponse, error) {
+	acc, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", req.Account)
+	}
+	deadManSwitch, err := qs.keeper.GetDeadManSwitch(sdk.UnwrapSDKContext(ctx), acc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeadManSwitchResponse{
+		DeadManSwitch: deadManSwitch,
+	}, nil
+}
+
+// AccountTradingVolume queries the trading volume of the account within the window and its volume tier.
+func (qs QueryService) AccountTradingVolume(
+	ctx context.Context,
+	req *types.QueryAccountTradingVolumeRequest,
+) (*types.QueryAccountTradingVolumeResponse, error) {
+	acc, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", req.Account)
+	}
+
+	return qs.keeper.GetAccountTradingVolume(sdk.UnwrapSDKContext(ctx), acc)
+}
+
+// RewardPrograms queries the reward programs of the order books.
+func (qs QueryService) RewardPrograms(
+	ctx context.Context,
+	req *types.QueryRewardProgramsRequest,
+) (*types.QueryRewardProgramsResponse, error) {
+	rewardPrograms, pageRes, err := qs.keeper.GetRewardPrograms(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRewardProgramsResponse{
+		RewardPrograms: rewardPrograms,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// AccountRewards queries the rewards accrued by the account in the reward programs.
+func (qs QueryService) AccountRewards(
+	ctx context.Context,
+	req *types.QueryAccountRewardsRequest,
+) (*types.QueryAccountRewardsResponse, error) {
+	acc, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", req.Account)
+	}
+	accountRewards, err := qs.keeper.GetAccountRewards(sdk.UnwrapSDKContext(ctx), acc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountRewardsResponse{
+		AccountRewards: accountRewards,
+	}, nil
+}
+
+// OrderQuota queries the order quota of the account and its usage.
+func (qs QueryService) OrderQuota(
+	ctx context.Context,
+	req *types.QueryOrderQuotaRequest,
+) (*types.QueryOrderQuotaResponse, error) {
+	acc, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", req.Account)
+	}
+
+	return qs.keeper.GetOrderQuota(sdk.UnwrapSDKContext(ctx), acc)
+}
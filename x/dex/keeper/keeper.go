@@ -29,6 +29,8 @@ type Keeper struct {
 	accountQueryServer types.AccountQueryServer
 	assetFTKeeper      types.AssetFTKeeper
 	delayKeeper        types.DelayKeeper
+	bankKeeper         types.BankKeeper
+	distributionKeeper types.DistributionKeeper
 	authority          string
 }
 
@@ -40,6 +42,8 @@ func NewKeeper(
 	accountQueryServer types.AccountQueryServer,
 	assetFTKeeper types.AssetFTKeeper,
 	delayKeeper types.DelayKeeper,
+	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -50,6 +54,8 @@ func NewKeeper(
 		assetFTKeeper:      assetFTKeeper,
 		authority:          authority,
 		delayKeeper:        delayKeeper,
+		bankKeeper:         bankKeeper,
+		distributionKeeper: distributionKeeper,
 	}
 }
 
@@ -75,31 +81,196 @@ func (k Keeper) PlaceOrder(ctx sdk.Context, order types.Order) error {
 		return err
 	}
 
-	if err := k.reserveOrderID(ctx, accNumber, order.ID); err != nil {
+	if err := k.placeNewOrder(ctx, params, accNumber, order); err != nil {
 		return err
 	}
 
-	// validate duplicated order ID
-	_, err = k.getOrderSequenceByID(ctx, accNumber, order.ID)
+	return k.refreshAccountDeadManSwitch(ctx, creator)
+}
+
+// BatchPlaceOrders places the orders of the creator one by one in the provided order.
+// In the all-or-nothing mode the first failed order fails the whole batch, in the best-effort mode the failed
+// orders are skipped and reported in the results.
+func (k Keeper) BatchPlaceOrders(
+	ctx sdk.Context, creator sdk.AccAddress, orders []types.Order, mode types.BatchMode,
+) ([]types.BatchOrderResult, error) {
+	k.logger(ctx).Debug("Placing batch of orders.", "creator", creator.String(), "count", len(orders), "mode", mode)
+
+	params, err := k.GetParams(ctx)
 	if err != nil {
-		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
-			return err
-		}
-	} else {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "order with the id %q is already created", order.ID)
+		return nil, err
 	}
 
-	orderBookID, oppositeOrderBookID, err := k.getOrGenOrderBookIDs(ctx, order.BaseDenom, order.QuoteDenom)
+	accNumber, err := k.getAccountNumber(ctx, creator)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	results, err := k.applyBatch(ctx, mode, len(orders), func(ctx sdk.Context, i int) (string, error) {
+		order := orders[i]
+		if order.Creator != creator.String() {
+			return order.ID, sdkerrors.Wrapf(
+				types.ErrInvalidInput, "order creator %s doesn't match the batch creator", order.Creator,
+			)
+		}
+		if err := k.validateOrder(ctx, params, order); err != nil {
+			return order.ID, err
+		}
+
+		return order.ID, k.placeNewOrder(ctx, params, accNumber, order)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return k.matchOrder(ctx, params, accNumber, orderBookID, oppositeOrderBookID, order)
+	return results, k.refreshAccountDeadManSwitch(ctx, creator)
 }
 
 // CancelOrder cancels order and unlock locked balance.
 func (k Keeper) CancelOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string) error {
-	return k.cancelOrder(ctx, acc, orderID)
+	if err := k.cancelOrder(ctx, acc, orderID); err != nil {
+		return err
+	}
+
+	return k.refreshAccountDeadManSwitch(ctx, acc)
+}
+
+// BatchCancelOrders cancels the orders of the account one by one in the provided order.
+// In the all-or-nothing mode the first failed cancellation fails the whole batch, in the best-effort mode the failed
+// cancellations are skipped and reported in the results.
+func (k Keeper) BatchCancelOrders(
+	ctx sdk.Context, acc sdk.AccAddress, orderIDs []string, mode types.BatchMode,
+) ([]types.BatchOrderResult, error) {
+	results, err := k.applyBatch(ctx, mode, len(orderIDs), func(ctx sdk.Context, i int) (string, error) {
+		return orderIDs[i], k.cancelOrder(ctx, acc, orderIDs[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, k.refreshAccountDeadManSwitch(ctx, acc)
+}
+
+// CancelAllOrders cancels up to the limit orders of the account matching the filter and returns their IDs. The order
+// book orders are canceled first and the trigger orders next, both in the order of their IDs.
+func (k Keeper) CancelAllOrders(
+	ctx sdk.Context, acc sdk.AccAddress, filter func(types.Order) bool, limit uint32,
+) ([]string, error) {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	orderIDs, err := k.getAccountOrderIDsToCancel(ctx, accNumber, filter, limit)
+	if err != nil {
+		return nil, err
+	}
+	// the orders are canceled after the iteration is finished
+	for _, orderID := range orderIDs {
+		if err := k.cancelOrder(ctx, acc, orderID); err != nil {
+			return nil, err
+		}
+	}
+
+	return orderIDs, k.refreshAccountDeadManSwitch(ctx, acc)
+}
+
+// ReplaceOrder changes the price and/or the remaining quantity of the order. The order keeps its time priority if
+// only its quantity is reduced, otherwise the order is canceled and placed again with the same ID.
+func (k Keeper) ReplaceOrder(
+	ctx sdk.Context,
+	acc sdk.AccAddress,
+	orderID string,
+	price *types.Price,
+	quantity sdkmath.Int,
+) error {
+	if err := k.replaceOrder(ctx, acc, orderID, price, quantity); err != nil {
+		return err
+	}
+
+	return k.refreshAccountDeadManSwitch(ctx, acc)
+}
+
+func (k Keeper) replaceOrder(
+	ctx sdk.Context,
+	acc sdk.AccAddress,
+	orderID string,
+	price *types.Price,
+	quantity sdkmath.Int,
+) error {
+	k.logger(ctx).Debug("Replacing order.", "acc", acc, "orderID", orderID, "price", price, "quantity", quantity)
+
+	order, record, err := k.getOrderWithRecordByAddressAndID(ctx, acc, orderID)
+	if err != nil {
+		return err
+	}
+	if order.DisplayQuantity != nil {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "iceberg order %s can't be replaced, cancel it and place the new one", orderID,
+		)
+	}
+
+	newPrice := record.Price
+	if price != nil {
+		newPrice = *price
+	}
+	newQuantity := record.RemainingBaseQuantity
+	if !quantity.IsNil() && !quantity.IsZero() {
+		newQuantity = quantity
+	}
+
+	priceChanged := newPrice.String() != record.Price.String()
+	if !priceChanged && newQuantity.Equal(record.RemainingBaseQuantity) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "order %s already has the provided price and quantity", orderID)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	priorityKept := !priceChanged && newQuantity.LT(record.RemainingBaseQuantity)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderReplaced{
+		Creator:               order.Creator,
+		ID:                    order.ID,
+		Sequence:              order.Sequence,
+		Price:                 newPrice,
+		RemainingBaseQuantity: newQuantity,
+		PriorityKept:          priorityKept,
+		OrderBookID:           record.OrderBookID,
+		BaseDenom:             order.BaseDenom,
+		QuoteDenom:            order.QuoteDenom,
+		Side:                  order.Side,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderReplaced: %s", err)
+	}
+
+	if priorityKept {
+		return k.reduceOrder(ctx, params, acc, order, record, newQuantity)
+	}
+
+	if err := k.cancelOrderWithRecord(ctx, acc, order, record); err != nil {
+		return err
+	}
+
+	newOrder := types.Order{
+		Creator:     order.Creator,
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          order.ID,
+		BaseDenom:   order.BaseDenom,
+		QuoteDenom:  order.QuoteDenom,
+		Price:       &newPrice,
+		Quantity:    newQuantity,
+		Side:        order.Side,
+		GoodTil:     order.GoodTil,
+		TimeInForce: order.TimeInForce,
+		AllOrNone:   order.AllOrNone,
+	}
+	if err := k.validateOrder(ctx, params, newOrder); err != nil {
+		return err
+	}
+
+	return k.placeOrder(ctx, params, record.AccountNumber, newOrder)
 }
 
 // CancelOrderBySequence cancels order and unlock locked balance by order sequence.
@@ -137,6 +308,21 @@ func (k Keeper) CancelOrdersByDenom(ctx sdk.Context, admin, acc sdk.AccAddress,
 		}
 	}
 
+	triggerOrders, _, err := k.getPaginatedTriggerOrders(
+		ctx, types.CreateTriggerOrderKeyPrefix(accNumber), &query.PageRequest{Limit: query.PaginationMaxLimit},
+	)
+	if err != nil {
+		return err
+	}
+	for _, order := range triggerOrders {
+		if order.BaseDenom != denom && order.QuoteDenom != denom {
+			continue
+		}
+		if err := k.cancelTriggerOrder(ctx, accNumber, order); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -149,7 +335,15 @@ func (k Keeper) GetOrderBookIDByDenoms(ctx sdk.Context, baseDenom, quoteDenom st
 func (k Keeper) GetOrderByAddressAndID(ctx sdk.Context, acc sdk.AccAddress, orderID string) (types.Order, error) {
 	order, _, err := k.getOrderWithRecordByAddressAndID(ctx, acc, orderID)
 	if err != nil {
-		return types.Order{}, err
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.Order{}, err
+		}
+		// the order might be not activated trigger order
+		accNumber, err := k.getAccountNumber(ctx, acc)
+		if err != nil {
+			return types.Order{}, err
+		}
+		return k.getTriggerOrder(ctx, accNumber, orderID)
 	}
 
 	return order, nil
@@ -200,21 +394,44 @@ func (k Keeper) GetOrderBookParams(
 	if err != nil {
 		return nil, err
 	}
-	baseURABigInt := baseURA.BigInt()
-	quoteURABigInt := quoteURA.BigInt()
-	_, priceTickExponent := ComputePriceTick(baseURABigInt, quoteURABigInt, params.PriceTickExponent)
-	quantityStep, _ := ComputeQuantityStep(baseURABigInt, params.QuantityStepExponent-sdkmath.LegacyPrecision)
-	quantityStepRes := sdkmath.NewIntFromBigInt(quantityStep)
-	priceTick, err := types.NewPrice(1, int8(priceTickExponent))
+	orderBookData, err := k.getOrderBookDataByDenoms(ctx, baseDenom, quoteDenom)
 	if err != nil {
 		return nil, err
 	}
 
+	baseURABigInt := baseURA.BigInt()
+	quoteURABigInt := quoteURA.BigInt()
+	var priceTick types.Price
+	if orderBookData.PriceTick != nil {
+		priceTick = *orderBookData.PriceTick
+	} else {
+		_, priceTickExponent := ComputePriceTick(baseURABigInt, quoteURABigInt, params.PriceTickExponent)
+		priceTick, err = types.NewPrice(1, int8(priceTickExponent))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var quantityStepRes sdkmath.Int
+	if orderBookData.QuantityStep != nil {
+		quantityStepRes = *orderBookData.QuantityStep
+	} else {
+		quantityStep, _ := ComputeQuantityStep(baseURABigInt, params.QuantityStepExponent-sdkmath.LegacyPrecision)
+		quantityStepRes = sdkmath.NewIntFromBigInt(quantityStep)
+	}
+
+	makerFeeRate, takerFeeRate := params.GetFeeRates(baseDenom, quoteDenom)
+
 	return &types.QueryOrderBookParamsResponse{
 		PriceTick:                  priceTick,
 		QuantityStep:               quantityStepRes,
 		BaseDenomUnifiedRefAmount:  baseURA,
 		QuoteDenomUnifiedRefAmount: quoteURA,
+		MakerFeeRate:               makerFeeRate,
+		TakerFeeRate:               takerFeeRate,
+		Status:                     orderBookData.Status,
+		MinQuantity:                orderBookData.MinQuantity,
+		HaltedUntilHeight:          orderBookData.HaltedUntilHeight,
+		AuctionUntilHeight:         orderBookData.AuctionUntilHeight,
 	}, nil
 }
 
@@ -252,6 +469,9 @@ func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params types.Par
 	if err := params.ValidateBasic(); err != nil {
 		return err
 	}
+	if params.FeeCollector != "" && k.accountKeeper.GetModuleAddress(params.FeeCollector) == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "fee collector module account %s doesn't exist", params.FeeCollector)
+	}
 
 	return k.SetParams(ctx, params)
 }
@@ -330,10 +550,13 @@ func (k Keeper) GetAccountsOrders(
 				Quantity:                  orderData.Quantity,
 				Side:                      orderData.Side,
 				GoodTil:                   orderData.GoodTil,
-				TimeInForce:               types.TIME_IN_FORCE_GTC,
+				TimeInForce:               orderDataTimeInForce(orderData),
 				RemainingBaseQuantity:     orderBookRecord.RemainingBaseQuantity,
 				RemainingSpendableBalance: orderBookRecord.RemainingSpendableBalance,
 				Reserve:                   orderData.Reserve,
+				DisplayQuantity:           orderData.DisplayQuantity,
+				HiddenBaseQuantity:        orderBookRecord.HiddenBaseQuantity,
+				AllOrNone:                 orderBookRecord.AllOrNone,
 			}, nil
 		},
 		// constructor
@@ -359,7 +582,16 @@ func (k Keeper) GetOrderBooksWithID(
 
 // SaveOrderBookIDWithData saves order book ID with corresponding data.
 func (k Keeper) SaveOrderBookIDWithData(ctx sdk.Context, orderBookID uint32, data types.OrderBookData) error {
-	return k.saveOrderBookIDWithData(ctx, orderBookID, data.BaseDenom, data.QuoteDenom)
+	// the opening auction index isn't exported, so it's restored from the order book data
+	if data.AuctionUntilHeight != 0 {
+		if err := k.storeService.OpenKVStore(ctx).Set(
+			types.CreateOpeningAuctionKey(uint64(data.AuctionUntilHeight), orderBookID), types.StoreTrue,
+		); err != nil {
+			return err
+		}
+	}
+
+	return k.saveOrderBookIDWithData(ctx, orderBookID, data)
 }
 
 // GetOrderSequence returns current order sequence.
@@ -488,6 +720,159 @@ func (k Keeper) GetOrderData(ctx sdk.Context, orderSequence uint64) (types.Order
 	return val, nil
 }
 
+// orderDataTimeInForce returns the time in force of the stored order, the orders saved before the time in force was
+// stored are the GTC orders.
+func orderDataTimeInForce(orderData types.OrderData) types.TimeInForce {
+	if orderData.TimeInForce == types.TIME_IN_FORCE_UNSPECIFIED {
+		return types.TIME_IN_FORCE_GTC
+	}
+	return orderData.TimeInForce
+}
+
+func (k Keeper) placeNewOrder(ctx sdk.Context, params types.Params, accNumber uint64, order types.Order) error {
+	if err := k.reserveOrderID(ctx, accNumber, order.ID); err != nil {
+		return err
+	}
+
+	// validate duplicated order ID
+	_, err := k.getOrderSequenceByID(ctx, accNumber, order.ID)
+	if err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+	} else {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "order with the id %q is already created", order.ID)
+	}
+	order.GoodTil = resolveGoodTil(ctx, params, order)
+
+	return k.placeOrder(ctx, params, accNumber, order)
+}
+
+func (k Keeper) applyBatch(
+	ctx sdk.Context,
+	mode types.BatchMode,
+	size int,
+	applyItem func(ctx sdk.Context, i int) (string, error),
+) ([]types.BatchOrderResult, error) {
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.BatchOrderResult, 0, size)
+	for i := range size {
+		if mode == types.BATCH_MODE_ALL_OR_NOTHING {
+			orderID, err := applyItem(ctx, i)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to apply batch item %d, order id %q", i, orderID)
+			}
+			results = append(results, types.NewBatchOrderResult(orderID, nil))
+			continue
+		}
+
+		// the failed item must not leave partial state changes
+		cacheCtx, writeCache := ctx.CacheContext()
+		orderID, err := applyItem(cacheCtx, i)
+		if err == nil {
+			writeCache()
+		}
+		results = append(results, types.NewBatchOrderResult(orderID, err))
+	}
+
+	return results, nil
+}
+
+func (k Keeper) placeOrder(ctx sdk.Context, params types.Params, accNumber uint64, order types.Order) error {
+	orderBookID, oppositeOrderBookID, err := k.getOrGenOrderBookIDs(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	if order.Trigger != nil {
+		return k.createTriggerOrder(ctx, params, accNumber, orderBookID, oppositeOrderBookID, order)
+	}
+
+	if err := k.matchOrder(ctx, params, accNumber, orderBookID, oppositeOrderBookID, order); err != nil {
+		return err
+	}
+
+	return k.activateTriggerOrders(ctx, orderBookID, oppositeOrderBookID)
+}
+
+// reduceOrder reduces the remaining quantity of the order in place, so the order keeps its time priority.
+func (k Keeper) reduceOrder(
+	ctx sdk.Context,
+	params types.Params,
+	acc sdk.AccAddress,
+	order types.Order,
+	record types.OrderBookRecord,
+	newQuantity sdkmath.Int,
+) error {
+	orderBookData, err := k.getOrderBookData(ctx, record.OrderBookID)
+	if err != nil {
+		return err
+	}
+	baseURA, err := k.getAssetFTUnifiedRefAmount(ctx, order.BaseDenom, params.DefaultUnifiedRefAmount)
+	if err != nil {
+		return err
+	}
+	if err := validateOrderBookQuantityStep(
+		newQuantity.BigInt(), orderBookData, baseURA, params.QuantityStepExponent,
+	); err != nil {
+		return err
+	}
+
+	lockedCoin, err := types.ComputeLimitOrderLockedBalance(
+		order.Side, order.BaseDenom, order.QuoteDenom, newQuantity, record.Price,
+	)
+	if err != nil {
+		return err
+	}
+	newSpendableBalance := sdkmath.MinInt(lockedCoin.Amount, record.RemainingSpendableBalance)
+
+	expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+		order.Side, order.BaseDenom, order.QuoteDenom, record.RemainingBaseQuantity, record.Price,
+	)
+	if err != nil {
+		return err
+	}
+	newExpectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+		order.Side, order.BaseDenom, order.QuoteDenom, newQuantity, record.Price,
+	)
+	if err != nil {
+		return err
+	}
+
+	reducedQuantity := record.RemainingBaseQuantity.Sub(newQuantity)
+	record.RemainingBaseQuantity = newQuantity
+	unlockedCoin := sdk.NewCoin(order.GetSpendDenom(), record.RemainingSpendableBalance.Sub(newSpendableBalance))
+	record.RemainingSpendableBalance = newSpendableBalance
+	if !isOrderRecordExecutableAsMaker(&record) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "the order with the quantity %s can't be executed", newQuantity.String(),
+		)
+	}
+
+	if err := k.saveOrderBookRecord(ctx, record); err != nil {
+		return err
+	}
+
+	orderData, err := k.GetOrderData(ctx, record.OrderSequence)
+	if err != nil {
+		return err
+	}
+	orderData.Quantity = orderData.Quantity.Sub(reducedQuantity)
+	if err := k.saveOrderData(ctx, record.OrderSequence, orderData); err != nil {
+		return err
+	}
+
+	return k.assetFTKeeper.DEXDecreaseLimits(
+		ctx,
+		acc,
+		sdk.NewCoins(unlockedCoin),
+		expectedToReceiveCoin.Sub(newExpectedToReceiveCoin),
+	)
+}
+
 func (k Keeper) validateOrder(ctx sdk.Context, params types.Params, order types.Order) error {
 	if err := order.Validate(); err != nil {
 		return err
@@ -497,6 +882,34 @@ func (k Keeper) validateOrder(ctx sdk.Context, params types.Params, order types.
 		return err
 	}
 
+	// order book
+	orderBookData, err := k.getOrderBookDataByDenoms(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return err
+	}
+	if !orderBookData.Status.IsActive() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"order book %s/%s isn't active, status: %s",
+			order.BaseDenom, order.QuoteDenom, orderBookData.Status.String(),
+		)
+	}
+	if orderBookData.HaltedUntilHeight > ctx.BlockHeight() {
+		return sdkerrors.Wrapf(
+			types.ErrOrderBookHalted,
+			"order book %s/%s is halted by the circuit breaker until height %d",
+			order.BaseDenom, order.QuoteDenom, orderBookData.HaltedUntilHeight,
+		)
+	}
+	if orderBookData.AuctionUntilHeight != 0 && order.Trigger == nil && !isOpeningAuctionOrder(order) {
+		return sdkerrors.Wrapf(
+			types.ErrOrderBookInAuction,
+			"order book %s/%s is in the opening auction until height %d, only %s and %s limit orders are accepted",
+			order.BaseDenom, order.QuoteDenom, orderBookData.AuctionUntilHeight,
+			types.TIME_IN_FORCE_GTC.String(), types.TIME_IN_FORCE_POST_ONLY.String(),
+		)
+	}
+
 	baseURA, err := k.getAssetFTUnifiedRefAmount(ctx, order.BaseDenom, params.DefaultUnifiedRefAmount)
 	if err != nil {
 		return err
@@ -507,13 +920,31 @@ func (k Keeper) validateOrder(ctx sdk.Context, params types.Params, order types.
 	}
 
 	// quantity
-	if err := validateQuantityStep(order.Quantity.BigInt(), baseURA, params.QuantityStepExponent); err != nil {
+	if err := validateOrderBookQuantityStep(
+		order.Quantity.BigInt(), orderBookData, baseURA, params.QuantityStepExponent,
+	); err != nil {
 		return err
 	}
+	if order.DisplayQuantity != nil {
+		if err := validateOrderBookQuantityStep(
+			order.DisplayQuantity.BigInt(), orderBookData, baseURA, params.QuantityStepExponent,
+		); err != nil {
+			return err
+		}
+	}
+	if orderBookData.MinQuantity != nil && order.Quantity.LT(*orderBookData.MinQuantity) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"quantity %s is less than min quantity of the order book: %s",
+			order.Quantity.String(), orderBookData.MinQuantity.String(),
+		)
+	}
 
 	// price
 	if order.Type == types.ORDER_TYPE_LIMIT {
-		if err := validatePriceTick(order.Price.Rat(), baseURA, quoteURA, params.PriceTickExponent); err != nil {
+		if err := validateOrderBookPriceTick(
+			order.Price.Rat(), orderBookData, baseURA, quoteURA, params.PriceTickExponent,
+		); err != nil {
 			return err
 		}
 	}
@@ -633,7 +1064,10 @@ func (k Keeper) genOrderBookIDsFromDenoms(ctx sdk.Context, denom0, denom1 string
 	if err != nil {
 		return 0, err
 	}
-	if err := k.saveOrderBookIDWithData(ctx, orderBookID0, denom0, denom1); err != nil {
+	if err := k.saveOrderBookIDWithData(ctx, orderBookID0, types.OrderBookData{
+		BaseDenom:  denom0,
+		QuoteDenom: denom1,
+	}); err != nil {
 		return 0, err
 	}
 
@@ -641,7 +1075,10 @@ func (k Keeper) genOrderBookIDsFromDenoms(ctx sdk.Context, denom0, denom1 string
 	if err != nil {
 		return 0, err
 	}
-	if err := k.saveOrderBookIDWithData(ctx, orderBookID1, denom1, denom0); err != nil {
+	if err := k.saveOrderBookIDWithData(ctx, orderBookID1, types.OrderBookData{
+		BaseDenom:  denom1,
+		QuoteDenom: denom0,
+	}); err != nil {
 		return 0, err
 	}
 
@@ -660,9 +1097,9 @@ func (k Keeper) genNextOrderBookID(ctx sdk.Context) (uint32, error) {
 func (k Keeper) saveOrderBookIDWithData(
 	ctx sdk.Context,
 	orderBookID uint32,
-	denom0, denom1 string,
+	data types.OrderBookData,
 ) error {
-	key, err := types.CreateOrderBookKey(denom0, denom1)
+	key, err := types.CreateOrderBookKey(data.BaseDenom, data.QuoteDenom)
 	if err != nil {
 		return err
 	}
@@ -670,10 +1107,7 @@ func (k Keeper) saveOrderBookIDWithData(
 		return err
 	}
 
-	return k.saveOrderBookData(ctx, orderBookID, types.OrderBookData{
-		BaseDenom:  denom0,
-		QuoteDenom: denom1,
-	})
+	return k.saveOrderBookData(ctx, orderBookID, data)
 }
 
 func (k Keeper) createOrder(
@@ -688,10 +1122,14 @@ func (k Keeper) createOrder(
 		"record", record.String(),
 	)
 
+	maxOrdersPerDenom, err := k.getMaxOrdersPerDenom(ctx, params, record.AccountNumber)
+	if err != nil {
+		return err
+	}
 	if err := k.incrementAccountDenomsOrdersCounter(
 		ctx,
 		record.AccountNumber,
-		params.MaxOrdersPerDenom,
+		maxOrdersPerDenom,
 		order.Denoms(),
 	); err != nil {
 		return err
@@ -711,8 +1149,14 @@ func (k Keeper) createOrder(
 		Creator:                   order.Creator,
 		ID:                        order.ID,
 		Sequence:                  order.Sequence,
-		RemainingBaseQuantity:     record.RemainingBaseQuantity,
+		RemainingBaseQuantity:     record.TotalRemainingBaseQuantity(),
 		RemainingSpendableBalance: record.RemainingSpendableBalance,
+		OrderBookID:               record.OrderBookID,
+		BaseDenom:                 order.BaseDenom,
+		QuoteDenom:                order.QuoteDenom,
+		Side:                      order.Side,
+		Price:                     *order.Price,
+		VisibleBaseQuantity:       record.RemainingBaseQuantity,
 	}); err != nil {
 		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderCreated: %s", err)
 	}
@@ -733,10 +1177,10 @@ func (k Keeper) saveOrderWithOrderBookRecord(
 			order.Type.String(),
 		)
 	}
-	if order.TimeInForce != types.TIME_IN_FORCE_GTC {
+	if order.TimeInForce != types.TIME_IN_FORCE_GTC && order.TimeInForce != types.TIME_IN_FORCE_POST_ONLY {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidInput,
-			"it's prohibited to save not GTC order types, type: %s",
+			"it's prohibited to save not GTC or post-only order types, type: %s",
 			order.TimeInForce.String(),
 		)
 	}
@@ -751,7 +1195,7 @@ func (k Keeper) saveOrderWithOrderBookRecord(
 	}
 
 	if order.GoodTil != nil {
-		if err := k.delayGoodTilCancellation(
+		if err := k.saveOrderExpiration(
 			ctx,
 			*order.GoodTil,
 			record.OrderSequence,
@@ -762,13 +1206,16 @@ func (k Keeper) saveOrderWithOrderBookRecord(
 	}
 
 	if err := k.saveOrderData(ctx, record.OrderSequence, types.OrderData{
-		OrderID:     order.ID,
-		OrderBookID: record.OrderBookID,
-		Price:       *order.Price,
-		Quantity:    order.Quantity,
-		Side:        order.Side,
-		GoodTil:     order.GoodTil,
-		Reserve:     order.Reserve,
+		OrderID:           order.ID,
+		OrderBookID:       record.OrderBookID,
+		Price:             *order.Price,
+		Quantity:          order.Quantity,
+		Side:              order.Side,
+		GoodTil:           order.GoodTil,
+		Reserve:           order.Reserve,
+		DisplayQuantity:   order.DisplayQuantity,
+		TimeInForce:       order.TimeInForce,
+		PlacementSequence: record.OrderSequence,
 	}); err != nil {
 		return err
 	}
@@ -801,7 +1248,7 @@ func (k Keeper) removeOrderByRecord(
 		return err
 	}
 	if orderData.GoodTil != nil {
-		if err := k.removeGoodTilDelay(ctx, *orderData.GoodTil, record.OrderSequence); err != nil {
+		if err := k.removeOrderExpiration(ctx, *orderData.GoodTil, record.OrderSequence); err != nil {
 			return err
 		}
 	}
@@ -831,8 +1278,13 @@ func (k Keeper) removeOrderByRecord(
 		Creator:                   creator.String(),
 		ID:                        record.OrderID,
 		Sequence:                  record.OrderSequence,
-		RemainingBaseQuantity:     record.RemainingBaseQuantity,
+		RemainingBaseQuantity:     record.TotalRemainingBaseQuantity(),
 		RemainingSpendableBalance: record.RemainingSpendableBalance,
+		OrderBookID:               record.OrderBookID,
+		BaseDenom:                 orderBookData.BaseDenom,
+		QuoteDenom:                orderBookData.QuoteDenom,
+		Side:                      record.Side,
+		Price:                     record.Price,
 	}); err != nil {
 		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderClosed: %s", err)
 	}
@@ -846,25 +1298,63 @@ func (k Keeper) saveOrderBookData(ctx sdk.Context, orderBookID uint32, data type
 
 func (k Keeper) cancelOrderBySequence(ctx sdk.Context, acc sdk.AccAddress, orderSequence uint64) error {
 	orderData, err := k.GetOrderData(ctx, orderSequence)
-	if err != nil {
+	if err == nil {
+		return k.cancelOrder(ctx, acc, orderData.OrderID)
+	}
+	if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
 		return err
 	}
-	return k.cancelOrder(ctx, acc, orderData.OrderID)
+
+	// the trigger order doesn't have the order data, so it's found by the sequence in the account trigger orders
+	accNumber, accErr := k.getAccountNumber(ctx, acc)
+	if accErr != nil {
+		return accErr
+	}
+	triggerOrder, found, triggerErr := k.getTriggerOrderBySequence(ctx, accNumber, orderSequence)
+	if triggerErr != nil {
+		return triggerErr
+	}
+	if !found {
+		return err
+	}
+
+	return k.cancelTriggerOrder(ctx, accNumber, triggerOrder)
 }
 
 func (k Keeper) cancelOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string) error {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+	triggerOrder, err := k.getTriggerOrder(ctx, accNumber, orderID)
+	if err == nil {
+		return k.cancelTriggerOrder(ctx, accNumber, triggerOrder)
+	}
+	if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+		return err
+	}
+
 	order, record, err := k.getOrderWithRecordByAddressAndID(ctx, acc, orderID)
 	if err != nil {
 		return err
 	}
 
+	return k.cancelOrderWithRecord(ctx, acc, order, record)
+}
+
+func (k Keeper) cancelOrderWithRecord(
+	ctx sdk.Context,
+	acc sdk.AccAddress,
+	order types.Order,
+	record types.OrderBookRecord,
+) error {
 	if err := k.removeOrderByRecord(ctx, acc, record); err != nil {
 		return err
 	}
 
 	lockedCoins := sdk.NewCoins(sdk.NewCoin(order.GetSpendDenom(), order.RemainingSpendableBalance))
 	expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
-		order.Side, order.BaseDenom, order.QuoteDenom, record.RemainingBaseQuantity, *order.Price,
+		order.Side, order.BaseDenom, order.QuoteDenom, record.TotalRemainingBaseQuantity(), *order.Price,
 	)
 	if err != nil {
 		return err
@@ -880,6 +1370,69 @@ func (k Keeper) cancelOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string)
 	)
 }
 
+func (k Keeper) getAccountOrderIDsToCancel(
+	ctx sdk.Context, accNumber uint64, filter func(types.Order) bool, limit uint32,
+) ([]string, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateOrderIDToSequenceKeyPrefix(accNumber),
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orderIDs := make([]string, 0)
+	orderBookIDToOrderBookData := make(map[uint32]types.OrderBookData)
+	for ; iterator.Valid() && len(orderIDs) < int(limit); iterator.Next() {
+		var orderSequence gogotypes.UInt64Value
+		if err := k.cdc.Unmarshal(iterator.Value(), &orderSequence); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal order sequence: %s", err)
+		}
+		orderData, err := k.GetOrderData(ctx, orderSequence.Value)
+		if err != nil {
+			return nil, err
+		}
+		orderBookData, ok := orderBookIDToOrderBookData[orderData.OrderBookID]
+		if !ok {
+			orderBookData, err = k.getOrderBookData(ctx, orderData.OrderBookID)
+			if err != nil {
+				return nil, err
+			}
+			orderBookIDToOrderBookData[orderData.OrderBookID] = orderBookData
+		}
+		if !filter(types.Order{
+			BaseDenom:  orderBookData.BaseDenom,
+			QuoteDenom: orderBookData.QuoteDenom,
+			Side:       orderData.Side,
+			Price:      &orderData.Price,
+			Sequence:   orderSequence.Value,
+		}) {
+			continue
+		}
+		orderIDs = append(orderIDs, orderData.OrderID)
+	}
+
+	if len(orderIDs) == int(limit) {
+		return orderIDs, nil
+	}
+
+	triggerIterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateTriggerOrderKeyPrefix(accNumber),
+	).Iterator(nil, nil)
+	defer triggerIterator.Close()
+
+	for ; triggerIterator.Valid() && len(orderIDs) < int(limit); triggerIterator.Next() {
+		var order types.Order
+		if err := k.cdc.Unmarshal(triggerIterator.Value(), &order); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal trigger order: %s", err)
+		}
+		if !filter(order) {
+			continue
+		}
+		orderIDs = append(orderIDs, order.ID)
+	}
+
+	return orderIDs, nil
+}
+
 func (k Keeper) getOrderBookData(ctx sdk.Context, orderBookID uint32) (types.OrderBookData, error) {
 	var val types.OrderBookData
 	if err := k.getDataFromStore(ctx, types.CreateOrderBookDataKey(orderBookID), &val); err != nil {
@@ -909,6 +1462,8 @@ func (k Keeper) saveOrderBookRecord(
 		AccountNumber:             record.AccountNumber,
 		RemainingBaseQuantity:     record.RemainingBaseQuantity,
 		RemainingSpendableBalance: record.RemainingSpendableBalance,
+		HiddenBaseQuantity:        record.HiddenBaseQuantity,
+		AllOrNone:                 record.AllOrNone,
 	})
 }
 
@@ -959,10 +1514,13 @@ func (k Keeper) getOrderWithRecordByAddressAndID(
 			Quantity:                  orderData.Quantity,
 			Side:                      orderBookRecord.Side,
 			GoodTil:                   orderData.GoodTil,
-			TimeInForce:               types.TIME_IN_FORCE_GTC,
+			TimeInForce:               orderDataTimeInForce(orderData),
 			RemainingBaseQuantity:     orderBookRecord.RemainingBaseQuantity,
 			RemainingSpendableBalance: orderBookRecord.RemainingSpendableBalance,
 			Reserve:                   orderData.Reserve,
+			DisplayQuantity:           orderData.DisplayQuantity,
+			HiddenBaseQuantity:        orderBookRecord.HiddenBaseQuantity,
+			AllOrNone:                 orderBookRecord.AllOrNone,
 		},
 		orderBookRecord,
 		nil
@@ -997,6 +1555,8 @@ func (k Keeper) getOrderBookRecord(
 		AccountNumber:             val.AccountNumber,
 		RemainingBaseQuantity:     val.RemainingBaseQuantity,
 		RemainingSpendableBalance: val.RemainingSpendableBalance,
+		HiddenBaseQuantity:        val.HiddenBaseQuantity,
+		AllOrNone:                 val.AllOrNone,
 	}, nil
 }
 
@@ -1056,10 +1616,13 @@ func (k Keeper) getPaginatedOrders(
 				Quantity:                  orderData.Quantity,
 				Side:                      orderData.Side,
 				GoodTil:                   orderData.GoodTil,
-				TimeInForce:               types.TIME_IN_FORCE_GTC,
+				TimeInForce:               orderDataTimeInForce(orderData),
 				RemainingBaseQuantity:     orderBookRecord.RemainingBaseQuantity,
 				RemainingSpendableBalance: orderBookRecord.RemainingSpendableBalance,
 				Reserve:                   orderData.Reserve,
+				DisplayQuantity:           orderData.DisplayQuantity,
+				HiddenBaseQuantity:        orderBookRecord.HiddenBaseQuantity,
+				AllOrNone:                 orderBookRecord.AllOrNone,
 			}, nil
 		},
 		// constructor
@@ -1148,7 +1711,7 @@ func (k Keeper) getPaginatedOrderBookOrders(
 				return nil, err
 			}
 
-			return &types.Order{
+			order := types.Order{
 				Creator:                   acc.String(),
 				Type:                      types.ORDER_TYPE_LIMIT,
 				ID:                        record.OrderID,
@@ -1159,11 +1722,20 @@ func (k Keeper) getPaginatedOrderBookOrders(
 				Quantity:                  orderData.Quantity,
 				Side:                      side,
 				GoodTil:                   orderData.GoodTil,
-				TimeInForce:               types.TIME_IN_FORCE_GTC,
+				TimeInForce:               orderDataTimeInForce(orderData),
 				RemainingBaseQuantity:     record.RemainingBaseQuantity,
 				RemainingSpendableBalance: record.RemainingSpendableBalance,
 				Reserve:                   orderData.Reserve,
-			}, nil
+				AllOrNone:                 record.AllOrNone,
+			}
+			if orderData.DisplayQuantity != nil {
+				// the iceberg order is shown with the visible quantity only
+				if err := hideIcebergOrderQuantity(&order, *orderData.DisplayQuantity); err != nil {
+					return nil, err
+				}
+			}
+
+			return &order, nil
 		},
 		// constructor
 		func() *types.OrderBookRecordData {
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_AllOrNoneOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	allOrNoneOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "all-or-none",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		AllOrNone:   true,
+	}
+	regularOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "regular",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(500_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeTestOrder(t, testApp, sdkCtx, allOrNoneOrder)
+	placeTestOrder(t, testApp, sdkCtx, regularOrder)
+
+	// the flag is shown in the order queries
+	storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, allOrNoneOrder.ID)
+	require.NoError(t, err)
+	require.True(t, storedOrder.AllOrNone)
+	orderBookOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false}, lo.Map(orderBookOrders, func(o types.Order, _ int) bool {
+		return o.AllOrNone
+	}))
+
+	// the all-or-none order can't be filled entirely, so it's skipped and the regular order is matched
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     testSet.acc3.String(),
+		ID:          "taker1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(400_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	})
+	storedOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, allOrNoneOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, "1000000", storedOrder.RemainingBaseQuantity.String())
+	storedOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, regularOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, "100000", storedOrder.RemainingBaseQuantity.String())
+
+	// the taker fills the all-or-none order entirely
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     testSet.acc3.String(),
+		ID:          "taker2",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	})
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, allOrNoneOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.Equal(t,
+		sdk.NewInt64Coin(testSet.denom2, 375_000).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.denom2).String(),
+	)
+	storedOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, regularOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, "100000", storedOrder.RemainingBaseQuantity.String())
+
+	// the all-or-none taker order crossing the order book isn't executed partially, it's rejected
+	allOrNoneTakerOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker3",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(300_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		AllOrNone:   true,
+	}
+	cacheCtx, _ := sdkCtx.CacheContext()
+	testApp.MintAndSendCoin(t, cacheCtx, testSet.acc3, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 112_500)))
+	fundOrderReserve(t, testApp, cacheCtx, testSet.acc3)
+	require.ErrorIs(t, dexKeeper.PlaceOrder(cacheCtx, allOrNoneTakerOrder), types.ErrAllOrNoneOrderNotFilled)
+
+	// the all-or-none taker order not crossing the order book is saved with the full quantity without any execution
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     testSet.acc3.String(),
+		ID:          "taker-regular",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	})
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, regularOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	placeTestOrder(t, testApp, sdkCtx, allOrNoneTakerOrder)
+	storedOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc3, "taker3")
+	require.NoError(t, err)
+	require.True(t, storedOrder.AllOrNone)
+	require.Equal(t, "300000", storedOrder.RemainingBaseQuantity.String())
+	require.Equal(t, "112500", storedOrder.RemainingSpendableBalance.String())
+
+	// the resting all-or-none order is matched by the taker which fills it entirely
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     testSet.acc1.String(),
+		ID:          "taker4",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(300_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	})
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc3, "taker3")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc3, testSet.denom2).IsZero())
+}
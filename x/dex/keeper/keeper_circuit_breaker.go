@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	matchingengine "github.com/CoreumFoundation/coreum/v6/x/dex/matching-engine"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// getCircuitBreakerPriceBand returns the range of the trade prices allowed by the circuit breaker, expressed in the
+// order book. The nil band is returned if the circuit breaker is disabled, the order book pair has no trades yet, or
+// the order book pair is resumed after the halt and the first trade sets the new reference price.
+func (k Keeper) getCircuitBreakerPriceBand(
+	ctx sdk.Context,
+	params types.Params,
+	orderBookID, invertedOrderBookID uint32,
+) (*matchingengine.PriceBand, error) {
+	if !params.IsCircuitBreakerEnabled() {
+		return nil, nil //nolint:nilnil // the nil band means the trade price isn't limited
+	}
+
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return nil, err
+	}
+	if orderBookData.HaltedUntilHeight != 0 {
+		return nil, nil //nolint:nilnil // the nil band means the trade price isn't limited
+	}
+
+	lastPrice, found, err := k.getLastPrice(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil //nolint:nilnil // the nil band means the trade price isn't limited
+	}
+
+	maxDeviation := cbig.NewRatFromBigInts(
+		params.CircuitBreakerMaxPriceDeviation.BigInt(),
+		cbig.IntTenToThePower(big.NewInt(sdkmath.LegacyPrecision)),
+	)
+	priceDeviation := cbig.RatMul(lastPrice, maxDeviation)
+
+	return &matchingengine.PriceBand{
+		ReferencePrice: lastPrice,
+		MinPrice:       cbig.RatMax(new(big.Rat).Sub(lastPrice, priceDeviation), new(big.Rat)),
+		MaxPrice:       new(big.Rat).Add(lastPrice, priceDeviation),
+	}, nil
+}
+
+// triggerCircuitBreaker halts the order book pair for the cooldown period.
+func (k Keeper) triggerCircuitBreaker(
+	ctx sdk.Context,
+	params types.Params,
+	orderBookID, invertedOrderBookID uint32,
+	result matchingengine.CircuitBreakerResult,
+) error {
+	haltedUntilHeight := ctx.BlockHeight() + int64(params.CircuitBreakerCooldownBlocks)
+	k.logger(ctx).Debug(
+		"Halting order book pair.",
+		"orderBookID", orderBookID,
+		"invertedOrderBookID", invertedOrderBookID,
+		"haltedUntilHeight", haltedUntilHeight,
+	)
+
+	if err := k.setOrderBooksHaltedUntilHeight(ctx, orderBookID, invertedOrderBookID, haltedUntilHeight); err != nil {
+		return err
+	}
+	// the order book pair is resumed with the opening auction, which starts at the first height the orders are accepted
+	if err := k.setOrderBooksAuctionUntilHeight(
+		ctx, orderBookID, invertedOrderBookID, openingAuctionUntilHeight(params, haltedUntilHeight-1),
+	); err != nil {
+		return err
+	}
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCircuitBreakerTriggered{
+		OrderBookID:       orderBookID,
+		BaseDenom:         orderBookData.BaseDenom,
+		QuoteDenom:        orderBookData.QuoteDenom,
+		ReferencePrice:    result.ReferencePrice.RatString(),
+		Price:             result.TradePrice.RatString(),
+		HaltedUntilHeight: haltedUntilHeight,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventCircuitBreakerTriggered: %s", err)
+	}
+
+	return nil
+}
+
+// isOrderBookHalted returns true if the order book is halted by the circuit breaker.
+func (k Keeper) isOrderBookHalted(ctx sdk.Context, orderBookID uint32) (bool, error) {
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return false, err
+	}
+
+	return orderBookData.HaltedUntilHeight > ctx.BlockHeight(), nil
+}
+
+// resetCircuitBreaker removes the expired halt of the order book pair once the first trade after the halt is executed.
+func (k Keeper) resetCircuitBreaker(ctx sdk.Context, orderBookID uint32) error {
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	if orderBookData.HaltedUntilHeight == 0 {
+		return nil
+	}
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+
+	return k.setOrderBooksHaltedUntilHeight(ctx, orderBookID, invertedOrderBookID, 0)
+}
+
+func (k Keeper) setOrderBooksHaltedUntilHeight(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	haltedUntilHeight int64,
+) error {
+	for _, id := range []uint32{orderBookID, invertedOrderBookID} {
+		orderBookData, err := k.getOrderBookData(ctx, id)
+		if err != nil {
+			return err
+		}
+		orderBookData.HaltedUntilHeight = haltedUntilHeight
+		if err := k.saveOrderBookData(ctx, id, orderBookData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
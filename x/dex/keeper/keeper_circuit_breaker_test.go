@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_CircuitBreaker(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.CircuitBreakerMaxPriceDeviation = sdkmath.LegacyMustNewDecFromStr("0.1")
+	params.CircuitBreakerCooldownBlocks = 10
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	farSellOrder := sellOrder
+	farSellOrder.ID = "sell2"
+	farSellOrder.Price = lo.ToPtr(types.MustNewPriceFromString("5e-1"))
+	placeTestOrder(t, testApp, sdkCtx, sellOrder)
+	placeTestOrder(t, testApp, sdkCtx, farSellOrder)
+
+	// the first trade isn't limited since there is no reference price
+	buyOrder := sellOrder
+	buyOrder.Creator = testSet.acc2.String()
+	buyOrder.ID = "buy1"
+	buyOrder.Side = types.SIDE_BUY
+	buyOrder.TimeInForce = types.TIME_IN_FORCE_IOC
+	placeTestOrder(t, testApp, sdkCtx, buyOrder)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// the trade price deviates from the last price more than allowed
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	buyOrder.ID = "buy2"
+	buyOrder.Price = farSellOrder.Price
+	buyOrder.TimeInForce = types.TIME_IN_FORCE_GTC
+	placeTestOrder(t, testApp, sdkCtx, buyOrder)
+	haltedUntilHeight := sdkCtx.BlockHeight() + 10
+
+	events := lo.Filter(sdkCtx.EventManager().Events(), func(evt sdk.Event, _ int) bool {
+		return evt.Type == "coreum.dex.v1.EventCircuitBreakerTriggered"
+	})
+	require.Len(t, events, 1)
+	require.Empty(t, readOrderEvents(t, sdkCtx).OrdersReduced)
+
+	// the taker order isn't saved and its balance isn't locked
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, "buy2")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc2, testSet.denom2).IsZero())
+	storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, farSellOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, farSellOrder.Quantity.String(), storedOrder.RemainingBaseQuantity.String())
+
+	// the order book pair is halted
+	for _, denoms := range [][]string{{testSet.denom1, testSet.denom2}, {testSet.denom2, testSet.denom1}} {
+		orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, denoms[0], denoms[1])
+		require.NoError(t, err)
+		require.Equal(t, haltedUntilHeight, orderBookParams.HaltedUntilHeight)
+	}
+	buyOrder.ID = "buy3"
+	buyOrder.Price = sellOrder.Price
+	buyOrder.TimeInForce = types.TIME_IN_FORCE_IOC
+	require.ErrorIs(t, dexKeeper.PlaceOrder(sdkCtx, buyOrder), types.ErrOrderBookHalted)
+
+	// after the cooldown the first trade isn't limited and sets the new reference price
+	sdkCtx = sdkCtx.WithBlockHeight(haltedUntilHeight)
+	buyOrder.ID = "buy4"
+	buyOrder.Price = farSellOrder.Price
+	placeTestOrder(t, testApp, sdkCtx, buyOrder)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, farSellOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Zero(t, orderBookParams.HaltedUntilHeight)
+}
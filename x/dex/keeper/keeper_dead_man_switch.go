@@ -0,0 +1,367 @@
+package keeper
+
+import (
+	"math"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// deadManSwitchCancellationBatchSize is the max number of order IDs loaded by one scan of the dead man's switch
+// cancellation.
+const deadManSwitchCancellationBatchSize = 100
+
+// SetDeadManSwitch arms or updates the dead man's switch of the account, the zero timeout disarms the switch.
+func (k Keeper) SetDeadManSwitch(ctx sdk.Context, acc sdk.AccAddress, timeoutBlocks uint64) error {
+	k.logger(ctx).Debug("Setting dead man's switch.", "acc", acc.String(), "timeoutBlocks", timeoutBlocks)
+
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	deadManSwitch, found, err := k.getDeadManSwitch(ctx, accNumber)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := k.removeDeadManSwitch(ctx, accNumber, deadManSwitch); err != nil {
+			return err
+		}
+	}
+	if timeoutBlocks == 0 {
+		return nil
+	}
+
+	return k.armDeadManSwitch(ctx, accNumber, types.DeadManSwitch{
+		Creator:       acc.String(),
+		TimeoutBlocks: timeoutBlocks,
+	})
+}
+
+// Heartbeat refreshes the armed dead man's switch of the account.
+func (k Keeper) Heartbeat(ctx sdk.Context, acc sdk.AccAddress) error {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	deadManSwitch, found, err := k.getDeadManSwitch(ctx, accNumber)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "dead man's switch of %s isn't armed", acc.String())
+	}
+
+	return k.refreshDeadManSwitch(ctx, accNumber, deadManSwitch)
+}
+
+// GetDeadManSwitch returns the dead man's switch of the account.
+func (k Keeper) GetDeadManSwitch(ctx sdk.Context, acc sdk.AccAddress) (types.DeadManSwitch, error) {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return types.DeadManSwitch{}, err
+	}
+
+	deadManSwitch, found, err := k.getDeadManSwitch(ctx, accNumber)
+	if err != nil {
+		return types.DeadManSwitch{}, err
+	}
+	if !found {
+		return types.DeadManSwitch{}, sdkerrors.Wrapf(
+			types.ErrRecordNotFound, "dead man's switch of %s isn't armed", acc.String(),
+		)
+	}
+
+	return deadManSwitch, nil
+}
+
+// GetDeadManSwitches returns paginated dead man's switches of all accounts.
+func (k Keeper) GetDeadManSwitches(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.DeadManSwitch, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.DeadManSwitchKeyPrefix)
+	deadManSwitches, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pagination,
+		// builder
+		func(_ []byte, deadManSwitch *types.DeadManSwitch) (*types.DeadManSwitch, error) {
+			return deadManSwitch, nil
+		},
+		// constructor
+		func() *types.DeadManSwitch {
+			return &types.DeadManSwitch{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	result := make([]types.DeadManSwitch, 0, len(deadManSwitches))
+	for _, deadManSwitch := range deadManSwitches {
+		result = append(result, *deadManSwitch)
+	}
+
+	return result, pageRes, nil
+}
+
+// SaveDeadManSwitch saves the dead man's switch, the delayed cancellation is stored by the delay module.
+func (k Keeper) SaveDeadManSwitch(ctx sdk.Context, accNumber uint64, deadManSwitch types.DeadManSwitch) error {
+	return k.setDataToStore(ctx, types.CreateDeadManSwitchKey(accNumber), &deadManSwitch)
+}
+
+// ExecuteDeadManSwitch disarms the expired dead man's switch of the account and cancels the orders placed before the
+// switch is triggered. The cancellation is limited by the order expiration sweep gas limit param, and the remaining
+// orders are cancelled in the next blocks. The execution errors are logged to not fail the block.
+func (k Keeper) ExecuteDeadManSwitch(
+	ctx sdk.Context, acc sdk.AccAddress, cancellation types.CancelDeadManSwitchOrders,
+) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.executeDeadManSwitch(cacheCtx, acc, cancellation); err != nil {
+		k.logger(ctx).Error("Failed to execute dead man's switch.", "acc", acc.String(), "err", err)
+		return nil
+	}
+	writeCache()
+
+	return nil
+}
+
+func (k Keeper) executeDeadManSwitch(
+	ctx sdk.Context, acc sdk.AccAddress, cancellation types.CancelDeadManSwitchOrders,
+) error {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	// the zero order sequence means that the switch isn't triggered yet
+	if cancellation.OrderSequence == 0 {
+		deadManSwitch, found, err := k.getDeadManSwitch(ctx, accNumber)
+		if err != nil {
+			return err
+		}
+		if !found {
+			k.logger(ctx).Debug("Dead man's switch isn't armed.", "acc", acc.String())
+			return nil
+		}
+		k.logger(ctx).Debug("Executing dead man's switch.", "acc", acc.String())
+
+		if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateDeadManSwitchKey(accNumber)); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDeadManSwitchTriggered{
+			Creator:          deadManSwitch.Creator,
+			ExpirationHeight: deadManSwitch.ExpirationHeight,
+		}); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventDeadManSwitchTriggered: %s", err)
+		}
+
+		orderSequence, err := k.GetOrderSequence(ctx)
+		if err != nil {
+			return err
+		}
+		cancellation = types.CancelDeadManSwitchOrders{
+			Creator:       acc.String(),
+			OrderSequence: orderSequence,
+		}
+	}
+
+	cancelled, err := k.cancelDeadManSwitchOrders(ctx, acc, accNumber, &cancellation)
+	if err != nil || cancelled {
+		return err
+	}
+
+	k.logger(ctx).Debug("Dead man's switch cancellation gas limit is reached.", "acc", acc.String())
+	// the remaining orders are cancelled in the next block
+	if err := k.delayKeeper.ExecuteAfterBlock(
+		ctx,
+		types.BuildDeadManSwitchCancellationDelayKey(accNumber),
+		&cancellation,
+		uint64(ctx.BlockHeight()),
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to store dead man's switch remaining orders cancellation")
+	}
+
+	return nil
+}
+
+// cancelDeadManSwitchOrders cancels the account orders and then the trigger orders placed with the sequence up to the
+// cancellation one until the order expiration sweep gas limit is reached. The orders are scanned from the cancellation
+// cursor which is moved forward with the cancelled orders, and the function returns false if some orders remain.
+func (k Keeper) cancelDeadManSwitchOrders(
+	ctx sdk.Context, acc sdk.AccAddress, accNumber uint64, cancellation *types.CancelDeadManSwitchOrders,
+) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	cancelCtx := ctx.WithGasMeter(gasMeter)
+	for {
+		orderIDs, err := k.getDeadManSwitchOrderIDsToCancel(cancelCtx, accNumber, *cancellation)
+		if err != nil {
+			return false, err
+		}
+		if len(orderIDs) == 0 {
+			if cancellation.TriggerOrders {
+				return true, nil
+			}
+			cancellation.TriggerOrders = true
+			cancellation.OrderIDCursor = ""
+			continue
+		}
+		for _, orderID := range orderIDs {
+			if err := k.cancelOrder(cancelCtx, acc, orderID); err != nil {
+				return false, err
+			}
+			cancellation.OrderIDCursor = orderID
+			if gasMeter.GasConsumed() >= params.OrderExpirationSweepGasLimit {
+				return false, nil
+			}
+		}
+	}
+}
+
+// getDeadManSwitchOrderIDsToCancel returns the batch of the account order IDs, or trigger order IDs, placed with the
+// sequence up to the cancellation one, starting from the cancellation cursor.
+func (k Keeper) getDeadManSwitchOrderIDsToCancel(
+	ctx sdk.Context, accNumber uint64, cancellation types.CancelDeadManSwitchOrders,
+) ([]string, error) {
+	keyPrefix := types.CreateOrderIDToSequenceKeyPrefix(accNumber)
+	if cancellation.TriggerOrders {
+		keyPrefix = types.CreateTriggerOrderKeyPrefix(accNumber)
+	}
+	var start []byte
+	if cancellation.OrderIDCursor != "" {
+		start = []byte(cancellation.OrderIDCursor)
+	}
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), keyPrefix).Iterator(start, nil)
+	defer iterator.Close()
+
+	orderIDs := make([]string, 0)
+	for ; iterator.Valid() && len(orderIDs) < deadManSwitchCancellationBatchSize; iterator.Next() {
+		var (
+			orderID           string
+			placementSequence uint64
+		)
+		if cancellation.TriggerOrders {
+			var order types.Order
+			if err := k.cdc.Unmarshal(iterator.Value(), &order); err != nil {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal trigger order: %s", err)
+			}
+			orderID, placementSequence = order.ID, order.Sequence
+		} else {
+			var orderSequence gogotypes.UInt64Value
+			if err := k.cdc.Unmarshal(iterator.Value(), &orderSequence); err != nil {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal order sequence: %s", err)
+			}
+			orderData, err := k.GetOrderData(ctx, orderSequence.Value)
+			if err != nil {
+				return nil, err
+			}
+			orderID, placementSequence = orderData.OrderID, orderData.PlacementSequence
+			// the orders saved before the placement sequence is introduced are never refreshed
+			if placementSequence == 0 {
+				placementSequence = orderSequence.Value
+			}
+		}
+		if placementSequence > cancellation.OrderSequence {
+			continue
+		}
+		orderIDs = append(orderIDs, orderID)
+	}
+
+	return orderIDs, nil
+}
+
+// refreshAccountDeadManSwitch refreshes the dead man's switch of the account if it's armed.
+func (k Keeper) refreshAccountDeadManSwitch(ctx sdk.Context, acc sdk.AccAddress) error {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	deadManSwitch, found, err := k.getDeadManSwitch(ctx, accNumber)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	return k.refreshDeadManSwitch(ctx, accNumber, deadManSwitch)
+}
+
+func (k Keeper) refreshDeadManSwitch(ctx sdk.Context, accNumber uint64, deadManSwitch types.DeadManSwitch) error {
+	if err := k.removeDeadManSwitch(ctx, accNumber, deadManSwitch); err != nil {
+		return err
+	}
+
+	return k.armDeadManSwitch(ctx, accNumber, deadManSwitch)
+}
+
+func (k Keeper) armDeadManSwitch(ctx sdk.Context, accNumber uint64, deadManSwitch types.DeadManSwitch) error {
+	currentHeight := uint64(ctx.BlockHeight())
+	if deadManSwitch.TimeoutBlocks > math.MaxUint64-currentHeight {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "dead man's switch timeout blocks %d is too big", deadManSwitch.TimeoutBlocks,
+		)
+	}
+	deadManSwitch.ExpirationHeight = currentHeight + deadManSwitch.TimeoutBlocks
+
+	k.logger(ctx).Debug("Arming dead man's switch.", "deadManSwitch", deadManSwitch.String())
+
+	if err := k.delayKeeper.ExecuteAfterBlock(
+		ctx,
+		types.BuildDeadManSwitchDelayKey(accNumber),
+		&types.CancelDeadManSwitchOrders{
+			Creator: deadManSwitch.Creator,
+		},
+		deadManSwitch.ExpirationHeight,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to store dead man's switch delayed cancellation")
+	}
+
+	return k.SaveDeadManSwitch(ctx, accNumber, deadManSwitch)
+}
+
+func (k Keeper) removeDeadManSwitch(ctx sdk.Context, accNumber uint64, deadManSwitch types.DeadManSwitch) error {
+	k.logger(ctx).Debug("Removing dead man's switch.", "deadManSwitch", deadManSwitch.String())
+
+	if err := k.delayKeeper.RemoveExecuteAtBlock(
+		ctx,
+		types.BuildDeadManSwitchDelayKey(accNumber),
+		deadManSwitch.ExpirationHeight,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to remove dead man's switch delayed cancellation")
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(types.CreateDeadManSwitchKey(accNumber))
+}
+
+func (k Keeper) getDeadManSwitch(ctx sdk.Context, accNumber uint64) (types.DeadManSwitch, bool, error) {
+	var deadManSwitch types.DeadManSwitch
+	if err := k.getDataFromStore(ctx, types.CreateDeadManSwitchKey(accNumber), &deadManSwitch); err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.DeadManSwitch{}, false, nil
+		}
+		return types.DeadManSwitch{}, false, err
+	}
+
+	return deadManSwitch, true, nil
+}
@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_DeadManSwitch(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(100)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	acc, _ := testApp.GenAccount(sdkCtx)
+	limitOrder := types.Order{
+		Creator:     acc.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	triggerOrder := limitOrder
+	triggerOrder.ID = "id2"
+	triggerOrder.Trigger = &types.Trigger{
+		Price:     types.MustNewPriceFromString("125e-2"),
+		Condition: types.TRIGGER_CONDITION_PRICE_LTE,
+	}
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 2_000_000)))
+	fundOrderReserve(t, testApp, sdkCtx, acc)
+	fundOrderReserve(t, testApp, sdkCtx, acc)
+
+	// the heartbeat requires the armed switch
+	require.ErrorIs(t, dexKeeper.Heartbeat(sdkCtx, acc), types.ErrInvalidInput)
+	_, err := dexKeeper.GetDeadManSwitch(sdkCtx, acc)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// arm the switch
+	require.NoError(t, dexKeeper.SetDeadManSwitch(sdkCtx, acc, 5))
+	requireDeadManSwitch(t, sdkCtx, dexKeeper, acc, 105)
+
+	// the order placement refreshes the switch
+	sdkCtx = sdkCtx.WithBlockHeight(103)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, limitOrder))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, triggerOrder))
+	requireDeadManSwitch(t, sdkCtx, dexKeeper, acc, 108)
+
+	// the heartbeat refreshes the switch
+	sdkCtx = sdkCtx.WithBlockHeight(104)
+	require.NoError(t, dexKeeper.Heartbeat(sdkCtx, acc))
+	requireDeadManSwitch(t, sdkCtx, dexKeeper, acc, 109)
+	blockItems, err := testApp.DelayKeeper.ExportBlockItems(sdkCtx)
+	require.NoError(t, err)
+	require.Len(t, blockItems, 1)
+	require.Equal(t, uint64(109), blockItems[0].Height)
+
+	// the switch isn't triggered at the expiration height
+	sdkCtx = sdkCtx.WithBlockHeight(109).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, testApp.DelayKeeper.ExecuteAllItems(sdkCtx))
+	require.Empty(t, readDeadManSwitchEvents(t, sdkCtx))
+	orders, _, err := dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	// the switch is triggered after the expiration height
+	sdkCtx = sdkCtx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, testApp.DelayKeeper.ExecuteAllItems(sdkCtx))
+	require.Equal(t, []types.EventDeadManSwitchTriggered{
+		{Creator: acc.String(), ExpirationHeight: 109},
+	}, readDeadManSwitchEvents(t, sdkCtx))
+	orders, _, err = dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Empty(t, orders)
+	triggerOrders, _, err := dexKeeper.GetTriggerOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Empty(t, triggerOrders)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1).IsZero())
+
+	// the triggered switch is disarmed
+	_, err = dexKeeper.GetDeadManSwitch(sdkCtx, acc)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// arm and disarm the switch
+	require.NoError(t, dexKeeper.SetDeadManSwitch(sdkCtx, acc, 10))
+	requireDeadManSwitch(t, sdkCtx, dexKeeper, acc, 120)
+	require.NoError(t, dexKeeper.SetDeadManSwitch(sdkCtx, acc, 0))
+	_, err = dexKeeper.GetDeadManSwitch(sdkCtx, acc)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	blockItems, err = testApp.DelayKeeper.ExportBlockItems(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, blockItems)
+}
+
+func TestKeeper_DeadManSwitchCancellationGasLimit(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(100)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	// the limit allows cancelling one order per block
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.OrderExpirationSweepGasLimit = 1
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	acc, _ := testApp.GenAccount(sdkCtx)
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 3_000_000)))
+	placeOrder := func(sdkCtx sdk.Context, orderID string) {
+		fundOrderReserve(t, testApp, sdkCtx, acc)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          orderID,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+			Quantity:    sdkmath.NewInt(1_000_000),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}))
+	}
+	placeOrder(sdkCtx, "id1")
+	placeOrder(sdkCtx, "id2")
+	require.NoError(t, dexKeeper.SetDeadManSwitch(sdkCtx, acc, 5))
+
+	// the triggered switch cancels the first order only
+	sdkCtx = sdkCtx.WithBlockHeight(106).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, testApp.DelayKeeper.ExecuteAllItems(sdkCtx))
+	require.Len(t, readDeadManSwitchEvents(t, sdkCtx), 1)
+	orders, _, err := dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	_, err = dexKeeper.GetDeadManSwitch(sdkCtx, acc)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// the order placed after the switch is triggered isn't cancelled
+	placeOrder(sdkCtx, "id3")
+
+	// the remaining order is cancelled in the next block without emitting the event again
+	sdkCtx = sdkCtx.WithBlockHeight(107).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, testApp.DelayKeeper.ExecuteAllItems(sdkCtx))
+	require.Empty(t, readDeadManSwitchEvents(t, sdkCtx))
+	orders, _, err = dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, "id3", orders[0].ID)
+
+	// the cancellation is completed
+	sdkCtx = sdkCtx.WithBlockHeight(108)
+	require.NoError(t, testApp.DelayKeeper.ExecuteAllItems(sdkCtx))
+	blockItems, err := testApp.DelayKeeper.ExportBlockItems(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, blockItems)
+	orders, _, err = dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+}
+
+func TestKeeper_DeadManSwitchRefreshedIcebergOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(100)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	// the limit allows cancelling one order per block
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.OrderExpirationSweepGasLimit = 1
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	acc, _ := testApp.GenAccount(sdkCtx)
+	order := types.Order{
+		Creator:     acc.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeTestOrder(t, testApp, sdkCtx, order)
+	icebergOrder := order
+	icebergOrder.ID = "id2"
+	icebergOrder.Price = lo.ToPtr(types.MustNewPriceFromString("11e-1"))
+	icebergOrder.DisplayQuantity = lo.ToPtr(sdkmath.NewInt(300_000))
+	placeTestOrder(t, testApp, sdkCtx, icebergOrder)
+	require.NoError(t, dexKeeper.SetDeadManSwitch(sdkCtx, acc, 5))
+
+	// the triggered switch cancels the first order only
+	sdkCtx = sdkCtx.WithBlockHeight(106).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, testApp.DelayKeeper.ExecuteAllItems(sdkCtx))
+	require.Len(t, readDeadManSwitchEvents(t, sdkCtx), 1)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, order.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// the iceberg order is refreshed with the new sequence after the switch is triggered
+	storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, icebergOrder.ID)
+	require.NoError(t, err)
+	icebergSequence := storedOrder.Sequence
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("11e-1")),
+		Quantity:    sdkmath.NewInt(300_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	})
+	storedOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, icebergOrder.ID)
+	require.NoError(t, err)
+	require.Greater(t, storedOrder.Sequence, icebergSequence)
+
+	// the order placed after the switch is triggered isn't cancelled
+	order.ID = "id3"
+	placeTestOrder(t, testApp, sdkCtx, order)
+
+	// the refreshed iceberg order is cancelled in the next block
+	sdkCtx = sdkCtx.WithBlockHeight(107)
+	require.NoError(t, testApp.DelayKeeper.ExecuteAllItems(sdkCtx))
+	orders, _, err := dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, "id3", orders[0].ID)
+
+	// the cancellation is completed
+	sdkCtx = sdkCtx.WithBlockHeight(108)
+	require.NoError(t, testApp.DelayKeeper.ExecuteAllItems(sdkCtx))
+	blockItems, err := testApp.DelayKeeper.ExportBlockItems(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, blockItems)
+	orders, _, err = dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+}
+
+func requireDeadManSwitch(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	dexKeeper keeper.Keeper,
+	acc sdk.AccAddress,
+	expirationHeight uint64,
+) {
+	t.Helper()
+
+	deadManSwitch, err := dexKeeper.GetDeadManSwitch(sdkCtx, acc)
+	require.NoError(t, err)
+	require.Equal(t, expirationHeight, deadManSwitch.ExpirationHeight)
+}
+
+func readDeadManSwitchEvents(t *testing.T, sdkCtx sdk.Context) []types.EventDeadManSwitchTriggered {
+	events := make([]types.EventDeadManSwitchTriggered, 0)
+	for _, evt := range sdkCtx.EventManager().Events().ToABCIEvents() {
+		if evt.Type != "coreum.dex.v1.EventDeadManSwitchTriggered" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(evt)
+		require.NoError(t, err)
+		events = append(events, *msg.(*types.EventDeadManSwitchTriggered))
+	}
+
+	return events
+}
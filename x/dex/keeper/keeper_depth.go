@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// GetOrderBookDepth returns the order book price levels of both sides aggregated by price.
+func (k Keeper) GetOrderBookDepth(
+	ctx sdk.Context,
+	baseDenom, quoteDenom string,
+	limit uint32,
+) (*types.QueryOrderBookDepthResponse, error) {
+	limit, err := types.NormalizeOrderBookDepthLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	bids, err := k.getOrderBookSidePriceLevels(ctx, orderBookID, types.SIDE_BUY, limit)
+	if err != nil {
+		return nil, err
+	}
+	asks, err := k.getOrderBookSidePriceLevels(ctx, orderBookID, types.SIDE_SELL, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryOrderBookDepthResponse{
+		Bids: bids,
+		Asks: asks,
+	}
+	if len(bids) > 0 {
+		res.BestBid = lo.ToPtr(bids[0].Price)
+	}
+	if len(asks) > 0 {
+		res.BestAsk = lo.ToPtr(asks[0].Price)
+	}
+	// the order book might be crossed in the opening auction, then there is no spread
+	if res.BestBid != nil && res.BestAsk != nil && res.BestAsk.Rat().Cmp(res.BestBid.Rat()) > 0 {
+		spread, err := types.NewPriceFromRat(new(big.Rat).Sub(res.BestAsk.Rat(), res.BestBid.Rat()))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to compute spread: %s", err)
+		}
+		res.Spread = &spread
+	}
+
+	return res, nil
+}
+
+// getOrderBookSidePriceLevels returns up to limit price levels of the order book side starting from the best price.
+func (k Keeper) getOrderBookSidePriceLevels(
+	ctx sdk.Context,
+	orderBookID uint32,
+	side types.Side,
+	limit uint32,
+) ([]types.PriceLevel, error) {
+	iterator := k.NewOrderBookSideIterator(ctx, orderBookID, side)
+	defer iterator.Close()
+
+	levels := make([]types.PriceLevel, 0)
+	for {
+		record, found, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			break
+		}
+
+		if len(levels) > 0 {
+			lastLevel := &levels[len(levels)-1]
+			if lastLevel.Price.Rat().Cmp(record.Price.Rat()) == 0 {
+				lastLevel.RemainingBaseQuantity = lastLevel.RemainingBaseQuantity.Add(record.RemainingBaseQuantity)
+				lastLevel.OrdersCount++
+				continue
+			}
+		}
+		if uint32(len(levels)) == limit {
+			break
+		}
+		levels = append(levels, types.PriceLevel{
+			Price:                 record.Price,
+			RemainingBaseQuantity: record.RemainingBaseQuantity,
+			OrdersCount:           1,
+		})
+	}
+
+	return levels, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// TransferCollectedFees transfers the trading fees collected on the module account to the fee collector module account
+// or to the community pool if the fee collector isn't set. The fee which can't be transferred, e.g. because of the
+// asset ft freezing or whitelisting, stays on the module account until the next attempt.
+func (k Keeper) TransferCollectedFees(ctx sdk.Context) error {
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	fees := k.bankKeeper.GetAllBalances(ctx, moduleAddress)
+	if fees.IsZero() {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, fee := range fees {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.transferFee(cacheCtx, params.FeeCollector, moduleAddress, fee); err != nil {
+			k.logger(ctx).Error("Failed to transfer the collected fee.", "fee", fee.String(), "err", err)
+			continue
+		}
+		writeCache()
+	}
+
+	return nil
+}
+
+func (k Keeper) transferFee(ctx sdk.Context, feeCollector string, moduleAddress sdk.AccAddress, fee sdk.Coin) error {
+	if feeCollector == "" {
+		return k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), moduleAddress)
+	}
+	// the bank keeper panics if the module account doesn't exist
+	if k.accountKeeper.GetModuleAddress(feeCollector) == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "fee collector module account %s doesn't exist", feeCollector)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, feeCollector, sdk.NewCoins(fee))
+}
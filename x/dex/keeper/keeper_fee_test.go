@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/docker/distribution/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_TradingFees(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	dexModuleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.002")
+	params.OrderBookFeeRates = []types.OrderBookFeeRates{
+		{
+			// the rates are applied to the inverted order book as well
+			BaseDenom:    testSet.denom3,
+			QuoteDenom:   testSet.denom1,
+			MakerFeeRate: sdkmath.LegacyZeroDec(),
+			TakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.01"),
+		},
+	}
+	require.NoError(t, dexKeeper.UpdateParams(sdkCtx, govAddr, params))
+
+	orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom3)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyZeroDec().String(), orderBookParams.MakerFeeRate.String())
+	require.Equal(t, "0.010000000000000000", orderBookParams.TakerFeeRate.String())
+
+	// trade with the default fee rates
+	maker, taker := placeFeeTestOrders(t, sdkCtx, testApp, testSet.denom1, testSet.denom2)
+	events := readOrderEvents(t, sdkCtx)
+
+	makerReduced, ok := events.getOrderReduced(maker.Creator, maker.ID)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom2, 1_200_000).String(), makerReduced.ReceivedCoin.String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom2, 1_200).String(), makerReduced.Fee.String())
+	takerReduced, ok := events.getOrderReduced(taker.Creator, taker.ID)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 1_000_000).String(), takerReduced.ReceivedCoin.String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 2_000).String(), takerReduced.Fee.String())
+
+	require.Equal(t,
+		sdkmath.NewInt(1_198_800).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(maker.Creator), testSet.denom2).Amount.String(),
+	)
+	require.Equal(t,
+		sdkmath.NewInt(998_000).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(taker.Creator), testSet.denom1).Amount.String(),
+	)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 2_000), sdk.NewInt64Coin(testSet.denom2, 1_200)).String(),
+		testApp.BankKeeper.GetAllBalances(sdkCtx, dexModuleAddr).String(),
+	)
+
+	// the fees are sent to the community pool
+	require.NoError(t, dexKeeper.TransferCollectedFees(sdkCtx))
+	require.True(t, testApp.BankKeeper.GetAllBalances(sdkCtx, dexModuleAddr).IsZero())
+	feePool, err := testApp.DistrKeeper.FeePool.Get(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, "2000", feePool.CommunityPool.AmountOf(testSet.denom1).TruncateInt().String())
+	require.Equal(t, "1200", feePool.CommunityPool.AmountOf(testSet.denom2).TruncateInt().String())
+
+	// trade with the order book fee rates
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	maker, taker = placeFeeTestOrders(t, sdkCtx, testApp, testSet.denom1, testSet.denom3)
+	events = readOrderEvents(t, sdkCtx)
+	makerReduced, ok = events.getOrderReduced(maker.Creator, maker.ID)
+	require.True(t, ok)
+	require.True(t, makerReduced.Fee.IsZero())
+	takerReduced, ok = events.getOrderReduced(taker.Creator, taker.ID)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 10_000).String(), takerReduced.Fee.String())
+
+	// the fee collector module account must exist
+	params.FeeCollector = "unknown"
+	require.ErrorIs(t, dexKeeper.UpdateParams(sdkCtx, govAddr, params), types.ErrInvalidInput)
+	params.FeeCollector = authtypes.FeeCollectorName
+	require.NoError(t, dexKeeper.UpdateParams(sdkCtx, govAddr, params))
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalanceBefore := testApp.BankKeeper.GetBalance(sdkCtx, feeCollectorAddr, testSet.denom1)
+	require.NoError(t, dexKeeper.TransferCollectedFees(sdkCtx))
+	require.True(t, testApp.BankKeeper.GetAllBalances(sdkCtx, dexModuleAddr).IsZero())
+	require.Equal(t,
+		feeCollectorBalanceBefore.Amount.AddRaw(10_000).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, feeCollectorAddr, testSet.denom1).Amount.String(),
+	)
+}
+
+func TestKeeper_TradingFees_Whitelisting(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	dexModuleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	require.NoError(t, dexKeeper.UpdateParams(sdkCtx, authtypes.NewModuleAddress(govtypes.ModuleName).String(), params))
+
+	// the maker is whitelisted to receive the quote denom
+	placeFeeTestOrders(t, sdkCtx, testApp, testSet.denom1, testSet.ftDenomWhitelisting1)
+	fee := sdk.NewInt64Coin(testSet.ftDenomWhitelisting1, 1_200)
+	require.Equal(t, fee.String(), testApp.BankKeeper.GetAllBalances(sdkCtx, dexModuleAddr).String())
+
+	// the distribution module isn't whitelisted, so the fee stays on the module account
+	require.NoError(t, dexKeeper.TransferCollectedFees(sdkCtx))
+	require.Equal(t, fee.String(), testApp.BankKeeper.GetAllBalances(sdkCtx, dexModuleAddr).String())
+
+	distrAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	require.NoError(t, testApp.AssetFTKeeper.SetWhitelistedBalance(sdkCtx, testSet.issuer, distrAddr, fee))
+	require.NoError(t, dexKeeper.TransferCollectedFees(sdkCtx))
+	require.True(t, testApp.BankKeeper.GetAllBalances(sdkCtx, dexModuleAddr).IsZero())
+}
+
+// placeFeeTestOrders places the maker sell order and the taker buy order matching it fully.
+func placeFeeTestOrders(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	testApp *simapp.App,
+	baseDenom, quoteDenom string,
+) (types.Order, types.Order) {
+	makerAddr, _ := testApp.GenAccount(sdkCtx)
+	takerAddr, _ := testApp.GenAccount(sdkCtx)
+
+	return placeAccountsFeeTestOrders(t, sdkCtx, testApp, makerAddr, takerAddr, baseDenom, quoteDenom)
+}
+
+// placeAccountsFeeTestOrders places the maker sell order and the taker buy order of the accounts matching it fully.
+func placeAccountsFeeTestOrders(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	testApp *simapp.App,
+	makerAddr, takerAddr sdk.AccAddress,
+	baseDenom, quoteDenom string,
+) (types.Order, types.Order) {
+	makerOrder := types.Order{
+		Creator:     makerAddr.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          uuid.Generate().String(),
+		BaseDenom:   baseDenom,
+		QuoteDenom:  quoteDenom,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	takerOrder := makerOrder
+	takerOrder.Creator = takerAddr.String()
+	takerOrder.ID = uuid.Generate().String()
+	takerOrder.Side = types.SIDE_BUY
+
+	for i, order := range []types.Order{makerOrder, takerOrder} {
+		placementCtx := sdkCtx
+		if i == 0 {
+			// only the taker order placement events are kept
+			placementCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+		}
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		expectedToReceive, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+			order.Side, order.BaseDenom, order.QuoteDenom, order.Quantity, *order.Price,
+		)
+		require.NoError(t, err)
+		testApp.AssetFTKeeper.SetWhitelistedBalances(
+			sdkCtx, sdk.MustAccAddressFromBech32(order.Creator), sdk.NewCoins(lockedBalance, expectedToReceive),
+		)
+		placeTestOrder(t, testApp, placementCtx, order)
+	}
+
+	return makerOrder, takerOrder
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// refreshIcebergOrder saves the iceberg order record with the refreshed visible quantity under the new order sequence,
+// so the order is moved to the end of the price level queue. The limits of the order aren't changed since they cover
+// both the visible and the hidden quantities.
+func (k Keeper) refreshIcebergOrder(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	record types.OrderBookRecord,
+) error {
+	k.logger(ctx).Debug("Refreshing iceberg order.", "record", record.String())
+
+	previousSequence := record.OrderSequence
+	if err := k.removeOrderBookRecord(
+		ctx, record.OrderBookID, record.Side, record.Price, previousSequence,
+	); err != nil {
+		return err
+	}
+
+	orderData, err := k.GetOrderData(ctx, previousSequence)
+	if err != nil {
+		return err
+	}
+	if orderData.GoodTil != nil {
+		if err := k.removeOrderExpiration(ctx, *orderData.GoodTil, previousSequence); err != nil {
+			return err
+		}
+	}
+	if err := k.removeOrderData(ctx, previousSequence); err != nil {
+		return err
+	}
+
+	orderBookData, err := k.getOrderBookData(ctx, record.OrderBookID)
+	if err != nil {
+		return err
+	}
+	denoms := []string{orderBookData.BaseDenom, orderBookData.QuoteDenom}
+	if err := k.removeAccountDenomOrderSequence(ctx, record.AccountNumber, denoms, previousSequence); err != nil {
+		return err
+	}
+
+	record.OrderSequence, err = k.genNextOrderSequence(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.saveOrderBookRecord(ctx, record); err != nil {
+		return err
+	}
+	if orderData.GoodTil != nil {
+		if err := k.saveOrderExpiration(ctx, *orderData.GoodTil, record.OrderSequence, creator); err != nil {
+			return err
+		}
+	}
+	if err := k.saveOrderData(ctx, record.OrderSequence, orderData); err != nil {
+		return err
+	}
+	if err := k.saveOrderIDToSequence(ctx, record.AccountNumber, record.OrderID, record.OrderSequence); err != nil {
+		return err
+	}
+	if err := k.saveAccountDenomOrderSequence(ctx, record.AccountNumber, denoms, record.OrderSequence); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderRefreshed{
+		Creator:               creator.String(),
+		ID:                    record.OrderID,
+		PreviousSequence:      previousSequence,
+		Sequence:              record.OrderSequence,
+		RemainingBaseQuantity: record.RemainingBaseQuantity,
+		OrderBookID:           record.OrderBookID,
+		BaseDenom:             orderBookData.BaseDenom,
+		QuoteDenom:            orderBookData.QuoteDenom,
+		Side:                  record.Side,
+		Price:                 record.Price,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderRefreshed: %s", err)
+	}
+
+	return nil
+}
+
+// hideIcebergOrderQuantity updates the order to show only the visible quantity of the iceberg order.
+func hideIcebergOrderQuantity(order *types.Order, displayQuantity sdkmath.Int) error {
+	visibleLockedBalance, err := types.ComputeLimitOrderLockedBalance(
+		order.Side, order.BaseDenom, order.QuoteDenom, order.RemainingBaseQuantity, *order.Price,
+	)
+	if err != nil {
+		return err
+	}
+
+	order.Quantity = displayQuantity
+	order.RemainingSpendableBalance = sdkmath.MinInt(order.RemainingSpendableBalance, visibleLockedBalance.Amount)
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_IcebergOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	icebergOrder := types.Order{
+		Creator:         testSet.acc1.String(),
+		Type:            types.ORDER_TYPE_LIMIT,
+		ID:              "iceberg",
+		BaseDenom:       testSet.denom1,
+		QuoteDenom:      testSet.denom2,
+		Price:           lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:        sdkmath.NewInt(1_000_000),
+		Side:            types.SIDE_SELL,
+		TimeInForce:     types.TIME_IN_FORCE_GTC,
+		DisplayQuantity: lo.ToPtr(sdkmath.NewInt(300_000)),
+	}
+	regularOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "regular",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(500_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeTestOrder(t, testApp, sdkCtx, icebergOrder)
+	placeTestOrder(t, testApp, sdkCtx, regularOrder)
+
+	// the order book shows the visible quantity only
+	orderBookOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, orderBookOrders, 2)
+	require.Equal(t, icebergOrder.ID, orderBookOrders[0].ID)
+	require.Equal(t, "300000", orderBookOrders[0].Quantity.String())
+	require.Equal(t, "300000", orderBookOrders[0].RemainingBaseQuantity.String())
+	require.Equal(t, "300000", orderBookOrders[0].RemainingSpendableBalance.String())
+	require.Nil(t, orderBookOrders[0].DisplayQuantity)
+	require.Nil(t, orderBookOrders[0].HiddenBaseQuantity)
+
+	// the creator sees the hidden quantity
+	storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, icebergOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, "1000000", storedOrder.Quantity.String())
+	require.Equal(t, "300000", storedOrder.RemainingBaseQuantity.String())
+	require.Equal(t, "1000000", storedOrder.RemainingSpendableBalance.String())
+	require.Equal(t, "300000", storedOrder.DisplayQuantity.String())
+	require.Equal(t, "700000", storedOrder.HiddenBaseQuantity.String())
+	icebergSequence := storedOrder.Sequence
+
+	// the visible quantity is filled and refreshed, the rest is filled by the regular order
+	takerOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		ID:          "taker1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(400_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	placeTestOrder(t, testApp, sdkCtx, takerOrder)
+	events := readOrderEvents(t, sdkCtx)
+	require.Len(t, events.OrdersReduced, 3)
+	require.Empty(t, events.OrdersClosed)
+
+	storedOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, icebergOrder.ID)
+	require.NoError(t, err)
+	require.Greater(t, storedOrder.Sequence, icebergSequence)
+	require.Equal(t, "300000", storedOrder.RemainingBaseQuantity.String())
+	require.Equal(t, "400000", storedOrder.HiddenBaseQuantity.String())
+	require.Equal(t, "700000", storedOrder.RemainingSpendableBalance.String())
+
+	refreshedEvents := lo.Filter(sdkCtx.EventManager().Events(), func(evt sdk.Event, _ int) bool {
+		return evt.Type == "coreum.dex.v1.EventOrderRefreshed"
+	})
+	require.Len(t, refreshedEvents, 1)
+
+	// the refreshed order lost its time priority
+	orderBookOrders, _, err = dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{regularOrder.ID, icebergOrder.ID}, lo.Map(orderBookOrders, func(o types.Order, _ int) string {
+		return o.ID
+	}))
+
+	takerOrder.ID = "taker2"
+	takerOrder.Quantity = sdkmath.NewInt(500_000)
+	placeTestOrder(t, testApp, sdkCtx, takerOrder)
+	storedOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, icebergOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, "200000", storedOrder.RemainingBaseQuantity.String())
+	require.Equal(t, "400000", storedOrder.HiddenBaseQuantity.String())
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, regularOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// the iceberg order can't be replaced
+	require.ErrorIs(t, dexKeeper.ReplaceOrder(
+		sdkCtx, testSet.acc1, icebergOrder.ID, nil, sdkmath.NewInt(100_000),
+	), types.ErrInvalidInput)
+
+	// the cancellation releases both the visible and the hidden quantities
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, testSet.acc1, icebergOrder.ID))
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).IsZero())
+	require.Equal(t,
+		sdk.NewInt64Coin(testSet.denom1, 600_000).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.denom1).String(),
+	)
+	require.Equal(t,
+		sdk.NewInt64Coin(testSet.denom2, 150_000).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.denom2).String(),
+	)
+}
+
+func TestKeeper_IcebergOrderRefreshedInTakerMatching(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	icebergOrder := types.Order{
+		Creator:         testSet.acc1.String(),
+		Type:            types.ORDER_TYPE_LIMIT,
+		ID:              "iceberg",
+		BaseDenom:       testSet.denom1,
+		QuoteDenom:      testSet.denom2,
+		Price:           lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:        sdkmath.NewInt(1_000_000),
+		Side:            types.SIDE_SELL,
+		TimeInForce:     types.TIME_IN_FORCE_GTC,
+		DisplayQuantity: lo.ToPtr(sdkmath.NewInt(300_000)),
+	}
+	placeTestOrder(t, testApp, sdkCtx, icebergOrder)
+
+	// the taker order is greater than the visible quantity, so the iceberg order is refreshed and matched again
+	takerOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(600_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	placeTestOrder(t, testApp, sdkCtx, takerOrder)
+
+	events := readOrderEvents(t, sdkCtx)
+	require.Len(t, events.Trades, 2)
+	refreshedEvents := lo.Filter(sdkCtx.EventManager().Events(), func(evt sdk.Event, _ int) bool {
+		return evt.Type == "coreum.dex.v1.EventOrderRefreshed"
+	})
+	require.Len(t, refreshedEvents, 1)
+
+	// each match of the refreshed iceberg order has its own maker event, and the taker fee is charged for both
+	makerEvents := lo.Filter(events.OrdersReduced, func(evt types.EventOrderReduced, _ int) bool {
+		return evt.ID == icebergOrder.ID
+	})
+	require.Len(t, makerEvents, 2)
+	for _, evt := range makerEvents {
+		require.Equal(t, testSet.acc1.String(), evt.Creator)
+		require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 300_000).String(), evt.SentCoin.String())
+		require.Equal(t, sdk.NewInt64Coin(testSet.denom2, 112_500).String(), evt.ReceivedCoin.String())
+		require.Equal(t, testSet.denom1, evt.BaseDenom)
+		require.Equal(t, testSet.denom2, evt.QuoteDenom)
+		require.Equal(t, types.SIDE_SELL, evt.Side)
+		require.Equal(t, "375e-3", evt.Price.String())
+	}
+	takerReduced, ok := events.getOrderReduced(testSet.acc3.String(), takerOrder.ID)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 600_000).String(), takerReduced.ReceivedCoin.String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 600).String(), takerReduced.Fee.String())
+
+	// the taker order is filled, so the order book isn't crossed
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc3, takerOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	buyOrders, _, err := dexKeeper.GetOrderBookOrders(sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_BUY, nil)
+	require.NoError(t, err)
+	require.Empty(t, buyOrders)
+	require.Equal(t,
+		sdk.NewInt64Coin(testSet.denom1, 599_400).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc3, testSet.denom1).String(),
+	)
+
+	storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, icebergOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, "300000", storedOrder.RemainingBaseQuantity.String())
+	require.Equal(t, "100000", storedOrder.HiddenBaseQuantity.String())
+	require.Equal(t, "400000", storedOrder.RemainingSpendableBalance.String())
+	require.Equal(t,
+		sdk.NewInt64Coin(testSet.denom1, 400_000).String(),
+		testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).String(),
+	)
+
+	// the iceberg order is closed if the refreshed part is filled entirely
+	takerOrder.ID = "taker2"
+	takerOrder.Quantity = sdkmath.NewInt(400_000)
+	placeTestOrder(t, testApp, sdkCtx, takerOrder)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, icebergOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc3, takerOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).IsZero())
+}
@@ -55,18 +55,49 @@ func (k Keeper) matchOrder(
 		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderPlaced: %s", err)
 	}
 
-	mr, err := engine.MatchOrder(ctx, accNumber, orderBookID, takerOrder, remainingBalance)
+	inAuction, err := k.isOrderBookInAuction(ctx, orderBookID)
 	if err != nil {
 		return err
 	}
 
+	var mr matchingengine.MatchingResult
+	if inAuction {
+		// the orders placed during the opening auction aren't matched until the auction is uncrossed
+		mr, err = matchingengine.NewUnmatchedResult(accNumber, orderBookID, takerOrder, remainingBalance)
+		if err != nil {
+			return err
+		}
+	} else {
+		priceBand, err := k.getCircuitBreakerPriceBand(ctx, params, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return err
+		}
+		mr, err = engine.MatchOrder(ctx, accNumber, orderBookID, takerOrder, remainingBalance, priceBand)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The remaining part of the order which triggered the circuit breaker isn't added to the order book, since the
+	// order book pair is halted.
+	if mr.CircuitBreaker != nil {
+		if err := k.triggerCircuitBreaker(
+			ctx, params, orderBookID, invertedOrderBookID, *mr.CircuitBreaker,
+		); err != nil {
+			return err
+		}
+	}
+
 	switch takerOrder.Type {
 	case types.ORDER_TYPE_LIMIT:
 		switch takerOrder.TimeInForce {
-		case types.TIME_IN_FORCE_GTC:
-			// If taker order is filled fully or not executable as maker we just apply matching result and return.
-			if mr.TakerIsFilled || !isOrderRecordExecutableAsMaker(&mr.TakerRecord) {
-				return k.applyMatchingResult(ctx, mr)
+		case types.TIME_IN_FORCE_GTC, types.TIME_IN_FORCE_POST_ONLY:
+			// The post-only order is never matched, since the matching engine rejects it if it crosses the order book.
+			// If taker order is filled fully, canceled by the self-trade prevention, stopped by the circuit breaker or
+			// not executable as maker we just apply matching result and return.
+			if mr.TakerIsFilled || mr.TakerIsCanceled || mr.CircuitBreaker != nil ||
+				!isOrderRecordExecutableAsMaker(&mr.TakerRecord) {
+				return k.applyMatchingResult(ctx, params, mr)
 			}
 
 			// If taker orders is not filled fully we need to:
@@ -76,6 +107,11 @@ func (k Keeper) matchOrder(
 			if err := mr.IncreaseTakerLimitsForRecord(params, takerOrder, &mr.TakerRecord); err != nil {
 				return err
 			}
+			// the iceberg order shows only the display quantity, the rest of the quantity is hidden
+			if takerOrder.DisplayQuantity != nil {
+				mr.TakerRecord.SetDisplayQuantity(*takerOrder.DisplayQuantity)
+			}
+			mr.TakerOrderReducedEvent.VisibleBaseQuantity = mr.TakerRecord.RemainingBaseQuantity
 
 			// In partial match case, we should create an order for the remaining part, and it makes sense to happen
 			// after finalizing the match, but since a call to smart contract happens in applyMatchingResult, it will be
@@ -86,15 +122,15 @@ func (k Keeper) matchOrder(
 				return err
 			}
 
-			return k.applyMatchingResult(ctx, mr)
+			return k.applyMatchingResult(ctx, params, mr)
 		case types.TIME_IN_FORCE_IOC:
-			return k.applyMatchingResult(ctx, mr)
+			return k.applyMatchingResult(ctx, params, mr)
 		case types.TIME_IN_FORCE_FOK:
-			// ensure full order fill
-			if mr.TakerRecord.RemainingBaseQuantity.IsPositive() {
+			// ensure full order fill, the order isn't executed if the self-trade prevention is applied
+			if mr.TakerRecord.RemainingBaseQuantity.IsPositive() || len(mr.SelfTradeEvents) > 0 {
 				return nil
 			}
-			return k.applyMatchingResult(ctx, mr)
+			return k.applyMatchingResult(ctx, params, mr)
 		default:
 			return sdkerrors.Wrapf(
 				types.ErrInvalidInput,
@@ -104,7 +140,7 @@ func (k Keeper) matchOrder(
 	case types.ORDER_TYPE_MARKET:
 		switch takerOrder.TimeInForce {
 		case types.TIME_IN_FORCE_IOC:
-			return k.applyMatchingResult(ctx, mr)
+			return k.applyMatchingResult(ctx, params, mr)
 		default:
 			return sdkerrors.Wrapf(
 				types.ErrInvalidInput,
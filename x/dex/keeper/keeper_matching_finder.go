@@ -16,13 +16,52 @@ import (
 type MatchingFinder struct {
 	log log.Logger
 
-	directOBIterator   *OrderBookIterator
-	invertedOBIterator *OrderBookIterator
+	directOBQueue   *orderBookSideQueue
+	invertedOBQueue *orderBookSideQueue
 
 	order types.Order
 
 	directOBRecord   *types.OrderBookRecord
 	invertedOBRecord *types.OrderBookRecord
+	// the requeued records lose the time priority, so they are matched after the records with the same price
+	directOBRecordRequeued   bool
+	invertedOBRecordRequeued bool
+}
+
+// orderBookSideQueue returns the records of the order book side iterator merged with the requeued records.
+type orderBookSideQueue struct {
+	iterator *OrderBookIterator
+	// nextRecord is the record read from the iterator but not returned yet
+	nextRecord *types.OrderBookRecord
+	requeued   []types.OrderBookRecord
+}
+
+// next returns the next record and the flag that indicates whether the record is requeued. The requeued record has
+// the price of the best level, since it's matched before, so it's returned once the iterator leaves the level.
+func (q *orderBookSideQueue) next() (types.OrderBookRecord, bool, bool, error) {
+	if q.nextRecord == nil {
+		record, found, err := q.iterator.Next()
+		if err != nil {
+			return types.OrderBookRecord{}, false, false, err
+		}
+		if found {
+			q.nextRecord = &record
+		}
+	}
+
+	if len(q.requeued) > 0 &&
+		(q.nextRecord == nil || !cbig.RatEQ(q.nextRecord.Price.Rat(), q.requeued[0].Price.Rat())) {
+		record := q.requeued[0]
+		q.requeued = q.requeued[1:]
+		return record, true, true, nil
+	}
+	if q.nextRecord == nil {
+		return types.OrderBookRecord{}, false, false, nil
+	}
+	record := *q.nextRecord
+	q.nextRecord = nil
+
+	return record, true, false, nil
 }
 
 // NewMatchingFinder returns new instance of the MatchingFinder.
@@ -39,8 +78,12 @@ func (k Keeper) NewMatchingFinder(
 	return &MatchingFinder{
 		log: k.logger(ctx),
 
-		directOBIterator:   k.NewOrderBookSideIterator(ctx, orderBookID, oppositeSide),
-		invertedOBIterator: k.NewOrderBookSideIterator(ctx, invertedOrderBookID, order.Side),
+		directOBQueue: &orderBookSideQueue{
+			iterator: k.NewOrderBookSideIterator(ctx, orderBookID, oppositeSide),
+		},
+		invertedOBQueue: &orderBookSideQueue{
+			iterator: k.NewOrderBookSideIterator(ctx, invertedOrderBookID, order.Side),
+		},
 
 		order: order,
 	}, nil
@@ -90,12 +133,23 @@ func (mf *MatchingFinder) Next() (types.OrderBookRecord, bool, error) {
 	return record, true, nil
 }
 
+// Requeue puts the refreshed iceberg record back to the queue behind the records with the same price.
+func (mf *MatchingFinder) Requeue(record types.OrderBookRecord) {
+	mf.log.Debug("Requeuing OB record.", "record", record.String())
+	// the records of the inverted order book have the same side as the taker order
+	if record.Side == mf.order.Side {
+		mf.invertedOBQueue.requeued = append(mf.invertedOBQueue.requeued, record)
+		return
+	}
+	mf.directOBQueue.requeued = append(mf.directOBQueue.requeued, record)
+}
+
 // Close closes used iterators for the MatchingFinder.
 func (mf *MatchingFinder) Close() error {
-	if err := mf.directOBIterator.Close(); err != nil {
+	if err := mf.directOBQueue.iterator.Close(); err != nil {
 		return sdkerrors.Wrapf(err, "failed to close directOBIterator")
 	}
-	if err := mf.invertedOBIterator.Close(); err != nil {
+	if err := mf.invertedOBQueue.iterator.Close(); err != nil {
 		return sdkerrors.Wrapf(err, "failed to close invertedOBIterator")
 	}
 
@@ -104,22 +158,24 @@ func (mf *MatchingFinder) Close() error {
 
 func (mf *MatchingFinder) loadOrders() error {
 	if mf.directOBRecord == nil {
-		directOBRecord, found, err := mf.directOBIterator.Next()
+		directOBRecord, found, requeued, err := mf.directOBQueue.next()
 		if err != nil {
 			return err
 		}
 		if found {
 			mf.directOBRecord = &directOBRecord
+			mf.directOBRecordRequeued = requeued
 		}
 	}
 
 	if mf.invertedOBRecord == nil {
-		invertedOBRecord, found, err := mf.invertedOBIterator.Next()
+		invertedOBRecord, found, requeued, err := mf.invertedOBQueue.next()
 		if err != nil {
 			return err
 		}
 		if found {
 			mf.invertedOBRecord = &invertedOBRecord
+			mf.invertedOBRecordRequeued = requeued
 		}
 	}
 
@@ -137,8 +193,11 @@ func (mf *MatchingFinder) isDirectRecordBestMatch(directMatches, invertedMatches
 	directOBPriceRat := mf.directOBRecord.Price.Rat()
 	invertedOBInvPriceRat := cbig.RatInv(mf.invertedOBRecord.Price.Rat())
 
-	// if both prices are the same then FIFO by OrderSequence wins
+	// if both prices are the same then FIFO by OrderSequence wins, the requeued record is behind the others
 	if cbig.RatEQ(directOBPriceRat, invertedOBInvPriceRat) {
+		if mf.directOBRecordRequeued != mf.invertedOBRecordRequeued {
+			return mf.invertedOBRecordRequeued
+		}
 		return mf.directOBRecord.OrderSequence < mf.invertedOBRecord.OrderSequence
 	}
 
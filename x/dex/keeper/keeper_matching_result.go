@@ -2,9 +2,12 @@ package keeper
 
 import (
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
 	matchingengine "github.com/CoreumFoundation/coreum/v6/x/dex/matching-engine"
 	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
@@ -16,12 +19,27 @@ type RecordToAddress struct {
 	Record  *types.OrderBookRecord
 }
 
-func (k Keeper) applyMatchingResult(ctx sdk.Context, mr matchingengine.MatchingResult) error {
+func (k Keeper) applyMatchingResult(
+	ctx sdk.Context,
+	params types.Params,
+	mr matchingengine.MatchingResult,
+) error {
 	// if matched passed but no changes are applied return
-	if mr.FTActions.CreatorExpectedToSpend.IsNil() {
+	if mr.FTActions.CreatorExpectedToSpend.IsNil() && len(mr.SelfTradeEvents) == 0 {
 		return nil
 	}
 
+	// the fees are collected on the module account and transferred to the fee collector in the end blocker
+	makerFeeRate, takerFeeRate := params.GetFeeRates(mr.FTActions.Order.BaseDenom, mr.FTActions.Order.QuoteDenom)
+	if err := mr.ChargeFees(
+		authtypes.NewModuleAddress(types.ModuleName),
+		makerFeeRate,
+		takerFeeRate,
+		k.newFeeDiscountProvider(ctx, params),
+	); err != nil {
+		return err
+	}
+
 	for _, item := range mr.RecordsToRemove {
 		if err := k.removeOrderByRecord(ctx, item.Address, *item.Record); err != nil {
 			return err
@@ -34,10 +52,38 @@ func (k Keeper) applyMatchingResult(ctx sdk.Context, mr matchingengine.MatchingR
 		}
 	}
 
+	for _, item := range mr.RecordsToRefresh {
+		if err := k.refreshIcebergOrder(ctx, item.Address, *item.Record); err != nil {
+			return err
+		}
+	}
+
+	if mr.LastTrade != nil {
+		if err := k.saveOrderBookLastTrade(ctx, *mr.LastTrade); err != nil {
+			return err
+		}
+		// the first trade after the halt sets the new reference price of the circuit breaker
+		if err := k.resetCircuitBreaker(ctx, mr.LastTrade.OrderBookID); err != nil {
+			return err
+		}
+		if err := k.updatePriceAccumulators(ctx, params, *mr.LastTrade); err != nil {
+			return err
+		}
+	}
+
+	if err := k.updateTradingVolumes(ctx, params, mr.TradeEvents); err != nil {
+		return err
+	}
+
 	if err := k.publishMatchingEvents(ctx, mr); err != nil {
 		return err
 	}
 
+	// the self-trade prevention might cancel the maker orders without any trade, so only the limits are decreased
+	if mr.FTActions.CreatorExpectedToSpend.IsNil() {
+		return k.decreaseMakerLimits(ctx, mr.FTActions)
+	}
+
 	// the call to smart contract is the last call here to avoid reentrancy vulnerability.
 	return k.assetFTKeeper.DEXExecuteActions(ctx, mr.FTActions)
 }
@@ -57,5 +103,35 @@ func (k Keeper) publishMatchingEvents(
 		}
 	}
 
+	for _, evt := range mr.TradeEvents {
+		if err := ctx.EventManager().EmitTypedEvent(&evt); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventTrade: %s", err)
+		}
+	}
+
+	for _, evt := range mr.SelfTradeEvents {
+		if err := ctx.EventManager().EmitTypedEvent(&evt); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventSelfTradePrevented: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) decreaseMakerLimits(ctx sdk.Context, actions assetfttypes.DEXActions) error {
+	for _, unlock := range actions.DecreaseLocked {
+		if err := k.assetFTKeeper.DEXDecreaseLimits(
+			ctx, unlock.Address, sdk.NewCoins(unlock.Coin), sdk.NewCoin(unlock.Coin.Denom, sdkmath.ZeroInt()),
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, decrease := range actions.DecreaseExpectedToReceive {
+		if err := k.assetFTKeeper.DEXDecreaseLimits(ctx, decrease.Address, nil, decrease.Coin); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
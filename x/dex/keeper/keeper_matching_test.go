@@ -88,6 +88,7 @@ func (tt tst) run(t *testing.T) {
 			require.True(t, sdkerrors.IsOf(
 				err,
 				assetfttypes.ErrDEXInsufficientSpendableBalance, assetfttypes.ErrWhitelistedLimitExceeded,
+				types.ErrPostOnlyOrderMatched,
 			))
 			expectedErr := tt.wantErrorContains(testSet)
 			require.ErrorContains(t, err, expectedErr)
@@ -4822,6 +4823,511 @@ func TestKeeper_MatchOrders_FOKMatching(t *testing.T) {
 	}
 }
 
+func TestKeeper_MatchOrders_PostOnlyMatching(t *testing.T) {
+	tests := []tst{
+		{
+			name: "no_match_limit_buy_time_in_force_post_only",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserveTimes(2),
+						sdk.NewInt64Coin(testSet.denom1, 1_000_000),
+						sdk.NewInt64Coin(testSet.denom2, 375_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					{
+						Creator:     testSet.acc1.String(),
+						Type:        types.ORDER_TYPE_LIMIT,
+						ID:          "id1",
+						BaseDenom:   testSet.denom1,
+						QuoteDenom:  testSet.denom2,
+						Price:       lo.ToPtr(types.MustNewPriceFromString("376e-3")),
+						Quantity:    sdkmath.NewInt(1_000_000),
+						Side:        types.SIDE_SELL,
+						TimeInForce: types.TIME_IN_FORCE_GTC,
+					},
+					{
+						Creator:     testSet.acc1.String(),
+						Type:        types.ORDER_TYPE_LIMIT,
+						ID:          "id2",
+						BaseDenom:   testSet.denom1,
+						QuoteDenom:  testSet.denom2,
+						Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+						Quantity:    sdkmath.NewInt(1_000_000),
+						Side:        types.SIDE_BUY,
+						TimeInForce: types.TIME_IN_FORCE_POST_ONLY,
+					},
+				}
+			},
+			wantOrders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					{
+						Creator:                   testSet.acc1.String(),
+						Type:                      types.ORDER_TYPE_LIMIT,
+						ID:                        "id1",
+						BaseDenom:                 testSet.denom1,
+						QuoteDenom:                testSet.denom2,
+						Price:                     lo.ToPtr(types.MustNewPriceFromString("376e-3")),
+						Quantity:                  sdkmath.NewInt(1_000_000),
+						Side:                      types.SIDE_SELL,
+						TimeInForce:               types.TIME_IN_FORCE_GTC,
+						RemainingBaseQuantity:     sdkmath.NewInt(1_000_000),
+						RemainingSpendableBalance: sdkmath.NewInt(1_000_000),
+					},
+					{
+						Creator:                   testSet.acc1.String(),
+						Type:                      types.ORDER_TYPE_LIMIT,
+						ID:                        "id2",
+						BaseDenom:                 testSet.denom1,
+						QuoteDenom:                testSet.denom2,
+						Price:                     lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+						Quantity:                  sdkmath.NewInt(1_000_000),
+						Side:                      types.SIDE_BUY,
+						TimeInForce:               types.TIME_IN_FORCE_POST_ONLY,
+						RemainingBaseQuantity:     sdkmath.NewInt(1_000_000),
+						RemainingSpendableBalance: sdkmath.NewInt(375_000),
+					},
+				}
+			},
+			wantAvailableBalances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{}
+			},
+		},
+		{
+			name: "match_limit_directOB_maker_sell_taker_buy_time_in_force_post_only",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom1, 1_000_000),
+					),
+					testSet.acc2.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom2, 376_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					{
+						Creator:     testSet.acc1.String(),
+						Type:        types.ORDER_TYPE_LIMIT,
+						ID:          "id1",
+						BaseDenom:   testSet.denom1,
+						QuoteDenom:  testSet.denom2,
+						Price:       lo.ToPtr(types.MustNewPriceFromString("376e-3")),
+						Quantity:    sdkmath.NewInt(1_000_000),
+						Side:        types.SIDE_SELL,
+						TimeInForce: types.TIME_IN_FORCE_GTC,
+					},
+					{
+						Creator:     testSet.acc2.String(),
+						Type:        types.ORDER_TYPE_LIMIT,
+						ID:          "id2",
+						BaseDenom:   testSet.denom1,
+						QuoteDenom:  testSet.denom2,
+						Price:       lo.ToPtr(types.MustNewPriceFromString("376e-3")),
+						Quantity:    sdkmath.NewInt(1_000_000),
+						Side:        types.SIDE_BUY,
+						TimeInForce: types.TIME_IN_FORCE_POST_ONLY,
+					},
+				}
+			},
+			wantErrorContains: func(testSet TestSet) string {
+				return "order id2 crosses the order book at price 376e-3"
+			},
+		},
+		{
+			name: "match_limit_invertedOB_maker_buy_taker_buy_time_in_force_post_only",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom1, 2_000_000),
+					),
+					testSet.acc2.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom2, 1_000_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					{
+						Creator:     testSet.acc1.String(),
+						Type:        types.ORDER_TYPE_LIMIT,
+						ID:          "id1",
+						BaseDenom:   testSet.denom2,
+						QuoteDenom:  testSet.denom1,
+						Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+						Quantity:    sdkmath.NewInt(1_000_000),
+						Side:        types.SIDE_BUY,
+						TimeInForce: types.TIME_IN_FORCE_GTC,
+					},
+					{
+						Creator:     testSet.acc2.String(),
+						Type:        types.ORDER_TYPE_LIMIT,
+						ID:          "id2",
+						BaseDenom:   testSet.denom1,
+						QuoteDenom:  testSet.denom2,
+						Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+						Quantity:    sdkmath.NewInt(2_000_000),
+						Side:        types.SIDE_BUY,
+						TimeInForce: types.TIME_IN_FORCE_POST_ONLY,
+					},
+				}
+			},
+			wantErrorContains: func(testSet TestSet) string {
+				return "order id2 crosses the order book"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t)
+		})
+	}
+}
+
+func TestKeeper_MatchOrders_SelfTradePrevention(t *testing.T) {
+	sellOrder := func(testSet TestSet, creator sdk.AccAddress, id, price string, quantity int64) types.Order {
+		return types.Order{
+			Creator:     creator.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString(price)),
+			Quantity:    sdkmath.NewInt(quantity),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+	buyOrder := func(
+		testSet TestSet,
+		creator sdk.AccAddress,
+		id, price string,
+		quantity int64,
+		timeInForce types.TimeInForce,
+		selfTradePrevention types.SelfTradePrevention,
+	) types.Order {
+		return types.Order{
+			Creator:             creator.String(),
+			Type:                types.ORDER_TYPE_LIMIT,
+			ID:                  id,
+			BaseDenom:           testSet.denom1,
+			QuoteDenom:          testSet.denom2,
+			Price:               lo.ToPtr(types.MustNewPriceFromString(price)),
+			Quantity:            sdkmath.NewInt(quantity),
+			Side:                types.SIDE_BUY,
+			TimeInForce:         timeInForce,
+			SelfTradePrevention: selfTradePrevention,
+		}
+	}
+	withRemaining := func(order types.Order, remainingBaseQuantity, remainingSpendableBalance int64) types.Order {
+		order.SelfTradePrevention = types.SELF_TRADE_PREVENTION_UNSPECIFIED
+		order.RemainingBaseQuantity = sdkmath.NewInt(remainingBaseQuantity)
+		order.RemainingSpendableBalance = sdkmath.NewInt(remainingSpendableBalance)
+		return order
+	}
+
+	tests := []tst{
+		{
+			name: "cancel_newest",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserveTimes(2),
+						sdk.NewInt64Coin(testSet.denom1, 1_000_000),
+						sdk.NewInt64Coin(testSet.denom2, 375_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					sellOrder(testSet, testSet.acc1, "id1", "375e-3", 1_000_000),
+					buyOrder(
+						testSet, testSet.acc1, "id2", "375e-3", 1_000_000,
+						types.TIME_IN_FORCE_GTC, types.SELF_TRADE_PREVENTION_CANCEL_NEWEST,
+					),
+				}
+			},
+			wantOrders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					withRemaining(sellOrder(testSet, testSet.acc1, "id1", "375e-3", 1_000_000), 1_000_000, 1_000_000),
+				}
+			},
+			wantAvailableBalances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom2, 375_000),
+					),
+				}
+			},
+		},
+		{
+			name: "cancel_oldest",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserveTimes(2),
+						sdk.NewInt64Coin(testSet.denom1, 500_000),
+						sdk.NewInt64Coin(testSet.denom2, 376_000),
+					),
+					testSet.acc2.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom1, 500_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					sellOrder(testSet, testSet.acc1, "id1", "375e-3", 500_000),
+					sellOrder(testSet, testSet.acc2, "id2", "376e-3", 500_000),
+					// the own order is canceled and the order of the other account is matched
+					buyOrder(
+						testSet, testSet.acc1, "id3", "376e-3", 1_000_000,
+						types.TIME_IN_FORCE_GTC, types.SELF_TRADE_PREVENTION_CANCEL_OLDEST,
+					),
+				}
+			},
+			wantOrders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					withRemaining(
+						buyOrder(
+							testSet, testSet.acc1, "id3", "376e-3", 1_000_000,
+							types.TIME_IN_FORCE_GTC, types.SELF_TRADE_PREVENTION_CANCEL_OLDEST,
+						),
+						500_000, 188_000,
+					),
+				}
+			},
+			wantAvailableBalances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom1, 1_000_000),
+					),
+					testSet.acc2.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom2, 188_000),
+					),
+				}
+			},
+		},
+		{
+			name: "cancel_both",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserveTimes(2),
+						sdk.NewInt64Coin(testSet.denom1, 1_000_000),
+						sdk.NewInt64Coin(testSet.denom2, 375_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					sellOrder(testSet, testSet.acc1, "id1", "375e-3", 1_000_000),
+					buyOrder(
+						testSet, testSet.acc1, "id2", "375e-3", 1_000_000,
+						types.TIME_IN_FORCE_GTC, types.SELF_TRADE_PREVENTION_CANCEL_BOTH,
+					),
+				}
+			},
+			wantOrders: func(testSet TestSet) []types.Order {
+				return []types.Order{}
+			},
+			wantAvailableBalances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserveTimes(2),
+						sdk.NewInt64Coin(testSet.denom1, 1_000_000),
+						sdk.NewInt64Coin(testSet.denom2, 375_000),
+					),
+				}
+			},
+		},
+		{
+			name: "decrement_and_cancel_maker_smaller",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserveTimes(2),
+						sdk.NewInt64Coin(testSet.denom1, 400_000),
+						sdk.NewInt64Coin(testSet.denom2, 375_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					sellOrder(testSet, testSet.acc1, "id1", "375e-3", 400_000),
+					buyOrder(
+						testSet, testSet.acc1, "id2", "375e-3", 1_000_000,
+						types.TIME_IN_FORCE_GTC, types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+					),
+				}
+			},
+			wantOrders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					withRemaining(
+						buyOrder(
+							testSet, testSet.acc1, "id2", "375e-3", 1_000_000,
+							types.TIME_IN_FORCE_GTC, types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+						),
+						600_000, 225_000,
+					),
+				}
+			},
+			wantAvailableBalances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom1, 400_000),
+						sdk.NewInt64Coin(testSet.denom2, 150_000),
+					),
+				}
+			},
+		},
+		{
+			name: "decrement_and_cancel_taker_smaller",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserveTimes(2),
+						sdk.NewInt64Coin(testSet.denom1, 1_000_000),
+						sdk.NewInt64Coin(testSet.denom2, 150_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					sellOrder(testSet, testSet.acc1, "id1", "375e-3", 1_000_000),
+					buyOrder(
+						testSet, testSet.acc1, "id2", "375e-3", 400_000,
+						types.TIME_IN_FORCE_GTC, types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+					),
+				}
+			},
+			wantOrders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					withRemaining(sellOrder(testSet, testSet.acc1, "id1", "375e-3", 1_000_000), 600_000, 600_000),
+				}
+			},
+			wantAvailableBalances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom1, 400_000),
+						sdk.NewInt64Coin(testSet.denom2, 150_000),
+					),
+				}
+			},
+		},
+		{
+			name: "fok_not_executed",
+			balances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom1, 500_000),
+						sdk.NewInt64Coin(testSet.denom2, 375_000),
+					),
+					testSet.acc2.String(): sdk.NewCoins(
+						testSet.orderReserve,
+						sdk.NewInt64Coin(testSet.denom1, 500_000),
+					),
+				}
+			},
+			orders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					sellOrder(testSet, testSet.acc1, "id1", "375e-3", 500_000),
+					sellOrder(testSet, testSet.acc2, "id2", "375e-3", 500_000),
+					buyOrder(
+						testSet, testSet.acc1, "id3", "375e-3", 500_000,
+						types.TIME_IN_FORCE_FOK, types.SELF_TRADE_PREVENTION_CANCEL_OLDEST,
+					),
+				}
+			},
+			wantOrders: func(testSet TestSet) []types.Order {
+				return []types.Order{
+					withRemaining(sellOrder(testSet, testSet.acc1, "id1", "375e-3", 500_000), 500_000, 500_000),
+					withRemaining(sellOrder(testSet, testSet.acc2, "id2", "375e-3", 500_000), 500_000, 500_000),
+				}
+			},
+			wantAvailableBalances: func(testSet TestSet) map[string]sdk.Coins {
+				return map[string]sdk.Coins{
+					testSet.acc1.String(): sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 375_000)),
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t)
+		})
+	}
+}
+
+func TestKeeper_SelfTradePreventionEvents(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	makerOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(400_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	takerOrder := makerOrder
+	takerOrder.ID = "id2"
+	takerOrder.Quantity = sdkmath.NewInt(1_000_000)
+	takerOrder.Side = types.SIDE_BUY
+	takerOrder.SelfTradePrevention = types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL
+
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(
+		testSet.orderReserveTimes(2),
+		sdk.NewInt64Coin(testSet.denom1, 400_000),
+		sdk.NewInt64Coin(testSet.denom2, 375_000),
+	))
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, makerOrder))
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, takerOrder))
+	events := readOrderEvents(t, sdkCtx)
+
+	require.Empty(t, events.OrdersReduced)
+	require.Empty(t, events.Trades)
+	require.Equal(t, []types.EventSelfTradePrevented{
+		{
+			Creator:                  testSet.acc1.String(),
+			SelfTradePrevention:      types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+			TakerOrderID:             takerOrder.ID,
+			TakerOrderSequence:       events.OrderPlaced.Sequence,
+			MakerOrderID:             makerOrder.ID,
+			MakerOrderSequence:       events.OrderPlaced.Sequence - 1,
+			TakerCanceled:            false,
+			MakerCanceled:            true,
+			DecrementedBaseQuantity:  sdkmath.NewInt(400_000),
+			MakerVisibleBaseQuantity: sdkmath.ZeroInt(),
+		},
+	}, events.SelfTrades)
+	require.Len(t, events.OrdersClosed, 1)
+	require.Equal(t, makerOrder.ID, events.OrdersClosed[0].ID)
+	require.NotNil(t, events.OrderCreated)
+	require.Equal(t, sdkmath.NewInt(600_000).String(), events.OrderCreated.RemainingBaseQuantity.String())
+}
+
 func TestKeeper_MatchOrders_Whitelisting(t *testing.T) {
 	tests := []tst{
 		{
@@ -6650,19 +7156,30 @@ func assertOrderPlacementResult(
 
 	t.Logf("Order found in the order book.")
 	require.NotNil(t, events.OrderCreated)
+	require.Positive(t, events.OrderCreated.OrderBookID)
 	require.Equal(t, types.EventOrderCreated{
 		Creator:                   storedOrder.Creator,
 		ID:                        storedOrder.ID,
 		Sequence:                  events.OrderPlaced.Sequence,
 		RemainingBaseQuantity:     storedOrder.RemainingBaseQuantity,
 		RemainingSpendableBalance: storedOrder.RemainingSpendableBalance,
+		OrderBookID:               events.OrderCreated.OrderBookID,
+		BaseDenom:                 storedOrder.BaseDenom,
+		QuoteDenom:                storedOrder.QuoteDenom,
+		Side:                      storedOrder.Side,
+		Price:                     *storedOrder.Price,
+		VisibleBaseQuantity:       storedOrder.RemainingBaseQuantity,
 	}, *events.OrderCreated)
 
 	if order.Type != types.ORDER_TYPE_LIMIT {
 		t.Fatalf("Saved not market order, type: %s", order.Type.String())
 	}
-	if order.TimeInForce != types.TIME_IN_FORCE_GTC {
-		t.Fatalf("Saved not GTC order, time in force: %s", order.TimeInForce.String())
+	switch order.TimeInForce {
+	case types.TIME_IN_FORCE_GTC:
+	case types.TIME_IN_FORCE_POST_ONLY:
+		require.Equal(t, order.Quantity.String(), storedOrder.RemainingBaseQuantity.String())
+	default:
+		t.Fatalf("Saved not GTC or post-only order, time in force: %s", order.TimeInForce.String())
 	}
 }
 
@@ -6699,6 +7216,10 @@ func assertPlacementEvents(t *testing.T, order types.Order, events OrderPlacemen
 		makerSentAmt = makerSentAmt.Add(reducedEvt.SentCoin)
 		makerReceivedAmt = makerReceivedAmt.Add(reducedEvt.ReceivedCoin)
 	}
+	// the self-trade prevention decrements the order without the execution
+	for _, selfTradeEvt := range events.SelfTrades {
+		expectedRemainingSpendQuantity = expectedRemainingSpendQuantity.Sub(selfTradeEvt.DecrementedBaseQuantity)
+	}
 	require.Equal(t, takerSentAmt.String(), makerReceivedAmt.String())
 	require.Equal(t, makerSentAmt.String(), takerReceivedAmt.String())
 	if events.OrderCreated != nil {
@@ -6712,6 +7233,12 @@ func assertPlacementEvents(t *testing.T, order types.Order, events OrderPlacemen
 			Sequence:                  events.OrderPlaced.Sequence,
 			RemainingBaseQuantity:     expectedRemainingSpendQuantity,
 			RemainingSpendableBalance: expectedRemainingBalance.Amount,
+			OrderBookID:               events.OrderCreated.OrderBookID,
+			BaseDenom:                 order.BaseDenom,
+			QuoteDenom:                order.QuoteDenom,
+			Side:                      order.Side,
+			Price:                     *order.Price,
+			VisibleBaseQuantity:       expectedRemainingSpendQuantity,
 		}, *events.OrderCreated)
 	}
 }
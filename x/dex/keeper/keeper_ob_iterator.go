@@ -169,6 +169,8 @@ func (i *OrderBookIterator) readOrderBookRecordFromIterator() (types.OrderBookRe
 		AccountNumber:             storedRecord.AccountNumber,
 		RemainingBaseQuantity:     storedRecord.RemainingBaseQuantity,
 		RemainingSpendableBalance: storedRecord.RemainingSpendableBalance,
+		HiddenBaseQuantity:        storedRecord.HiddenBaseQuantity,
+		AllOrNone:                 storedRecord.AllOrNone,
 	}, nil
 }
 
@@ -0,0 +1,574 @@
+package keeper
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/samber/lo"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	matchingengine "github.com/CoreumFoundation/coreum/v6/x/dex/matching-engine"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// openingAuctionRecord is the order book record participating in the opening auction, with the price expressed in the
+// order book of the auction.
+type openingAuctionRecord struct {
+	record   types.OrderBookRecord
+	price    *big.Rat
+	inverted bool
+}
+
+func newOpeningAuctionRecord(orderBookID uint32, record types.OrderBookRecord) openingAuctionRecord {
+	if record.OrderBookID == orderBookID {
+		return openingAuctionRecord{
+			record: record,
+			price:  record.Price.Rat(),
+		}
+	}
+
+	return openingAuctionRecord{
+		record:   record,
+		price:    cbig.RatInv(record.Price.Rat()),
+		inverted: true,
+	}
+}
+
+// UncrossOpeningAuctions uncrosses the opening auctions ending at the current height, after which the order books are
+// switched to the continuous matching.
+func (k Keeper) UncrossOpeningAuctions(ctx sdk.Context) error {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	auctionStore := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OpeningAuctionKeyPrefix)
+
+	iterator := auctionStore.Iterator(nil, store.AppendUint64ToOrderedBytes(nil, uint64(ctx.BlockHeight())+1))
+	orderBookIDs := make([]uint32, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		_, orderBookID, err := types.DecodeOpeningAuctionKey(iterator.Key())
+		if err != nil {
+			return errors.Join(err, iterator.Close())
+		}
+		orderBookIDs = append(orderBookIDs, orderBookID)
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, orderBookID := range orderBookIDs {
+		if err := k.uncrossOpeningAuction(ctx, orderBookID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) uncrossOpeningAuction(ctx sdk.Context, orderBookID uint32) error {
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	// both order books of the pair are indexed, so the auction is already uncrossed with the inverted order book, or
+	// continued in the next block
+	if orderBookData.AuctionUntilHeight == 0 || orderBookData.AuctionUntilHeight > ctx.BlockHeight() {
+		return nil
+	}
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	if err := k.setOrderBooksAuctionUntilHeight(ctx, orderBookID, invertedOrderBookID, 0); err != nil {
+		return err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	k.logger(ctx).Debug("Uncrossing opening auction.", "orderBookID", orderBookID)
+	price, baseQuantity, crossed := (*types.Price)(nil), sdkmath.ZeroInt(), false
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := func() error {
+		var capped bool
+		price, baseQuantity, capped, err = k.executeOpeningAuction(cacheCtx, params, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return err
+		}
+		// the crossing records exceeding the limit are executed by the next uncross, unless nothing is executed
+		if capped && baseQuantity.IsPositive() {
+			crossed = true
+			return nil
+		}
+		crossed, err = k.cancelOpeningAuctionCrossingRecords(
+			cacheCtx, orderBookID, invertedOrderBookID, params.OpeningAuctionMaxRecords,
+		)
+		if err != nil || crossed {
+			return err
+		}
+		return k.activateTriggerOrders(cacheCtx, orderBookID, invertedOrderBookID)
+	}(); err != nil {
+		// the failed uncross must not halt the chain, and the crossed order book pair isn't switched to the continuous
+		// matching, so the uncross is retried in the next block
+		k.logger(ctx).Error("Failed to uncross opening auction.", "orderBookID", orderBookID, "err", err)
+		price, baseQuantity, crossed = nil, sdkmath.ZeroInt(), true
+	} else {
+		writeCache()
+	}
+
+	if crossed {
+		k.logger(ctx).Debug("Opening auction is still crossed.", "orderBookID", orderBookID)
+		if err := k.setOrderBooksAuctionUntilHeight(
+			ctx, orderBookID, invertedOrderBookID, ctx.BlockHeight()+1,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOpeningAuctionUncrossed{
+		OrderBookID:  orderBookID,
+		BaseDenom:    orderBookData.BaseDenom,
+		QuoteDenom:   orderBookData.QuoteDenom,
+		Price:        price,
+		BaseQuantity: baseQuantity,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOpeningAuctionUncrossed: %s", err)
+	}
+
+	return nil
+}
+
+// executeOpeningAuction executes the crossing orders of the order book pair at the clearing price. The buy orders of
+// the auction order book are the takers, they are matched in the price-time priority against the sell orders. It
+// returns true if the crossing records exceed the max records param, so only the best of them are executed.
+func (k Keeper) executeOpeningAuction(
+	ctx sdk.Context,
+	params types.Params,
+	orderBookID, invertedOrderBookID uint32,
+) (*types.Price, sdkmath.Int, bool, error) {
+	buys, sells, capped, err := k.getOpeningAuctionRecords(
+		ctx, orderBookID, invertedOrderBookID, params.OpeningAuctionMaxRecords,
+	)
+	if err != nil {
+		return nil, sdkmath.Int{}, false, err
+	}
+	price, found := findOpeningAuctionPrice(buys, sells)
+	if !found {
+		return nil, sdkmath.ZeroInt(), capped, nil
+	}
+	priceRat := price.Rat()
+	k.logger(ctx).Debug("Opening auction clearing price found.", "orderBookID", orderBookID, "price", price.String())
+
+	// the buy records are loaded in the price-time priority
+	takers := lo.Filter(buys, func(r openingAuctionRecord, _ int) bool {
+		return cbig.RatGTE(r.price, priceRat)
+	})
+
+	auctionPrice := matchingengine.AuctionPrice{OrderBookID: orderBookID, Price: price}
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	executedQuantity := sdkmath.ZeroInt()
+	for _, taker := range takers {
+		mr, err := k.executeOpeningAuctionTaker(
+			ctx, params, cachedAccKeeper, taker.record, invertedOrderBookID, auctionPrice,
+		)
+		if err != nil {
+			return nil, sdkmath.Int{}, false, err
+		}
+		for _, evt := range mr.TradeEvents {
+			executedQuantity = executedQuantity.Add(evt.BaseQuantity)
+		}
+	}
+
+	return &price, executedQuantity, capped, nil
+}
+
+func (k Keeper) executeOpeningAuctionTaker(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	record types.OrderBookRecord,
+	invertedOrderBookID uint32,
+	auctionPrice matchingengine.AuctionPrice,
+) (matchingengine.MatchingResult, error) {
+	takerAddr, err := cachedAccKeeper.GetAccountAddress(ctx, record.AccountNumber)
+	if err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+	takerOrder, takerRecord, err := k.getOrderWithRecordByAddressAndID(ctx, takerAddr, record.OrderID)
+	if err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+
+	// The makers are the sell orders of the auction order book with the price lower or equal to the clearing price,
+	// they are read from the store for each taker, since the matching of the previous taker updates them.
+	mf, err := k.NewMatchingFinder(ctx, auctionPrice.OrderBookID, invertedOrderBookID, types.Order{
+		Type:  types.ORDER_TYPE_LIMIT,
+		Side:  types.SIDE_BUY,
+		Price: &auctionPrice.Price,
+	})
+	if err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+	// the iceberg order is executed with its total quantity, and the remaining part shows the display quantity again
+	matchingRecord := takerRecord
+	matchingRecord.RemainingBaseQuantity = takerRecord.TotalRemainingBaseQuantity()
+	matchingRecord.HiddenBaseQuantity = nil
+	engine := matchingengine.NewMatchingEngine(mf, cachedAccKeeper, k.logger(ctx), k)
+	mr, err := engine.MatchAuctionOrder(ctx, matchingRecord, takerOrder, auctionPrice)
+	if err := errors.Join(err, mf.Close()); err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+	// the all-or-none order isn't executed partially, so it's left in the order book
+	if takerOrder.AllOrNone && !mr.TakerIsFilled && !mr.TakerIsCanceled {
+		return matchingengine.MatchingResult{}, nil
+	}
+	if mr.FTActions.CreatorExpectedToSpend.IsNil() && len(mr.SelfTradeEvents) == 0 {
+		return mr, nil
+	}
+
+	// The taker order is executed at the clearing price which might be better than the order price, so the limits of
+	// the saved order are released and the limits of the remaining part are set again by the matching result.
+	spendDenom := takerOrder.QuoteDenom
+	if takerOrder.Side == types.SIDE_SELL {
+		spendDenom = takerOrder.BaseDenom
+	}
+	lockedCoin := sdk.NewCoin(spendDenom, takerRecord.RemainingSpendableBalance)
+	expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+		takerOrder.Side,
+		takerOrder.BaseDenom,
+		takerOrder.QuoteDenom,
+		takerRecord.TotalRemainingBaseQuantity(),
+		takerRecord.Price,
+	)
+	if err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+	if err := k.assetFTKeeper.DEXDecreaseLimits(
+		ctx, takerAddr, sdk.NewCoins(lockedCoin), expectedToReceiveCoin,
+	); err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+
+	if !mr.TakerIsFilled && !mr.TakerIsCanceled && isOrderRecordExecutableAsMaker(&mr.TakerRecord) {
+		if err := mr.IncreaseTakerOrderLimitsForRecord(takerOrder, &mr.TakerRecord); err != nil {
+			return matchingengine.MatchingResult{}, err
+		}
+		if takerOrder.DisplayQuantity != nil {
+			mr.TakerRecord.SetDisplayQuantity(*takerOrder.DisplayQuantity)
+		}
+		mr.TakerOrderReducedEvent.VisibleBaseQuantity = mr.TakerRecord.RemainingBaseQuantity
+		if err := k.saveOrderBookRecord(ctx, mr.TakerRecord); err != nil {
+			return matchingengine.MatchingResult{}, err
+		}
+	} else {
+		if takerOrder.Reserve.IsPositive() {
+			mr.FTActions.AddDecreaseLocked(takerAddr, takerOrder.Reserve)
+		}
+		mr.RemoveRecord(takerAddr, &mr.TakerRecord)
+	}
+
+	return mr, k.applyMatchingResult(ctx, params, mr)
+}
+
+// getOpeningAuctionRecords returns the crossing buy and sell records of the order book pair in the price-time priority
+// of the auction order book. The records of each side are limited by the max records, and the returned flag is true if
+// there are more crossing records.
+func (k Keeper) getOpeningAuctionRecords(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	maxRecords uint64,
+) ([]openingAuctionRecord, []openingAuctionRecord, bool, error) {
+	bestBuy, bestSell, crossed, err := k.getOpeningAuctionBestRecords(ctx, orderBookID, invertedOrderBookID)
+	if err != nil || !crossed {
+		return nil, nil, false, err
+	}
+
+	buys, buysCapped, err := k.getOpeningAuctionSideRecords(
+		ctx, orderBookID, invertedOrderBookID, types.SIDE_BUY, maxRecords,
+		func(r openingAuctionRecord) bool { return cbig.RatGTE(r.price, bestSell.price) },
+	)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	sells, sellsCapped, err := k.getOpeningAuctionSideRecords(
+		ctx, orderBookID, invertedOrderBookID, types.SIDE_SELL, maxRecords,
+		func(r openingAuctionRecord) bool { return cbig.RatLTE(r.price, bestBuy.price) },
+	)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	return buys, sells, buysCapped || sellsCapped, nil
+}
+
+// getOpeningAuctionSideRecords returns the records of the side of the auction order book pair matching the filter in
+// the price-time priority, the records are read until the first record not matching the filter.
+func (k Keeper) getOpeningAuctionSideRecords(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	side types.Side,
+	maxRecords uint64,
+	filter func(openingAuctionRecord) bool,
+) ([]openingAuctionRecord, bool, error) {
+	mf, err := k.newOpeningAuctionSideFinder(ctx, orderBookID, invertedOrderBookID, side)
+	if err != nil {
+		return nil, false, err
+	}
+	records := make([]openingAuctionRecord, 0)
+	for {
+		record, found, err := mf.Next()
+		if err != nil {
+			return nil, false, errors.Join(err, mf.Close())
+		}
+		if !found {
+			break
+		}
+		auctionRecord := newOpeningAuctionRecord(orderBookID, record)
+		if !filter(auctionRecord) {
+			break
+		}
+		if uint64(len(records)) == maxRecords {
+			return records, true, mf.Close()
+		}
+		records = append(records, auctionRecord)
+	}
+
+	return records, false, mf.Close()
+}
+
+// getOpeningAuctionBestRecords returns the best buy and sell records of the auction order book pair, and true if they
+// cross.
+func (k Keeper) getOpeningAuctionBestRecords(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+) (openingAuctionRecord, openingAuctionRecord, bool, error) {
+	bestRecords := make([]openingAuctionRecord, 0, 2)
+	for _, side := range []types.Side{types.SIDE_BUY, types.SIDE_SELL} {
+		mf, err := k.newOpeningAuctionSideFinder(ctx, orderBookID, invertedOrderBookID, side)
+		if err != nil {
+			return openingAuctionRecord{}, openingAuctionRecord{}, false, err
+		}
+		record, found, err := mf.Next()
+		if err := errors.Join(err, mf.Close()); err != nil {
+			return openingAuctionRecord{}, openingAuctionRecord{}, false, err
+		}
+		if !found {
+			return openingAuctionRecord{}, openingAuctionRecord{}, false, nil
+		}
+		bestRecords = append(bestRecords, newOpeningAuctionRecord(orderBookID, record))
+	}
+	bestBuy, bestSell := bestRecords[0], bestRecords[1]
+
+	return bestBuy, bestSell, cbig.RatGTE(bestBuy.price, bestSell.price), nil
+}
+
+// newOpeningAuctionSideFinder returns the matching finder of the market order opposite to the side, so it returns all
+// the records of the side of the auction order book pair in the price-time priority.
+func (k Keeper) newOpeningAuctionSideFinder(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	side types.Side,
+) (*MatchingFinder, error) {
+	oppositeSide, err := side.Opposite()
+	if err != nil {
+		return nil, err
+	}
+
+	return k.NewMatchingFinder(ctx, orderBookID, invertedOrderBookID, types.Order{
+		Type: types.ORDER_TYPE_MARKET,
+		Side: oppositeSide,
+	})
+}
+
+// cancelOpeningAuctionCrossingRecords cancels the records which still cross the order book pair after the auction
+// execution, the all-or-none records which can't be filled entirely first and then the later records. The number of
+// the canceled records is limited by the max records, and it returns true if the order book pair is still crossed.
+func (k Keeper) cancelOpeningAuctionCrossingRecords(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	maxRecords uint64,
+) (bool, error) {
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	for range maxRecords {
+		bestBuy, bestSell, crossed, err := k.getOpeningAuctionBestRecords(ctx, orderBookID, invertedOrderBookID)
+		if err != nil || !crossed {
+			return false, err
+		}
+		record := bestBuy.record
+		if (bestSell.record.AllOrNone && !bestBuy.record.AllOrNone) ||
+			(bestSell.record.AllOrNone == bestBuy.record.AllOrNone &&
+				bestSell.record.OrderSequence > bestBuy.record.OrderSequence) {
+			record = bestSell.record
+		}
+		k.logger(ctx).Debug("Canceling opening auction crossing record.", "record", record.String())
+		creator, err := cachedAccKeeper.GetAccountAddress(ctx, record.AccountNumber)
+		if err != nil {
+			return false, err
+		}
+		if err := k.cancelOrder(ctx, creator, record.OrderID); err != nil {
+			return false, err
+		}
+	}
+
+	_, _, crossed, err := k.getOpeningAuctionBestRecords(ctx, orderBookID, invertedOrderBookID)
+	return crossed, err
+}
+
+// findOpeningAuctionPrice returns the clearing price maximizing the executed base quantity. If several prices execute
+// the same quantity, the price with the lower imbalance of the demand and supply is selected, and then the lower
+// price. The clearing price is selected from the prices of the orders which are expressible in the order book of the
+// auction. The iceberg records participate with the total quantity, and the all-or-none records aren't taken into
+// account, since they are executed only if they are filled entirely.
+func findOpeningAuctionPrice(buys, sells []openingAuctionRecord) (types.Price, bool) {
+	buys = lo.Filter(buys, func(r openingAuctionRecord, _ int) bool { return !r.record.AllOrNone })
+	sells = lo.Filter(sells, func(r openingAuctionRecord, _ int) bool { return !r.record.AllOrNone })
+
+	candidates := make([]types.Price, 0, len(buys)+len(sells))
+	for _, record := range append(append([]openingAuctionRecord{}, buys...), sells...) {
+		price, err := types.NewPriceFromRat(record.price)
+		if err != nil || !cbig.RatEQ(price.Rat(), record.price) {
+			continue
+		}
+		candidates = append(candidates, price)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return cbig.RatLT(candidates[i].Rat(), candidates[j].Rat())
+	})
+
+	buys = append([]openingAuctionRecord{}, buys...)
+	sort.SliceStable(buys, func(i, j int) bool { return cbig.RatLT(buys[i].price, buys[j].price) })
+	sells = append([]openingAuctionRecord{}, sells...)
+	sort.SliceStable(sells, func(i, j int) bool { return cbig.RatLT(sells[i].price, sells[j].price) })
+
+	// The demand at the price is the quantity of the buy records with the price greater or equal, and the supply is the
+	// quantity of the sell records with the price less or equal. The quantities of the inverted records depend on the
+	// price, so they are summed separately and converted for each candidate.
+	demand, invertedDemand := new(big.Rat), new(big.Rat)
+	for _, buy := range buys {
+		quantity := cbig.NewRatFromBigInt(buy.record.TotalRemainingBaseQuantity().BigInt())
+		if buy.inverted {
+			invertedDemand.Add(invertedDemand, quantity)
+		} else {
+			demand.Add(demand, quantity)
+		}
+	}
+	supply, invertedSupply := new(big.Rat), new(big.Rat)
+
+	var (
+		bestPrice     types.Price
+		bestVolume    = new(big.Rat)
+		bestImbalance *big.Rat
+	)
+	buyIndex, sellIndex := 0, 0
+	for _, candidate := range candidates {
+		price := candidate.Rat()
+		for ; buyIndex < len(buys) && cbig.RatLT(buys[buyIndex].price, price); buyIndex++ {
+			quantity := cbig.NewRatFromBigInt(buys[buyIndex].record.TotalRemainingBaseQuantity().BigInt())
+			if buys[buyIndex].inverted {
+				invertedDemand.Sub(invertedDemand, quantity)
+			} else {
+				demand.Sub(demand, quantity)
+			}
+		}
+		for ; sellIndex < len(sells) && cbig.RatLTE(sells[sellIndex].price, price); sellIndex++ {
+			quantity := cbig.NewRatFromBigInt(sells[sellIndex].record.TotalRemainingBaseQuantity().BigInt())
+			if sells[sellIndex].inverted {
+				invertedSupply.Add(invertedSupply, quantity)
+			} else {
+				supply.Add(supply, quantity)
+			}
+		}
+
+		totalDemand := new(big.Rat).Add(demand, cbig.RatDiv(invertedDemand, price))
+		totalSupply := new(big.Rat).Add(supply, cbig.RatDiv(invertedSupply, price))
+		volume := cbig.RatMin(totalDemand, totalSupply)
+		imbalance := new(big.Rat).Abs(new(big.Rat).Sub(totalDemand, totalSupply))
+		// the candidates are sorted, so the lower price is preferred if the volume and imbalance are equal
+		if cbig.RatGT(volume, bestVolume) ||
+			(cbig.RatEQ(volume, bestVolume) && bestImbalance != nil && cbig.RatLT(imbalance, bestImbalance)) {
+			bestPrice, bestVolume, bestImbalance = candidate, volume, imbalance
+		}
+	}
+
+	return bestPrice, bestImbalance != nil
+}
+
+func (k Keeper) isOrderBookInAuction(ctx sdk.Context, orderBookID uint32) (bool, error) {
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return false, err
+	}
+
+	return orderBookData.AuctionUntilHeight != 0, nil
+}
+
+// isOpeningAuctionOrder returns true if the order can be placed to the order book in the opening auction.
+func isOpeningAuctionOrder(order types.Order) bool {
+	return order.Type == types.ORDER_TYPE_LIMIT &&
+		(order.TimeInForce == types.TIME_IN_FORCE_GTC || order.TimeInForce == types.TIME_IN_FORCE_POST_ONLY)
+}
+
+// openingAuctionUntilHeight returns the height at the end of which the opening auction started at the height is
+// uncrossed, or zero if the opening auction is disabled.
+func openingAuctionUntilHeight(params types.Params, startHeight int64) int64 {
+	if params.OpeningAuctionBlocks == 0 {
+		return 0
+	}
+
+	return startHeight + int64(params.OpeningAuctionBlocks)
+}
+
+func (k Keeper) setOrderBooksAuctionUntilHeight(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	auctionUntilHeight int64,
+) error {
+	for _, id := range []uint32{orderBookID, invertedOrderBookID} {
+		orderBookData, err := k.getOrderBookData(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.setOrderBookAuctionUntilHeight(ctx, id, &orderBookData, auctionUntilHeight); err != nil {
+			return err
+		}
+		if err := k.saveOrderBookData(ctx, id, orderBookData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setOrderBookAuctionUntilHeight sets the opening auction end height of the order book data and updates the opening
+// auction index, the order book data must be saved by the caller.
+func (k Keeper) setOrderBookAuctionUntilHeight(
+	ctx sdk.Context,
+	orderBookID uint32,
+	orderBookData *types.OrderBookData,
+	auctionUntilHeight int64,
+) error {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	if orderBookData.AuctionUntilHeight != 0 {
+		if err := moduleStore.Delete(
+			types.CreateOpeningAuctionKey(uint64(orderBookData.AuctionUntilHeight), orderBookID),
+		); err != nil {
+			return err
+		}
+	}
+	if auctionUntilHeight != 0 {
+		if err := moduleStore.Set(
+			types.CreateOpeningAuctionKey(uint64(auctionUntilHeight), orderBookID), types.StoreTrue,
+		); err != nil {
+			return err
+		}
+	}
+	orderBookData.AuctionUntilHeight = auctionUntilHeight
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/event"
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_OpeningAuction(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(100)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.OpeningAuctionBlocks = 3
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	// the registered order book pair is opened with the opening auction
+	require.NoError(t, dexKeeper.CreateOrderBook(
+		sdkCtx, authtypes.NewModuleAddress(govtypes.ModuleName), testSet.denom1, testSet.denom2, nil, nil, nil,
+	))
+	auctionUntilHeight := sdkCtx.BlockHeight() + 3
+	for _, denoms := range [][]string{{testSet.denom1, testSet.denom2}, {testSet.denom2, testSet.denom1}} {
+		orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, denoms[0], denoms[1])
+		require.NoError(t, err)
+		require.Equal(t, auctionUntilHeight, orderBookParams.AuctionUntilHeight)
+	}
+
+	sellOrder1 := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("3e-1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	sellOrder2 := sellOrder1
+	sellOrder2.ID = "sell2"
+	sellOrder2.Price = lo.ToPtr(types.MustNewPriceFromString("4e-1"))
+	buyOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "buy1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	// the sell order of the inverted order book is the buy order at the price 4e-1
+	invertedSellOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell1",
+		BaseDenom:   testSet.denom2,
+		QuoteDenom:  testSet.denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("25e-1")),
+		Quantity:    sdkmath.NewInt(20_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+
+	// only the good til canceled and post-only limit orders are accepted in the opening auction
+	iocOrder := buyOrder
+	iocOrder.ID = "ioc"
+	iocOrder.TimeInForce = types.TIME_IN_FORCE_IOC
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 50_000)))
+	cacheCtx, _ := sdkCtx.CacheContext()
+	require.ErrorIs(t, dexKeeper.PlaceOrder(cacheCtx, iocOrder), types.ErrOrderBookInAuction)
+
+	// the crossing orders are accepted, but they aren't matched
+	for _, order := range []types.Order{sellOrder1, sellOrder2, buyOrder, invertedSellOrder} {
+		creator := sdk.MustAccAddressFromBech32(order.Creator)
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, sdkCtx, creator, sdk.NewCoins(lockedBalance))
+		fundOrderReserve(t, testApp, sdkCtx, creator)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+	storedBuyOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, buyOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, buyOrder.Quantity.String(), storedBuyOrder.RemainingBaseQuantity.String())
+
+	// the crossed order book has no spread
+	depth, err := dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 0)
+	require.NoError(t, err)
+	require.Equal(t, "5e-1", depth.BestBid.String())
+	require.Equal(t, "3e-1", depth.BestAsk.String())
+	require.Nil(t, depth.Spread)
+
+	// the auction isn't uncrossed before the end height
+	sdkCtx = sdkCtx.WithBlockHeight(auctionUntilHeight - 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.UncrossOpeningAuctions(sdkCtx))
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, buyOrder.ID)
+	require.NoError(t, err)
+
+	// The clearing price 4e-1 maximizes the executed quantity: the demand is 100_000 + 20_000 / 0.4 = 150_000 and the
+	// supply is 200_000. The buy order is executed first since it has the better price.
+	sdkCtx = sdkCtx.WithBlockHeight(auctionUntilHeight).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.UncrossOpeningAuctions(sdkCtx))
+
+	uncrossedEvents, err := event.FindTypedEvents[*types.EventOpeningAuctionUncrossed](
+		sdkCtx.EventManager().ABCIEvents(),
+	)
+	require.NoError(t, err)
+	require.Len(t, uncrossedEvents, 1)
+	require.Equal(t, "4e-1", uncrossedEvents[0].Price.String())
+	require.Equal(t, sdkmath.NewInt(150_000).String(), uncrossedEvents[0].BaseQuantity.String())
+
+	tradeEvents, err := event.FindTypedEvents[*types.EventTrade](sdkCtx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Len(t, tradeEvents, 2)
+	for _, evt := range tradeEvents {
+		require.Equal(t, "4e-1", evt.Price.String())
+		require.Equal(t, testSet.denom1, evt.BaseDenom)
+	}
+
+	// the buy order pays the clearing price, and the remaining locked balance is released
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, buyOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.Equal(t, "100000", testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc2, testSet.denom1).Amount.String())
+	require.Equal(t, "60000", testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc2, testSet.denom2).Amount.String())
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc2, testSet.denom2).IsZero())
+
+	// the sell order below the clearing price receives the clearing price
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder1.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.Equal(t, "60000", testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.denom2).Amount.String())
+	storedSellOrder2, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder2.ID)
+	require.NoError(t, err)
+	require.Equal(t, "50000", storedSellOrder2.RemainingBaseQuantity.String())
+
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc3, invertedSellOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.Equal(t, "50000", testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc3, testSet.denom1).Amount.String())
+
+	// the order book pair is switched to the continuous matching
+	for _, denoms := range [][]string{{testSet.denom1, testSet.denom2}, {testSet.denom2, testSet.denom1}} {
+		orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, denoms[0], denoms[1])
+		require.NoError(t, err)
+		require.Zero(t, orderBookParams.AuctionUntilHeight)
+	}
+	iocOrder.Price = lo.ToPtr(types.MustNewPriceFromString("4e-1"))
+	iocOrder.Quantity = sdkmath.NewInt(50_000)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, iocOrder))
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder2.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func TestKeeper_OpeningAuctionAllOrNoneAndIcebergOrders(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(100)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.OpeningAuctionBlocks = 3
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+	require.NoError(t, dexKeeper.CreateOrderBook(
+		sdkCtx, authtypes.NewModuleAddress(govtypes.ModuleName), testSet.denom1, testSet.denom2, nil, nil, nil,
+	))
+	auctionUntilHeight := sdkCtx.BlockHeight() + 3
+
+	icebergOrder := types.Order{
+		Creator:         testSet.acc1.String(),
+		ID:              "iceberg",
+		BaseDenom:       testSet.denom1,
+		QuoteDenom:      testSet.denom2,
+		Price:           lo.ToPtr(types.MustNewPriceFromString("3e-1")),
+		Quantity:        sdkmath.NewInt(300_000),
+		Side:            types.SIDE_SELL,
+		TimeInForce:     types.TIME_IN_FORCE_GTC,
+		DisplayQuantity: lo.ToPtr(sdkmath.NewInt(100_000)),
+	}
+	buyOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		ID:          "buy",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(250_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	allOrNoneOrder := buyOrder
+	allOrNoneOrder.Creator = testSet.acc3.String()
+	allOrNoneOrder.ID = "all-or-none"
+	allOrNoneOrder.Price = lo.ToPtr(types.MustNewPriceFromString("4e-1"))
+	allOrNoneOrder.Quantity = sdkmath.NewInt(500_000)
+	allOrNoneOrder.AllOrNone = true
+	for _, order := range []types.Order{icebergOrder, buyOrder, allOrNoneOrder} {
+		placeTestOrder(t, testApp, sdkCtx, order)
+	}
+
+	// The iceberg order participates with the total quantity, so the buy order is filled entirely at the clearing
+	// price 3e-1. The all-or-none order can't be filled entirely, so it isn't executed.
+	sdkCtx = sdkCtx.WithBlockHeight(auctionUntilHeight).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.UncrossOpeningAuctions(sdkCtx))
+	uncrossedEvents, err := event.FindTypedEvents[*types.EventOpeningAuctionUncrossed](
+		sdkCtx.EventManager().ABCIEvents(),
+	)
+	require.NoError(t, err)
+	require.Len(t, uncrossedEvents, 1)
+	require.Equal(t, "3e-1", uncrossedEvents[0].Price.String())
+	require.Equal(t, sdkmath.NewInt(250_000).String(), uncrossedEvents[0].BaseQuantity.String())
+
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, buyOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.Equal(t, "250000", testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc2, testSet.denom1).Amount.String())
+	storedIcebergOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, icebergOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, "50000", storedIcebergOrder.RemainingBaseQuantity.String())
+
+	// the all-or-none order still crosses the order book, so it's canceled
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc3, allOrNoneOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc3, testSet.denom2).IsZero())
+
+	orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Zero(t, orderBookParams.AuctionUntilHeight)
+}
+
+func TestKeeper_OpeningAuctionMaxRecords(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(100)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.OpeningAuctionBlocks = 3
+	params.OpeningAuctionMaxRecords = 1
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+	require.NoError(t, dexKeeper.CreateOrderBook(
+		sdkCtx, authtypes.NewModuleAddress(govtypes.ModuleName), testSet.denom1, testSet.denom2, nil, nil, nil,
+	))
+	auctionUntilHeight := sdkCtx.BlockHeight() + 3
+
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		ID:          "sell1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("3e-1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	buyOrder := sellOrder
+	buyOrder.Creator = testSet.acc2.String()
+	buyOrder.ID = "buy1"
+	buyOrder.Price = lo.ToPtr(types.MustNewPriceFromString("5e-1"))
+	buyOrder.Side = types.SIDE_BUY
+	for i := range 2 {
+		sellOrder.ID = fmt.Sprintf("sell%d", i)
+		buyOrder.ID = fmt.Sprintf("buy%d", i)
+		placeTestOrder(t, testApp, sdkCtx, sellOrder)
+		placeTestOrder(t, testApp, sdkCtx, buyOrder)
+	}
+
+	// only the best crossing record of each side is executed, and the auction is continued in the next block
+	sdkCtx = sdkCtx.WithBlockHeight(auctionUntilHeight).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.UncrossOpeningAuctions(sdkCtx))
+	uncrossedEvents, err := event.FindTypedEvents[*types.EventOpeningAuctionUncrossed](
+		sdkCtx.EventManager().ABCIEvents(),
+	)
+	require.NoError(t, err)
+	require.Len(t, uncrossedEvents, 1)
+	require.Equal(t, sdkmath.NewInt(100_000).String(), uncrossedEvents[0].BaseQuantity.String())
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, "buy0")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, "buy1")
+	require.NoError(t, err)
+	orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Equal(t, auctionUntilHeight+1, orderBookParams.AuctionUntilHeight)
+
+	// the rest of the crossing records are executed in the next block, after which the auction is finished
+	sdkCtx = sdkCtx.WithBlockHeight(auctionUntilHeight + 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.UncrossOpeningAuctions(sdkCtx))
+	uncrossedEvents, err = event.FindTypedEvents[*types.EventOpeningAuctionUncrossed](
+		sdkCtx.EventManager().ABCIEvents(),
+	)
+	require.NoError(t, err)
+	require.Len(t, uncrossedEvents, 1)
+	require.Equal(t, "3e-1", uncrossedEvents[0].Price.String())
+	require.Equal(t, sdkmath.NewInt(100_000).String(), uncrossedEvents[0].BaseQuantity.String())
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, "buy1")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, "sell1")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	orderBookParams, err = dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Zero(t, orderBookParams.AuctionUntilHeight)
+}
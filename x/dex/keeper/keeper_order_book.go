@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// CreateOrderBook registers the order book pair with the optional price tick, quantity step and min quantity of the
+// order book. The inverted order book is registered without overrides.
+func (k Keeper) CreateOrderBook(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	baseDenom, quoteDenom string,
+	priceTick *types.Price,
+	quantityStep, minQuantity *sdkmath.Int,
+) error {
+	if err := k.validateOrderBookUpdateIsAllowed(ctx, sender, baseDenom); err != nil {
+		return err
+	}
+	if err := k.validateDenomPair(ctx, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	// the registered order book pair is opened with the opening auction
+	auctionUntilHeight := openingAuctionUntilHeight(params, ctx.BlockHeight())
+
+	orderBookID, invertedOrderBookID, err := k.getOrGenOrderBookIDs(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	if orderBookData.Status != types.ORDER_BOOK_STATUS_UNSPECIFIED {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "order book %s/%s is already registered", baseDenom, quoteDenom)
+	}
+	orderBookData.Status = types.ORDER_BOOK_STATUS_ACTIVE
+	orderBookData.PriceTick = priceTick
+	orderBookData.QuantityStep = quantityStep
+	orderBookData.MinQuantity = minQuantity
+	if err := k.setOrderBookAuctionUntilHeight(ctx, orderBookID, &orderBookData, auctionUntilHeight); err != nil {
+		return err
+	}
+	if err := k.updateOrderBookData(ctx, orderBookID, orderBookData); err != nil {
+		return err
+	}
+
+	invertedOrderBookData, err := k.getOrderBookData(ctx, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	invertedOrderBookData.Status = types.ORDER_BOOK_STATUS_ACTIVE
+	if err := k.setOrderBookAuctionUntilHeight(
+		ctx, invertedOrderBookID, &invertedOrderBookData, auctionUntilHeight,
+	); err != nil {
+		return err
+	}
+
+	return k.updateOrderBookData(ctx, invertedOrderBookID, invertedOrderBookData)
+}
+
+// UpdateOrderBook updates the price tick, quantity step and min quantity of the existing order book. The nil value
+// removes the corresponding override.
+func (k Keeper) UpdateOrderBook(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	baseDenom, quoteDenom string,
+	priceTick *types.Price,
+	quantityStep, minQuantity *sdkmath.Int,
+) error {
+	if err := k.validateOrderBookUpdateIsAllowed(ctx, sender, baseDenom); err != nil {
+		return err
+	}
+
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	orderBookData.PriceTick = priceTick
+	orderBookData.QuantityStep = quantityStep
+	orderBookData.MinQuantity = minQuantity
+
+	return k.updateOrderBookData(ctx, orderBookID, orderBookData)
+}
+
+// UpdateOrderBookStatus sets the status of the existing order book pair, both the order book and the inverted order
+// book. The orders of the delisted order book pair are canceled. The resumed order book pair is opened with the opening
+// auction, and the auction of the paused or delisted order book pair is stopped without the uncross.
+func (k Keeper) UpdateOrderBookStatus(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	baseDenom, quoteDenom string,
+	status types.OrderBookStatus,
+) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+	if err := k.validateOrderBookUpdateIsAllowed(ctx, sender, baseDenom); err != nil {
+		return err
+	}
+
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+	invertedOrderBookID, err := k.getOrderBookIDByDenoms(ctx, quoteDenom, baseDenom)
+	if err != nil {
+		return err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range []uint32{orderBookID, invertedOrderBookID} {
+		orderBookData, err := k.getOrderBookData(ctx, id)
+		if err != nil {
+			return err
+		}
+		auctionUntilHeight := orderBookData.AuctionUntilHeight
+		switch {
+		case !status.IsActive():
+			auctionUntilHeight = 0
+		case !orderBookData.Status.IsActive():
+			auctionUntilHeight = openingAuctionUntilHeight(params, ctx.BlockHeight())
+		}
+		if err := k.setOrderBookAuctionUntilHeight(ctx, id, &orderBookData, auctionUntilHeight); err != nil {
+			return err
+		}
+		orderBookData.Status = status
+		if err := k.updateOrderBookData(ctx, id, orderBookData); err != nil {
+			return err
+		}
+		if status == types.ORDER_BOOK_STATUS_DELISTED {
+			if err := k.cancelOrderBookOrders(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) validateOrderBookUpdateIsAllowed(ctx sdk.Context, sender sdk.AccAddress, baseDenom string) error {
+	if sender.String() == k.authority {
+		return nil
+	}
+
+	return k.assetFTKeeper.ValidateDEXOrderBookUpdateIsAllowed(ctx, sender, baseDenom)
+}
+
+// getOrderBookDataByDenoms returns the order book data, or the data of the active order book with the default
+// settings if the order book isn't created yet.
+func (k Keeper) getOrderBookDataByDenoms(
+	ctx sdk.Context,
+	baseDenom, quoteDenom string,
+) (types.OrderBookData, error) {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.OrderBookData{
+				BaseDenom:  baseDenom,
+				QuoteDenom: quoteDenom,
+			}, nil
+		}
+		return types.OrderBookData{}, err
+	}
+
+	return k.getOrderBookData(ctx, orderBookID)
+}
+
+// getInvertedOrderBookID returns the ID of the inverted order book of the existing order book.
+func (k Keeper) getInvertedOrderBookID(ctx sdk.Context, orderBookID uint32) (uint32, error) {
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.getOrderBookIDByDenoms(ctx, orderBookData.QuoteDenom, orderBookData.BaseDenom)
+}
+
+func (k Keeper) updateOrderBookData(ctx sdk.Context, orderBookID uint32, data types.OrderBookData) error {
+	if err := k.saveOrderBookData(ctx, orderBookID, data); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderBookUpdated{
+		OrderBookID: orderBookID,
+		Data:        data,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderBookUpdated: %s", err)
+	}
+
+	return nil
+}
+
+// cancelOrderBookOrders cancels all the orders and not activated trigger orders of the order book.
+func (k Keeper) cancelOrderBookOrders(ctx sdk.Context, orderBookID uint32) error {
+	records := make([]types.OrderBookRecord, 0)
+	for _, side := range []types.Side{types.SIDE_BUY, types.SIDE_SELL} {
+		sideRecords, err := k.getOrderBookSideRecords(ctx, orderBookID, side)
+		if err != nil {
+			return err
+		}
+		records = append(records, sideRecords...)
+	}
+
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	for _, record := range records {
+		creator, err := cachedAccKeeper.GetAccountAddress(ctx, record.AccountNumber)
+		if err != nil {
+			return err
+		}
+		if err := k.cancelOrder(ctx, creator, record.OrderID); err != nil {
+			return err
+		}
+	}
+
+	triggerOrderKeys := make([][]byte, 0)
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	for _, condition := range []types.TriggerCondition{
+		types.TRIGGER_CONDITION_PRICE_GTE, types.TRIGGER_CONDITION_PRICE_LTE,
+	} {
+		iterator := prefix.NewStore(
+			runtime.KVStoreAdapter(moduleStore), types.CreateTriggerOrderBookConditionKey(orderBookID, condition),
+		).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			triggerOrderKeys = append(triggerOrderKeys, iterator.Value())
+		}
+		if err := iterator.Close(); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range triggerOrderKeys {
+		accNumber, orderID, err := types.DecodeTriggerOrderKey(key)
+		if err != nil {
+			return err
+		}
+		order, err := k.getTriggerOrder(ctx, accNumber, orderID)
+		if err != nil {
+			return err
+		}
+		if err := k.cancelTriggerOrder(ctx, accNumber, order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) getOrderBookSideRecords(
+	ctx sdk.Context,
+	orderBookID uint32,
+	side types.Side,
+) ([]types.OrderBookRecord, error) {
+	iterator := NewOrderBookIterator(ctx, k.cdc, k.storeService, orderBookID, side, false)
+	defer iterator.Close()
+
+	records := make([]types.OrderBookRecord, 0)
+	for {
+		record, found, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return records, nil
+		}
+		records = append(records, record)
+	}
+}
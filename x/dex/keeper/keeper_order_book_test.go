@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_CreateAndUpdateOrderBook(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// only the governance or the base denom admin is able to register the order book
+	require.ErrorIs(t, dexKeeper.CreateOrderBook(
+		sdkCtx, testSet.acc1, testSet.denom1, testSet.denom2, nil, nil, nil,
+	), cosmoserrors.ErrUnauthorized)
+
+	require.NoError(t, dexKeeper.CreateOrderBook(
+		sdkCtx,
+		govAddr,
+		testSet.denom1,
+		testSet.denom2,
+		lo.ToPtr(types.MustNewPriceFromString("5e-2")),
+		lo.ToPtr(sdkmath.NewInt(1_000)),
+		lo.ToPtr(sdkmath.NewInt(10_000)),
+	))
+	require.ErrorIs(t, dexKeeper.CreateOrderBook(
+		sdkCtx, govAddr, testSet.denom1, testSet.denom2, nil, nil, nil,
+	), types.ErrInvalidInput)
+
+	orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Equal(t, types.ORDER_BOOK_STATUS_ACTIVE, orderBookParams.Status)
+	require.Equal(t, "5e-2", orderBookParams.PriceTick.String())
+	require.Equal(t, "1000", orderBookParams.QuantityStep.String())
+	require.Equal(t, "10000", orderBookParams.MinQuantity.String())
+
+	// the inverted order book is registered without overrides
+	invertedOrderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom2, testSet.denom1)
+	require.NoError(t, err)
+	require.Equal(t, types.ORDER_BOOK_STATUS_ACTIVE, invertedOrderBookParams.Status)
+	require.Nil(t, invertedOrderBookParams.MinQuantity)
+
+	order := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("35e-2")),
+		Quantity:    sdkmath.NewInt(20_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 100_000)))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+
+	invalidOrder := order
+	invalidOrder.Quantity = sdkmath.NewInt(5_000)
+	require.ErrorContains(t, dexKeeper.PlaceOrder(sdkCtx, invalidOrder), "less than min quantity")
+	invalidOrder.Quantity = sdkmath.NewInt(20_500)
+	require.ErrorContains(t, dexKeeper.PlaceOrder(sdkCtx, invalidOrder), "has to be multiple of quantity step")
+	invalidOrder = order
+	invalidOrder.Price = lo.ToPtr(types.MustNewPriceFromString("375e-3"))
+	require.ErrorContains(t, dexKeeper.PlaceOrder(sdkCtx, invalidOrder), "has to be multiple of price tick")
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+
+	// the base denom admin removes the overrides
+	require.ErrorIs(t, dexKeeper.UpdateOrderBook(
+		sdkCtx, testSet.acc1, testSet.denom1, testSet.denom2, nil, nil, nil,
+	), cosmoserrors.ErrUnauthorized)
+	require.NoError(t, dexKeeper.UpdateOrderBook(
+		sdkCtx, testSet.issuer, testSet.denom1, testSet.denom2, nil, nil, nil,
+	))
+	orderBookParams, err = dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Equal(t, types.ORDER_BOOK_STATUS_ACTIVE, orderBookParams.Status)
+	require.Nil(t, orderBookParams.MinQuantity)
+
+	order.ID = "id2"
+	order.Price = lo.ToPtr(types.MustNewPriceFromString("375e-3"))
+	order.Quantity = sdkmath.NewInt(50_000)
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+
+	// the not existing order book can't be updated
+	require.ErrorIs(t, dexKeeper.UpdateOrderBook(
+		sdkCtx, govAddr, testSet.denom1, testSet.denom3, nil, nil, nil,
+	), types.ErrRecordNotFound)
+}
+
+func TestKeeper_UpdateOrderBookStatus(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	// the order book created implicitly is active
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("375e-3")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 2_000_000)))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, sellOrder))
+
+	orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Equal(t, types.ORDER_BOOK_STATUS_UNSPECIFIED, orderBookParams.Status)
+
+	// the admin of the base denom pauses the order book pair
+	require.ErrorIs(t, dexKeeper.UpdateOrderBookStatus(
+		sdkCtx, testSet.acc1, testSet.denom1, testSet.denom2, types.ORDER_BOOK_STATUS_PAUSED,
+	), cosmoserrors.ErrUnauthorized)
+	require.NoError(t, dexKeeper.UpdateOrderBookStatus(
+		sdkCtx, testSet.issuer, testSet.denom1, testSet.denom2, types.ORDER_BOOK_STATUS_PAUSED,
+	))
+
+	invertedOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "inverted",
+		BaseDenom:   testSet.denom2,
+		QuoteDenom:  testSet.denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 100_000)))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc2)
+	require.ErrorContains(t, dexKeeper.PlaceOrder(sdkCtx, invertedOrder), "isn't active")
+
+	anotherSellOrder := sellOrder
+	anotherSellOrder.ID = "sell2"
+	require.ErrorContains(t, dexKeeper.PlaceOrder(sdkCtx, anotherSellOrder), "isn't active")
+
+	// the existing order is kept
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder.ID)
+	require.NoError(t, err)
+
+	// the order book pair is resumed
+	require.NoError(t, dexKeeper.UpdateOrderBookStatus(
+		sdkCtx, testSet.issuer, testSet.denom2, testSet.denom1, types.ORDER_BOOK_STATUS_ACTIVE,
+	))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, anotherSellOrder))
+
+	// the order book pair is delisted and the orders are canceled
+	require.NoError(t, dexKeeper.UpdateOrderBookStatus(
+		sdkCtx,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		testSet.denom1,
+		testSet.denom2,
+		types.ORDER_BOOK_STATUS_DELISTED,
+	))
+	orders, _, err := dexKeeper.GetOrders(sdkCtx, testSet.acc1, nil)
+	require.NoError(t, err)
+	require.Empty(t, orders)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).IsZero())
+	require.ErrorContains(t, dexKeeper.PlaceOrder(sdkCtx, invertedOrder), "isn't active")
+
+	orderBookParams, err = dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom2, testSet.denom1)
+	require.NoError(t, err)
+	require.Equal(t, types.ORDER_BOOK_STATUS_DELISTED, orderBookParams.Status)
+}
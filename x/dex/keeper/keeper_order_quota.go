@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// SetOrderQuota sets the additional number of orders per denom the account can have. The reserve locked for the
+// previous quota is released, and the reserve computed with the current params is locked for the new quota.
+func (k Keeper) SetOrderQuota(ctx sdk.Context, acc sdk.AccAddress, extraOrdersPerDenom uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	orderQuota, found, err := k.getOrderQuota(ctx, accNumber)
+	if err != nil {
+		return err
+	}
+	if extraOrdersPerDenom == orderQuota.ExtraOrdersPerDenom {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "the account already has %d extra orders per denom", extraOrdersPerDenom,
+		)
+	}
+
+	reserve, err := params.ComputeOrderQuotaReserve(extraOrdersPerDenom)
+	if err != nil {
+		return err
+	}
+
+	// the quota can't be lowered below the number of the orders the account already has
+	if extraOrdersPerDenom < orderQuota.ExtraOrdersPerDenom {
+		maxOrdersPerDenom, err := k.getTierMaxOrdersPerDenom(ctx, params, accNumber)
+		if err != nil {
+			return err
+		}
+		usedOrdersPerDenom, err := k.getAccountUsedOrdersPerDenom(ctx, accNumber)
+		if err != nil {
+			return err
+		}
+		if usedOrdersPerDenom > maxOrdersPerDenom+extraOrdersPerDenom {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"the account has %d orders per denom, which exceeds the new max orders per denom %d",
+				usedOrdersPerDenom, maxOrdersPerDenom+extraOrdersPerDenom,
+			)
+		}
+	}
+
+	if found && orderQuota.Reserve.IsPositive() {
+		if err := k.assetFTKeeper.DEXDecreaseLimits(
+			ctx, acc, sdk.NewCoins(orderQuota.Reserve), sdk.NewCoin(orderQuota.Reserve.Denom, sdkmath.ZeroInt()),
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to release the order quota reserve")
+		}
+	}
+	if reserve.IsPositive() {
+		if err := k.assetFTKeeper.DEXIncreaseLimits(
+			ctx, acc, sdk.NewCoins(reserve), sdk.NewCoin(reserve.Denom, sdkmath.ZeroInt()),
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to lock the order quota reserve")
+		}
+	}
+
+	orderQuota = types.OrderQuota{
+		Account:             acc.String(),
+		ExtraOrdersPerDenom: extraOrdersPerDenom,
+		Reserve:             reserve,
+	}
+	if extraOrdersPerDenom == 0 {
+		if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateOrderQuotaKey(accNumber)); err != nil {
+			return err
+		}
+	} else if err := k.SaveOrderQuota(ctx, accNumber, orderQuota); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderQuotaUpdated{
+		Account:             orderQuota.Account,
+		ExtraOrdersPerDenom: orderQuota.ExtraOrdersPerDenom,
+		Reserve:             orderQuota.Reserve,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderQuotaUpdated: %s", err)
+	}
+
+	return nil
+}
+
+// GetOrderQuota returns the order quota of the account and its usage.
+func (k Keeper) GetOrderQuota(ctx sdk.Context, acc sdk.AccAddress) (*types.QueryOrderQuotaResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	maxOrdersPerDenom, err := k.getMaxOrdersPerDenom(ctx, params, accNumber)
+	if err != nil {
+		return nil, err
+	}
+	orderQuota, _, err := k.getOrderQuota(ctx, accNumber)
+	if err != nil {
+		return nil, err
+	}
+	usedOrdersPerDenom, err := k.getAccountUsedOrdersPerDenom(ctx, accNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	reserve := orderQuota.Reserve
+	if reserve.IsNil() {
+		reserve = sdk.NewCoin(params.OrderReserve.Denom, sdkmath.ZeroInt())
+	}
+
+	return &types.QueryOrderQuotaResponse{
+		MaxOrdersPerDenom:   maxOrdersPerDenom,
+		ExtraOrdersPerDenom: orderQuota.ExtraOrdersPerDenom,
+		Reserve:             reserve,
+		UsedOrdersPerDenom:  usedOrdersPerDenom,
+	}, nil
+}
+
+// GetOrderQuotas returns paginated order quotas of all accounts.
+func (k Keeper) GetOrderQuotas(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.OrderQuota, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OrderQuotaKeyPrefix)
+	orderQuotas, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pagination,
+		func(_ []byte, orderQuota *types.OrderQuota) (*types.OrderQuota, error) {
+			return orderQuota, nil
+		},
+		func() *types.OrderQuota {
+			return &types.OrderQuota{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(orderQuotas, func(orderQuota *types.OrderQuota, _ int) types.OrderQuota {
+		return *orderQuota
+	}), pageRes, nil
+}
+
+// SaveOrderQuota saves the account order quota.
+func (k Keeper) SaveOrderQuota(ctx sdk.Context, accNumber uint64, orderQuota types.OrderQuota) error {
+	return k.setDataToStore(ctx, types.CreateOrderQuotaKey(accNumber), &orderQuota)
+}
+
+func (k Keeper) getOrderQuota(ctx sdk.Context, accNumber uint64) (types.OrderQuota, bool, error) {
+	var orderQuota types.OrderQuota
+	if err := k.getDataFromStore(ctx, types.CreateOrderQuotaKey(accNumber), &orderQuota); err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.OrderQuota{}, false, nil
+		}
+		return types.OrderQuota{}, false, err
+	}
+
+	return orderQuota, true, nil
+}
+
+// getAccountUsedOrdersPerDenom returns the highest number of the account orders per denom.
+func (k Keeper) getAccountUsedOrdersPerDenom(ctx sdk.Context, accNumber uint64) (uint64, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateAccountDenomOrdersCountKeyPrefix(accNumber),
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var usedOrdersPerDenom uint64
+	for ; iterator.Valid(); iterator.Next() {
+		var count gogotypes.UInt64Value
+		if err := k.cdc.Unmarshal(iterator.Value(), &count); err != nil {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal orders count: %s", err)
+		}
+		usedOrdersPerDenom = max(usedOrdersPerDenom, count.GetValue())
+	}
+
+	return usedOrdersPerDenom, nil
+}
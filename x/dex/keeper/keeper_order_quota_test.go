@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/event"
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_OrderQuota(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MaxOrdersPerDenom = 1
+	params.OrderQuotaReserveMultiplier = sdkmath.LegacyMustNewDecFromStr("1.5")
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+	reserveDenom := params.OrderReserve.Denom
+
+	acc, _ := testApp.GenAccount(sdkCtx)
+	placeOrder := func(ctx sdk.Context, id string) error {
+		order := types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, ctx, acc, sdk.NewCoins(lockedBalance))
+		fundOrderReserve(t, testApp, ctx, acc)
+		return dexKeeper.PlaceOrder(ctx, order)
+	}
+	requireOrderQuota := func(expected types.QueryOrderQuotaResponse) {
+		t.Helper()
+		res, err := dexKeeper.GetOrderQuota(sdkCtx, acc)
+		require.NoError(t, err)
+		require.Equal(t, expected.String(), res.String())
+	}
+	requireLockedReserve := func(amount int64) {
+		t.Helper()
+		require.Equal(
+			t,
+			sdk.NewInt64Coin(reserveDenom, amount).String(),
+			testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, reserveDenom).String(),
+		)
+	}
+
+	require.NoError(t, placeOrder(sdkCtx, "order1"))
+	cacheCtx, _ := sdkCtx.CacheContext()
+	require.ErrorIs(t, placeOrder(cacheCtx, "order2"), types.ErrInvalidInput)
+	requireOrderQuota(types.QueryOrderQuotaResponse{
+		MaxOrdersPerDenom:  1,
+		Reserve:            sdk.NewInt64Coin(reserveDenom, 0),
+		UsedOrdersPerDenom: 1,
+	})
+
+	// the reserve of the additional orders must be available
+	cacheCtx, _ = sdkCtx.CacheContext()
+	require.ErrorIs(t, dexKeeper.SetOrderQuota(cacheCtx, acc, 2), assetfttypes.ErrDEXInsufficientSpendableBalance)
+
+	// the reserve of each additional order is 1.5 of the order reserve
+	require.NoError(t, testApp.FundAccount(sdkCtx, acc, sdk.NewCoins(sdk.NewInt64Coin(reserveDenom, 30_000_000))))
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.SetOrderQuota(sdkCtx, acc, 2))
+	updatedEvents, err := event.FindTypedEvents[*types.EventOrderQuotaUpdated](sdkCtx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Equal(t, []*types.EventOrderQuotaUpdated{
+		{
+			Account:             acc.String(),
+			ExtraOrdersPerDenom: 2,
+			Reserve:             sdk.NewInt64Coin(reserveDenom, 30_000_000),
+		},
+	}, updatedEvents)
+	requireLockedReserve(40_000_000)
+	cacheCtx, _ = sdkCtx.CacheContext()
+	require.ErrorIs(t, dexKeeper.SetOrderQuota(cacheCtx, acc, 2), types.ErrInvalidInput)
+
+	require.NoError(t, placeOrder(sdkCtx, "order2"))
+	require.NoError(t, placeOrder(sdkCtx, "order3"))
+	cacheCtx, _ = sdkCtx.CacheContext()
+	require.ErrorIs(t, placeOrder(cacheCtx, "order4"), types.ErrInvalidInput)
+	requireOrderQuota(types.QueryOrderQuotaResponse{
+		MaxOrdersPerDenom:   3,
+		ExtraOrdersPerDenom: 2,
+		Reserve:             sdk.NewInt64Coin(reserveDenom, 30_000_000),
+		UsedOrdersPerDenom:  3,
+	})
+
+	// the quota can't be lowered below the number of the account orders
+	cacheCtx, _ = sdkCtx.CacheContext()
+	require.ErrorIs(t, dexKeeper.SetOrderQuota(cacheCtx, acc, 1), types.ErrInvalidInput)
+
+	// the reserve is released when the quota is lowered
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, acc, "order3"))
+	require.NoError(t, dexKeeper.SetOrderQuota(sdkCtx, acc, 1))
+	requireLockedReserve(35_000_000)
+	requireOrderQuota(types.QueryOrderQuotaResponse{
+		MaxOrdersPerDenom:   2,
+		ExtraOrdersPerDenom: 1,
+		Reserve:             sdk.NewInt64Coin(reserveDenom, 15_000_000),
+		UsedOrdersPerDenom:  2,
+	})
+
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, acc, "order2"))
+	require.NoError(t, dexKeeper.SetOrderQuota(sdkCtx, acc, 0))
+	requireLockedReserve(10_000_000)
+	requireOrderQuota(types.QueryOrderQuotaResponse{
+		MaxOrdersPerDenom:  1,
+		Reserve:            sdk.NewInt64Coin(reserveDenom, 0),
+		UsedOrdersPerDenom: 1,
+	})
+	orderQuotas, _, err := dexKeeper.GetOrderQuotas(sdkCtx, nil)
+	require.NoError(t, err)
+	require.Empty(t, orderQuotas)
+
+	// the zero multiplier disables the order quota extension
+	params.OrderQuotaReserveMultiplier = sdkmath.LegacyZeroDec()
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+	cacheCtx, _ = sdkCtx.CacheContext()
+	require.ErrorIs(t, dexKeeper.SetOrderQuota(cacheCtx, acc, 1), types.ErrInvalidInput)
+}
@@ -0,0 +1,576 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// maxRewardSampledOrdersPerSide is the max number of the orders of the order book side sampled by the reward program
+// in the epoch, starting from the best price.
+const maxRewardSampledOrdersPerSide = 1000
+
+// FundRewardProgram creates the reward program of the order book or adds the amount to the pool of the existing
+// program and updates its settings.
+func (k Keeper) FundRewardProgram(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	baseDenom, quoteDenom string,
+	amount sdk.Coin,
+	rewardPerEpoch sdkmath.Int,
+	maxTicks uint32,
+	minQuantity sdkmath.Int,
+) error {
+	if err := k.validateOrderBookUpdateIsAllowed(ctx, sender, baseDenom); err != nil {
+		return err
+	}
+	if err := k.validateDenomPair(ctx, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+	if err := types.ValidateRewardProgramSettings(rewardPerEpoch, maxTicks, minQuantity); err != nil {
+		return err
+	}
+
+	orderBookID, _, err := k.getOrGenOrderBookIDs(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+
+	program := types.RewardProgram{
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+		Pool:       sdk.NewCoin(amount.Denom, sdkmath.ZeroInt()),
+	}
+	if err := k.getDataFromStore(ctx, types.CreateRewardProgramKey(orderBookID), &program); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+	}
+	if program.Pool.Denom != amount.Denom {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"the reward program of order book %s/%s is funded with %s", baseDenom, quoteDenom, program.Pool.Denom,
+		)
+	}
+	program.Pool = program.Pool.Add(amount)
+	program.RewardPerEpoch = rewardPerEpoch
+	program.MaxTicks = maxTicks
+	program.MinQuantity = minQuantity
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.RewardPoolModuleName, sdk.NewCoins(amount),
+	); err != nil {
+		return err
+	}
+	if err := k.setDataToStore(ctx, types.CreateRewardProgramKey(orderBookID), &program); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardProgramFunded{
+		Sender:  sender.String(),
+		Amount:  amount,
+		Program: program,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventRewardProgramFunded: %s", err)
+	}
+
+	return nil
+}
+
+// ClaimRewards sends the rewards accrued by the account in all reward programs to the account.
+func (k Keeper) ClaimRewards(ctx sdk.Context, acc sdk.AccAddress) (sdk.Coins, error) {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	accountRewards, orderBookIDs, err := k.getAccountRewards(ctx, accNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := sdk.NewCoins()
+	for i, accountReward := range accountRewards {
+		if !accountReward.Reward.IsPositive() {
+			continue
+		}
+		rewards = rewards.Add(accountReward.Reward)
+		accountReward.Reward.Amount = sdkmath.ZeroInt()
+		if err := k.setDataToStore(
+			ctx, types.CreateAccountRewardKey(accNumber, orderBookIDs[i]), &accountReward,
+		); err != nil {
+			return nil, err
+		}
+	}
+	if rewards.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "no rewards to claim by %s", acc.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardPoolModuleName, acc, rewards); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardsClaimed{
+		Account: acc.String(),
+		Amount:  rewards,
+	}); err != nil {
+		return nil, sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventRewardsClaimed: %s", err)
+	}
+
+	return rewards, nil
+}
+
+// DistributeRewards distributes the epoch rewards of the reward programs. The distribution is started at the last block
+// of the epoch and is limited by the reward distribution gas limit param, the remaining programs are distributed in the
+// next blocks.
+func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var cursor gogotypes.UInt32Value
+	if err := k.getDataFromStore(ctx, types.RewardDistributionCursorKey, &cursor); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+		// the distribution isn't in progress, so the next one is started at the last block of the epoch
+		if ctx.BlockHeight()%int64(params.RewardEpochBlocks) != 0 {
+			return nil
+		}
+	}
+
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	distributionCtx := ctx.WithGasMeter(gasMeter)
+	for {
+		if gasMeter.GasConsumed() >= params.RewardDistributionGasLimit {
+			k.logger(ctx).Debug(
+				"Reward distribution gas limit is reached.",
+				"gasConsumed", gasMeter.GasConsumed(),
+				"gasLimit", params.RewardDistributionGasLimit,
+			)
+			return k.setUint32Value(ctx, types.RewardDistributionCursorKey, cursor.Value)
+		}
+
+		orderBookID, program, found, err := k.getNextRewardProgram(distributionCtx, cursor.Value)
+		if err != nil {
+			return err
+		}
+		if !found || orderBookID == math.MaxUint32 {
+			return k.storeService.OpenKVStore(ctx).Delete(types.RewardDistributionCursorKey)
+		}
+
+		cacheCtx, writeCache := distributionCtx.CacheContext()
+		if err := k.distributeProgramRewards(cacheCtx, orderBookID, program); err != nil {
+			k.logger(ctx).Error(
+				"Failed to distribute the reward program rewards.",
+				"baseDenom", program.BaseDenom, "quoteDenom", program.QuoteDenom, "err", err,
+			)
+		} else {
+			writeCache()
+		}
+		cursor.Value = orderBookID + 1
+	}
+}
+
+// GetRewardPrograms returns paginated reward programs of the order books.
+func (k Keeper) GetRewardPrograms(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.RewardProgram, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.RewardProgramKeyPrefix)
+	programs, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pagination,
+		func(_ []byte, program *types.RewardProgram) (*types.RewardProgram, error) {
+			return program, nil
+		},
+		func() *types.RewardProgram {
+			return &types.RewardProgram{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(programs, func(program *types.RewardProgram, _ int) types.RewardProgram {
+		return *program
+	}), pageRes, nil
+}
+
+// GetAccountsRewards returns paginated rewards accrued by all accounts.
+func (k Keeper) GetAccountsRewards(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.AccountReward, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.AccountRewardKeyPrefix)
+	accountRewards, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pagination,
+		func(_ []byte, accountReward *types.AccountReward) (*types.AccountReward, error) {
+			return accountReward, nil
+		},
+		func() *types.AccountReward {
+			return &types.AccountReward{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(accountRewards, func(accountReward *types.AccountReward, _ int) types.AccountReward {
+		return *accountReward
+	}), pageRes, nil
+}
+
+// GetAccountRewards returns the rewards accrued by the account in all reward programs.
+func (k Keeper) GetAccountRewards(ctx sdk.Context, acc sdk.AccAddress) ([]types.AccountReward, error) {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	accountRewards, _, err := k.getAccountRewards(ctx, accNumber)
+	return accountRewards, err
+}
+
+// SaveRewardProgram saves the reward program of the existing order book.
+func (k Keeper) SaveRewardProgram(ctx sdk.Context, program types.RewardProgram) error {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, program.BaseDenom, program.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	return k.setDataToStore(ctx, types.CreateRewardProgramKey(orderBookID), &program)
+}
+
+// SaveAccountReward saves the reward accrued by the account in the reward program of the existing order book.
+func (k Keeper) SaveAccountReward(ctx sdk.Context, accNumber uint64, accountReward types.AccountReward) error {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, accountReward.BaseDenom, accountReward.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	return k.setDataToStore(ctx, types.CreateAccountRewardKey(accNumber, orderBookID), &accountReward)
+}
+
+// distributeProgramRewards distributes the epoch reward of the program between the accounts of the qualifying orders
+// pro-rata to their points, the remainder of the rounding is kept in the pool.
+func (k Keeper) distributeProgramRewards(ctx sdk.Context, orderBookID uint32, program types.RewardProgram) error {
+	epochReward := sdkmath.MinInt(program.RewardPerEpoch, program.Pool.Amount)
+	if !epochReward.IsPositive() {
+		return nil
+	}
+
+	accountsPoints, err := k.sampleRewardProgramOrders(ctx, orderBookID, program)
+	if err != nil {
+		return err
+	}
+	totalPoints := sdkmath.ZeroInt()
+	accNumbers := make([]uint64, 0, len(accountsPoints))
+	for accNumber, points := range accountsPoints {
+		totalPoints = totalPoints.Add(points)
+		accNumbers = append(accNumbers, accNumber)
+	}
+	if !totalPoints.IsPositive() {
+		return nil
+	}
+	// the map iteration order is random, so the accounts are sorted to keep the state deterministic
+	sort.Slice(accNumbers, func(i, j int) bool { return accNumbers[i] < accNumbers[j] })
+
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	distributed := sdkmath.ZeroInt()
+	for _, accNumber := range accNumbers {
+		points := accountsPoints[accNumber]
+		reward := epochReward.Mul(points).Quo(totalPoints)
+		acc, err := cachedAccKeeper.GetAccountAddress(ctx, accNumber)
+		if err != nil {
+			return err
+		}
+		if err := k.addAccountReward(
+			ctx, accNumber, acc, orderBookID, program, points, sdk.NewCoin(program.Pool.Denom, reward),
+		); err != nil {
+			return err
+		}
+		distributed = distributed.Add(reward)
+	}
+
+	program.Pool.Amount = program.Pool.Amount.Sub(distributed)
+	if err := k.setDataToStore(ctx, types.CreateRewardProgramKey(orderBookID), &program); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardsDistributed{
+		BaseDenom:  program.BaseDenom,
+		QuoteDenom: program.QuoteDenom,
+		Amount:     sdk.NewCoin(program.Pool.Denom, distributed),
+		Points:     totalPoints,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventRewardsDistributed: %s", err)
+	}
+
+	return nil
+}
+
+// sampleRewardProgramOrders returns the points of the accounts of the orders qualifying for the reward program. The
+// orders of the inverted order book are sampled as the orders of the opposite side with the inverted price. The order
+// qualifies if its price is within the max ticks from the mid price of the order book and its remaining base quantity
+// isn't less than the min quantity, the points of the order are its remaining base quantity.
+func (k Keeper) sampleRewardProgramOrders(
+	ctx sdk.Context,
+	orderBookID uint32,
+	program types.RewardProgram,
+) (map[uint64]sdkmath.Int, error) {
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return nil, err
+	}
+
+	bestBid, found, err := k.getRewardProgramBestPrice(
+		ctx, orderBookID, types.SIDE_BUY, invertedOrderBookID, types.SIDE_SELL,
+	)
+	if err != nil || !found {
+		return nil, err
+	}
+	bestAsk, found, err := k.getRewardProgramBestPrice(
+		ctx, orderBookID, types.SIDE_SELL, invertedOrderBookID, types.SIDE_BUY,
+	)
+	if err != nil || !found {
+		return nil, err
+	}
+	midPrice := new(big.Rat).Add(bestBid, bestAsk)
+	midPrice.Quo(midPrice, big.NewRat(2, 1))
+
+	orderBookParams, err := k.GetOrderBookParams(ctx, program.BaseDenom, program.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+	maxDistance := new(big.Rat).Mul(orderBookParams.PriceTick.Rat(), new(big.Rat).SetUint64(uint64(program.MaxTicks)))
+
+	accountsPoints := make(map[uint64]sdkmath.Int)
+	for _, orderBookSide := range []struct {
+		orderBookID uint32
+		side        types.Side
+		inverted    bool
+	}{
+		{orderBookID: orderBookID, side: types.SIDE_BUY},
+		{orderBookID: orderBookID, side: types.SIDE_SELL},
+		{orderBookID: invertedOrderBookID, side: types.SIDE_BUY, inverted: true},
+		{orderBookID: invertedOrderBookID, side: types.SIDE_SELL, inverted: true},
+	} {
+		if err := k.sampleRewardProgramSideOrders(
+			ctx,
+			orderBookSide.orderBookID,
+			orderBookSide.side,
+			orderBookSide.inverted,
+			midPrice,
+			maxDistance,
+			program.MinQuantity,
+			accountsPoints,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return accountsPoints, nil
+}
+
+func (k Keeper) sampleRewardProgramSideOrders(
+	ctx sdk.Context,
+	orderBookID uint32,
+	side types.Side,
+	inverted bool,
+	midPrice, maxDistance *big.Rat,
+	minQuantity sdkmath.Int,
+	accountsPoints map[uint64]sdkmath.Int,
+) error {
+	iterator := k.NewOrderBookSideIterator(ctx, orderBookID, side)
+	defer iterator.Close()
+
+	for range maxRewardSampledOrdersPerSide {
+		record, found, err := iterator.Next()
+		if err != nil {
+			return err
+		}
+		if !found {
+			return nil
+		}
+		price, quantity := rewardProgramRecordPriceAndQuantity(record, inverted)
+		// the orders are sorted from the best price, so the next orders are farther from the mid price
+		distance := new(big.Rat).Sub(midPrice, price)
+		if distance.Abs(distance).Cmp(maxDistance) > 0 {
+			return nil
+		}
+		if quantity.LT(minQuantity) || !quantity.IsPositive() {
+			continue
+		}
+		points, ok := accountsPoints[record.AccountNumber]
+		if !ok {
+			points = sdkmath.ZeroInt()
+		}
+		accountsPoints[record.AccountNumber] = points.Add(quantity)
+	}
+
+	return nil
+}
+
+// getRewardProgramBestPrice returns the best price of the order book side, taking into account the opposite side of
+// the inverted order book.
+func (k Keeper) getRewardProgramBestPrice(
+	ctx sdk.Context,
+	orderBookID uint32,
+	side types.Side,
+	invertedOrderBookID uint32,
+	invertedSide types.Side,
+) (*big.Rat, bool, error) {
+	bestPrice, found, err := k.getBestPrice(ctx, orderBookID, side)
+	if err != nil {
+		return nil, false, err
+	}
+	invertedBestPrice, invertedFound, err := k.getBestPrice(ctx, invertedOrderBookID, invertedSide)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch {
+	case !invertedFound:
+		if !found {
+			return nil, false, nil
+		}
+		return bestPrice.Rat(), true, nil
+	case !found:
+		return new(big.Rat).Inv(invertedBestPrice.Rat()), true, nil
+	}
+
+	price := bestPrice.Rat()
+	invertedPrice := new(big.Rat).Inv(invertedBestPrice.Rat())
+	// the best bid is the highest price and the best ask is the lowest one
+	if (side == types.SIDE_BUY) == (invertedPrice.Cmp(price) > 0) {
+		return invertedPrice, true, nil
+	}
+
+	return price, true, nil
+}
+
+// rewardProgramRecordPriceAndQuantity returns the price and the remaining base quantity of the record in terms of the
+// direct order book. The base quantity of the inverted order book record is the executable quote quantity.
+func rewardProgramRecordPriceAndQuantity(record types.OrderBookRecord, inverted bool) (*big.Rat, sdkmath.Int) {
+	if !inverted {
+		return record.Price.Rat(), record.RemainingBaseQuantity
+	}
+
+	_, quoteQuantity := computeMaxIntExecutionQuantity(record.Price.Rat(), record.RemainingBaseQuantity.BigInt())
+	return new(big.Rat).Inv(record.Price.Rat()), sdkmath.NewIntFromBigInt(quoteQuantity)
+}
+
+func (k Keeper) getBestPrice(ctx sdk.Context, orderBookID uint32, side types.Side) (types.Price, bool, error) {
+	iterator := k.NewOrderBookSideIterator(ctx, orderBookID, side)
+	defer iterator.Close()
+
+	record, found, err := iterator.Next()
+	if err != nil || !found {
+		return types.Price{}, false, err
+	}
+
+	return record.Price, true, nil
+}
+
+// getNextRewardProgram returns the reward program with the lowest order book ID not less than the provided one.
+func (k Keeper) getNextRewardProgram(
+	ctx sdk.Context, fromOrderBookID uint32,
+) (uint32, types.RewardProgram, bool, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.RewardProgramKeyPrefix).Iterator(
+		store.AppendUint32ToOrderedBytes(nil, fromOrderBookID), nil,
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, types.RewardProgram{}, false, nil
+	}
+	orderBookID, err := types.DecodeOrderBookDataKey(iterator.Key())
+	if err != nil {
+		return 0, types.RewardProgram{}, false, sdkerrors.Wrapf(
+			types.ErrInvalidKey, "failed to decode reward program key: %s", err,
+		)
+	}
+	var program types.RewardProgram
+	if err := k.cdc.Unmarshal(iterator.Value(), &program); err != nil {
+		return 0, types.RewardProgram{}, false, sdkerrors.Wrapf(
+			types.ErrInvalidState, "failed to unmarshal reward program: %s", err,
+		)
+	}
+
+	return orderBookID, program, true, nil
+}
+
+func (k Keeper) addAccountReward(
+	ctx sdk.Context,
+	accNumber uint64,
+	acc sdk.AccAddress,
+	orderBookID uint32,
+	program types.RewardProgram,
+	points sdkmath.Int,
+	reward sdk.Coin,
+) error {
+	key := types.CreateAccountRewardKey(accNumber, orderBookID)
+	accountReward := types.AccountReward{
+		Account:    acc.String(),
+		BaseDenom:  program.BaseDenom,
+		QuoteDenom: program.QuoteDenom,
+		Points:     sdkmath.ZeroInt(),
+		Reward:     sdk.NewCoin(reward.Denom, sdkmath.ZeroInt()),
+	}
+	if err := k.getDataFromStore(ctx, key, &accountReward); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+	}
+	accountReward.Points = accountReward.Points.Add(points)
+	accountReward.Reward = accountReward.Reward.Add(reward)
+
+	return k.setDataToStore(ctx, key, &accountReward)
+}
+
+func (k Keeper) getAccountRewards(ctx sdk.Context, accNumber uint64) ([]types.AccountReward, []uint32, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateAccountRewardsKey(accNumber),
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	accountRewards := make([]types.AccountReward, 0)
+	orderBookIDs := make([]uint32, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		orderBookID, err := types.DecodeOrderBookDataKey(iterator.Key())
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidKey, "failed to decode account reward key: %s", err)
+		}
+		var accountReward types.AccountReward
+		if err := k.cdc.Unmarshal(iterator.Value(), &accountReward); err != nil {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal account reward: %s", err)
+		}
+		accountRewards = append(accountRewards, accountReward)
+		orderBookIDs = append(orderBookIDs, orderBookID)
+	}
+
+	return accountRewards, orderBookIDs, nil
+}
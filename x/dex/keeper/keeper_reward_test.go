@@ -0,0 +1,348 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/event"
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_RewardProgram(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(101)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Equal(t, "1e-6", orderBookParams.PriceTick.String())
+
+	// only the governance or the base denom admin is able to fund the reward program
+	fundProgram := func(sender sdk.AccAddress, amount sdk.Coin) error {
+		return dexKeeper.FundRewardProgram(
+			sdkCtx,
+			sender,
+			testSet.denom1,
+			testSet.denom2,
+			amount,
+			sdkmath.NewInt(300),
+			3,
+			sdkmath.NewInt(100_000),
+		)
+	}
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom3, 1_000)))
+	cacheCtx, _ := sdkCtx.CacheContext()
+	require.ErrorIs(t, dexKeeper.FundRewardProgram(
+		cacheCtx,
+		testSet.acc1,
+		testSet.denom1,
+		testSet.denom2,
+		sdk.NewInt64Coin(testSet.denom3, 1_000),
+		sdkmath.NewInt(300),
+		3,
+		sdkmath.NewInt(100_000),
+	), cosmoserrors.ErrUnauthorized)
+
+	require.NoError(t, fundProgram(testSet.issuer, sdk.NewInt64Coin(testSet.denom3, 400)))
+	require.NoError(t, fundProgram(testSet.issuer, sdk.NewInt64Coin(testSet.denom3, 600)))
+	// the pool can't be funded with the other denom
+	cacheCtx, _ = sdkCtx.CacheContext()
+	require.ErrorIs(t, dexKeeper.FundRewardProgram(
+		cacheCtx,
+		testSet.issuer,
+		testSet.denom1,
+		testSet.denom2,
+		sdk.NewInt64Coin(testSet.denom2, 1_000),
+		sdkmath.NewInt(300),
+		3,
+		sdkmath.NewInt(100_000),
+	), types.ErrInvalidInput)
+
+	programs, _, err := dexKeeper.GetRewardPrograms(sdkCtx, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RewardProgram{
+		{
+			BaseDenom:      testSet.denom1,
+			QuoteDenom:     testSet.denom2,
+			Pool:           sdk.NewInt64Coin(testSet.denom3, 1_000),
+			RewardPerEpoch: sdkmath.NewInt(300),
+			MaxTicks:       3,
+			MinQuantity:    sdkmath.NewInt(100_000),
+		},
+	}, programs)
+
+	// the mid price is 1.000002, so the orders within 3e-6 from it qualify
+	orders := []types.Order{
+		{
+			Creator:     testSet.acc1.String(),
+			ID:          "ask",
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1000004e-6")),
+			Quantity:    sdkmath.NewInt(250_000),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		{
+			Creator:     testSet.acc2.String(),
+			ID:          "bid",
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    sdkmath.NewInt(500_000),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		// below the min quantity
+		{
+			Creator:     testSet.acc3.String(),
+			ID:          "small-bid",
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    sdkmath.NewInt(50_000),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		// out of the max ticks
+		{
+			Creator:     testSet.acc3.String(),
+			ID:          "far-bid",
+			Price:       lo.ToPtr(types.MustNewPriceFromString("999e-3")),
+			Quantity:    sdkmath.NewInt(100_000),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+	}
+	for _, order := range orders {
+		order.Type = types.ORDER_TYPE_LIMIT
+		order.BaseDenom = testSet.denom1
+		order.QuoteDenom = testSet.denom2
+		creator := sdk.MustAccAddressFromBech32(order.Creator)
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, sdkCtx, creator, sdk.NewCoins(lockedBalance))
+		fundOrderReserve(t, testApp, sdkCtx, creator)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	// the rewards aren't distributed in the middle of the epoch
+	require.NoError(t, dexKeeper.DistributeRewards(sdkCtx))
+	accountRewards, err := dexKeeper.GetAccountRewards(sdkCtx, testSet.acc1)
+	require.NoError(t, err)
+	require.Empty(t, accountRewards)
+	cacheCtx, _ = sdkCtx.CacheContext()
+	_, err = dexKeeper.ClaimRewards(cacheCtx, testSet.acc1)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// the rewards are distributed at the end of the epoch
+	sdkCtx = sdkCtx.WithBlockHeight(200).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.DistributeRewards(sdkCtx))
+	distributedEvents, err := event.FindTypedEvents[*types.EventRewardsDistributed](
+		sdkCtx.EventManager().ABCIEvents(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*types.EventRewardsDistributed{
+		{
+			BaseDenom:  testSet.denom1,
+			QuoteDenom: testSet.denom2,
+			Amount:     sdk.NewInt64Coin(testSet.denom3, 300),
+			Points:     sdkmath.NewInt(750_000),
+		},
+	}, distributedEvents)
+
+	requireAccountReward(t, sdkCtx, testApp.DEXKeeper, testSet.acc1, 250_000, sdk.NewInt64Coin(testSet.denom3, 100))
+	requireAccountReward(t, sdkCtx, testApp.DEXKeeper, testSet.acc2, 500_000, sdk.NewInt64Coin(testSet.denom3, 200))
+	accountRewards, err = dexKeeper.GetAccountRewards(sdkCtx, testSet.acc3)
+	require.NoError(t, err)
+	require.Empty(t, accountRewards)
+
+	programs, _, err = dexKeeper.GetRewardPrograms(sdkCtx, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom3, 700).String(), programs[0].Pool.String())
+
+	// the accrued rewards are claimed, and the points are kept
+	balanceBefore := testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.denom3)
+	claimed, err := dexKeeper.ClaimRewards(sdkCtx, testSet.acc1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom3, 100)).String(), claimed.String())
+	require.Equal(
+		t,
+		balanceBefore.AddAmount(sdkmath.NewInt(100)).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.denom3).String(),
+	)
+	requireAccountReward(t, sdkCtx, testApp.DEXKeeper, testSet.acc1, 250_000, sdk.NewInt64Coin(testSet.denom3, 0))
+	cacheCtx, _ = sdkCtx.CacheContext()
+	_, err = dexKeeper.ClaimRewards(cacheCtx, testSet.acc1)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// the epoch reward is limited by the pool, and the remainder of the rounding is kept in the pool
+	for _, height := range []int64{300, 400, 500} {
+		require.NoError(t, dexKeeper.DistributeRewards(sdkCtx.WithBlockHeight(height)))
+	}
+	programs, _, err = dexKeeper.GetRewardPrograms(sdkCtx, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom3, 1).String(), programs[0].Pool.String())
+	requireAccountReward(t, sdkCtx, testApp.DEXKeeper, testSet.acc1, 1_000_000, sdk.NewInt64Coin(testSet.denom3, 233))
+	requireAccountReward(t, sdkCtx, testApp.DEXKeeper, testSet.acc2, 2_000_000, sdk.NewInt64Coin(testSet.denom3, 666))
+}
+
+func TestKeeper_RewardProgramInvertedOrders(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(101)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	require.NoError(t, dexKeeper.FundRewardProgram(
+		sdkCtx,
+		testSet.issuer,
+		testSet.denom1,
+		testSet.denom2,
+		sdk.NewInt64Coin(testSet.denom3, 1_000),
+		sdkmath.NewInt(300),
+		3,
+		sdkmath.NewInt(100_000),
+	))
+
+	// the sell orders of the inverted order book are sampled as the bids with the inverted price and the quote quantity
+	orders := []types.Order{
+		{
+			Creator:     testSet.acc1.String(),
+			ID:          "ask",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1000005e-6")),
+			Quantity:    sdkmath.NewInt(200_000),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		{
+			Creator:     testSet.acc2.String(),
+			ID:          "inverted-ask",
+			BaseDenom:   testSet.denom2,
+			QuoteDenom:  testSet.denom1,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    sdkmath.NewInt(200_000),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		// the inverted price 1/0.999 is out of the max ticks
+		{
+			Creator:     testSet.acc3.String(),
+			ID:          "far-inverted-bid",
+			BaseDenom:   testSet.denom2,
+			QuoteDenom:  testSet.denom1,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("999e-3")),
+			Quantity:    sdkmath.NewInt(200_000),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+	}
+	for _, order := range orders {
+		order.Type = types.ORDER_TYPE_LIMIT
+		creator := sdk.MustAccAddressFromBech32(order.Creator)
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, sdkCtx, creator, sdk.NewCoins(lockedBalance))
+		fundOrderReserve(t, testApp, sdkCtx, creator)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	sdkCtx = sdkCtx.WithBlockHeight(200)
+	require.NoError(t, dexKeeper.DistributeRewards(sdkCtx))
+	requireAccountReward(t, sdkCtx, testApp.DEXKeeper, testSet.acc1, 200_000, sdk.NewInt64Coin(testSet.denom3, 150))
+	requireAccountReward(t, sdkCtx, testApp.DEXKeeper, testSet.acc2, 200_000, sdk.NewInt64Coin(testSet.denom3, 150))
+	accountRewards, err := dexKeeper.GetAccountRewards(sdkCtx, testSet.acc3)
+	require.NoError(t, err)
+	require.Empty(t, accountRewards)
+}
+
+func TestKeeper_RewardDistributionGasLimit(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false).WithBlockHeight(101)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	// the limit allows distributing one program per block
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.RewardDistributionGasLimit = 1
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	for i, denoms := range [][]string{{testSet.denom1, testSet.denom2}, {testSet.denom3, testSet.denom2}} {
+		require.NoError(t, dexKeeper.FundRewardProgram(
+			sdkCtx,
+			testSet.issuer,
+			denoms[0],
+			denoms[1],
+			sdk.NewInt64Coin(testSet.denom3, 1_000),
+			sdkmath.NewInt(300),
+			3,
+			sdkmath.NewInt(100_000),
+		))
+		for _, side := range []types.Side{types.SIDE_BUY, types.SIDE_SELL} {
+			order := types.Order{
+				Creator:     testSet.acc1.String(),
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          fmt.Sprintf("id%d-%s", i, side),
+				BaseDenom:   denoms[0],
+				QuoteDenom:  denoms[1],
+				Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+				Quantity:    sdkmath.NewInt(200_000),
+				Side:        side,
+				TimeInForce: types.TIME_IN_FORCE_GTC,
+			}
+			if side == types.SIDE_SELL {
+				order.Price = lo.ToPtr(types.MustNewPriceFromString("1000005e-6"))
+			}
+			lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+			require.NoError(t, err)
+			testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(lockedBalance))
+			fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+			require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+		}
+	}
+	requireDistributedPrograms := func(sdkCtx sdk.Context, expectedCount int) {
+		t.Helper()
+		sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, dexKeeper.DistributeRewards(sdkCtx))
+		distributedEvents := lo.Filter(sdkCtx.EventManager().ABCIEvents(), func(evt abci.Event, _ int) bool {
+			return evt.Type == "coreum.dex.v1.EventRewardsDistributed"
+		})
+		require.Len(t, distributedEvents, expectedCount)
+	}
+
+	// the distribution started at the end of the epoch is continued in the next blocks
+	requireDistributedPrograms(sdkCtx.WithBlockHeight(199), 0)
+	requireDistributedPrograms(sdkCtx.WithBlockHeight(200), 1)
+	requireDistributedPrograms(sdkCtx.WithBlockHeight(201), 1)
+	requireDistributedPrograms(sdkCtx.WithBlockHeight(202), 0)
+	requireDistributedPrograms(sdkCtx.WithBlockHeight(203), 0)
+	requireDistributedPrograms(sdkCtx.WithBlockHeight(300), 1)
+}
+
+func requireAccountReward(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	dexKeeper keeper.Keeper,
+	acc sdk.AccAddress,
+	points int64,
+	reward sdk.Coin,
+) {
+	t.Helper()
+
+	accountRewards, err := dexKeeper.GetAccountRewards(sdkCtx, acc)
+	require.NoError(t, err)
+	require.Len(t, accountRewards, 1)
+	require.Equal(t, acc.String(), accountRewards[0].Account)
+	require.Equal(t, sdkmath.NewInt(points).String(), accountRewards[0].Points.String())
+	require.Equal(t, reward.String(), accountRewards[0].Reward.String())
+}
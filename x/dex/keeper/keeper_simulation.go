@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/samber/lo"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	matchingengine "github.com/CoreumFoundation/coreum/v6/x/dex/matching-engine"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// simulatedOrderID is the ID of the simulated order.
+const simulatedOrderID = "simulated-order"
+
+// unlimitedSimulationBalance is the spendable balance of the market order simulated without the creator.
+var unlimitedSimulationBalance = sdkmath.NewIntFromBigInt(
+	cbig.IntSub(new(big.Int).Lsh(big.NewInt(1), sdkmath.MaxBitLen-1), big.NewInt(1)),
+)
+
+// SimulateOrder matches the order against the order books without applying the matching result.
+func (k Keeper) SimulateOrder(
+	ctx sdk.Context,
+	req *types.QuerySimulateOrderRequest,
+) (*types.QuerySimulateOrderResponse, error) {
+	// the matching doesn't change the state, but the cache context guarantees it
+	ctx, _ = ctx.CacheContext()
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order := types.Order{
+		Creator:     req.Creator,
+		Type:        req.Type,
+		ID:          simulatedOrderID,
+		BaseDenom:   req.BaseDenom,
+		QuoteDenom:  req.QuoteDenom,
+		Price:       req.Price,
+		Quantity:    req.Quantity,
+		Side:        req.Side,
+		TimeInForce: req.TimeInForce,
+	}
+	if order.Creator == "" {
+		order.Creator = authtypes.NewModuleAddress(types.ModuleName).String()
+	}
+	if err := k.validateOrder(ctx, params, order); err != nil {
+		return nil, err
+	}
+
+	var (
+		accNumber        uint64
+		remainingBalance sdkmath.Int
+	)
+	if req.Creator != "" {
+		creator, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", req.Creator)
+		}
+		if accNumber, err = k.getAccountNumber(ctx, creator); err != nil {
+			return nil, err
+		}
+		if remainingBalance, err = k.getInitialRemainingBalance(ctx, order); err != nil {
+			return nil, err
+		}
+	} else {
+		if remainingBalance, err = getSimulationRemainingBalance(order); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &types.QuerySimulateOrderResponse{
+		ExecutedBaseQuantity:  sdkmath.ZeroInt(),
+		ExecutedQuoteQuantity: sdkmath.ZeroInt(),
+		RemainingBaseQuantity: order.Quantity,
+		Slippage:              sdkmath.LegacyZeroDec(),
+		Trades:                make([]types.EventTrade, 0),
+		TakerFee:              sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()),
+		MakerFee:              sdk.NewCoin(order.GetSpendDenom(), sdkmath.ZeroInt()),
+	}
+
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			// there is nothing to match with
+			return res, nil
+		}
+		return nil, err
+	}
+	invertedOrderBookID, err := k.getOrderBookIDByDenoms(ctx, order.QuoteDenom, order.BaseDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	mr, err := k.simulateMatching(ctx, params, accNumber, orderBookID, invertedOrderBookID, order, remainingBalance)
+	if err != nil {
+		return nil, err
+	}
+	// the fill-or-kill order isn't executed if it isn't filled fully
+	if order.TimeInForce == types.TIME_IN_FORCE_FOK && !mr.TakerIsFilled {
+		return res, nil
+	}
+
+	// the fees are charged as on the placement, so the discount of the creator is applied if it's set
+	makerFeeRate, takerFeeRate := params.GetFeeRates(order.BaseDenom, order.QuoteDenom)
+	if err := mr.ChargeFees(
+		authtypes.NewModuleAddress(types.ModuleName),
+		makerFeeRate,
+		takerFeeRate,
+		k.newFeeDiscountProvider(ctx, params),
+	); err != nil {
+		return nil, err
+	}
+
+	if req.Creator == "" {
+		for i := range mr.TradeEvents {
+			mr.TradeEvents[i].Taker = ""
+		}
+	}
+
+	return fillSimulationResponse(res, order, mr)
+}
+
+func (k Keeper) simulateMatching(
+	ctx sdk.Context,
+	params types.Params,
+	accNumber uint64,
+	orderBookID, invertedOrderBookID uint32,
+	order types.Order,
+	remainingBalance sdkmath.Int,
+) (matchingengine.MatchingResult, error) {
+	mf, err := k.NewMatchingFinder(ctx, orderBookID, invertedOrderBookID, order)
+	if err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+	defer func() {
+		if err := mf.Close(); err != nil {
+			k.logger(ctx).Error(err.Error())
+		}
+	}()
+
+	inAuction, err := k.isOrderBookInAuction(ctx, orderBookID)
+	if err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+	if inAuction {
+		return matchingengine.NewUnmatchedResult(accNumber, orderBookID, order, remainingBalance)
+	}
+
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	engine := matchingengine.NewMatchingEngine(mf, cachedAccKeeper, k.logger(ctx), k)
+
+	priceBand, err := k.getCircuitBreakerPriceBand(ctx, params, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return matchingengine.MatchingResult{}, err
+	}
+
+	return engine.MatchOrder(ctx, accNumber, orderBookID, order, remainingBalance, priceBand)
+}
+
+// getSimulationRemainingBalance returns the remaining balance of the order simulated without the creator.
+func getSimulationRemainingBalance(order types.Order) (sdkmath.Int, error) {
+	switch order.Type {
+	case types.ORDER_TYPE_LIMIT:
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		return lockedBalance.Amount, nil
+	case types.ORDER_TYPE_MARKET:
+		if order.Side == types.SIDE_SELL {
+			return order.Quantity, nil
+		}
+		return unlimitedSimulationBalance, nil
+	default:
+		return sdkmath.Int{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "unexpected order type : %s", order.Type.String(),
+		)
+	}
+}
+
+func fillSimulationResponse(
+	res *types.QuerySimulateOrderResponse,
+	order types.Order,
+	mr matchingengine.MatchingResult,
+) (*types.QuerySimulateOrderResponse, error) {
+	sent, received := mr.TakerOrderReducedEvent.SentCoin.Amount, mr.TakerOrderReducedEvent.ReceivedCoin.Amount
+	if order.Side == types.SIDE_BUY {
+		res.ExecutedBaseQuantity, res.ExecutedQuoteQuantity = received, sent
+	} else {
+		res.ExecutedBaseQuantity, res.ExecutedQuoteQuantity = sent, received
+	}
+	res.RemainingBaseQuantity = mr.TakerRecord.RemainingBaseQuantity
+	res.Filled = mr.TakerIsFilled
+	res.Trades = mr.TradeEvents
+	res.TakerFee = mr.TakerOrderReducedEvent.Fee
+	for _, makerEvt := range mr.MakerOrderReducedEvents {
+		res.MakerFee = res.MakerFee.Add(makerEvt.Fee)
+	}
+
+	if len(mr.TradeEvents) == 0 || !res.ExecutedBaseQuantity.IsPositive() || !res.ExecutedQuoteQuantity.IsPositive() {
+		return res, nil
+	}
+
+	averagePrice := cbig.NewRatFromBigInts(res.ExecutedQuoteQuantity.BigInt(), res.ExecutedBaseQuantity.BigInt())
+	// the trade price is expressed in the maker order book
+	firstTrade := mr.TradeEvents[0]
+	bestPrice := firstTrade.Price.Rat()
+	if firstTrade.BaseDenom != order.BaseDenom {
+		bestPrice = cbig.RatInv(bestPrice)
+	}
+
+	averagePriceRes, err := types.NewPriceFromRat(averagePrice)
+	if err != nil {
+		return nil, err
+	}
+	bestPriceRes, err := types.NewPriceFromRat(bestPrice)
+	if err != nil {
+		return nil, err
+	}
+	res.AveragePrice = lo.ToPtr(averagePriceRes)
+	res.BestPrice = lo.ToPtr(bestPriceRes)
+
+	// the buy order gets worse prices going up, and the sell order going down
+	priceDiff := new(big.Rat).Sub(averagePrice, bestPrice)
+	if order.Side == types.SIDE_SELL {
+		priceDiff.Neg(priceDiff)
+	}
+	slippage := cbig.RatDiv(priceDiff, bestPrice)
+	slippageInt := cbig.IntQuo(
+		cbig.IntMul(slippage.Num(), cbig.IntTenToThePower(big.NewInt(sdkmath.LegacyPrecision))), slippage.Denom(),
+	)
+	if slippageInt.BitLen() > sdkmath.MaxBitLen {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "slippage %s is out of range", slippage.String())
+	}
+	res.Slippage = sdkmath.LegacyNewDecFromBigIntWithPrec(slippageInt, sdkmath.LegacyPrecision)
+
+	return res, nil
+}
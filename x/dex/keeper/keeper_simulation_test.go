@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/docker/distribution/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_SimulateOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	maker, _ := testApp.GenAccount(sdkCtx)
+	makerOrders := []types.Order{
+		{
+			Creator:     maker.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          uuid.Generate().String(),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("13e-1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		{
+			Creator:     maker.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          uuid.Generate().String(),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("14e-1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		// the inverted order sells denom1 for 125e-2 denom2
+		{
+			Creator:     maker.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          uuid.Generate().String(),
+			BaseDenom:   testSet.denom2,
+			QuoteDenom:  testSet.denom1,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("8e-1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+	}
+	for _, order := range makerOrders {
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, sdkCtx, maker, sdk.NewCoins(lockedBalance))
+		fundOrderReserve(t, testApp, sdkCtx, maker)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	depthBefore, err := dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 0)
+	require.NoError(t, err)
+
+	// simulate without creator
+	simulateReq := &types.QuerySimulateOrderRequest{
+		Type:        types.ORDER_TYPE_MARKET,
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Quantity:    defaultQuantityStep.MulRaw(2),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}
+	simulateRes, err := dexKeeper.SimulateOrder(sdkCtx, simulateReq)
+	require.NoError(t, err)
+	require.True(t, simulateRes.Filled)
+	require.Equal(t, defaultQuantityStep.MulRaw(2).String(), simulateRes.ExecutedBaseQuantity.String())
+	require.True(t, simulateRes.RemainingBaseQuantity.IsZero())
+	require.Len(t, simulateRes.Trades, 3)
+	// the inverted order has the best price
+	require.Equal(t, makerOrders[2].ID, simulateRes.Trades[0].MakerOrderID)
+	require.Equal(t, testSet.denom2, simulateRes.Trades[0].BaseDenom)
+	require.Equal(t, makerOrders[0].ID, simulateRes.Trades[1].MakerOrderID)
+	require.Equal(t, makerOrders[1].ID, simulateRes.Trades[2].MakerOrderID)
+	require.Empty(t, simulateRes.Trades[0].Taker)
+	require.Equal(t, "125e-2", simulateRes.BestPrice.String())
+	require.True(t, simulateRes.Slippage.IsPositive())
+
+	// the state is not changed
+	depthAfter, err := dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 0)
+	require.NoError(t, err)
+	require.Equal(t, depthBefore, depthAfter)
+
+	// the fill-or-kill order isn't executed if it can't be filled fully
+	fokRes, err := dexKeeper.SimulateOrder(sdkCtx, &types.QuerySimulateOrderRequest{
+		Type:        types.ORDER_TYPE_LIMIT,
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("14e-1")),
+		Quantity:    defaultQuantityStep.MulRaw(10),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_FOK,
+	})
+	require.NoError(t, err)
+	require.False(t, fokRes.Filled)
+	require.True(t, fokRes.ExecutedBaseQuantity.IsZero())
+	require.Empty(t, fokRes.Trades)
+	require.Nil(t, fokRes.AveragePrice)
+
+	// the post-only order crossing the order book is rejected
+	_, err = dexKeeper.SimulateOrder(sdkCtx, &types.QuerySimulateOrderRequest{
+		Type:        types.ORDER_TYPE_LIMIT,
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("14e-1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_POST_ONLY,
+	})
+	require.ErrorIs(t, err, types.ErrPostOnlyOrderMatched)
+
+	// the simulation result matches the real execution including the fees
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.002")
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	taker, _ := testApp.GenAccount(sdkCtx)
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(sdk.NewCoin(testSet.denom2, sdkmath.NewInt(1_000_000))))
+	fundOrderReserve(t, testApp, sdkCtx, taker)
+
+	simulateReq.Creator = taker.String()
+	simulateRes, err = dexKeeper.SimulateOrder(sdkCtx, simulateReq)
+	require.NoError(t, err)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        simulateReq.Type,
+		ID:          uuid.Generate().String(),
+		BaseDenom:   simulateReq.BaseDenom,
+		QuoteDenom:  simulateReq.QuoteDenom,
+		Quantity:    simulateReq.Quantity,
+		Side:        simulateReq.Side,
+		TimeInForce: simulateReq.TimeInForce,
+	}))
+	events := readOrderEvents(t, sdkCtx)
+	takerReduced, ok := events.getOrderReduced(taker.String(), events.OrderPlaced.ID)
+	require.True(t, ok)
+	require.Equal(t, takerReduced.ReceivedCoin.Amount.String(), simulateRes.ExecutedBaseQuantity.String())
+	require.Equal(t, takerReduced.SentCoin.Amount.String(), simulateRes.ExecutedQuoteQuantity.String())
+	require.True(t, simulateRes.TakerFee.IsPositive())
+	require.Equal(t, takerReduced.Fee.String(), simulateRes.TakerFee.String())
+	makerFee := sdk.NewCoin(testSet.denom2, sdkmath.ZeroInt())
+	for _, makerEvt := range events.OrdersReduced {
+		if makerEvt.Creator == maker.String() {
+			makerFee = makerFee.Add(makerEvt.Fee)
+		}
+	}
+	require.True(t, simulateRes.MakerFee.IsPositive())
+	require.Equal(t, makerFee.String(), simulateRes.MakerFee.String())
+	require.Len(t, events.Trades, len(simulateRes.Trades))
+	for i, trade := range events.Trades {
+		require.Equal(t, trade.MakerOrderID, simulateRes.Trades[i].MakerOrderID)
+		require.Equal(t, trade.BaseQuantity.String(), simulateRes.Trades[i].BaseQuantity.String())
+		require.Equal(t, trade.QuoteQuantity.String(), simulateRes.Trades[i].QuoteQuantity.String())
+	}
+
+	// there is nothing to match with
+	emptyRes, err := dexKeeper.SimulateOrder(sdkCtx, &types.QuerySimulateOrderRequest{
+		Type:        types.ORDER_TYPE_MARKET,
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom3,
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	})
+	require.NoError(t, err)
+	require.False(t, emptyRes.Filled)
+	require.Equal(t, defaultQuantityStep.String(), emptyRes.RemainingBaseQuantity.String())
+}
@@ -0,0 +1,398 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// swapOrderIDPrefix is the prefix of the IDs of the market orders executing the swap hops.
+const swapOrderIDPrefix = "swap-"
+
+// swapRouteFunc executes the swap through the route and returns the spent and received coins.
+type swapRouteFunc func(ctx sdk.Context, route []string) (sdk.Coin, sdk.Coin, error)
+
+type swapResult struct {
+	route   []string
+	coinIn  sdk.Coin
+	coinOut sdk.Coin
+}
+
+// SwapExactIn swaps the input coin to the output denom by the market orders executed one by one through the order
+// books of the route. If the route is empty the route with the max output is selected automatically.
+func (k Keeper) SwapExactIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	coinIn sdk.Coin,
+	denomOut string,
+	route []string,
+	minAmountOut sdkmath.Int,
+) (sdk.Coin, sdk.Coin, error) {
+	k.logger(ctx).Debug(
+		"Swapping exact in.", "sender", sender.String(), "coinIn", coinIn.String(), "denomOut", denomOut, "route", route,
+	)
+
+	params, accNumber, err := k.getSwapParamsAndAccountNumber(ctx, sender)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	res, err := k.executeSwap(
+		ctx,
+		params,
+		coinIn.Denom,
+		denomOut,
+		route,
+		func(ctx sdk.Context, route []string) (sdk.Coin, sdk.Coin, error) {
+			return k.swapExactInRoute(ctx, params, sender, accNumber, coinIn, route)
+		},
+		func(res, best swapResult) bool {
+			return res.coinOut.Amount.GT(best.coinOut.Amount)
+		},
+	)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if res.coinOut.Amount.LT(minAmountOut) {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"received amount %s is less than the min amount out %s", res.coinOut.String(), minAmountOut.String(),
+		)
+	}
+
+	return res.coinIn, res.coinOut, k.emitSwapEvent(ctx, sender, res)
+}
+
+// SwapExactOut swaps the input denom to the output coin by the market orders executed one by one through the order
+// books of the route. If the route is empty the route with the min input is selected automatically.
+func (k Keeper) SwapExactOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denomIn string,
+	coinOut sdk.Coin,
+	route []string,
+	maxAmountIn sdkmath.Int,
+) (sdk.Coin, sdk.Coin, error) {
+	k.logger(ctx).Debug(
+		"Swapping exact out.", "sender", sender.String(), "denomIn", denomIn, "coinOut", coinOut.String(), "route", route,
+	)
+
+	params, accNumber, err := k.getSwapParamsAndAccountNumber(ctx, sender)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	res, err := k.executeSwap(
+		ctx,
+		params,
+		denomIn,
+		coinOut.Denom,
+		route,
+		func(ctx sdk.Context, route []string) (sdk.Coin, sdk.Coin, error) {
+			return k.swapExactOutRoute(ctx, params, sender, accNumber, coinOut, route, maxAmountIn)
+		},
+		func(res, best swapResult) bool {
+			return res.coinIn.Amount.LT(best.coinIn.Amount)
+		},
+	)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return res.coinIn, res.coinOut, k.emitSwapEvent(ctx, sender, res)
+}
+
+func (k Keeper) getSwapParamsAndAccountNumber(ctx sdk.Context, sender sdk.AccAddress) (types.Params, uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Params{}, 0, err
+	}
+	accNumber, err := k.getAccountNumber(ctx, sender)
+	if err != nil {
+		return types.Params{}, 0, err
+	}
+
+	return params, accNumber, nil
+}
+
+// executeSwap executes the swap through the provided route, or, if the route is empty, through each of the candidate
+// routes in a separate cache context and applies the best one. The candidate routes failing to execute are skipped.
+func (k Keeper) executeSwap(
+	ctx sdk.Context,
+	params types.Params,
+	denomIn, denomOut string,
+	route []string,
+	swap swapRouteFunc,
+	isBetter func(res, best swapResult) bool,
+) (swapResult, error) {
+	if len(route) != 0 {
+		if err := types.ValidateSwapRoute(route, denomIn, denomOut); err != nil {
+			return swapResult{}, err
+		}
+		coinIn, coinOut, err := swap(ctx, route)
+		if err != nil {
+			return swapResult{}, err
+		}
+		return swapResult{route: route, coinIn: coinIn, coinOut: coinOut}, nil
+	}
+
+	var (
+		best      *swapResult
+		writeBest func()
+	)
+	for _, candidate := range getSwapRouteCandidates(params, denomIn, denomOut) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		coinIn, coinOut, err := swap(cacheCtx, candidate)
+		if err != nil {
+			k.logger(ctx).Debug("Swap route skipped.", "route", candidate, "err", err)
+			continue
+		}
+		res := swapResult{route: candidate, coinIn: coinIn, coinOut: coinOut}
+		if best == nil || isBetter(res, *best) {
+			best = &res
+			writeBest = writeCache
+		}
+	}
+	if best == nil {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrInvalidInput, "no swap route found from %s to %s", denomIn, denomOut)
+	}
+	writeBest()
+
+	return *best, nil
+}
+
+// swapExactInRoute sells the whole received amount on each hop, the remainder not fitting the quantity step of the
+// order book is kept by the sender.
+func (k Keeper) swapExactInRoute(
+	ctx sdk.Context,
+	params types.Params,
+	sender sdk.AccAddress,
+	accNumber uint64,
+	coinIn sdk.Coin,
+	route []string,
+) (sdk.Coin, sdk.Coin, error) {
+	var spent sdkmath.Int
+	amount := coinIn.Amount
+	for i := range len(route) - 1 {
+		baseDenom, quoteDenom := route[i], route[i+1]
+		quantity, err := k.roundSwapQuantity(ctx, baseDenom, quoteDenom, amount, false)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		sent, received, err := k.executeSwapHop(
+			ctx, params, sender, accNumber, types.SIDE_SELL, baseDenom, quoteDenom, quantity,
+		)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if i == 0 {
+			spent = sent
+		}
+		amount = received
+	}
+
+	return sdk.NewCoin(route[0], spent), sdk.NewCoin(route[len(route)-1], amount), nil
+}
+
+// swapExactOutRoute computes the quantities of the hops backwards from the output coin by the simulation, and then
+// buys the quantities hop by hop. Each hop can't spend more than the previous hop has received, so the balances of the
+// intermediate denoms the sender had before the swap aren't used.
+func (k Keeper) swapExactOutRoute(
+	ctx sdk.Context,
+	params types.Params,
+	sender sdk.AccAddress,
+	accNumber uint64,
+	coinOut sdk.Coin,
+	route []string,
+	maxAmountIn sdkmath.Int,
+) (sdk.Coin, sdk.Coin, error) {
+	hops := len(route) - 1
+	quantities := make([]sdkmath.Int, hops)
+	need := coinOut.Amount
+	for i := hops - 1; i >= 0; i-- {
+		baseDenom, quoteDenom := route[i+1], route[i]
+		quantity, err := k.computeSwapBuyQuantity(ctx, params, accNumber, baseDenom, quoteDenom, need)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		res, err := k.SimulateOrder(ctx, &types.QuerySimulateOrderRequest{
+			Type:        types.ORDER_TYPE_MARKET,
+			BaseDenom:   baseDenom,
+			QuoteDenom:  quoteDenom,
+			Quantity:    quantity,
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_IOC,
+		})
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if !res.Filled {
+			return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"not enough liquidity in the order book %s/%s to buy %s", baseDenom, quoteDenom, quantity.String(),
+			)
+		}
+		quantities[i] = quantity
+		need = res.ExecutedQuoteQuantity
+	}
+
+	var spent sdkmath.Int
+	available := maxAmountIn
+	for i := range hops {
+		baseDenom, quoteDenom := route[i+1], route[i]
+		sent, received, err := k.executeSwapHop(
+			ctx, params, sender, accNumber, types.SIDE_BUY, baseDenom, quoteDenom, quantities[i],
+		)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if sent.GT(available) {
+			return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"spent amount %s%s exceeds the available amount %s%s", sent.String(), quoteDenom,
+				available.String(), quoteDenom,
+			)
+		}
+		if i == 0 {
+			spent = sent
+		}
+		available = received
+	}
+	if available.LT(coinOut.Amount) {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"received amount %s%s is less than the requested %s", available.String(), coinOut.Denom, coinOut.String(),
+		)
+	}
+
+	return sdk.NewCoin(route[0], spent), sdk.NewCoin(coinOut.Denom, available), nil
+}
+
+// computeSwapBuyQuantity returns the quantity to buy to receive the required amount after the taker fee.
+func (k Keeper) computeSwapBuyQuantity(
+	ctx sdk.Context,
+	params types.Params,
+	accNumber uint64,
+	baseDenom, quoteDenom string,
+	need sdkmath.Int,
+) (sdkmath.Int, error) {
+	_, takerFeeRate := params.GetFeeRates(baseDenom, quoteDenom)
+	tierNumber, tier, err := k.GetAccountVolumeTier(ctx, params, accNumber)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if tierNumber != 0 {
+		takerFeeRate = takerFeeRate.Mul(sdkmath.LegacyOneDec().Sub(tier.FeeDiscount))
+	}
+
+	// the fee is truncated, so the quantity rounded up covers it
+	quantity := sdkmath.LegacyNewDecFromInt(need).
+		QuoRoundUp(sdkmath.LegacyOneDec().Sub(takerFeeRate)).
+		Ceil().
+		TruncateInt()
+
+	return k.roundSwapQuantity(ctx, baseDenom, quoteDenom, quantity, true)
+}
+
+// roundSwapQuantity rounds the quantity to the quantity step of the order book.
+func (k Keeper) roundSwapQuantity(
+	ctx sdk.Context,
+	baseDenom, quoteDenom string,
+	quantity sdkmath.Int,
+	roundUp bool,
+) (sdkmath.Int, error) {
+	orderBookParams, err := k.GetOrderBookParams(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	step := orderBookParams.QuantityStep
+	rounded := quantity.Quo(step).Mul(step)
+	if roundUp && rounded.LT(quantity) {
+		rounded = rounded.Add(step)
+	}
+	if !rounded.IsPositive() {
+		return sdkmath.Int{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"amount %s is less than the quantity step %s of the order book %s/%s",
+			quantity.String(), step.String(), baseDenom, quoteDenom,
+		)
+	}
+
+	return rounded, nil
+}
+
+// executeSwapHop places the market order of the swap hop and returns the spent and received amounts.
+func (k Keeper) executeSwapHop(
+	ctx sdk.Context,
+	params types.Params,
+	sender sdk.AccAddress,
+	accNumber uint64,
+	side types.Side,
+	baseDenom, quoteDenom string,
+	quantity sdkmath.Int,
+) (sdkmath.Int, sdkmath.Int, error) {
+	orderSequence, err := k.GetOrderSequence(ctx)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	order := types.Order{
+		Creator:     sender.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		ID:          fmt.Sprintf("%s%d", swapOrderIDPrefix, orderSequence+1),
+		BaseDenom:   baseDenom,
+		QuoteDenom:  quoteDenom,
+		Quantity:    quantity,
+		Side:        side,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}
+	if err := k.validateOrder(ctx, params, order); err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+
+	spendDenom, receiveDenom := order.GetSpendDenom(), order.GetReceiveDenom()
+	spendBalance := k.bankKeeper.GetBalance(ctx, sender, spendDenom).Amount
+	receiveBalance := k.bankKeeper.GetBalance(ctx, sender, receiveDenom).Amount
+	if err := k.placeOrder(ctx, params, accNumber, order); err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	spent := spendBalance.Sub(k.bankKeeper.GetBalance(ctx, sender, spendDenom).Amount)
+	received := k.bankKeeper.GetBalance(ctx, sender, receiveDenom).Amount.Sub(receiveBalance)
+	if !received.IsPositive() {
+		return sdkmath.Int{}, sdkmath.Int{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "no liquidity in the order book %s/%s", baseDenom, quoteDenom,
+		)
+	}
+
+	return spent, received, nil
+}
+
+func (k Keeper) emitSwapEvent(ctx sdk.Context, sender sdk.AccAddress, res swapResult) error {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSwap{
+		Creator: sender.String(),
+		Route:   res.route,
+		CoinIn:  res.coinIn,
+		CoinOut: res.coinOut,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventSwap: %s", err)
+	}
+
+	return nil
+}
+
+// getSwapRouteCandidates returns the direct route and the routes through each of the swap route denoms.
+func getSwapRouteCandidates(params types.Params, denomIn, denomOut string) [][]string {
+	candidates := [][]string{{denomIn, denomOut}}
+	for _, denom := range params.SwapRouteDenoms {
+		if denom == denomIn || denom == denomOut {
+			continue
+		}
+		candidates = append(candidates, []string{denomIn, denom, denomOut})
+	}
+
+	return candidates
+}
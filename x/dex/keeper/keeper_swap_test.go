@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/docker/distribution/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/event"
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_SwapExactIn(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+	denom1, denom2, denom3 := testSet.denom1, testSet.denom2, testSet.denom3
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.SwapRouteDenoms = []string{denom2}
+	require.NoError(t, dexKeeper.UpdateParams(
+		sdkCtx, authtypes.NewModuleAddress(govtypes.ModuleName).String(), params,
+	))
+
+	maker, _ := testApp.GenAccount(sdkCtx)
+	// 1denom1 = 2denom2 = 6denom3 through denom2, and 1denom1 = 5denom3 directly
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     maker.String(),
+		ID:          uuid.Generate().String(),
+		BaseDenom:   denom1,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     maker.String(),
+		ID:          uuid.Generate().String(),
+		BaseDenom:   denom2,
+		QuoteDenom:  denom3,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("3")),
+		Quantity:    sdkmath.NewInt(10_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     maker.String(),
+		ID:          uuid.Generate().String(),
+		BaseDenom:   denom1,
+		QuoteDenom:  denom3,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+
+	sender, _ := testApp.GenAccount(sdkCtx)
+	testApp.MintAndSendCoin(t, sdkCtx, sender, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	// the provided direct route
+	coinIn, coinOut, err := dexKeeper.SwapExactIn(
+		sdkCtx, sender, sdk.NewInt64Coin(denom1, 100_000), denom3, []string{denom1, denom3}, sdkmath.NewInt(500_000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom1, 100_000).String(), coinIn.String())
+	require.Equal(t, sdk.NewInt64Coin(denom3, 500_000).String(), coinOut.String())
+
+	// the min amount out isn't reached
+	cacheCtx, _ := sdkCtx.CacheContext()
+	_, _, err = dexKeeper.SwapExactIn(
+		cacheCtx, sender, sdk.NewInt64Coin(denom1, 100_000), denom3, []string{denom1, denom2, denom3},
+		sdkmath.NewInt(600_001),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	require.ErrorContains(t, err, "is less than the min amount out")
+
+	// the amount less than the quantity step can't be swapped
+	cacheCtx, _ = sdkCtx.CacheContext()
+	_, _, err = dexKeeper.SwapExactIn(
+		cacheCtx, sender, sdk.NewInt64Coin(denom1, 9_999), denom3, []string{denom1, denom3}, sdkmath.NewInt(1),
+	)
+	require.ErrorContains(t, err, "is less than the quantity step")
+
+	// the route through denom2 is selected automatically, since it gives more, the remainder not fitting the quantity
+	// step isn't spent
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	coinIn, coinOut, err = dexKeeper.SwapExactIn(
+		sdkCtx, sender, sdk.NewInt64Coin(denom1, 505_000), denom3, nil, sdkmath.NewInt(3_000_000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom1, 500_000).String(), coinIn.String())
+	require.Equal(t, sdk.NewInt64Coin(denom3, 3_000_000).String(), coinOut.String())
+	requireSwapEvent(t, sdkCtx, types.EventSwap{
+		Creator: sender.String(),
+		Route:   []string{denom1, denom2, denom3},
+		CoinIn:  coinIn,
+		CoinOut: coinOut,
+	})
+
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(denom1, 400_000),
+		sdk.NewInt64Coin(denom3, 3_500_000),
+	).String(), testApp.BankKeeper.GetAllBalances(sdkCtx, sender).String())
+
+	// there is no route
+	_, _, err = dexKeeper.SwapExactIn(
+		sdkCtx, sender, sdk.NewInt64Coin(denom3, 100_000), denom1, nil, sdkmath.NewInt(1),
+	)
+	require.ErrorContains(t, err, "no swap route found")
+}
+
+func TestKeeper_SwapExactOut(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+	denom1, denom2, denom3 := testSet.denom1, testSet.denom2, testSet.denom3
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	require.NoError(t, dexKeeper.UpdateParams(
+		sdkCtx, authtypes.NewModuleAddress(govtypes.ModuleName).String(), params,
+	))
+
+	maker, _ := testApp.GenAccount(sdkCtx)
+	// 1denom2 = 2denom1 and 1denom3 = 3denom2
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     maker.String(),
+		ID:          uuid.Generate().String(),
+		BaseDenom:   denom2,
+		QuoteDenom:  denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    sdkmath.NewInt(10_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+	placeTestOrder(t, testApp, sdkCtx, types.Order{
+		Creator:     maker.String(),
+		ID:          uuid.Generate().String(),
+		BaseDenom:   denom3,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("3")),
+		Quantity:    sdkmath.NewInt(10_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+
+	sender, _ := testApp.GenAccount(sdkCtx)
+	testApp.MintAndSendCoin(t, sdkCtx, sender, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000)))
+
+	// the quantities are increased by the taker fee and rounded up to the quantity step, so 1_010_000denom3 is bought
+	// for 3_030_000denom2, and 3_040_000denom2 for 6_080_000denom1
+	route := []string{denom1, denom2, denom3}
+	cacheCtx, _ := sdkCtx.CacheContext()
+	_, _, err = dexKeeper.SwapExactOut(
+		cacheCtx, sender, denom1, sdk.NewInt64Coin(denom3, 1_000_000), route, sdkmath.NewInt(6_079_999),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	require.ErrorContains(t, err, "exceeds the available amount")
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	coinIn, coinOut, err := dexKeeper.SwapExactOut(
+		sdkCtx, sender, denom1, sdk.NewInt64Coin(denom3, 1_000_000), route, sdkmath.NewInt(6_080_000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom1, 6_080_000).String(), coinIn.String())
+	require.Equal(t, sdk.NewInt64Coin(denom3, 1_008_990).String(), coinOut.String())
+	requireSwapEvent(t, sdkCtx, types.EventSwap{
+		Creator: sender.String(),
+		Route:   route,
+		CoinIn:  coinIn,
+		CoinOut: coinOut,
+	})
+
+	// the denom2 remaining after the fee is kept by the sender
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(denom1, 3_920_000),
+		sdk.NewInt64Coin(denom2, 6_960),
+		sdk.NewInt64Coin(denom3, 1_008_990),
+	).String(), testApp.BankKeeper.GetAllBalances(sdkCtx, sender).String())
+
+	// there is not enough liquidity
+	cacheCtx, _ = sdkCtx.CacheContext()
+	_, _, err = dexKeeper.SwapExactOut(
+		cacheCtx, sender, denom1, sdk.NewInt64Coin(denom3, 10_000_000), route, sdkmath.NewInt(100_000_000),
+	)
+	require.ErrorContains(t, err, "not enough liquidity")
+}
+
+func requireSwapEvent(t *testing.T, sdkCtx sdk.Context, expected types.EventSwap) {
+	t.Helper()
+
+	events, err := event.FindTypedEvents[*types.EventSwap](sdkCtx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, expected.Creator, events[0].Creator)
+	require.Equal(t, expected.Route, events[0].Route)
+	require.Equal(t, expected.CoinIn.String(), events[0].CoinIn.String())
+	require.Equal(t, expected.CoinOut.String(), events[0].CoinOut.String())
+}
@@ -30,6 +30,8 @@ type OrderPlacementEvents struct {
 	OrdersReduced []types.EventOrderReduced
 	OrderCreated  *types.EventOrderCreated
 	OrdersClosed  []types.EventOrderClosed
+	Trades        []types.EventTrade
+	SelfTrades    []types.EventSelfTradePrevented
 }
 
 func (o OrderPlacementEvents) getOrderReduced(acc, id string) (types.EventOrderReduced, bool) {
@@ -50,6 +52,7 @@ func readOrderEvents(
 		OrderCreated:  nil,
 		OrdersReduced: make([]types.EventOrderReduced, 0),
 		OrdersClosed:  make([]types.EventOrderClosed, 0),
+		Trades:        make([]types.EventTrade, 0),
 	}
 
 	for _, evt := range sdkCtx.EventManager().Events().ToABCIEvents() {
@@ -69,6 +72,10 @@ func readOrderEvents(
 			events.OrderCreated = typedEvt
 		case *types.EventOrderClosed:
 			events.OrdersClosed = append(events.OrdersClosed, *typedEvt)
+		case *types.EventTrade:
+			events.Trades = append(events.Trades, *typedEvt)
+		case *types.EventSelfTradePrevented:
+			events.SelfTrades = append(events.SelfTrades, *typedEvt)
 		}
 	}
 
@@ -265,6 +272,338 @@ func TestKeeper_PlaceAndGetOrderByID(t *testing.T) {
 	require.Equal(t, buyOrder, gotOrder)
 }
 
+func TestKeeper_BatchPlaceAndCancelOrders(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	acc, _ := testApp.GenAccount(sdkCtx)
+	newSellOrder := func(id string) types.Order {
+		return types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+			Quantity:    sdkmath.NewInt(1_000_000),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+	// the balance is enough for two orders only
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 2_000_000)))
+	for range 3 {
+		fundOrderReserve(t, testApp, sdkCtx, acc)
+	}
+	orders := []types.Order{newSellOrder("id1"), newSellOrder("id2"), newSellOrder("id3")}
+
+	// all-or-nothing mode fails the whole batch
+	allOrNothingCtx := simapp.CopyContextWithMultiStore(sdkCtx)
+	_, err := dexKeeper.BatchPlaceOrders(allOrNothingCtx, acc, orders, types.BATCH_MODE_ALL_OR_NOTHING)
+	require.ErrorIs(t, err, assetfttypes.ErrDEXInsufficientSpendableBalance)
+	require.ErrorContains(t, err, `batch item 2, order id "id3"`)
+
+	// best-effort mode places the orders which can be placed
+	results, err := dexKeeper.BatchPlaceOrders(sdkCtx, acc, orders, types.BATCH_MODE_BEST_EFFORT)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, types.BatchOrderResult{ID: "id1", Success: true}, results[0])
+	require.Equal(t, types.BatchOrderResult{ID: "id2", Success: true}, results[1])
+	require.Equal(t, "id3", results[2].ID)
+	require.False(t, results[2].Success)
+	require.Contains(t, results[2].Error, "insufficient")
+	count, err := dexKeeper.GetAccountDenomOrdersCount(sdkCtx, acc, testSet.denom1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, "id3")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// all-or-nothing cancellation with non-existing order fails the whole batch
+	allOrNothingCtx = simapp.CopyContextWithMultiStore(sdkCtx)
+	_, err = dexKeeper.BatchCancelOrders(
+		allOrNothingCtx, acc, []string{"id1", "id2", "id3"}, types.BATCH_MODE_ALL_OR_NOTHING,
+	)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// best-effort cancellation cancels the existing orders
+	results, err = dexKeeper.BatchCancelOrders(sdkCtx, acc, []string{"id3", "id1", "id2"}, types.BATCH_MODE_BEST_EFFORT)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.False(t, results[0].Success)
+	require.NotEmpty(t, results[0].Error)
+	require.Equal(t, types.BatchOrderResult{ID: "id1", Success: true}, results[1])
+	require.Equal(t, types.BatchOrderResult{ID: "id2", Success: true}, results[2])
+	count, err = dexKeeper.GetAccountDenomOrdersCount(sdkCtx, acc, testSet.denom1)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1).IsZero())
+
+	// unspecified mode is rejected
+	_, err = dexKeeper.BatchCancelOrders(sdkCtx, acc, []string{"id1"}, types.BATCH_MODE_UNSPECIFIED)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+}
+
+func TestKeeper_CancelAllOrders(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	acc, _ := testApp.GenAccount(sdkCtx)
+	newOrder := func(id, quoteDenom, price string, side types.Side) types.Order {
+		return types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  quoteDenom,
+			Price:       lo.ToPtr(types.MustNewPriceFromString(price)),
+			Quantity:    sdkmath.NewInt(1_000_000),
+			Side:        side,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+	triggerOrder := newOrder("id5", testSet.denom2, "2", types.SIDE_SELL)
+	triggerOrder.Trigger = &types.Trigger{
+		Price:     types.MustNewPriceFromString("125e-2"),
+		Condition: types.TRIGGER_CONDITION_PRICE_LTE,
+	}
+	orders := []types.Order{
+		newOrder("id1", testSet.denom2, "12e-1", types.SIDE_SELL),
+		newOrder("id2", testSet.denom2, "15e-1", types.SIDE_SELL),
+		newOrder("id3", testSet.denom3, "12e-1", types.SIDE_SELL),
+		newOrder("id4", testSet.denom2, "1e-1", types.SIDE_BUY),
+		triggerOrder,
+	}
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(
+		sdk.NewInt64Coin(testSet.denom1, 4_000_000),
+		sdk.NewInt64Coin(testSet.denom2, 100_000),
+	))
+	for _, order := range orders {
+		fundOrderReserve(t, testApp, sdkCtx, acc)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	cancelAllOrders := func(msg types.MsgCancelAllOrders, limit uint32) []string {
+		ids, err := dexKeeper.CancelAllOrders(sdkCtx, acc, msg.MatchesOrder, limit)
+		require.NoError(t, err)
+		return ids
+	}
+
+	// the limit is respected
+	require.Equal(t, []string{"id1"}, cancelAllOrders(types.MsgCancelAllOrders{
+		BaseDenom:  testSet.denom1,
+		QuoteDenom: testSet.denom2,
+		Side:       types.SIDE_SELL,
+	}, 1))
+
+	// nothing matches
+	require.Empty(t, cancelAllOrders(types.MsgCancelAllOrders{
+		BaseDenom:  testSet.denom1,
+		QuoteDenom: testSet.denom3,
+		Side:       types.SIDE_BUY,
+	}, 10))
+
+	// the price range matches the order book order and the trigger order
+	require.Equal(t, []string{"id2", "id5"}, cancelAllOrders(types.MsgCancelAllOrders{
+		BaseDenom:  testSet.denom1,
+		QuoteDenom: testSet.denom2,
+		MinPrice:   lo.ToPtr(types.MustNewPriceFromString("13e-1")),
+	}, 10))
+
+	// the remaining orders are canceled without filters
+	require.Equal(t, []string{"id3", "id4"}, cancelAllOrders(types.MsgCancelAllOrders{}, 10))
+
+	orders, _, err := dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Empty(t, orders)
+	triggerOrders, _, err := dexKeeper.GetTriggerOrders(sdkCtx, acc, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Empty(t, triggerOrders)
+	require.True(t, assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1).IsZero())
+	require.True(t, assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom2).IsZero())
+	for _, denom := range []string{testSet.denom1, testSet.denom2, testSet.denom3} {
+		count, err := dexKeeper.GetAccountDenomOrdersCount(sdkCtx, acc, denom)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	}
+}
+
+func TestKeeper_ReplaceOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	acc, _ := testApp.GenAccount(sdkCtx)
+	sellOrder1 := types.Order{
+		Creator:     acc.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.ftDenomWhitelisting1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	sellOrder2 := sellOrder1
+	sellOrder2.ID = "id2"
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 2_000_000)))
+	fundOrderReserve(t, testApp, sdkCtx, acc)
+	fundOrderReserve(t, testApp, sdkCtx, acc)
+	require.NoError(t, testApp.AssetFTKeeper.SetWhitelistedBalance(
+		sdkCtx, testSet.issuer, acc, sdk.NewInt64Coin(testSet.ftDenomWhitelisting1, 2_400_000),
+	))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, sellOrder1))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, sellOrder2))
+
+	// nothing to replace
+	require.ErrorContains(
+		t,
+		dexKeeper.ReplaceOrder(
+			simapp.CopyContextWithMultiStore(sdkCtx), acc, sellOrder1.ID, sellOrder1.Price, sdkmath.Int{},
+		),
+		"already has the provided price and quantity",
+	)
+
+	// reduce the quantity of the first order
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ReplaceOrder(sdkCtx, acc, sellOrder1.ID, nil, sdkmath.NewInt(500_000)))
+	events := readOrderEvents(t, sdkCtx)
+	require.Empty(t, events.OrdersClosed)
+	require.Nil(t, events.OrderCreated)
+
+	gotOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, sellOrder1.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), gotOrder.Sequence)
+	require.Equal(t, sdkmath.NewInt(500_000).String(), gotOrder.Quantity.String())
+	require.Equal(t, sdkmath.NewInt(500_000).String(), gotOrder.RemainingBaseQuantity.String())
+	require.Equal(t, sdkmath.NewInt(500_000).String(), gotOrder.RemainingSpendableBalance.String())
+	require.Equal(
+		t,
+		sdk.NewInt64Coin(testSet.denom1, 1_500_000).String(),
+		assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1).String(),
+	)
+	require.Equal(
+		t,
+		sdk.NewInt64Coin(testSet.ftDenomWhitelisting1, 1_800_000).String(),
+		assetFTKeeper.GetDEXExpectedToReceivedBalance(sdkCtx, acc, testSet.ftDenomWhitelisting1).String(),
+	)
+
+	// the reduced order keeps the priority, so it's matched first
+	buyOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "buy1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.ftDenomWhitelisting1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+		Quantity:    sdkmath.NewInt(500_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}
+	buyLockedBalance, err := buyOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	require.NoError(t, testApp.AssetFTKeeper.SetWhitelistedBalance(
+		sdkCtx, testSet.issuer, testSet.acc1, buyLockedBalance,
+	))
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(buyLockedBalance))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, buyOrder))
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, sellOrder1.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// change the price of the second order
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	newPrice := types.MustNewPriceFromString("11e-1")
+	require.NoError(t, dexKeeper.ReplaceOrder(sdkCtx, acc, sellOrder2.ID, &newPrice, sdkmath.Int{}))
+	events = readOrderEvents(t, sdkCtx)
+	require.Len(t, events.OrdersClosed, 1)
+	require.Equal(t, uint64(2), events.OrdersClosed[0].Sequence)
+	require.NotNil(t, events.OrderCreated)
+
+	gotOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, sellOrder2.ID)
+	require.NoError(t, err)
+	require.Equal(t, events.OrderCreated.Sequence, gotOrder.Sequence)
+	require.Equal(t, newPrice.String(), gotOrder.Price.String())
+	require.Equal(t, sdkmath.NewInt(1_000_000).String(), gotOrder.RemainingBaseQuantity.String())
+	require.Equal(
+		t,
+		sdk.NewInt64Coin(testSet.denom1, 1_000_000).String(),
+		assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1).String(),
+	)
+	require.Equal(
+		t,
+		sdk.NewInt64Coin(testSet.ftDenomWhitelisting1, 1_100_000).String(),
+		assetFTKeeper.GetDEXExpectedToReceivedBalance(sdkCtx, acc, testSet.ftDenomWhitelisting1).String(),
+	)
+	require.Equal(t, map[string]uint64{
+		testSet.denom1:               1,
+		testSet.ftDenomWhitelisting1: 1,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, acc))
+}
+
+func TestKeeper_ReplacePostOnlyOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("12e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	buyOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "buy1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_POST_ONLY,
+	}
+	for _, order := range []types.Order{sellOrder, buyOrder} {
+		creator := sdk.MustAccAddressFromBech32(order.Creator)
+		testApp.MintAndSendCoin(t, sdkCtx, creator, sdk.NewCoins(sdk.NewInt64Coin(order.GetSpendDenom(), 1_200_000)))
+		fundOrderReserve(t, testApp, sdkCtx, creator)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	// the replaced post-only order isn't matched
+	crossingPrice := types.MustNewPriceFromString("12e-1")
+	require.ErrorIs(
+		t,
+		dexKeeper.ReplaceOrder(
+			simapp.CopyContextWithMultiStore(sdkCtx), testSet.acc2, buyOrder.ID, &crossingPrice, sdkmath.Int{},
+		),
+		types.ErrPostOnlyOrderMatched,
+	)
+
+	// the replaced order keeps the post-only time in force
+	newPrice := types.MustNewPriceFromString("11e-1")
+	require.NoError(t, dexKeeper.ReplaceOrder(sdkCtx, testSet.acc2, buyOrder.ID, &newPrice, sdkmath.Int{}))
+	gotOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, buyOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, newPrice.String(), gotOrder.Price.String())
+	require.Equal(t, types.TIME_IN_FORCE_POST_ONLY, gotOrder.TimeInForce)
+}
+
 func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 	testApp := simapp.New()
 	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
@@ -313,12 +652,20 @@ func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 		Sequence: expectedSellOrderSequence, // first order sequence
 	}, events.OrderPlaced)
 
+	orderBookID, err := dexKeeper.GetOrderBookIDByDenoms(sdkCtx, sellOrder.BaseDenom, sellOrder.QuoteDenom)
+	require.NoError(t, err)
 	require.Equal(t, types.EventOrderCreated{
 		Creator:                   sellOrder.Creator,
 		ID:                        sellOrder.ID,
 		Sequence:                  expectedSellOrderSequence,
 		RemainingBaseQuantity:     sellOrder.Quantity,
 		RemainingSpendableBalance: sellLockedBalance.Amount,
+		OrderBookID:               orderBookID,
+		BaseDenom:                 sellOrder.BaseDenom,
+		QuoteDenom:                sellOrder.QuoteDenom,
+		Side:                      sellOrder.Side,
+		Price:                     *sellOrder.Price,
+		VisibleBaseQuantity:       sellOrder.Quantity,
 	}, *events.OrderCreated)
 	require.Empty(t, events.OrdersClosed)
 	require.Empty(t, events.OrdersReduced)
@@ -341,6 +688,11 @@ func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 			Sequence:                  expectedSellOrderSequence,
 			RemainingBaseQuantity:     sellOrder.Quantity,
 			RemainingSpendableBalance: sellLockedBalance.Amount,
+			OrderBookID:               orderBookID,
+			BaseDenom:                 sellOrder.BaseDenom,
+			QuoteDenom:                sellOrder.QuoteDenom,
+			Side:                      sellOrder.Side,
+			Price:                     *sellOrder.Price,
 		},
 	}, events.OrdersClosed)
 	require.Empty(t, events.OrdersReduced)
@@ -428,6 +780,12 @@ func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 		Sequence:                  expectedBuyOrderSequence,
 		RemainingBaseQuantity:     sdkmath.NewInt(4_000_000), // filled partially
 		RemainingSpendableBalance: sdkmath.NewInt(5_200_000),
+		OrderBookID:               orderBookID,
+		BaseDenom:                 buyOrder.BaseDenom,
+		QuoteDenom:                buyOrder.QuoteDenom,
+		Side:                      buyOrder.Side,
+		Price:                     *buyOrder.Price,
+		VisibleBaseQuantity:       sdkmath.NewInt(4_000_000),
 	}, *events.OrderCreated)
 
 	require.Equal(t, []types.EventOrderReduced{
@@ -437,13 +795,28 @@ func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 			Sequence:     expectedSellOrderSequence,
 			SentCoin:     sdk.NewCoin(sellOrder.BaseDenom, sdkmath.NewIntFromUint64(1_000_000)),
 			ReceivedCoin: sdk.NewCoin(sellOrder.QuoteDenom, sdkmath.NewIntFromUint64(1_200_000)),
+			Fee:          sdk.NewCoin(sellOrder.QuoteDenom, sdkmath.ZeroInt()),
+			OrderBookID:  orderBookID,
+			BaseDenom:    sellOrder.BaseDenom,
+			QuoteDenom:   sellOrder.QuoteDenom,
+			Side:         sellOrder.Side,
+			Price:        sellOrder.Price,
+			// the maker order is filled fully
+			VisibleBaseQuantity: sdkmath.ZeroInt(),
 		},
 		{
-			Creator:      buyOrder.Creator,
-			ID:           buyOrder.ID,
-			Sequence:     expectedBuyOrderSequence,
-			SentCoin:     sdk.NewCoin(buyOrder.QuoteDenom, sdkmath.NewIntFromUint64(1_200_000)),
-			ReceivedCoin: sdk.NewCoin(buyOrder.BaseDenom, sdkmath.NewIntFromUint64(1_000_000)),
+			Creator:             buyOrder.Creator,
+			ID:                  buyOrder.ID,
+			Sequence:            expectedBuyOrderSequence,
+			SentCoin:            sdk.NewCoin(buyOrder.QuoteDenom, sdkmath.NewIntFromUint64(1_200_000)),
+			ReceivedCoin:        sdk.NewCoin(buyOrder.BaseDenom, sdkmath.NewIntFromUint64(1_000_000)),
+			Fee:                 sdk.NewCoin(buyOrder.BaseDenom, sdkmath.ZeroInt()),
+			OrderBookID:         orderBookID,
+			BaseDenom:           buyOrder.BaseDenom,
+			QuoteDenom:          buyOrder.QuoteDenom,
+			Side:                buyOrder.Side,
+			Price:               buyOrder.Price,
+			VisibleBaseQuantity: sdkmath.NewInt(4_000_000),
 		},
 	}, events.OrdersReduced)
 
@@ -454,9 +827,30 @@ func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 			Sequence:                  expectedSellOrderSequence,
 			RemainingBaseQuantity:     sdkmath.ZeroInt(),
 			RemainingSpendableBalance: sdkmath.ZeroInt(),
+			OrderBookID:               orderBookID,
+			BaseDenom:                 sellOrder.BaseDenom,
+			QuoteDenom:                sellOrder.QuoteDenom,
+			Side:                      sellOrder.Side,
+			Price:                     *sellOrder.Price,
 		},
 	}, events.OrdersClosed)
 
+	require.Equal(t, []types.EventTrade{
+		{
+			OrderBookID:   orderBookID,
+			BaseDenom:     sellOrder.BaseDenom,
+			QuoteDenom:    sellOrder.QuoteDenom,
+			Price:         *sellOrder.Price,
+			BaseQuantity:  sdkmath.NewIntFromUint64(1_000_000),
+			QuoteQuantity: sdkmath.NewIntFromUint64(1_200_000),
+			TakerSide:     types.SIDE_BUY,
+			Maker:         sellOrder.Creator,
+			MakerOrderID:  sellOrder.ID,
+			Taker:         buyOrder.Creator,
+			TakerOrderID:  buyOrder.ID,
+		},
+	}, events.Trades)
+
 	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, sellOrder.ID)
 	require.ErrorIs(t, err, types.ErrRecordNotFound)
 	buyOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, buyOrder.ID)
@@ -743,6 +1137,105 @@ func TestKeeper_GetOrdersAndOrderBookOrders(t *testing.T) {
 	}, denom1To2Orders)
 }
 
+func TestKeeper_GetOrderBookDepth(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	acc, _ := testApp.GenAccount(sdkCtx)
+
+	_, err := dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 0)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	orderItems := []struct {
+		price    string
+		quantity sdkmath.Int
+		side     types.Side
+	}{
+		{price: "13e-1", quantity: defaultQuantityStep, side: types.SIDE_SELL},
+		{price: "14e-1", quantity: defaultQuantityStep, side: types.SIDE_SELL},
+		{price: "13e-1", quantity: defaultQuantityStep.MulRaw(2), side: types.SIDE_SELL},
+		{price: "11e-1", quantity: defaultQuantityStep, side: types.SIDE_BUY},
+		{price: "12e-1", quantity: defaultQuantityStep.MulRaw(3), side: types.SIDE_BUY},
+		{price: "1", quantity: defaultQuantityStep, side: types.SIDE_BUY},
+		{price: "11e-1", quantity: defaultQuantityStep, side: types.SIDE_BUY},
+	}
+	for _, item := range orderItems {
+		order := types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          uuid.Generate().String(),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString(item.price)),
+			Quantity:    item.quantity,
+			Side:        item.side,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(lockedBalance))
+		fundOrderReserve(t, testApp, sdkCtx, acc)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	depth, err := dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 0)
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceLevel{
+		{
+			Price:                 types.MustNewPriceFromString("12e-1"),
+			RemainingBaseQuantity: defaultQuantityStep.MulRaw(3),
+			OrdersCount:           1,
+		},
+		{
+			Price:                 types.MustNewPriceFromString("11e-1"),
+			RemainingBaseQuantity: defaultQuantityStep.MulRaw(2),
+			OrdersCount:           2,
+		},
+		{
+			Price:                 types.MustNewPriceFromString("1"),
+			RemainingBaseQuantity: defaultQuantityStep,
+			OrdersCount:           1,
+		},
+	}, depth.Bids)
+	require.Equal(t, []types.PriceLevel{
+		{
+			Price:                 types.MustNewPriceFromString("13e-1"),
+			RemainingBaseQuantity: defaultQuantityStep.MulRaw(3),
+			OrdersCount:           2,
+		},
+		{
+			Price:                 types.MustNewPriceFromString("14e-1"),
+			RemainingBaseQuantity: defaultQuantityStep,
+			OrdersCount:           1,
+		},
+	}, depth.Asks)
+	require.Equal(t, "12e-1", depth.BestBid.String())
+	require.Equal(t, "13e-1", depth.BestAsk.String())
+	require.Equal(t, "1e-1", depth.Spread.String())
+
+	// limit the levels
+	depth, err = dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 2)
+	require.NoError(t, err)
+	require.Len(t, depth.Bids, 2)
+	require.Equal(t, uint64(2), depth.Bids[1].OrdersCount)
+	require.Len(t, depth.Asks, 2)
+
+	_, err = dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, types.MaxOrderBookDepthLimit+1)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// the opposite order book is empty
+	depth, err = dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom2, testSet.denom1, 0)
+	require.NoError(t, err)
+	require.Empty(t, depth.Bids)
+	require.Empty(t, depth.Asks)
+	require.Nil(t, depth.BestBid)
+	require.Nil(t, depth.BestAsk)
+	require.Nil(t, depth.Spread)
+}
+
 func TestKeeper_GetOrderBooks(t *testing.T) {
 	testApp := simapp.New()
 	sdkCtx := testApp.NewContext(false)
@@ -1312,3 +1805,24 @@ func fundOrderReserve(
 	}
 	require.NoError(t, testApp.FundAccount(sdkCtx, acc, sdk.NewCoins(orderReserve)))
 }
+
+// placeTestOrder funds the creator with the locked balance of the limit order, and with the order reserve if the order
+// might be saved to the order book, and places the order.
+func placeTestOrder(
+	t *testing.T,
+	testApp *simapp.App,
+	sdkCtx sdk.Context,
+	order types.Order,
+) {
+	t.Helper()
+
+	order.Type = types.ORDER_TYPE_LIMIT
+	creator := sdk.MustAccAddressFromBech32(order.Creator)
+	lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, creator, sdk.NewCoins(lockedBalance))
+	if order.TimeInForce == types.TIME_IN_FORCE_GTC || order.TimeInForce == types.TIME_IN_FORCE_POST_ONLY {
+		fundOrderReserve(t, testApp, sdkCtx, creator)
+	}
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, order))
+}
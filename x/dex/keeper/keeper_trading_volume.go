@@ -0,0 +1,300 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+const secondsInDay = 24 * 60 * 60
+
+// GetAccountTradingVolume returns the trading volume of the account within the trading volume window and its volume
+// tier.
+func (k Keeper) GetAccountTradingVolume(
+	ctx sdk.Context,
+	acc sdk.AccAddress,
+) (*types.QueryAccountTradingVolumeResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	volume, err := k.getTradingVolume(ctx, params, accNumber)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryAccountTradingVolumeResponse{
+		Volume:            volume,
+		MaxOrdersPerDenom: params.MaxOrdersPerDenom,
+		FeeDiscount:       sdkmath.LegacyZeroDec(),
+	}
+	tierNumber, tier := params.GetVolumeTier(volume)
+	if tierNumber != 0 {
+		res.Tier = tierNumber
+		res.MaxOrdersPerDenom = tier.MaxOrdersPerDenom
+		res.FeeDiscount = tier.FeeDiscount
+	}
+
+	return res, nil
+}
+
+// GetTradingVolumes returns paginated daily trading volumes of all accounts.
+func (k Keeper) GetTradingVolumes(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.TradingVolume, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.TradingVolumeKeyPrefix)
+	tradingVolumes, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pagination,
+		func(_ []byte, tradingVolume *types.TradingVolume) (*types.TradingVolume, error) {
+			return tradingVolume, nil
+		},
+		func() *types.TradingVolume {
+			return &types.TradingVolume{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(tradingVolumes, func(tradingVolume *types.TradingVolume, _ int) types.TradingVolume {
+		return *tradingVolume
+	}), pageRes, nil
+}
+
+// SaveTradingVolume saves the account daily trading volume.
+func (k Keeper) SaveTradingVolume(ctx sdk.Context, accNumber uint64, tradingVolume types.TradingVolume) error {
+	return k.setDataToStore(ctx, types.CreateTradingVolumeKey(accNumber, tradingVolume.Day), &tradingVolume)
+}
+
+// GetAccountVolumeTier returns the number of the volume tier of the account starting from 1 and the tier, the zero
+// number is returned if the account has no tier.
+func (k Keeper) GetAccountVolumeTier(
+	ctx sdk.Context,
+	params types.Params,
+	accNumber uint64,
+) (uint32, types.VolumeTier, error) {
+	// the volume isn't read if there are no tiers to reduce the gas
+	if len(params.VolumeTiers) == 0 {
+		return 0, types.VolumeTier{}, nil
+	}
+
+	volume, err := k.getTradingVolume(ctx, params, accNumber)
+	if err != nil {
+		return 0, types.VolumeTier{}, err
+	}
+	tierNumber, tier := params.GetVolumeTier(volume)
+
+	return tierNumber, tier, nil
+}
+
+// getMaxOrdersPerDenom returns the max orders per denom of the account overridden by its volume tier and increased by
+// its order quota.
+func (k Keeper) getMaxOrdersPerDenom(ctx sdk.Context, params types.Params, accNumber uint64) (uint64, error) {
+	maxOrdersPerDenom, err := k.getTierMaxOrdersPerDenom(ctx, params, accNumber)
+	if err != nil {
+		return 0, err
+	}
+	orderQuota, _, err := k.getOrderQuota(ctx, accNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	return maxOrdersPerDenom + orderQuota.ExtraOrdersPerDenom, nil
+}
+
+// getTierMaxOrdersPerDenom returns the max orders per denom of the account overridden by its volume tier.
+func (k Keeper) getTierMaxOrdersPerDenom(ctx sdk.Context, params types.Params, accNumber uint64) (uint64, error) {
+	tierNumber, tier, err := k.GetAccountVolumeTier(ctx, params, accNumber)
+	if err != nil {
+		return 0, err
+	}
+	if tierNumber == 0 {
+		return params.MaxOrdersPerDenom, nil
+	}
+
+	return tier.MaxOrdersPerDenom, nil
+}
+
+// newFeeDiscountProvider returns the provider of the accounts fee discounts defined by their volume tiers, the
+// discounts are cached, since the same account might be charged several times within the matching.
+func (k Keeper) newFeeDiscountProvider(
+	ctx sdk.Context,
+	params types.Params,
+) func(acc sdk.AccAddress) (sdkmath.LegacyDec, error) {
+	discounts := make(map[string]sdkmath.LegacyDec)
+	return func(acc sdk.AccAddress) (sdkmath.LegacyDec, error) {
+		if discount, ok := discounts[acc.String()]; ok {
+			return discount, nil
+		}
+
+		discount := sdkmath.LegacyZeroDec()
+		if len(params.VolumeTiers) != 0 {
+			accNumber, err := k.getAccountNumber(ctx, acc)
+			if err != nil {
+				return sdkmath.LegacyDec{}, err
+			}
+			tierNumber, tier, err := k.GetAccountVolumeTier(ctx, params, accNumber)
+			if err != nil {
+				return sdkmath.LegacyDec{}, err
+			}
+			if tierNumber != 0 {
+				discount = tier.FeeDiscount
+			}
+		}
+		discounts[acc.String()] = discount
+
+		return discount, nil
+	}
+}
+
+// updateTradingVolumes adds the volume of the trades to the trading volumes of the makers and takers. The volume is the
+// traded base quantity normalized by the unified ref amount of the base denom.
+func (k Keeper) updateTradingVolumes(ctx sdk.Context, params types.Params, trades []types.EventTrade) error {
+	unifiedRefAmounts := make(map[string]sdkmath.LegacyDec)
+	accounts := make([]string, 0)
+	volumes := make(map[string]sdkmath.LegacyDec)
+	for _, trade := range trades {
+		baseURA, ok := unifiedRefAmounts[trade.BaseDenom]
+		if !ok {
+			var err error
+			baseURA, err = k.getAssetFTUnifiedRefAmount(ctx, trade.BaseDenom, params.DefaultUnifiedRefAmount)
+			if err != nil {
+				return err
+			}
+			unifiedRefAmounts[trade.BaseDenom] = baseURA
+		}
+
+		volume := sdkmath.LegacyNewDecFromInt(trade.BaseQuantity).Quo(baseURA)
+		for _, acc := range []string{trade.Maker, trade.Taker} {
+			accVolume, ok := volumes[acc]
+			if !ok {
+				// the slice keeps the order of the accounts deterministic
+				accounts = append(accounts, acc)
+				accVolume = sdkmath.LegacyZeroDec()
+			}
+			volumes[acc] = accVolume.Add(volume)
+		}
+	}
+
+	for _, acc := range accounts {
+		if !volumes[acc].IsPositive() {
+			continue
+		}
+		accAddr, err := sdk.AccAddressFromBech32(acc)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", acc)
+		}
+		if err := k.addTradingVolume(ctx, params, accAddr, volumes[acc]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) addTradingVolume(
+	ctx sdk.Context,
+	params types.Params,
+	acc sdk.AccAddress,
+	volume sdkmath.LegacyDec,
+) error {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	day := blockDay(ctx)
+	tradingVolume := types.TradingVolume{
+		Account: acc.String(),
+		Day:     day,
+		Volume:  sdkmath.LegacyZeroDec(),
+	}
+	if err := k.getDataFromStore(ctx, types.CreateTradingVolumeKey(accNumber, day), &tradingVolume); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+	}
+	tradingVolume.Volume = tradingVolume.Volume.Add(volume)
+	if err := k.SaveTradingVolume(ctx, accNumber, tradingVolume); err != nil {
+		return err
+	}
+
+	return k.pruneTradingVolumes(ctx, accNumber, windowStartDay(ctx, params))
+}
+
+// pruneTradingVolumes removes the account daily trading volumes of the days before the start day.
+func (k Keeper) pruneTradingVolumes(ctx sdk.Context, accNumber, startDay uint64) error {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	volumesStore := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateAccountTradingVolumesKey(accNumber),
+	)
+
+	iterator := volumesStore.Iterator(nil, store.AppendUint64ToOrderedBytes(nil, startDay))
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		volumesStore.Delete(key)
+	}
+
+	return nil
+}
+
+// getTradingVolume returns the sum of the account daily trading volumes within the trading volume window.
+func (k Keeper) getTradingVolume(ctx sdk.Context, params types.Params, accNumber uint64) (sdkmath.LegacyDec, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	volumesStore := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateAccountTradingVolumesKey(accNumber),
+	)
+
+	iterator := volumesStore.Iterator(store.AppendUint64ToOrderedBytes(nil, windowStartDay(ctx, params)), nil)
+	defer iterator.Close()
+
+	volume := sdkmath.LegacyZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		var tradingVolume types.TradingVolume
+		if err := k.cdc.Unmarshal(iterator.Value(), &tradingVolume); err != nil {
+			return sdkmath.LegacyDec{}, sdkerrors.Wrapf(
+				types.ErrInvalidState, "failed to unmarshal trading volume: %s", err,
+			)
+		}
+		volume = volume.Add(tradingVolume.Volume)
+	}
+
+	return volume, nil
+}
+
+// windowStartDay returns the first day of the trading volume window, the window includes the current day.
+func windowStartDay(ctx sdk.Context, params types.Params) uint64 {
+	day := blockDay(ctx)
+	windowDays := uint64(params.TradingVolumeWindowDays)
+	if day < windowDays {
+		return 0
+	}
+
+	return day - windowDays + 1
+}
+
+func blockDay(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().Unix() / secondsInDay)
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_TradingVolumeTiers(t *testing.T) {
+	testApp := simapp.New()
+	day := int64(19676)
+	sdkCtx := testApp.NewContext(false).WithBlockTime(time.Unix(day*24*3600+3600, 0))
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.002")
+	params.TradingVolumeWindowDays = 2
+	params.VolumeTiers = []types.VolumeTier{
+		{
+			MinVolume:         sdkmath.LegacyMustNewDecFromStr("1"),
+			MaxOrdersPerDenom: 1,
+			FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("0.5"),
+		},
+		{
+			MinVolume:         sdkmath.LegacyMustNewDecFromStr("3"),
+			MaxOrdersPerDenom: 200,
+			FeeDiscount:       sdkmath.LegacyOneDec(),
+		},
+	}
+	require.NoError(t, dexKeeper.UpdateParams(
+		sdkCtx, authtypes.NewModuleAddress(govtypes.ModuleName).String(), params,
+	))
+
+	maker, _ := testApp.GenAccount(sdkCtx)
+	taker, _ := testApp.GenAccount(sdkCtx)
+
+	// the account without trades has no tier
+	requireAccountTradingVolume(t, sdkCtx, testApp, maker, types.QueryAccountTradingVolumeResponse{
+		Volume:            sdkmath.LegacyZeroDec(),
+		MaxOrdersPerDenom: params.MaxOrdersPerDenom,
+		FeeDiscount:       sdkmath.LegacyZeroDec(),
+	})
+
+	// the first trade is charged with the full fees, the traded base quantity is normalized by the default unified ref
+	// amount, so the volume of the trade is 1
+	requireFeeTestOrdersFees(t, sdkCtx, testApp, maker, taker, testSet.denom1, testSet.denom2, 1_200, 2_000)
+	tier1 := types.QueryAccountTradingVolumeResponse{
+		Volume:            sdkmath.LegacyMustNewDecFromStr("1"),
+		Tier:              1,
+		MaxOrdersPerDenom: 1,
+		FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("0.5"),
+	}
+	requireAccountTradingVolume(t, sdkCtx, testApp, maker, tier1)
+	requireAccountTradingVolume(t, sdkCtx, testApp, taker, tier1)
+
+	// the tier overrides the max orders per denom
+	order := types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1e3")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	testApp.MintAndSendCoin(t, sdkCtx, maker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 2_000_000)))
+	fundOrderReserve(t, testApp, sdkCtx, maker)
+	fundOrderReserve(t, testApp, sdkCtx, maker)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	order.ID = "id2"
+	cacheCtx, _ := sdkCtx.CacheContext()
+	require.ErrorContains(t, dexKeeper.PlaceOrder(cacheCtx, order), "it's prohibited to save more than 1 orders per denom")
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, maker, "id1"))
+
+	// the fees are discounted by the tier
+	requireFeeTestOrdersFees(t, sdkCtx, testApp, maker, taker, testSet.denom1, testSet.denom2, 600, 1_000)
+
+	// the next day trade moves the accounts to the second tier
+	sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(24 * time.Hour))
+	requireFeeTestOrdersFees(t, sdkCtx, testApp, maker, taker, testSet.denom1, testSet.denom2, 600, 1_000)
+	tier2 := types.QueryAccountTradingVolumeResponse{
+		Volume:            sdkmath.LegacyMustNewDecFromStr("3"),
+		Tier:              2,
+		MaxOrdersPerDenom: 200,
+		FeeDiscount:       sdkmath.LegacyOneDec(),
+	}
+	requireAccountTradingVolume(t, sdkCtx, testApp, maker, tier2)
+	requireAccountTradingVolume(t, sdkCtx, testApp, taker, tier2)
+
+	// the first day volume leaves the window
+	sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(24 * time.Hour))
+	requireAccountTradingVolume(t, sdkCtx, testApp, maker, tier1)
+
+	// the trade is charged with the fees discounted by the first tier, the volume of the first day is pruned
+	requireFeeTestOrdersFees(t, sdkCtx, testApp, maker, taker, testSet.denom1, testSet.denom2, 600, 1_000)
+	tradingVolumes, _, err := dexKeeper.GetTradingVolumes(sdkCtx, &query.PageRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.TradingVolume{
+		{Account: maker.String(), Day: uint64(day + 1), Volume: sdkmath.LegacyMustNewDecFromStr("1")},
+		{Account: maker.String(), Day: uint64(day + 2), Volume: sdkmath.LegacyMustNewDecFromStr("1")},
+		{Account: taker.String(), Day: uint64(day + 1), Volume: sdkmath.LegacyMustNewDecFromStr("1")},
+		{Account: taker.String(), Day: uint64(day + 2), Volume: sdkmath.LegacyMustNewDecFromStr("1")},
+	}, tradingVolumes)
+}
+
+func requireFeeTestOrdersFees(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	testApp *simapp.App,
+	makerAddr, takerAddr sdk.AccAddress,
+	baseDenom, quoteDenom string,
+	expectedMakerFee, expectedTakerFee int64,
+) {
+	t.Helper()
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	maker, taker := placeAccountsFeeTestOrders(t, sdkCtx, testApp, makerAddr, takerAddr, baseDenom, quoteDenom)
+	events := readOrderEvents(t, sdkCtx)
+
+	makerReduced, ok := events.getOrderReduced(maker.Creator, maker.ID)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin(quoteDenom, expectedMakerFee).String(), makerReduced.Fee.String())
+	takerReduced, ok := events.getOrderReduced(taker.Creator, taker.ID)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin(baseDenom, expectedTakerFee).String(), takerReduced.Fee.String())
+}
+
+func requireAccountTradingVolume(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	testApp *simapp.App,
+	acc sdk.AccAddress,
+	expected types.QueryAccountTradingVolumeResponse,
+) {
+	t.Helper()
+
+	res, err := testApp.DEXKeeper.GetAccountTradingVolume(sdkCtx, acc)
+	require.NoError(t, err)
+	require.Equal(t, expected.Volume.String(), res.Volume.String())
+	require.Equal(t, expected.Tier, res.Tier)
+	require.Equal(t, expected.MaxOrdersPerDenom, res.MaxOrdersPerDenom)
+	require.Equal(t, expected.FeeDiscount.String(), res.FeeDiscount.String())
+}
@@ -0,0 +1,614 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// maxTriggerOrderActivations is the max number of trigger orders activated after a single order placement.
+// The remaining activated orders are placed after the next trade in the order book.
+const maxTriggerOrderActivations = 10
+
+// GetTriggerOrders returns creator trigger orders.
+func (k Keeper) GetTriggerOrders(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	accNumber, err := k.getAccountNumber(ctx, creator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.getPaginatedTriggerOrders(ctx, types.CreateTriggerOrderKeyPrefix(accNumber), pagination)
+}
+
+// GetAccountsTriggerOrders returns paginated trigger orders of all accounts.
+func (k Keeper) GetAccountsTriggerOrders(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	return k.getPaginatedTriggerOrders(ctx, types.TriggerOrderKeyPrefix, pagination)
+}
+
+// SaveTriggerOrder saves the trigger order.
+func (k Keeper) SaveTriggerOrder(ctx sdk.Context, accNumber uint64, order types.Order) error {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	return k.saveTriggerOrder(ctx, accNumber, orderBookID, order)
+}
+
+// GetOrderBooksLastTrades returns paginated order books last trades.
+func (k Keeper) GetOrderBooksLastTrades(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.OrderBookLastTrade, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OrderBookLastTradeKeyPrefix)
+	lastTrades, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pagination,
+		// builder
+		func(_ []byte, record *types.OrderBookLastTrade) (*types.OrderBookLastTrade, error) {
+			return record, nil
+		},
+		// constructor
+		func() *types.OrderBookLastTrade {
+			return &types.OrderBookLastTrade{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(lastTrades, func(lastTrade *types.OrderBookLastTrade, _ int) types.OrderBookLastTrade {
+		return *lastTrade
+	}), pageRes, nil
+}
+
+// SaveOrderBookLastTrade saves the order book last trade.
+func (k Keeper) SaveOrderBookLastTrade(ctx sdk.Context, lastTrade types.OrderBookLastTrade) error {
+	return k.saveOrderBookLastTrade(ctx, lastTrade)
+}
+
+func (k Keeper) createTriggerOrder(
+	ctx sdk.Context,
+	params types.Params,
+	accNumber uint64,
+	orderBookID, invertedOrderBookID uint32,
+	order types.Order,
+) error {
+	k.logger(ctx).Debug("Creating trigger order.", "order", order.String())
+
+	lastPrice, found, err := k.getLastPrice(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if found && order.Trigger.IsActivatedBy(lastPrice) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"trigger condition %s %s is already met by the last traded price %s",
+			order.Trigger.Condition.String(), order.Trigger.Price.String(), lastPrice.RatString(),
+		)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+	}
+
+	maxOrdersPerDenom, err := k.getMaxOrdersPerDenom(ctx, params, accNumber)
+	if err != nil {
+		return err
+	}
+	if err := k.incrementAccountDenomsOrdersCounter(
+		ctx,
+		accNumber,
+		maxOrdersPerDenom,
+		order.Denoms(),
+	); err != nil {
+		return err
+	}
+
+	orderSequence, err := k.genNextOrderSequence(ctx)
+	if err != nil {
+		return err
+	}
+	order.Sequence = orderSequence
+
+	lockedCoin, expectedToReceiveCoin, err := computeTriggerOrderLimits(order)
+	if err != nil {
+		return err
+	}
+	if err := k.assetFTKeeper.DEXCheckOrderAmounts(
+		ctx, newDEXOrder(creator, order), lockedCoin, expectedToReceiveCoin,
+	); err != nil {
+		return err
+	}
+
+	order.RemainingBaseQuantity = order.Quantity
+	order.RemainingSpendableBalance = lockedCoin.Amount
+
+	lockedCoins := sdk.NewCoins(lockedCoin)
+	if params.OrderReserve.IsPositive() {
+		order.Reserve = params.OrderReserve
+		lockedCoins = lockedCoins.Add(params.OrderReserve)
+	}
+
+	if err := k.assetFTKeeper.DEXIncreaseLimits(ctx, creator, lockedCoins, expectedToReceiveCoin); err != nil {
+		return err
+	}
+
+	if err := k.saveTriggerOrder(ctx, accNumber, orderBookID, order); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTriggerOrderCreated{
+		Creator:  order.Creator,
+		ID:       order.ID,
+		Sequence: order.Sequence,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventTriggerOrderCreated: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) cancelTriggerOrder(ctx sdk.Context, accNumber uint64, order types.Order) error {
+	if err := k.removeTriggerOrder(ctx, accNumber, order); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTriggerOrderCanceled{
+		Creator:  order.Creator,
+		ID:       order.ID,
+		Sequence: order.Sequence,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventTriggerOrderCanceled: %s", err)
+	}
+
+	return nil
+}
+
+// activateTriggerOrders places the trigger orders of the order book pair activated by the last traded price. Since
+// the placement of the activated order might produce new trades, the condition is evaluated again after each
+// activation. The trigger orders aren't activated while the order book pair is halted by the circuit breaker.
+func (k Keeper) activateTriggerOrders(ctx sdk.Context, orderBookID, invertedOrderBookID uint32) error {
+	for i := 0; i < maxTriggerOrderActivations; i++ {
+		halted, err := k.isOrderBookHalted(ctx, orderBookID)
+		if err != nil {
+			return err
+		}
+		if halted {
+			return nil
+		}
+		// the trigger orders are activated by the trades, so they are activated after the opening auction
+		inAuction, err := k.isOrderBookInAuction(ctx, orderBookID)
+		if err != nil {
+			return err
+		}
+		if inAuction {
+			return nil
+		}
+
+		lastPrice, found, err := k.getLastPrice(ctx, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return nil
+		}
+
+		order, accNumber, orderLastPrice, found, err := k.findActivatedTriggerOrder(
+			ctx, orderBookID, invertedOrderBookID, lastPrice,
+		)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return nil
+		}
+
+		if err := k.activateTriggerOrder(ctx, accNumber, order, orderLastPrice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) activateTriggerOrder(
+	ctx sdk.Context,
+	accNumber uint64,
+	order types.Order,
+	lastPrice *big.Rat,
+) error {
+	k.logger(ctx).Debug("Activating trigger order.", "order", order.String())
+
+	if err := k.removeTriggerOrder(ctx, accNumber, order); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTriggerOrderActivated{
+		Creator:   order.Creator,
+		ID:        order.ID,
+		Sequence:  order.Sequence,
+		LastPrice: lastPrice.RatString(),
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventTriggerOrderActivated: %s", err)
+	}
+
+	triggerOrderSequence := order.Sequence
+	order.Sequence = 0
+	order.Trigger = nil
+	order.RemainingBaseQuantity = sdkmath.Int{}
+	order.RemainingSpendableBalance = sdkmath.Int{}
+	order.Reserve = sdk.Coin{}
+
+	// The order is placed in the cached context, since its placement failure (e.g. insufficient balance or
+	// expired good til) must not fail the placement of the order which activated it.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.placeActivatedTriggerOrder(cacheCtx, accNumber, order); err != nil {
+		k.logger(ctx).Debug("Failed to place activated trigger order.", "order", order.String(), "err", err)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTriggerOrderCanceled{
+			Creator:  order.Creator,
+			ID:       order.ID,
+			Sequence: triggerOrderSequence,
+			Reason:   err.Error(),
+		}); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventTriggerOrderCanceled: %s", err)
+		}
+		return nil
+	}
+	writeCache()
+
+	return nil
+}
+
+func (k Keeper) placeActivatedTriggerOrder(ctx sdk.Context, accNumber uint64, order types.Order) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.validateOrder(ctx, params, order); err != nil {
+		return err
+	}
+
+	orderBookID, invertedOrderBookID, err := k.getOrGenOrderBookIDs(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	return k.matchOrder(ctx, params, accNumber, orderBookID, invertedOrderBookID, order)
+}
+
+// findActivatedTriggerOrder returns the activated trigger order with the lowest sequence of the order book pair,
+// along with the last traded price expressed in the order book of the order.
+func (k Keeper) findActivatedTriggerOrder(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	lastPrice *big.Rat,
+) (types.Order, uint64, *big.Rat, bool, error) {
+	var (
+		activatedOrder     types.Order
+		activatedAccNumber uint64
+		activatedLastPrice *big.Rat
+		activated          bool
+	)
+	for _, item := range []struct {
+		orderBookID uint32
+		lastPrice   *big.Rat
+	}{
+		{orderBookID: orderBookID, lastPrice: lastPrice},
+		{orderBookID: invertedOrderBookID, lastPrice: cbig.RatInv(lastPrice)},
+	} {
+		for _, condition := range []types.TriggerCondition{
+			types.TRIGGER_CONDITION_PRICE_GTE, types.TRIGGER_CONDITION_PRICE_LTE,
+		} {
+			order, accNumber, found, err := k.getBestTriggerOrder(ctx, item.orderBookID, condition)
+			if err != nil {
+				return types.Order{}, 0, nil, false, err
+			}
+			if !found || !order.Trigger.IsActivatedBy(item.lastPrice) {
+				continue
+			}
+			if !activated || order.Sequence < activatedOrder.Sequence {
+				activatedOrder = order
+				activatedAccNumber = accNumber
+				activatedLastPrice = item.lastPrice
+				activated = true
+			}
+		}
+	}
+
+	return activatedOrder, activatedAccNumber, activatedLastPrice, activated, nil
+}
+
+// getBestTriggerOrder returns the trigger order which is activated first by the price move, it's the order with the
+// lowest price for the GTE condition, and with the highest price for the LTE condition.
+func (k Keeper) getBestTriggerOrder(
+	ctx sdk.Context,
+	orderBookID uint32,
+	condition types.TriggerCondition,
+) (types.Order, uint64, bool, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateTriggerOrderBookConditionKey(orderBookID, condition),
+	)
+
+	iterator := store.Iterator(nil, nil)
+	if condition == types.TRIGGER_CONDITION_PRICE_LTE {
+		iterator = store.ReverseIterator(nil, nil)
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Order{}, 0, false, nil
+	}
+
+	accNumber, orderID, err := types.DecodeTriggerOrderKey(iterator.Value())
+	if err != nil {
+		return types.Order{}, 0, false, err
+	}
+	order, err := k.getTriggerOrder(ctx, accNumber, orderID)
+	if err != nil {
+		return types.Order{}, 0, false, err
+	}
+
+	return order, accNumber, true, nil
+}
+
+// getLastPrice returns the last traded price of the order book pair expressed in the order book.
+func (k Keeper) getLastPrice(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+) (*big.Rat, bool, error) {
+	lastTrade, found, err := k.getOrderBookLastTrade(ctx, orderBookID)
+	if err != nil {
+		return nil, false, err
+	}
+	invertedLastTrade, invertedFound, err := k.getOrderBookLastTrade(ctx, invertedOrderBookID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch {
+	case found && (!invertedFound || lastTrade.OrderSequence >= invertedLastTrade.OrderSequence):
+		return lastTrade.Price.Rat(), true, nil
+	case invertedFound:
+		return cbig.RatInv(invertedLastTrade.Price.Rat()), true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+func (k Keeper) getOrderBookLastTrade(
+	ctx sdk.Context,
+	orderBookID uint32,
+) (types.OrderBookLastTrade, bool, error) {
+	var val types.OrderBookLastTrade
+	if err := k.getDataFromStore(ctx, types.CreateOrderBookLastTradeKey(orderBookID), &val); err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.OrderBookLastTrade{}, false, nil
+		}
+		return types.OrderBookLastTrade{}, false, err
+	}
+
+	return val, true, nil
+}
+
+func (k Keeper) saveOrderBookLastTrade(ctx sdk.Context, lastTrade types.OrderBookLastTrade) error {
+	return k.setDataToStore(ctx, types.CreateOrderBookLastTradeKey(lastTrade.OrderBookID), &lastTrade)
+}
+
+func (k Keeper) getTriggerOrder(ctx sdk.Context, accNumber uint64, orderID string) (types.Order, error) {
+	var val types.Order
+	if err := k.getDataFromStore(ctx, types.CreateTriggerOrderKey(accNumber, orderID), &val); err != nil {
+		return types.Order{}, sdkerrors.Wrapf(
+			err, "failed to get trigger order, accNumber: %d, orderID: %s", accNumber, orderID,
+		)
+	}
+
+	return val, nil
+}
+
+func (k Keeper) saveTriggerOrder(ctx sdk.Context, accNumber uint64, orderBookID uint32, order types.Order) error {
+	if order.Trigger == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "trigger is required for the trigger order %s", order.ID)
+	}
+
+	key := types.CreateTriggerOrderKey(accNumber, order.ID)
+	if err := k.setDataToStore(ctx, key, &order); err != nil {
+		return err
+	}
+
+	// the trigger order locks the balance and the reserve, so it's expired as the order saved to the order book
+	if order.GoodTil != nil {
+		creator, err := sdk.AccAddressFromBech32(order.Creator)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+		}
+		if err := k.saveOrderExpiration(ctx, *order.GoodTil, order.Sequence, creator); err != nil {
+			return err
+		}
+	}
+
+	indexKey, err := types.CreateTriggerOrderBookKey(
+		orderBookID, order.Trigger.Condition, order.Trigger.Price, order.Sequence,
+	)
+	if err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(indexKey, key)
+}
+
+// removeTriggerOrder removes the trigger order from the store and unlocks its limits.
+func (k Keeper) removeTriggerOrder(ctx sdk.Context, accNumber uint64, order types.Order) error {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	indexKey, err := types.CreateTriggerOrderBookKey(
+		orderBookID, order.Trigger.Condition, order.Trigger.Price, order.Sequence,
+	)
+	if err != nil {
+		return err
+	}
+
+	kvStore := k.storeService.OpenKVStore(ctx)
+	if err := kvStore.Delete(indexKey); err != nil {
+		return err
+	}
+	if err := kvStore.Delete(types.CreateTriggerOrderKey(accNumber, order.ID)); err != nil {
+		return err
+	}
+	if order.GoodTil != nil {
+		if err := k.removeOrderExpiration(ctx, *order.GoodTil, order.Sequence); err != nil {
+			return err
+		}
+	}
+
+	if err := k.decrementAccountDenomOrdersCounter(ctx, accNumber, order.Denoms()); err != nil {
+		return err
+	}
+
+	_, expectedToReceiveCoin, err := computeTriggerOrderLimits(order)
+	if err != nil {
+		return err
+	}
+	lockedCoins := sdk.NewCoins(sdk.NewCoin(order.GetSpendDenom(), order.RemainingSpendableBalance))
+	if order.Reserve.IsPositive() {
+		lockedCoins = lockedCoins.Add(order.Reserve)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+	}
+
+	return k.assetFTKeeper.DEXDecreaseLimits(ctx, creator, lockedCoins, expectedToReceiveCoin)
+}
+
+// getTriggerOrderBySequence returns the account trigger order with the sequence.
+func (k Keeper) getTriggerOrderBySequence(
+	ctx sdk.Context,
+	accNumber uint64,
+	orderSequence uint64,
+) (types.Order, bool, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateTriggerOrderKeyPrefix(accNumber),
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.Order
+		if err := k.cdc.Unmarshal(iterator.Value(), &order); err != nil {
+			return types.Order{}, false, sdkerrors.Wrapf(
+				types.ErrInvalidState, "failed to unmarshal trigger order: %s", err,
+			)
+		}
+		if order.Sequence == orderSequence {
+			return order, true, nil
+		}
+	}
+
+	return types.Order{}, false, nil
+}
+
+func (k Keeper) getPaginatedTriggerOrders(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), keyPrefix)
+	orders, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pagination,
+		// builder
+		func(_ []byte, order *types.Order) (*types.Order, error) {
+			return order, nil
+		},
+		// constructor
+		func() *types.Order {
+			return &types.Order{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(orders, func(order *types.Order, _ int) types.Order {
+		return *order
+	}), pageRes, nil
+}
+
+// computeTriggerOrderLimits computes the balance locked and the balance expected to receive by the trigger order.
+// The market buy order spends the whole spendable balance at the time of the activation, so nothing is locked for it
+// except the reserve. The market order doesn't increase the expected to receive balance, since it's never saved to
+// the order book.
+func computeTriggerOrderLimits(order types.Order) (sdk.Coin, sdk.Coin, error) {
+	switch order.Type {
+	case types.ORDER_TYPE_LIMIT:
+		lockedCoin, err := order.ComputeLimitOrderLockedBalance()
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+			order.Side, order.BaseDenom, order.QuoteDenom, order.Quantity, *order.Price,
+		)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		return lockedCoin, expectedToReceiveCoin, nil
+	case types.ORDER_TYPE_MARKET:
+		lockedCoin := sdk.NewCoin(order.GetSpendDenom(), sdkmath.ZeroInt())
+		if order.Side == types.SIDE_SELL {
+			lockedCoin.Amount = order.Quantity
+		}
+		return lockedCoin, sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()), nil
+	default:
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "unexpected order type: %s", order.Type.String(),
+		)
+	}
+}
+
+func newDEXOrder(creator sdk.AccAddress, order types.Order) assetfttypes.DEXOrder {
+	var orderStrPrice *string
+	if order.Price != nil {
+		orderStrPrice = lo.ToPtr(order.Price.String())
+	}
+
+	return assetfttypes.DEXOrder{
+		Creator:    creator,
+		Type:       order.Type.String(),
+		ID:         order.ID,
+		Sequence:   order.Sequence,
+		BaseDenom:  order.BaseDenom,
+		QuoteDenom: order.QuoteDenom,
+		Price:      orderStrPrice,
+		Quantity:   order.Quantity,
+		Side:       order.Side.String(),
+	}
+}
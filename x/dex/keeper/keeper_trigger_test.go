@@ -0,0 +1,350 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/docker/distribution/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+type triggerOrderEvents struct {
+	Created   []types.EventTriggerOrderCreated
+	Activated []types.EventTriggerOrderActivated
+	Canceled  []types.EventTriggerOrderCanceled
+}
+
+func readTriggerOrderEvents(t *testing.T, sdkCtx sdk.Context) triggerOrderEvents {
+	var events triggerOrderEvents
+	for _, evt := range sdkCtx.EventManager().Events().ToABCIEvents() {
+		if !strings.HasPrefix(evt.Type, "coreum.dex.v1.EventTriggerOrder") {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(evt)
+		require.NoError(t, err)
+		switch typedEvt := msg.(type) {
+		case *types.EventTriggerOrderCreated:
+			events.Created = append(events.Created, *typedEvt)
+		case *types.EventTriggerOrderActivated:
+			events.Activated = append(events.Activated, *typedEvt)
+		case *types.EventTriggerOrderCanceled:
+			events.Canceled = append(events.Canceled, *typedEvt)
+		}
+	}
+
+	return events
+}
+
+func TestKeeper_PlaceActivateAndCancelTriggerOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	// stop-loss order selling denom1 once the price drops to 1.25
+	stopLossOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "stop-loss",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		Trigger: &types.Trigger{
+			Price:     types.MustNewPriceFromString("125e-2"),
+			Condition: types.TRIGGER_CONDITION_PRICE_LTE,
+		},
+	}
+	// take-profit order selling denom1 once the price grows to 2
+	takeProfitOrder := stopLossOrder
+	takeProfitOrder.ID = "take-profit"
+	takeProfitOrder.Trigger = &types.Trigger{
+		Price:     types.MustNewPriceFromString("2"),
+		Condition: types.TRIGGER_CONDITION_PRICE_GTE,
+	}
+	testApp.MintAndSendCoin(
+		t, sdkCtx, testSet.acc1, sdk.NewCoins(sdk.NewCoin(testSet.denom1, sdkmath.NewInt(2_000_000))),
+	)
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, stopLossOrder))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, takeProfitOrder))
+	require.Equal(t, []types.EventTriggerOrderCreated{
+		{Creator: stopLossOrder.Creator, ID: stopLossOrder.ID, Sequence: 1},
+		{Creator: takeProfitOrder.Creator, ID: takeProfitOrder.ID, Sequence: 2},
+	}, readTriggerOrderEvents(t, sdkCtx).Created)
+
+	// the trigger orders lock the balance but are not in the order book
+	require.Equal(
+		t,
+		sdk.NewCoin(testSet.denom1, sdkmath.NewInt(2_000_000)).String(),
+		assetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).String(),
+	)
+	orders, _, err := dexKeeper.GetOrders(sdkCtx, testSet.acc1, nil)
+	require.NoError(t, err)
+	require.Empty(t, orders)
+	triggerOrders, _, err := dexKeeper.GetTriggerOrders(sdkCtx, testSet.acc1, nil)
+	require.NoError(t, err)
+	require.Len(t, triggerOrders, 2)
+	order, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, stopLossOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, stopLossOrder.Trigger, order.Trigger)
+	require.Equal(t, map[string]uint64{
+		testSet.denom1: 2,
+		testSet.denom2: 2,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, testSet.acc1))
+
+	// cancel the take-profit order
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, testSet.acc1, takeProfitOrder.ID))
+	require.Equal(t, []types.EventTriggerOrderCanceled{
+		{Creator: takeProfitOrder.Creator, ID: takeProfitOrder.ID, Sequence: 2},
+	}, readTriggerOrderEvents(t, sdkCtx).Canceled)
+	require.Equal(
+		t,
+		sdk.NewCoin(testSet.denom1, sdkmath.NewInt(1_000_000)).String(),
+		assetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).String(),
+	)
+
+	// trade at 1.5, the stop-loss order isn't activated
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	placeTriggerTestTrade(t, testApp, sdkCtx, testSet, testSet.denom1, testSet.denom2, "15e-1")
+	require.Empty(t, readTriggerOrderEvents(t, sdkCtx).Activated)
+
+	// the order can't be placed with the condition already met
+	invalidOrder := stopLossOrder
+	invalidOrder.ID = "invalid"
+	invalidOrder.Trigger = &types.Trigger{
+		Price:     types.MustNewPriceFromString("16e-1"),
+		Condition: types.TRIGGER_CONDITION_PRICE_LTE,
+	}
+	require.ErrorContains(
+		t,
+		dexKeeper.PlaceOrder(simapp.CopyContextWithMultiStore(sdkCtx), invalidOrder),
+		"is already met by the last traded price 3/2",
+	)
+
+	// the order to match the activated stop-loss order
+	buyOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "buy",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	buyLockedBalance, err := buyOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc3, sdk.NewCoins(buyLockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc3)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, buyOrder))
+
+	// trade at 0.8 in the inverted order book, which is 1.25 in the stop-loss order book, activates the order
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	placeTriggerTestTrade(t, testApp, sdkCtx, testSet, testSet.denom2, testSet.denom1, "8e-1")
+	events := readTriggerOrderEvents(t, sdkCtx)
+	require.Equal(t, []types.EventTriggerOrderActivated{
+		{Creator: stopLossOrder.Creator, ID: stopLossOrder.ID, Sequence: 1, LastPrice: "5/4"},
+	}, events.Activated)
+	require.Empty(t, events.Canceled)
+
+	triggerOrders, _, err = dexKeeper.GetTriggerOrders(sdkCtx, testSet.acc1, nil)
+	require.NoError(t, err)
+	require.Empty(t, triggerOrders)
+
+	// the activated order is matched with the buy order
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, stopLossOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc3, buyOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.True(t, assetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).IsZero())
+	require.Equal(
+		t,
+		sdk.NewCoin(testSet.denom2, sdkmath.NewInt(1_000_000)).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.denom2).String(),
+	)
+	require.Equal(t, map[string]uint64{
+		testSet.denom1: 0,
+		testSet.denom2: 0,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, testSet.acc1))
+}
+
+func TestKeeper_TriggerOrderActivationFailure(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	triggerOrder := types.Order{
+		Creator:    testSet.acc1.String(),
+		Type:       types.ORDER_TYPE_LIMIT,
+		ID:         "take-profit",
+		BaseDenom:  testSet.denom1,
+		QuoteDenom: testSet.denom2,
+		Price:      lo.ToPtr(types.MustNewPriceFromString("3")),
+		Quantity:   sdkmath.NewInt(1_000_000),
+		Side:       types.SIDE_BUY,
+		GoodTil: &types.GoodTil{
+			GoodTilBlockHeight: uint64(sdkCtx.BlockHeight() + 1),
+		},
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		Trigger: &types.Trigger{
+			Price:     types.MustNewPriceFromString("2"),
+			Condition: types.TRIGGER_CONDITION_PRICE_GTE,
+		},
+	}
+	lockedBalance, err := triggerOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(lockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, triggerOrder))
+	require.Equal(
+		t,
+		lockedBalance.String(),
+		testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, lockedBalance.Denom).String(),
+	)
+
+	// the order is activated once its good til block height is reached
+	sdkCtx = sdkCtx.WithBlockHeight(sdkCtx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	placeTriggerTestTrade(t, testApp, sdkCtx, testSet, testSet.denom1, testSet.denom2, "2")
+	events := readTriggerOrderEvents(t, sdkCtx)
+	require.Len(t, events.Activated, 1)
+	require.Len(t, events.Canceled, 1)
+	require.Equal(t, triggerOrder.ID, events.Canceled[0].ID)
+	require.Contains(t, events.Canceled[0].Reason, "good til block height")
+
+	// the order is removed and unlocked
+	triggerOrders, _, err := testApp.DEXKeeper.GetTriggerOrders(sdkCtx, testSet.acc1, nil)
+	require.NoError(t, err)
+	require.Empty(t, triggerOrders)
+	_, err = testApp.DEXKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, triggerOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, lockedBalance.Denom).IsZero())
+	require.True(
+		t,
+		testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.orderReserve.Denom).IsZero(),
+	)
+}
+
+func TestKeeper_TriggerOrderExpiration(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	triggerOrder := types.Order{
+		Creator:    testSet.acc1.String(),
+		Type:       types.ORDER_TYPE_LIMIT,
+		ID:         "take-profit",
+		BaseDenom:  testSet.denom1,
+		QuoteDenom: testSet.denom2,
+		Price:      lo.ToPtr(types.MustNewPriceFromString("3")),
+		Quantity:   sdkmath.NewInt(1_000_000),
+		Side:       types.SIDE_BUY,
+		GoodTil: &types.GoodTil{
+			GoodTilBlockHeight: uint64(sdkCtx.BlockHeight() + 1),
+		},
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		Trigger: &types.Trigger{
+			Price:     types.MustNewPriceFromString("2"),
+			Condition: types.TRIGGER_CONDITION_PRICE_GTE,
+		},
+	}
+	lockedBalance, err := triggerOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(lockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, triggerOrder))
+
+	// the order isn't expired before its good til block height
+	require.NoError(t, testApp.DEXKeeper.SweepExpiredOrders(sdkCtx))
+	triggerOrders, _, err := testApp.DEXKeeper.GetTriggerOrders(sdkCtx, testSet.acc1, nil)
+	require.NoError(t, err)
+	require.Len(t, triggerOrders, 1)
+
+	// the not activated order is canceled once its good til block height is reached
+	sdkCtx = sdkCtx.WithBlockHeight(sdkCtx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, testApp.DEXKeeper.SweepExpiredOrders(sdkCtx))
+	events := readTriggerOrderEvents(t, sdkCtx)
+	require.Len(t, events.Canceled, 1)
+	require.Equal(t, triggerOrder.ID, events.Canceled[0].ID)
+
+	triggerOrders, _, err = testApp.DEXKeeper.GetTriggerOrders(sdkCtx, testSet.acc1, nil)
+	require.NoError(t, err)
+	require.Empty(t, triggerOrders)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, lockedBalance.Denom).IsZero())
+	require.True(
+		t,
+		testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.orderReserve.Denom).IsZero(),
+	)
+	require.Equal(t, map[string]uint64{
+		testSet.denom1: 0,
+		testSet.denom2: 0,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, testSet.acc1))
+}
+
+// placeTriggerTestTrade executes the trade of 1_000_000 base denom at the provided price. The maker is the limit sell
+// order placed by acc2 and the taker is the market buy order placed by acc3.
+func placeTriggerTestTrade(
+	t *testing.T,
+	testApp *simapp.App,
+	sdkCtx sdk.Context,
+	testSet TestSet,
+	baseDenom, quoteDenom, price string,
+) {
+	sellOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          uuid.Generate().String(),
+		BaseDenom:   baseDenom,
+		QuoteDenom:  quoteDenom,
+		Price:       lo.ToPtr(types.MustNewPriceFromString(price)),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	sellLockedBalance, err := sellOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(sellLockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc2)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, sellOrder))
+
+	buyOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		ID:          uuid.Generate().String(),
+		BaseDenom:   baseDenom,
+		QuoteDenom:  quoteDenom,
+		Quantity:    sellOrder.Quantity,
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}
+	buyBalance, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+		sellOrder.Side, baseDenom, quoteDenom, sellOrder.Quantity, *sellOrder.Price,
+	)
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc3, sdk.NewCoins(buyBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc3)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, buyOrder))
+}
@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"math/big"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+var nanosecondsInSecond = big.NewInt(int64(time.Second))
+
+// GetTWAP returns the time-weighted average price of the order book in the window. The end time is the block time if
+// it isn't provided.
+func (k Keeper) GetTWAP(
+	ctx sdk.Context,
+	baseDenom, quoteDenom string,
+	startTime time.Time,
+	endTime *time.Time,
+) (sdkmath.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	blockTime := ctx.BlockTime()
+	end := blockTime
+	if endTime != nil {
+		end = *endTime
+	}
+	if !startTime.Before(end) {
+		return sdkmath.LegacyDec{}, sdkerrors.Wrap(types.ErrInvalidInput, "start time must be before end time")
+	}
+	if end.After(blockTime) {
+		return sdkmath.LegacyDec{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "end time must not be after the block time %s", blockTime,
+		)
+	}
+	if startTime.Before(blockTime.Add(-params.TWAPRetentionPeriod)) {
+		return sdkmath.LegacyDec{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "start time is out of the TWAP retention period %s", params.TWAPRetentionPeriod,
+		)
+	}
+
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	startCumulativePrice, err := k.getCumulativePrice(ctx, orderBookID, startTime)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	endCumulativePrice, err := k.getCumulativePrice(ctx, orderBookID, end)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	return endCumulativePrice.Sub(startCumulativePrice).Quo(
+		sdkmath.LegacyNewDecWithPrec(end.Sub(startTime).Nanoseconds(), 9),
+	), nil
+}
+
+// GetPriceAccumulators returns paginated order books price accumulators.
+func (k Keeper) GetPriceAccumulators(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.PriceAccumulator, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.PriceAccumulatorKeyPrefix)
+	accumulators, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pagination,
+		func(_ []byte, record *types.PriceAccumulator) (*types.PriceAccumulator, error) {
+			return record, nil
+		},
+		func() *types.PriceAccumulator {
+			return &types.PriceAccumulator{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(accumulators, func(accumulator *types.PriceAccumulator, _ int) types.PriceAccumulator {
+		return *accumulator
+	}), pageRes, nil
+}
+
+// SavePriceAccumulator saves the order book price accumulator.
+func (k Keeper) SavePriceAccumulator(ctx sdk.Context, accumulator types.PriceAccumulator) error {
+	return k.setDataToStore(
+		ctx, types.CreatePriceAccumulatorKey(accumulator.OrderBookID, accumulator.Time), &accumulator,
+	)
+}
+
+// updatePriceAccumulators updates the price accumulators of both order books of the pair with the last traded price.
+func (k Keeper) updatePriceAccumulators(
+	ctx sdk.Context,
+	params types.Params,
+	lastTrade types.OrderBookLastTrade,
+) error {
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, lastTrade.OrderBookID)
+	if err != nil {
+		return err
+	}
+
+	price := lastTrade.Price.Rat()
+	if err := k.updatePriceAccumulator(ctx, params, lastTrade.OrderBookID, price); err != nil {
+		return err
+	}
+
+	return k.updatePriceAccumulator(ctx, params, invertedOrderBookID, cbig.RatInv(price))
+}
+
+func (k Keeper) updatePriceAccumulator(
+	ctx sdk.Context,
+	params types.Params,
+	orderBookID uint32,
+	price *big.Rat,
+) error {
+	lastPrice := ratToLegacyDec(price)
+	if !lastPrice.IsInValidRange() {
+		k.logger(ctx).Debug("Price is out of the accumulator range.", "orderBookID", orderBookID, "price", price)
+		return nil
+	}
+
+	blockTime := ctx.BlockTime()
+	accumulator := types.PriceAccumulator{
+		OrderBookID:     orderBookID,
+		Time:            blockTime,
+		LastPrice:       lastPrice,
+		CumulativePrice: sdkmath.LegacyZeroDec(),
+	}
+	latestAccumulator, found, err := k.getPriceAccumulator(ctx, orderBookID, blockTime)
+	if err != nil {
+		return err
+	}
+	if found {
+		accumulator.CumulativePrice = accumulatePrice(latestAccumulator, blockTime)
+		if !accumulator.CumulativePrice.IsInValidRange() {
+			k.logger(ctx).Debug("Cumulative price is out of the accumulator range.", "orderBookID", orderBookID)
+			return nil
+		}
+	}
+	if err := k.SavePriceAccumulator(ctx, accumulator); err != nil {
+		return err
+	}
+
+	return k.prunePriceAccumulators(ctx, orderBookID, blockTime.Add(-params.TWAPRetentionPeriod))
+}
+
+// prunePriceAccumulators removes the price accumulators created before the time, except the latest one, since it is
+// required to compute the cumulative price at the time.
+func (k Keeper) prunePriceAccumulators(ctx sdk.Context, orderBookID uint32, t time.Time) error {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	accumulatorsStore := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateOrderBookPriceAccumulatorsKey(orderBookID),
+	)
+
+	iterator := accumulatorsStore.Iterator(nil, store.AppendUint64ToOrderedBytes(nil, uint64(t.UnixNano())))
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(keys)-1; i++ {
+		accumulatorsStore.Delete(keys[i])
+	}
+
+	return nil
+}
+
+// getCumulativePrice returns the cumulative price of the order book at the time.
+func (k Keeper) getCumulativePrice(ctx sdk.Context, orderBookID uint32, t time.Time) (sdkmath.LegacyDec, error) {
+	accumulator, found, err := k.getPriceAccumulator(ctx, orderBookID, t)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if !found {
+		return sdkmath.LegacyDec{}, sdkerrors.Wrapf(
+			types.ErrRecordNotFound, "order book %d has no trades before %s", orderBookID, t,
+		)
+	}
+
+	cumulativePrice := accumulatePrice(accumulator, t)
+	if !cumulativePrice.IsInValidRange() {
+		return sdkmath.LegacyDec{}, sdkerrors.Wrapf(
+			types.ErrInvalidState, "cumulative price of order book %d is out of range", orderBookID,
+		)
+	}
+
+	return cumulativePrice, nil
+}
+
+// getPriceAccumulator returns the latest price accumulator of the order book created at or before the time.
+func (k Keeper) getPriceAccumulator(
+	ctx sdk.Context,
+	orderBookID uint32,
+	t time.Time,
+) (types.PriceAccumulator, bool, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	accumulatorsStore := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateOrderBookPriceAccumulatorsKey(orderBookID),
+	)
+
+	iterator := accumulatorsStore.ReverseIterator(
+		nil, store.AppendUint64ToOrderedBytes(nil, uint64(t.UnixNano())+1),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.PriceAccumulator{}, false, nil
+	}
+
+	var accumulator types.PriceAccumulator
+	if err := k.cdc.Unmarshal(iterator.Value(), &accumulator); err != nil {
+		return types.PriceAccumulator{}, false, sdkerrors.Wrapf(
+			types.ErrInvalidState, "failed to unmarshal price accumulator: %s", err,
+		)
+	}
+
+	return accumulator, true, nil
+}
+
+// accumulatePrice returns the cumulative price of the accumulator at the time, the last price of the accumulator is
+// effective since the accumulator time.
+func accumulatePrice(accumulator types.PriceAccumulator, t time.Time) sdkmath.LegacyDec {
+	elapsed := big.NewInt(t.Sub(accumulator.Time).Nanoseconds())
+	increment := cbig.IntQuo(cbig.IntMul(accumulator.LastPrice.BigInt(), elapsed), nanosecondsInSecond)
+
+	return sdkmath.LegacyNewDecFromBigIntWithPrec(
+		cbig.IntAdd(accumulator.CumulativePrice.BigInt(), increment), sdkmath.LegacyPrecision,
+	)
+}
+
+// ratToLegacyDec converts the rational number to the decimal, rounding it down to the decimal precision.
+func ratToLegacyDec(r *big.Rat) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecFromBigIntWithPrec(
+		cbig.IntQuo(cbig.IntMul(r.Num(), cbig.IntTenToThePower(big.NewInt(sdkmath.LegacyPrecision))), r.Denom()),
+		sdkmath.LegacyPrecision,
+	)
+}
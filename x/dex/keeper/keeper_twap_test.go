@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_TWAP(t *testing.T) {
+	testApp := simapp.New()
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	sdkCtx := testApp.NewContext(false).WithBlockTime(startTime)
+	testSet := genTestSet(t, sdkCtx, testApp)
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.TWAPRetentionPeriod = time.Hour
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	placeTWAPTestTrade(t, sdkCtx, testApp, testSet, "375e-3")
+	sdkCtx = sdkCtx.WithBlockTime(startTime.Add(100 * time.Second))
+	placeTWAPTestTrade(t, sdkCtx, testApp, testSet, "5e-1")
+	sdkCtx = sdkCtx.WithBlockTime(startTime.Add(300 * time.Second))
+
+	// (0.375 * 100 + 0.5 * 200) / 300
+	twap, err := dexKeeper.GetTWAP(sdkCtx, testSet.denom1, testSet.denom2, startTime, nil)
+	require.NoError(t, err)
+	require.Equal(t, "0.458333333333333333", twap.String())
+
+	// (1 / 0.375 * 100 + 2 * 200) / 300
+	twap, err = dexKeeper.GetTWAP(sdkCtx, testSet.denom2, testSet.denom1, startTime, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2.222222222222222222", twap.String())
+
+	twap, err = dexKeeper.GetTWAP(
+		sdkCtx,
+		testSet.denom1,
+		testSet.denom2,
+		startTime.Add(100*time.Second),
+		lo.ToPtr(startTime.Add(200*time.Second)),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "0.500000000000000000", twap.String())
+
+	// there are no trades before the start time
+	_, err = dexKeeper.GetTWAP(sdkCtx, testSet.denom1, testSet.denom2, startTime.Add(-time.Second), nil)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	// the window is invalid
+	_, err = dexKeeper.GetTWAP(
+		sdkCtx, testSet.denom1, testSet.denom2, startTime, lo.ToPtr(startTime.Add(time.Hour)),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	_, err = dexKeeper.GetTWAP(
+		sdkCtx, testSet.denom1, testSet.denom2, startTime, lo.ToPtr(startTime),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	// the order book doesn't exist
+	_, err = dexKeeper.GetTWAP(sdkCtx, testSet.denom1, testSet.denom3, startTime, nil)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// the accumulators out of the retention period are pruned except the latest one
+	sdkCtx = sdkCtx.WithBlockTime(startTime.Add(2 * time.Hour))
+	placeTWAPTestTrade(t, sdkCtx, testApp, testSet, "4e-1")
+	accumulators, _, err := dexKeeper.GetPriceAccumulators(sdkCtx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	require.NoError(t, err)
+	require.Len(t, accumulators, 4)
+
+	_, err = dexKeeper.GetTWAP(sdkCtx, testSet.denom1, testSet.denom2, startTime, nil)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	twap, err = dexKeeper.GetTWAP(sdkCtx, testSet.denom1, testSet.denom2, startTime.Add(time.Hour), nil)
+	require.NoError(t, err)
+	require.Equal(t, "0.500000000000000000", twap.String())
+}
+
+func placeTWAPTestTrade(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	testApp *simapp.App,
+	testSet TestSet,
+	price string,
+) {
+	id := fmt.Sprintf("%d", sdkCtx.BlockTime().Unix())
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell" + id,
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString(price)),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeTestOrder(t, testApp, sdkCtx, sellOrder)
+
+	buyOrder := sellOrder
+	buyOrder.Creator = testSet.acc2.String()
+	buyOrder.ID = "buy" + id
+	buyOrder.Side = types.SIDE_BUY
+	buyOrder.TimeInForce = types.TIME_IN_FORCE_IOC
+	placeTestOrder(t, testApp, sdkCtx, buyOrder)
+}
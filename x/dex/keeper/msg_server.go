@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -16,8 +17,64 @@ var _ types.MsgServer = MsgServer{}
 type MsgKeeper interface {
 	UpdateParams(ctx sdk.Context, authority string, params types.Params) error
 	PlaceOrder(ctx sdk.Context, order types.Order) error
+	ReplaceOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string, price *types.Price, quantity sdkmath.Int) error
 	CancelOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string) error
 	CancelOrdersByDenom(ctx sdk.Context, admin, acc sdk.AccAddress, denom string) error
+	BatchPlaceOrders(
+		ctx sdk.Context, creator sdk.AccAddress, orders []types.Order, mode types.BatchMode,
+	) ([]types.BatchOrderResult, error)
+	BatchCancelOrders(
+		ctx sdk.Context, acc sdk.AccAddress, orderIDs []string, mode types.BatchMode,
+	) ([]types.BatchOrderResult, error)
+	CancelAllOrders(
+		ctx sdk.Context, acc sdk.AccAddress, filter func(types.Order) bool, limit uint32,
+	) ([]string, error)
+	SetDeadManSwitch(ctx sdk.Context, acc sdk.AccAddress, timeoutBlocks uint64) error
+	Heartbeat(ctx sdk.Context, acc sdk.AccAddress) error
+	CreateOrderBook(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		baseDenom, quoteDenom string,
+		priceTick *types.Price,
+		quantityStep, minQuantity *sdkmath.Int,
+	) error
+	UpdateOrderBook(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		baseDenom, quoteDenom string,
+		priceTick *types.Price,
+		quantityStep, minQuantity *sdkmath.Int,
+	) error
+	UpdateOrderBookStatus(
+		ctx sdk.Context, sender sdk.AccAddress, baseDenom, quoteDenom string, status types.OrderBookStatus,
+	) error
+	SwapExactIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		coinIn sdk.Coin,
+		denomOut string,
+		route []string,
+		minAmountOut sdkmath.Int,
+	) (sdk.Coin, sdk.Coin, error)
+	SwapExactOut(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denomIn string,
+		coinOut sdk.Coin,
+		route []string,
+		maxAmountIn sdkmath.Int,
+	) (sdk.Coin, sdk.Coin, error)
+	FundRewardProgram(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		baseDenom, quoteDenom string,
+		amount sdk.Coin,
+		rewardPerEpoch sdkmath.Int,
+		maxTicks uint32,
+		minQuantity sdkmath.Int,
+	) error
+	ClaimRewards(ctx sdk.Context, acc sdk.AccAddress) (sdk.Coins, error)
+	SetOrderQuota(ctx sdk.Context, acc sdk.AccAddress, extraOrdersPerDenom uint64) error
 }
 
 // MsgServer serves grpc tx requests for dex module.
@@ -54,6 +111,18 @@ func (ms MsgServer) PlaceOrder(ctx context.Context, msg *types.MsgPlaceOrder) (*
 	return &types.EmptyResponse{}, nil
 }
 
+// ReplaceOrder changes the price and/or the quantity of the order.
+func (ms MsgServer) ReplaceOrder(ctx context.Context, msg *types.MsgReplaceOrder) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.ReplaceOrder(
+		sdk.UnwrapSDKContext(ctx), sender, msg.ID, msg.Price, msg.Quantity,
+	)
+}
+
 // CancelOrder cancels order and unlock locked balance.
 func (ms MsgServer) CancelOrder(ctx context.Context, msg *types.MsgCancelOrder) (*types.EmptyResponse, error) {
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -80,3 +149,221 @@ func (ms MsgServer) CancelOrdersByDenom(
 
 	return &types.EmptyResponse{}, ms.keeper.CancelOrdersByDenom(sdk.UnwrapSDKContext(ctx), sender, acc, msg.Denom)
 }
+
+// BatchPlaceOrders places multiple orders on orderbook.
+func (ms MsgServer) BatchPlaceOrders(
+	ctx context.Context, msg *types.MsgBatchPlaceOrders,
+) (*types.MsgBatchPlaceOrdersResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	orders, err := types.NewOrdersFromMsgBatchPlaceOrders(*msg)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := ms.keeper.BatchPlaceOrders(sdk.UnwrapSDKContext(ctx), sender, orders, msg.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchPlaceOrdersResponse{Results: results}, nil
+}
+
+// BatchCancelOrders cancels multiple orders and unlock locked balances.
+func (ms MsgServer) BatchCancelOrders(
+	ctx context.Context, msg *types.MsgBatchCancelOrders,
+) (*types.MsgBatchCancelOrdersResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	results, err := ms.keeper.BatchCancelOrders(sdk.UnwrapSDKContext(ctx), sender, msg.IDs, msg.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchCancelOrdersResponse{Results: results}, nil
+}
+
+// CancelAllOrders cancels the orders of the sender matching the filters and unlock locked balances.
+func (ms MsgServer) CancelAllOrders(
+	ctx context.Context, msg *types.MsgCancelAllOrders,
+) (*types.MsgCancelAllOrdersResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	ids, err := ms.keeper.CancelAllOrders(sdk.UnwrapSDKContext(ctx), sender, msg.MatchesOrder, msg.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAllOrdersResponse{IDs: ids}, nil
+}
+
+// SetDeadManSwitch arms, updates or disarms the dead man's switch of the sender.
+func (ms MsgServer) SetDeadManSwitch(
+	ctx context.Context, msg *types.MsgSetDeadManSwitch,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.SetDeadManSwitch(sdk.UnwrapSDKContext(ctx), sender, msg.TimeoutBlocks)
+}
+
+// Heartbeat refreshes the dead man's switch of the sender.
+func (ms MsgServer) Heartbeat(ctx context.Context, msg *types.MsgHeartbeat) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.Heartbeat(sdk.UnwrapSDKContext(ctx), sender)
+}
+
+// CreateOrderBook registers the order book pair.
+func (ms MsgServer) CreateOrderBook(ctx context.Context, msg *types.MsgCreateOrderBook) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.CreateOrderBook(
+		sdk.UnwrapSDKContext(ctx),
+		sender,
+		msg.BaseDenom,
+		msg.QuoteDenom,
+		msg.PriceTick,
+		msg.QuantityStep,
+		msg.MinQuantity,
+	)
+}
+
+// UpdateOrderBook updates the price tick, quantity step and min quantity of the order book.
+func (ms MsgServer) UpdateOrderBook(ctx context.Context, msg *types.MsgUpdateOrderBook) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.UpdateOrderBook(
+		sdk.UnwrapSDKContext(ctx),
+		sender,
+		msg.BaseDenom,
+		msg.QuoteDenom,
+		msg.PriceTick,
+		msg.QuantityStep,
+		msg.MinQuantity,
+	)
+}
+
+// UpdateOrderBookStatus pauses, resumes or delists the order book pair.
+func (ms MsgServer) UpdateOrderBookStatus(
+	ctx context.Context, msg *types.MsgUpdateOrderBookStatus,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.UpdateOrderBookStatus(
+		sdk.UnwrapSDKContext(ctx), sender, msg.BaseDenom, msg.QuoteDenom, msg.Status,
+	)
+}
+
+// SwapExactIn swaps the exact input coin to the output denom through the order books.
+func (ms MsgServer) SwapExactIn(
+	ctx context.Context, msg *types.MsgSwapExactIn,
+) (*types.MsgSwapExactInResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	coinIn, coinOut, err := ms.keeper.SwapExactIn(
+		sdk.UnwrapSDKContext(ctx), sender, msg.CoinIn, msg.DenomOut, msg.Route, msg.MinAmountOut,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactInResponse{CoinIn: coinIn, CoinOut: coinOut}, nil
+}
+
+// SwapExactOut swaps the input denom to the exact output coin through the order books.
+func (ms MsgServer) SwapExactOut(
+	ctx context.Context, msg *types.MsgSwapExactOut,
+) (*types.MsgSwapExactOutResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	coinIn, coinOut, err := ms.keeper.SwapExactOut(
+		sdk.UnwrapSDKContext(ctx), sender, msg.DenomIn, msg.CoinOut, msg.Route, msg.MaxAmountIn,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactOutResponse{CoinIn: coinIn, CoinOut: coinOut}, nil
+}
+
+// FundRewardProgram funds the reward program of the order book.
+func (ms MsgServer) FundRewardProgram(
+	ctx context.Context, msg *types.MsgFundRewardProgram,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.FundRewardProgram(
+		sdk.UnwrapSDKContext(ctx),
+		sender,
+		msg.BaseDenom,
+		msg.QuoteDenom,
+		msg.Amount,
+		msg.RewardPerEpoch,
+		msg.MaxTicks,
+		msg.MinQuantity,
+	)
+}
+
+// ClaimRewards claims the rewards accrued by the sender in the reward programs.
+func (ms MsgServer) ClaimRewards(
+	ctx context.Context, msg *types.MsgClaimRewards,
+) (*types.MsgClaimRewardsResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	amount, err := ms.keeper.ClaimRewards(sdk.UnwrapSDKContext(ctx), sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimRewardsResponse{Amount: amount}, nil
+}
+
+// SetOrderQuota sets the additional number of orders per denom the sender can have.
+func (ms MsgServer) SetOrderQuota(ctx context.Context, msg *types.MsgSetOrderQuota) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	if err := ms.keeper.SetOrderQuota(sdk.UnwrapSDKContext(ctx), sender, msg.ExtraOrdersPerDenom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
@@ -25,6 +25,28 @@ func validatePriceTick(price *big.Rat, baseURA, quoteURA sdkmath.LegacyDec, pric
 	return nil
 }
 
+// validateOrderBookPriceTick validates the price against the price tick override of the order book if set, or against
+// the price tick computed from the base and quote denoms unified ref amounts.
+func validateOrderBookPriceTick(
+	price *big.Rat,
+	orderBookData types.OrderBookData,
+	baseURA, quoteURA sdkmath.LegacyDec,
+	priceTickExponent int32,
+) error {
+	if orderBookData.PriceTick == nil {
+		return validatePriceTick(price, baseURA, quoteURA, priceTickExponent)
+	}
+	if !isPriceTickValid(price, orderBookData.PriceTick.Rat()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"invalid price, has to be multiple of price tick: %s",
+			orderBookData.PriceTick.String(),
+		)
+	}
+
+	return nil
+}
+
 func isPriceTickValid(price *big.Rat, priceTick *big.Rat) bool {
 	_, remainder := cbig.RatQuoWithIntRemainder(price, priceTick)
 	return cbig.IntEqZero(remainder)
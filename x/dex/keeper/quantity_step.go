@@ -27,6 +27,28 @@ func validateQuantityStep(quantity *big.Int, baseURA sdkmath.LegacyDec, quantity
 	return nil
 }
 
+// validateOrderBookQuantityStep validates the quantity against the quantity step override of the order book if set,
+// or against the quantity step computed from the base denom unified ref amount.
+func validateOrderBookQuantityStep(
+	quantity *big.Int,
+	orderBookData types.OrderBookData,
+	baseURA sdkmath.LegacyDec,
+	quantityStepExponent int32,
+) error {
+	if orderBookData.QuantityStep == nil {
+		return validateQuantityStep(quantity, baseURA, quantityStepExponent)
+	}
+	if !isQuantityStepValid(quantity, orderBookData.QuantityStep.BigInt()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"invalid quantity, has to be multiple of quantity step: %s",
+			orderBookData.QuantityStep.String(),
+		)
+	}
+
+	return nil
+}
+
 func isQuantityStepValid(quantity *big.Int, quantityStep *big.Int) bool {
 	remainder := cbig.IntRem(quantity, quantityStep)
 	return cbig.IntEqZero(remainder)
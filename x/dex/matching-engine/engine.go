@@ -57,5 +57,6 @@ func convertOrderToOrderBookRecord(
 		AccountNumber:             accNumber,
 		RemainingBaseQuantity:     order.Quantity,
 		RemainingSpendableBalance: remainingBalance,
+		AllOrNone:                 order.AllOrNone,
 	}
 }
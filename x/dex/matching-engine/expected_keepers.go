@@ -9,6 +9,8 @@ import (
 // OrderBookQueue is an interface which returns the next order with the best price.
 type OrderBookQueue interface {
 	Next() (types.OrderBookRecord, bool, error)
+	// Requeue puts the refreshed iceberg record back to the queue behind the records with the same price.
+	Requeue(record types.OrderBookRecord)
 }
 
 // DEXKeeper exposes methods of dex module needed by the matching engine.
@@ -3,6 +3,7 @@ package matchingengine
 import (
 	"math/big"
 
+	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -22,22 +23,54 @@ type Trade struct {
 	TakerSpends   *big.Int
 }
 
-// MatchOrder matches an incoming order against the orders present in the order book storage.
+// PriceBand is the range of the trade prices allowed by the circuit breaker, the prices are expressed in the order
+// book of the taker order.
+type PriceBand struct {
+	ReferencePrice *big.Rat
+	MinPrice       *big.Rat
+	MaxPrice       *big.Rat
+}
+
+// Contains returns true if the price is within the band.
+func (pb PriceBand) Contains(price *big.Rat) bool {
+	return cbig.RatGTE(price, pb.MinPrice) && cbig.RatLTE(price, pb.MaxPrice)
+}
+
+// AuctionPrice is the clearing price of the opening auction, all the auction trades are executed at it.
+type AuctionPrice struct {
+	OrderBookID uint32
+	Price       types.Price
+}
+
+// ratFor returns the clearing price expressed in the order book.
+func (ap AuctionPrice) ratFor(orderBookID uint32) *big.Rat {
+	if orderBookID == ap.OrderBookID {
+		return ap.Price.Rat()
+	}
+
+	return cbig.RatInv(ap.Price.Rat())
+}
+
+// MatchOrder matches an incoming order against the orders present in the order book storage. If the price band is
+// provided, the matching is stopped on the first trade with the price out of the band.
 func (me MatchingEngine) MatchOrder(
 	ctx sdk.Context,
 	accNumber uint64,
 	orderBookID uint32,
 	takerOrder types.Order,
 	initialRemainingBalance sdkmath.Int,
+	priceBand *PriceBand,
 ) (MatchingResult, error) {
 	mr, err := NewMatchingResult(takerOrder)
 	if err != nil {
 		return MatchingResult{}, err
 	}
 
+	mr.TakerOrderReducedEvent.OrderBookID = orderBookID
 	takerRecord := convertOrderToOrderBookRecord(accNumber, orderBookID, takerOrder, initialRemainingBalance)
 
 	takerIsFilled := false
+	var crossingRecord *types.OrderBookRecord
 	for {
 		makerRecord, matches, err := me.obq.Next()
 		if err != nil {
@@ -46,7 +79,33 @@ func (me MatchingEngine) MatchOrder(
 		if !matches {
 			break
 		}
-		takerIsFilled, err = me.matchRecords(ctx, &mr, &takerRecord, &makerRecord, takerOrder)
+		if crossingRecord == nil {
+			crossingRecord = &makerRecord
+		}
+		if takerOrder.TimeInForce == types.TIME_IN_FORCE_POST_ONLY {
+			return MatchingResult{}, sdkerrors.Wrapf(
+				types.ErrPostOnlyOrderMatched,
+				"order %s crosses the order book at price %s",
+				takerOrder.ID, makerRecord.Price.String(),
+			)
+		}
+		if priceBand != nil {
+			tradePrice := makerRecord.Price.Rat()
+			if makerRecord.Side == takerRecord.Side {
+				// the maker record is from the inverted order book
+				tradePrice = cbig.RatInv(tradePrice)
+			}
+			if !priceBand.Contains(tradePrice) {
+				me.logger.Debug(
+					"Circuit breaker triggered.",
+					"makerRecord", makerRecord.String(),
+					"tradePrice", tradePrice.RatString(),
+				)
+				mr.SetCircuitBreakerTriggered(*priceBand, tradePrice)
+				break
+			}
+		}
+		takerIsFilled, err = me.matchRecords(ctx, &mr, &takerRecord, &makerRecord, takerOrder, nil)
 		if err != nil {
 			return MatchingResult{}, err
 		}
@@ -55,17 +114,89 @@ func (me MatchingEngine) MatchOrder(
 		}
 	}
 
-	mr.TakerIsFilled = takerIsFilled
+	mr.TakerIsFilled = takerIsFilled && !mr.TakerIsCanceled
 	mr.TakerRecord = takerRecord
 
+	// the all-or-none order isn't executed partially, so if it crosses the order book, but isn't filled by the taker
+	// pass, it's rejected the same way as the crossing post-only order, since otherwise it would rest in the order book
+	// crossing the opposite side
+	if takerOrder.AllOrNone && !mr.TakerIsFilled && !mr.TakerIsCanceled && crossingRecord != nil {
+		return MatchingResult{}, sdkerrors.Wrapf(
+			types.ErrAllOrNoneOrderNotFilled,
+			"order %s crosses the order book at price %s",
+			takerOrder.ID, crossingRecord.Price.String(),
+		)
+	}
+
 	return mr, nil
 }
 
+// NewUnmatchedResult returns the matching result of the order which isn't matched, so the order is saved to the order
+// book with the full quantity.
+func NewUnmatchedResult(
+	accNumber uint64,
+	orderBookID uint32,
+	takerOrder types.Order,
+	initialRemainingBalance sdkmath.Int,
+) (MatchingResult, error) {
+	mr, err := NewMatchingResult(takerOrder)
+	if err != nil {
+		return MatchingResult{}, err
+	}
+	mr.TakerOrderReducedEvent.OrderBookID = orderBookID
+	mr.TakerRecord = convertOrderToOrderBookRecord(accNumber, orderBookID, takerOrder, initialRemainingBalance)
+
+	return mr, nil
+}
+
+// MatchAuctionOrder matches the order saved to the order book during the opening auction against the opposite orders
+// provided by the order book queue. All the trades are executed at the clearing price of the auction.
+func (me MatchingEngine) MatchAuctionOrder(
+	ctx sdk.Context,
+	takerRecord types.OrderBookRecord,
+	takerOrder types.Order,
+	auctionPrice AuctionPrice,
+) (MatchingResult, error) {
+	mr, err := NewMatchingResult(takerOrder)
+	if err != nil {
+		return MatchingResult{}, err
+	}
+	mr.TakerOrderReducedEvent.OrderBookID = takerRecord.OrderBookID
+
+	takerIsFilled := false
+	for {
+		makerRecord, matches, err := me.obq.Next()
+		if err != nil {
+			return MatchingResult{}, err
+		}
+		if !matches {
+			break
+		}
+		takerIsFilled, err = me.matchRecords(ctx, &mr, &takerRecord, &makerRecord, takerOrder, &auctionPrice)
+		if err != nil {
+			return MatchingResult{}, err
+		}
+		if takerIsFilled {
+			break
+		}
+	}
+
+	mr.TakerIsFilled = takerIsFilled && !mr.TakerIsCanceled
+	mr.TakerRecord = takerRecord
+
+	return mr, nil
+}
+
+// matchRecords matches the taker record with the maker record at the maker price, or at the clearing price if the
+// auction price is provided.
+//
+//nolint:funlen
 func (me MatchingEngine) matchRecords(
 	ctx sdk.Context,
 	mr *MatchingResult,
 	takerRecord, makerRecord *types.OrderBookRecord,
 	takerOrder types.Order,
+	auctionPrice *AuctionPrice,
 ) (bool, error) {
 	me.logger.Debug(
 		"Matching OB records.",
@@ -82,7 +213,31 @@ func (me MatchingEngine) matchRecords(
 
 	takerRecordForMatching := newMatchingOBRecord(takerRecord, false)
 	makerRecordForMatching := newMatchingOBRecord(makerRecord, isMakerInverted)
-	trade, closeResult := match(takerRecordForMatching, makerRecordForMatching)
+	tradePrice := makerRecordForMatching.Price
+	if auctionPrice != nil {
+		tradePrice = auctionPrice.ratFor(takerRecord.OrderBookID)
+		// the quantity of the inverted maker record is converted with the clearing price, since the record might receive
+		// only its remaining base quantity
+		if isMakerInverted {
+			makerRecordForMatching.BaseQuantity = cbig.RatMul(
+				cbig.NewRatFromBigInt(makerRecord.RemainingBaseQuantity.BigInt()), cbig.RatInv(tradePrice),
+			)
+		}
+	}
+	trade, closeResult := matchAtPrice(takerRecordForMatching, makerRecordForMatching, tradePrice)
+
+	// the all-or-none maker record which can't be filled entirely is skipped, and the matching continues with the next
+	// record
+	if makerRecord.AllOrNone && closeResult != closeMaker && closeResult != closeBoth {
+		me.logger.Debug("Skipping all-or-none maker record.", "makerRecord", makerRecord.String())
+		return false, nil
+	}
+
+	if takerRecord.AccountNumber == makerRecord.AccountNumber &&
+		takerOrder.SelfTradePrevention != types.SELF_TRADE_PREVENTION_UNSPECIFIED {
+		return me.preventSelfTrade(ctx, mr, takerRecord, makerRecord, takerOrder, takerReceivesDenom, takerSpendsDenom)
+	}
+
 	me.logger.Debug(
 		"Matching result.",
 		"trade", trade,
@@ -94,18 +249,175 @@ func (me MatchingEngine) matchRecords(
 	if err != nil {
 		return false, err
 	}
-	mr.SendFromTaker(
+
+	if !cbig.IntEqZero(trade.BaseQuantity) {
+		// the trade price is the maker price, so it's expressed in the maker order book
+		tradeOrderBookID, tradeOrderBookPrice, isTradeOrderBookInverted := makerRecord.OrderBookID,
+			makerRecord.Price, isMakerInverted
+		// the auction trade price is the clearing price, so it's expressed in the order book of the auction
+		if auctionPrice != nil {
+			tradeOrderBookID, tradeOrderBookPrice, isTradeOrderBookInverted = auctionPrice.OrderBookID,
+				auctionPrice.Price, auctionPrice.OrderBookID != takerRecord.OrderBookID
+		}
+		mr.SetLastTrade(tradeOrderBookID, tradeOrderBookPrice, takerOrder.Sequence)
+		mr.AddTradeEvent(newTradeEvent(
+			trade, takerOrder, makerAddr, makerRecord, tradeOrderBookID, tradeOrderBookPrice, isTradeOrderBookInverted,
+		))
+	}
+
+	takerSpendsCoin := sdk.NewCoin(takerSpendsDenom, sdkmath.NewIntFromBigInt(trade.TakerSpends))
+	// the maker receives the taker spent coin which is expected by the maker, but at the clearing price the maker
+	// might receive more than expected, so the expected to receive is decreased by the executed part of the maker
+	makerExpectedToReceiveCoin := takerSpendsCoin
+	if auctionPrice != nil {
+		expectedToReceiveBefore, err := types.ComputeLimitOrderExpectedToReceiveAmount(
+			makerRecord.Side, makerRecord.TotalRemainingBaseQuantity(), makerRecord.Price,
+		)
+		if err != nil {
+			return false, err
+		}
+		reduceRecords(takerRecord, makerRecord, trade, isMakerInverted)
+		expectedToReceiveAfter, err := types.ComputeLimitOrderExpectedToReceiveAmount(
+			makerRecord.Side, makerRecord.TotalRemainingBaseQuantity(), makerRecord.Price,
+		)
+		if err != nil {
+			return false, err
+		}
+		makerExpectedToReceiveCoin = sdk.NewCoin(takerSpendsDenom, expectedToReceiveBefore.Sub(expectedToReceiveAfter))
+	} else {
+		reduceRecords(takerRecord, makerRecord, trade, isMakerInverted)
+	}
+
+	makerEventIndex := mr.SendFromTaker(
 		makerAddr,
 		makerRecord.OrderID,
 		makerRecord.OrderSequence,
-		sdk.NewCoin(takerSpendsDenom, sdkmath.NewIntFromBigInt(trade.TakerSpends)),
+		takerSpendsCoin,
+		makerExpectedToReceiveCoin,
 	)
 	mr.SendFromMaker(
 		makerAddr,
-		makerRecord.OrderID,
+		makerEventIndex,
 		sdk.NewCoin(takerReceivesDenom, sdkmath.NewIntFromBigInt(trade.TakerReceives)),
 	)
 
+	makerBaseDenom, makerQuoteDenom := takerOrder.BaseDenom, takerOrder.QuoteDenom
+	if isMakerInverted {
+		makerBaseDenom, makerQuoteDenom = makerQuoteDenom, makerBaseDenom
+	}
+	mr.SetMakerOrderReducedRecord(makerEventIndex, *makerRecord, makerBaseDenom, makerQuoteDenom)
+
+	me.logger.Debug(
+		"Matched OB records after reduction.",
+		"takerRecord", takerRecord.String(),
+		"makerRecord", makerRecord.String(),
+	)
+
+	// Close, refresh or update maker record
+	if closeResult == closeMaker || closeResult == closeBoth || !isOrderRecordExecutableAsMaker(makerRecord) {
+		if err := me.closeOrRefreshMakerRecord(
+			ctx, mr, makerAddr, makerRecord, takerReceivesDenom, takerSpendsDenom,
+		); err != nil {
+			return false, err
+		}
+	} else {
+		mr.UpdateRecord(*makerRecord)
+	}
+
+	// We continue only if closeResult shouldn't close the taker record
+	return closeResult == closeTaker || closeResult == closeBoth, nil
+}
+
+// preventSelfTrade applies the taker order self-trade prevention instead of matching the records of the same creator.
+// It returns true if the taker order is canceled, so the matching must be stopped.
+func (me MatchingEngine) preventSelfTrade(
+	ctx sdk.Context,
+	mr *MatchingResult,
+	takerRecord, makerRecord *types.OrderBookRecord,
+	takerOrder types.Order,
+	takerReceivesDenom, takerSpendsDenom string,
+) (bool, error) {
+	evt := types.EventSelfTradePrevented{
+		Creator:                  takerOrder.Creator,
+		SelfTradePrevention:      takerOrder.SelfTradePrevention,
+		TakerOrderID:             takerOrder.ID,
+		TakerOrderSequence:       takerOrder.Sequence,
+		MakerOrderID:             makerRecord.OrderID,
+		MakerOrderSequence:       makerRecord.OrderSequence,
+		DecrementedBaseQuantity:  sdkmath.ZeroInt(),
+		MakerVisibleBaseQuantity: sdkmath.ZeroInt(),
+	}
+
+	// the maker and the taker are the same account
+	makerAddr := mr.TakerAddress
+	switch takerOrder.SelfTradePrevention {
+	case types.SELF_TRADE_PREVENTION_CANCEL_NEWEST:
+		evt.TakerCanceled = true
+	case types.SELF_TRADE_PREVENTION_CANCEL_OLDEST:
+		evt.MakerCanceled = true
+	case types.SELF_TRADE_PREVENTION_CANCEL_BOTH:
+		evt.TakerCanceled = true
+		evt.MakerCanceled = true
+	case types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL:
+		isMakerInverted := takerRecord.Side == makerRecord.Side
+		trade, closeResult := match(newMatchingOBRecord(takerRecord, false), newMatchingOBRecord(makerRecord, isMakerInverted))
+		reduceRecords(takerRecord, makerRecord, trade, isMakerInverted)
+		// the decremented part of the maker order isn't executed, so its limits are released
+		mr.DecreaseMakerLimits(
+			makerAddr,
+			sdk.NewCoins(sdk.NewCoin(takerReceivesDenom, sdkmath.NewIntFromBigInt(trade.TakerReceives))),
+			sdk.NewCoin(takerSpendsDenom, sdkmath.NewIntFromBigInt(trade.TakerSpends)),
+		)
+
+		evt.DecrementedBaseQuantity = sdkmath.NewIntFromBigInt(trade.BaseQuantity)
+		evt.TakerCanceled = closeResult == closeTaker || closeResult == closeBoth
+		makerDecremented := closeResult == closeMaker || closeResult == closeBoth ||
+			!isOrderRecordExecutableAsMaker(makerRecord)
+		switch {
+		case !makerDecremented:
+			evt.MakerVisibleBaseQuantity = makerRecord.RemainingBaseQuantity
+			mr.UpdateRecord(*makerRecord)
+		case makerRecord.HasHiddenBaseQuantity():
+			// the visible quantity of the iceberg order is decremented, so it's refreshed from the hidden quantity
+			if err := me.closeOrRefreshMakerRecord(
+				ctx, mr, makerAddr, makerRecord, takerReceivesDenom, takerSpendsDenom,
+			); err != nil {
+				return false, err
+			}
+		default:
+			evt.MakerCanceled = true
+		}
+	default:
+		return false, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "unsupported self-trade prevention: %s", takerOrder.SelfTradePrevention.String(),
+		)
+	}
+
+	me.logger.Debug(
+		"Self-trade prevented.",
+		"takerRecord", takerRecord.String(),
+		"makerRecord", makerRecord.String(),
+		"event", evt.String(),
+	)
+
+	if evt.MakerCanceled {
+		if err := me.closeMakerRecord(
+			ctx, mr, makerAddr, makerRecord, takerReceivesDenom, takerSpendsDenom,
+		); err != nil {
+			return false, err
+		}
+	}
+	mr.AddSelfTradeEvent(evt)
+
+	if evt.TakerCanceled {
+		mr.TakerIsCanceled = true
+	}
+
+	return evt.TakerCanceled, nil
+}
+
+// reduceRecords reduces the taker and maker records by the trade.
+func reduceRecords(takerRecord, makerRecord *types.OrderBookRecord, trade Trade, isMakerInverted bool) {
 	// Reduce taker
 	takerRecord.RemainingBaseQuantity = takerRecord.RemainingBaseQuantity.Sub(
 		sdkmath.NewIntFromBigInt(trade.BaseQuantity))
@@ -124,41 +436,75 @@ func (me MatchingEngine) matchRecords(
 		makerRecord.RemainingSpendableBalance = makerRecord.RemainingSpendableBalance.Sub(
 			sdkmath.NewIntFromBigInt(trade.TakerReceives))
 	}
+}
 
-	me.logger.Debug(
-		"Matched OB records after reduction.",
-		"takerRecord", takerRecord.String(),
-		"makerRecord", makerRecord.String(),
-	)
-
-	// Close or update maker record
-	if closeResult == closeMaker || closeResult == closeBoth || !isOrderRecordExecutableAsMaker(makerRecord) {
-		lockedCoins, expectedToReceiveCoin, err := me.getMakerLockedAndExpectedToReceiveCoins(
-			ctx,
-			makerRecord,
-			takerReceivesDenom,
-			takerSpendsDenom,
-		)
+// closeOrRefreshMakerRecord refreshes the visible quantity of the iceberg order from the hidden quantity if it's
+// possible, otherwise it closes the maker record.
+func (me MatchingEngine) closeOrRefreshMakerRecord(
+	ctx sdk.Context,
+	mr *MatchingResult,
+	makerAddr sdk.AccAddress,
+	makerRecord *types.OrderBookRecord,
+	makerSpendsDenom, makerReceivesDenom string,
+) error {
+	if makerRecord.HasHiddenBaseQuantity() {
+		orderData, err := me.dexKeeper.GetOrderData(ctx, makerRecord.OrderSequence)
 		if err != nil {
-			return false, err
+			return err
+		}
+		if orderData.DisplayQuantity == nil {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidState, "display quantity isn't set for the iceberg order %d", makerRecord.OrderSequence,
+			)
 		}
+		makerRecord.SetDisplayQuantity(*orderData.DisplayQuantity)
+		if isOrderRecordExecutableAsMaker(makerRecord) {
+			mr.RefreshRecord(makerAddr, *makerRecord)
+			// the refreshed record loses the time priority, but it still crosses the taker order, so it's matched after
+			// the records with the same price
+			me.obq.Requeue(*makerRecord)
+			return nil
+		}
+	}
 
-		mr.DecreaseMakerLimits(makerAddr, lockedCoins, expectedToReceiveCoin)
-		mr.RemoveRecord(makerAddr, makerRecord)
-	} else {
-		mr.UpdateRecord(*makerRecord)
+	return me.closeMakerRecord(ctx, mr, makerAddr, makerRecord, makerSpendsDenom, makerReceivesDenom)
+}
+
+// closeMakerRecord releases the maker record limits and registers the record for removal.
+func (me MatchingEngine) closeMakerRecord(
+	ctx sdk.Context,
+	mr *MatchingResult,
+	makerAddr sdk.AccAddress,
+	makerRecord *types.OrderBookRecord,
+	makerSpendsDenom, makerReceivesDenom string,
+) error {
+	lockedCoins, expectedToReceiveCoin, err := me.getMakerLockedAndExpectedToReceiveCoins(
+		ctx,
+		makerRecord,
+		makerSpendsDenom,
+		makerReceivesDenom,
+	)
+	if err != nil {
+		return err
 	}
 
-	// We continue only if closeResult shouldn't close the taker record
-	return closeResult == closeTaker || closeResult == closeBoth, nil
+	mr.DecreaseMakerLimits(makerAddr, lockedCoins, expectedToReceiveCoin)
+	mr.RemoveRecord(makerAddr, makerRecord)
+
+	return nil
 }
 
 func match(takerRecord, makerRecord OBRecord) (Trade, CloseResult) {
+	return matchAtPrice(takerRecord, makerRecord, makerRecord.Price)
+}
+
+// matchAtPrice matches the records at the price, the price must be not worse than the prices of both records.
+func matchAtPrice(takerRecord, makerRecord OBRecord, price *big.Rat) (Trade, CloseResult) {
 	if takerRecord.Side == makerRecord.Side {
 		return Trade{}, noneCloseType
 	}
 
-	trade := Trade{Price: makerRecord.Price}
+	trade := Trade{Price: price}
 
 	takerMaxBaseQuantityRat := takerRecord.MaxBaseQuantityForPrice(trade.Price)
 	makerMaxBaseQuantityRat := makerRecord.MaxBaseQuantityForPrice(trade.Price)
@@ -199,6 +545,42 @@ func match(takerRecord, makerRecord OBRecord) (Trade, CloseResult) {
 	return trade, closeRes
 }
 
+// newTradeEvent creates the trade event expressed in the order book of the trade price.
+func newTradeEvent(
+	trade Trade,
+	takerOrder types.Order,
+	makerAddr sdk.AccAddress,
+	makerRecord *types.OrderBookRecord,
+	orderBookID uint32,
+	price types.Price,
+	isOrderBookInverted bool,
+) types.EventTrade {
+	evt := types.EventTrade{
+		OrderBookID:   orderBookID,
+		BaseDenom:     takerOrder.BaseDenom,
+		QuoteDenom:    takerOrder.QuoteDenom,
+		Price:         price,
+		BaseQuantity:  sdkmath.NewIntFromBigInt(trade.BaseQuantity),
+		QuoteQuantity: sdkmath.NewIntFromBigInt(trade.QuoteQuantity),
+		TakerSide:     takerOrder.Side,
+		Maker:         makerAddr.String(),
+		MakerOrderID:  makerRecord.OrderID,
+		Taker:         takerOrder.Creator,
+		TakerOrderID:  takerOrder.ID,
+	}
+	if isOrderBookInverted {
+		evt.BaseDenom, evt.QuoteDenom = evt.QuoteDenom, evt.BaseDenom
+		evt.BaseQuantity, evt.QuoteQuantity = evt.QuoteQuantity, evt.BaseQuantity
+		// the taker side is opposite in the inverted order book
+		evt.TakerSide = types.SIDE_SELL
+		if takerOrder.Side == types.SIDE_SELL {
+			evt.TakerSide = types.SIDE_BUY
+		}
+	}
+
+	return evt
+}
+
 func (me MatchingEngine) getMakerLockedAndExpectedToReceiveCoins(
 	ctx sdk.Context,
 	makerRecord *types.OrderBookRecord,
@@ -219,7 +601,7 @@ func (me MatchingEngine) getMakerLockedAndExpectedToReceiveCoins(
 	}
 
 	expectedToReceiveAmt, err := types.ComputeLimitOrderExpectedToReceiveAmount(
-		makerRecord.Side, makerRecord.RemainingBaseQuantity, makerRecord.Price,
+		makerRecord.Side, makerRecord.TotalRemainingBaseQuantity(), makerRecord.Price,
 	)
 	if err != nil {
 		return nil, sdk.Coin{}, err
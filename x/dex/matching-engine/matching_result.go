@@ -1,6 +1,8 @@
 package matchingengine
 
 import (
+	"math/big"
+
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,8 +20,20 @@ type MatchingResult struct {
 	MakerOrderReducedEvents []types.EventOrderReduced
 	RecordsToRemove         []RecordToAddress
 	RecordToUpdate          *types.OrderBookRecord
+	RecordsToRefresh        []RecordToAddress
 	TakerIsFilled           bool
+	TakerIsCanceled         bool
 	TakerRecord             types.OrderBookRecord
+	LastTrade               *types.OrderBookLastTrade
+	TradeEvents             []types.EventTrade
+	SelfTradeEvents         []types.EventSelfTradePrevented
+	CircuitBreaker          *CircuitBreakerResult
+}
+
+// CircuitBreakerResult holds the reference price and the price of the trade which triggered the circuit breaker.
+type CircuitBreakerResult struct {
+	ReferencePrice *big.Rat
+	TradePrice     *big.Rat
 }
 
 // NewMatchingResult creates a new instance of MatchingResult.
@@ -55,6 +69,13 @@ func NewMatchingResult(order types.Order) (MatchingResult, error) {
 			Sequence:     order.Sequence,
 			SentCoin:     sdk.NewCoin(order.GetSpendDenom(), sdkmath.ZeroInt()),
 			ReceivedCoin: sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()),
+			Fee:          sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()),
+			BaseDenom:    order.BaseDenom,
+			QuoteDenom:   order.QuoteDenom,
+			Side:         order.Side,
+			Price:        order.Price,
+			// the taker order is visible in the order book only if its remaining part is saved to the order book
+			VisibleBaseQuantity: sdkmath.ZeroInt(),
 		},
 		MakerOrderReducedEvents: make([]types.EventOrderReduced, 0),
 		RecordsToRemove:         make([]RecordToAddress, 0),
@@ -62,23 +83,30 @@ func NewMatchingResult(order types.Order) (MatchingResult, error) {
 	}, nil
 }
 
-// SendFromTaker registers the coin to be sent from taker to maker.
+// SendFromTaker registers the coin to be sent from taker to maker, and the decrease of the coin expected to be
+// received by the maker.
 func (mr *MatchingResult) SendFromTaker(
-	makerAddr sdk.AccAddress, makerOrderID string, makerOrderSequence uint64, coin sdk.Coin,
-) {
+	makerAddr sdk.AccAddress,
+	makerOrderID string,
+	makerOrderSequence uint64,
+	coin, makerExpectedToReceiveCoin sdk.Coin,
+) int {
 	if coin.IsZero() {
-		return
+		return -1
 	}
 
 	mr.FTActions.AddCreatorExpectedToSpend(coin)
 	mr.FTActions.AddSend(mr.TakerAddress, makerAddr, coin)
-	mr.FTActions.AddDecreaseExpectedToReceive(makerAddr, coin)
+	if !makerExpectedToReceiveCoin.IsZero() {
+		mr.FTActions.AddDecreaseExpectedToReceive(makerAddr, makerExpectedToReceiveCoin)
+	}
 
-	mr.updateTakerSendEvents(makerAddr, makerOrderID, makerOrderSequence, coin)
+	return mr.updateTakerSendEvents(makerAddr, makerOrderID, makerOrderSequence, coin)
 }
 
-// SendFromMaker registers the coin to be sent from maker to taker.
-func (mr *MatchingResult) SendFromMaker(makerAddr sdk.AccAddress, makerOrderID string, coin sdk.Coin) {
+// SendFromMaker registers the coin to be sent from maker to taker. The maker event index is the index of the maker
+// order reduced event returned by the SendFromTaker for the same match, or -1 if there is no such event.
+func (mr *MatchingResult) SendFromMaker(makerAddr sdk.AccAddress, makerEventIndex int, coin sdk.Coin) {
 	if coin.IsZero() {
 		return
 	}
@@ -89,7 +117,7 @@ func (mr *MatchingResult) SendFromMaker(makerAddr sdk.AccAddress, makerOrderID s
 	mr.FTActions.AddDecreaseLocked(makerAddr, coin)
 	mr.FTActions.AddSend(makerAddr, mr.TakerAddress, coin)
 
-	mr.updateMakerSendEvents(makerAddr, makerOrderID, coin)
+	mr.updateMakerSendEvents(makerEventIndex, coin)
 }
 
 // DecreaseMakerLimits registers the coins to be unlocked and decreases the expected to receive.
@@ -114,6 +142,23 @@ func (mr *MatchingResult) IncreaseTakerLimitsForRecord(
 	params types.Params,
 	order types.Order,
 	takerRecord *types.OrderBookRecord,
+) error {
+	if err := mr.IncreaseTakerOrderLimitsForRecord(order, takerRecord); err != nil {
+		return err
+	}
+
+	if params.OrderReserve.IsPositive() {
+		mr.FTActions.AddIncreaseLocked(mr.TakerAddress, params.OrderReserve)
+	}
+
+	return nil
+}
+
+// IncreaseTakerOrderLimitsForRecord increases the limits required to execute the remaining part of the taker record
+// without the order reserve.
+func (mr *MatchingResult) IncreaseTakerOrderLimitsForRecord(
+	order types.Order,
+	takerRecord *types.OrderBookRecord,
 ) error {
 	lockedCoin, err := types.ComputeLimitOrderLockedBalance(
 		order.Side, order.BaseDenom, order.QuoteDenom, takerRecord.RemainingBaseQuantity, *order.Price,
@@ -136,52 +181,218 @@ func (mr *MatchingResult) IncreaseTakerLimitsForRecord(
 	mr.FTActions.AddCreatorExpectedToReceive(expectedToReceiveCoin)
 	mr.FTActions.AddIncreaseExpectedToReceive(mr.TakerAddress, expectedToReceiveCoin)
 
-	if params.OrderReserve.IsPositive() {
-		mr.FTActions.AddIncreaseLocked(mr.TakerAddress, params.OrderReserve)
+	return nil
+}
+
+// FeeDiscountProvider returns the share of the fee the account is exempted from.
+type FeeDiscountProvider func(acc sdk.AccAddress) (sdkmath.LegacyDec, error)
+
+// ChargeFees deducts the fees from the coins received by the makers and the taker and registers the fees to be sent
+// to the fee collector. The fee rates are reduced by the fee discounts of the accounts.
+func (mr *MatchingResult) ChargeFees(
+	feeCollector sdk.AccAddress,
+	makerFeeRate, takerFeeRate sdkmath.LegacyDec,
+	feeDiscountProvider FeeDiscountProvider,
+) error {
+	takerFeeRate, err := applyFeeDiscount(mr.TakerAddress, takerFeeRate, feeDiscountProvider)
+	if err != nil {
+		return err
+	}
+
+	for i := range mr.MakerOrderReducedEvents {
+		makerEvt := &mr.MakerOrderReducedEvents[i]
+		makerAddr, err := sdk.AccAddressFromBech32(makerEvt.Creator)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", makerEvt.Creator)
+		}
+
+		makerAccFeeRate, err := applyFeeDiscount(makerAddr, makerFeeRate, feeDiscountProvider)
+		if err != nil {
+			return err
+		}
+		if makerFee := computeFee(makerEvt.ReceivedCoin, makerAccFeeRate); makerFee.IsPositive() {
+			if err := mr.chargeFee(mr.TakerAddress, makerAddr, feeCollector, makerFee); err != nil {
+				return err
+			}
+			makerEvt.Fee = makerEvt.Fee.Add(makerFee)
+		}
+
+		if takerFee := computeFee(makerEvt.SentCoin, takerFeeRate); takerFee.IsPositive() {
+			if err := mr.chargeFee(makerAddr, mr.TakerAddress, feeCollector, takerFee); err != nil {
+				return err
+			}
+			mr.TakerOrderReducedEvent.Fee = mr.TakerOrderReducedEvent.Fee.Add(takerFee)
+		}
 	}
 
 	return nil
 }
 
+func applyFeeDiscount(
+	acc sdk.AccAddress,
+	feeRate sdkmath.LegacyDec,
+	feeDiscountProvider FeeDiscountProvider,
+) (sdkmath.LegacyDec, error) {
+	if !feeRate.IsPositive() {
+		return feeRate, nil
+	}
+	discount, err := feeDiscountProvider(acc)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if !discount.IsPositive() {
+		return feeRate, nil
+	}
+
+	return feeRate.Mul(sdkmath.LegacyOneDec().Sub(discount)), nil
+}
+
+// SetCircuitBreakerTriggered registers the trade price which triggered the circuit breaker.
+func (mr *MatchingResult) SetCircuitBreakerTriggered(priceBand PriceBand, tradePrice *big.Rat) {
+	mr.CircuitBreaker = &CircuitBreakerResult{
+		ReferencePrice: priceBand.ReferencePrice,
+		TradePrice:     tradePrice,
+	}
+}
+
+// SetLastTrade registers the last executed trade.
+func (mr *MatchingResult) SetLastTrade(orderBookID uint32, price types.Price, takerOrderSequence uint64) {
+	mr.LastTrade = &types.OrderBookLastTrade{
+		OrderBookID:   orderBookID,
+		Price:         price,
+		OrderSequence: takerOrderSequence,
+	}
+}
+
+// AddTradeEvent registers the executed trade event.
+func (mr *MatchingResult) AddTradeEvent(evt types.EventTrade) {
+	mr.TradeEvents = append(mr.TradeEvents, evt)
+}
+
+// AddSelfTradeEvent registers the self-trade prevention event.
+func (mr *MatchingResult) AddSelfTradeEvent(evt types.EventSelfTradePrevented) {
+	mr.SelfTradeEvents = append(mr.SelfTradeEvents, evt)
+}
+
 // RemoveRecord registers the record for removal.
 func (mr *MatchingResult) RemoveRecord(creator sdk.AccAddress, record *types.OrderBookRecord) {
+	// the record refreshed and closed in the same matching is removed without the refresh
+	mr.RecordsToRefresh = lo.Filter(mr.RecordsToRefresh, func(item RecordToAddress, _ int) bool {
+		return item.Record.OrderSequence != record.OrderSequence
+	})
 	mr.RecordsToRemove = append(mr.RecordsToRemove, RecordToAddress{
 		Address: creator,
 		Record:  record,
 	})
 }
 
+// RefreshRecord registers the iceberg order record with the refreshed visible quantity for the time priority update.
+// The record refreshed several times in the same matching is registered once with the latest state.
+func (mr *MatchingResult) RefreshRecord(creator sdk.AccAddress, record types.OrderBookRecord) {
+	if mr.updateRecordToRefresh(record) {
+		return
+	}
+	mr.RecordsToRefresh = append(mr.RecordsToRefresh, RecordToAddress{
+		Address: creator,
+		Record:  &record,
+	})
+}
+
+// SetMakerOrderReducedRecord sets the order book data of the maker order reduced event from the reduced maker record.
+// The refreshed iceberg record might be matched several times in the same matching, so the event is found by the index
+// returned by the SendFromTaker for the same match.
+func (mr *MatchingResult) SetMakerOrderReducedRecord(
+	makerEventIndex int,
+	makerRecord types.OrderBookRecord,
+	baseDenom, quoteDenom string,
+) {
+	if makerEventIndex < 0 {
+		return
+	}
+	evt := &mr.MakerOrderReducedEvents[makerEventIndex]
+	evt.OrderBookID = makerRecord.OrderBookID
+	evt.BaseDenom = baseDenom
+	evt.QuoteDenom = quoteDenom
+	evt.Side = makerRecord.Side
+	evt.Price = &makerRecord.Price
+	evt.VisibleBaseQuantity = makerRecord.RemainingBaseQuantity
+}
+
 // UpdateRecord registers the record for update.
 func (mr *MatchingResult) UpdateRecord(record types.OrderBookRecord) {
+	// the refreshed record is saved with the new sequence, so it's updated in the records to refresh
+	if mr.updateRecordToRefresh(record) {
+		return
+	}
 	mr.RecordToUpdate = &record
 }
 
+// updateRecordToRefresh replaces the registered record to refresh with the same sequence, and returns false if there
+// is no such record.
+func (mr *MatchingResult) updateRecordToRefresh(record types.OrderBookRecord) bool {
+	for i := range mr.RecordsToRefresh {
+		if mr.RecordsToRefresh[i].Record.OrderSequence == record.OrderSequence {
+			mr.RecordsToRefresh[i].Record = &record
+			return true
+		}
+	}
+
+	return false
+}
+
 func (mr *MatchingResult) updateTakerSendEvents(
 	makerAddr sdk.AccAddress,
 	makerOrderID string,
 	makerOrderSequence uint64,
 	coin sdk.Coin,
-) {
+) int {
 	mr.TakerOrderReducedEvent.SentCoin = mr.TakerOrderReducedEvent.SentCoin.Add(coin)
 	mr.MakerOrderReducedEvents = append(mr.MakerOrderReducedEvents, types.EventOrderReduced{
-		Creator:      makerAddr.String(),
-		ID:           makerOrderID,
-		Sequence:     makerOrderSequence,
-		ReceivedCoin: coin,
+		Creator:             makerAddr.String(),
+		ID:                  makerOrderID,
+		Sequence:            makerOrderSequence,
+		ReceivedCoin:        coin,
+		Fee:                 sdk.NewCoin(coin.Denom, sdkmath.ZeroInt()),
+		VisibleBaseQuantity: sdkmath.ZeroInt(),
 	})
+
+	return len(mr.MakerOrderReducedEvents) - 1
 }
 
-func (mr *MatchingResult) updateMakerSendEvents(
-	makerAddr sdk.AccAddress,
-	makerOrderID string,
-	coin sdk.Coin,
-) {
+func (mr *MatchingResult) updateMakerSendEvents(makerEventIndex int, coin sdk.Coin) {
 	mr.TakerOrderReducedEvent.ReceivedCoin = mr.TakerOrderReducedEvent.ReceivedCoin.Add(coin)
-	for i := range mr.MakerOrderReducedEvents {
-		// find corresponding event created by `updateTakerSendEvents`
-		if mr.MakerOrderReducedEvents[i].Creator == makerAddr.String() && mr.MakerOrderReducedEvents[i].ID == makerOrderID {
-			mr.MakerOrderReducedEvents[i].SentCoin = coin
-			break
+	// the event is created by `updateTakerSendEvents` for the same match
+	if makerEventIndex >= 0 {
+		mr.MakerOrderReducedEvents[makerEventIndex].SentCoin = coin
+	}
+}
+
+// chargeFee reduces the coin sent from the payer to the receiver by the fee and sends the fee to the fee collector.
+func (mr *MatchingResult) chargeFee(payer, receiver, feeCollector sdk.AccAddress, fee sdk.Coin) error {
+	for i, send := range mr.FTActions.Send {
+		if !send.FromAddress.Equals(payer) || !send.ToAddress.Equals(receiver) || send.Coin.Denom != fee.Denom {
+			continue
+		}
+		if send.Coin.Amount.LT(fee.Amount) {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidState, "fee %s is greater than the sent coin %s", fee.String(), send.Coin.String(),
+			)
 		}
+		mr.FTActions.Send[i].Coin = send.Coin.Sub(fee)
+		mr.FTActions.AddSend(payer, feeCollector, fee)
+		return nil
 	}
+
+	return sdkerrors.Wrapf(
+		types.ErrInvalidState, "coin %s sent from %s to %s not found", fee.Denom, payer.String(), receiver.String(),
+	)
+}
+
+// computeFee returns the fee of the received coin rounded down.
+func computeFee(coin sdk.Coin, feeRate sdkmath.LegacyDec) sdk.Coin {
+	if coin.IsNil() || feeRate.IsNil() {
+		return sdk.Coin{Denom: coin.Denom, Amount: sdkmath.ZeroInt()}
+	}
+
+	return sdk.NewCoin(coin.Denom, feeRate.MulInt(coin.Amount).TruncateInt())
 }
@@ -0,0 +1,59 @@
+package v2
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// DEXKeeper represents dex keeper.
+type DEXKeeper interface {
+	GetParams(ctx sdk.Context) (types.Params, error)
+	SetParams(ctx sdk.Context, params types.Params) error
+}
+
+// MigrateParams sets the zero maker and taker fee rates, the disabled circuit breaker, the default TWAP retention
+// period, the default order expiration sweep gas limit, the default trading volume window, the default reward epoch, the
+// default order quota reserve multiplier, the default reward distribution gas limit and the default opening auction max
+// records, since they are not set in the stored params.
+func MigrateParams(ctx sdk.Context, keeper DEXKeeper) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MakerFeeRate.IsNil() {
+		params.MakerFeeRate = sdkmath.LegacyZeroDec()
+	}
+	if params.TakerFeeRate.IsNil() {
+		params.TakerFeeRate = sdkmath.LegacyZeroDec()
+	}
+	if params.CircuitBreakerMaxPriceDeviation.IsNil() {
+		params.CircuitBreakerMaxPriceDeviation = sdkmath.LegacyZeroDec()
+	}
+	if params.TWAPRetentionPeriod == 0 {
+		params.TWAPRetentionPeriod = types.DefaultTWAPRetentionPeriod
+	}
+	if params.OrderExpirationSweepGasLimit == 0 {
+		params.OrderExpirationSweepGasLimit = types.DefaultOrderExpirationSweepGasLimit
+	}
+	if params.TradingVolumeWindowDays == 0 {
+		params.TradingVolumeWindowDays = types.DefaultTradingVolumeWindowDays
+	}
+	if params.RewardEpochBlocks == 0 {
+		params.RewardEpochBlocks = types.DefaultRewardEpochBlocks
+	}
+	// the missing dec param is decoded as zero
+	if params.OrderQuotaReserveMultiplier.IsNil() || params.OrderQuotaReserveMultiplier.IsZero() {
+		params.OrderQuotaReserveMultiplier = types.DefaultParams().OrderQuotaReserveMultiplier
+	}
+	if params.RewardDistributionGasLimit == 0 {
+		params.RewardDistributionGasLimit = types.DefaultRewardDistributionGasLimit
+	}
+	if params.OpeningAuctionMaxRecords == 0 {
+		params.OpeningAuctionMaxRecords = types.DefaultOpeningAuctionMaxRecords
+	}
+
+	return keeper.SetParams(ctx, params)
+}
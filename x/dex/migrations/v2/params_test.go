@@ -0,0 +1,41 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	v2 "github.com/CoreumFoundation/coreum/v6/x/dex/migrations/v2"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	dexKeeper := testApp.DEXKeeper
+
+	// the params stored before the migration don't have the fee rates, the circuit breaker, the TWAP retention period,
+	// the order expiration sweep gas limit, the trading volume window, the reward epoch, the order quota reserve
+	// multiplier, the reward distribution gas limit and the opening auction max records
+	params := types.DefaultParams()
+	params.MakerFeeRate = sdkmath.LegacyDec{}
+	params.TakerFeeRate = sdkmath.LegacyDec{}
+	params.CircuitBreakerMaxPriceDeviation = sdkmath.LegacyDec{}
+	params.TWAPRetentionPeriod = 0
+	params.OrderExpirationSweepGasLimit = 0
+	params.TradingVolumeWindowDays = 0
+	params.RewardEpochBlocks = 0
+	params.OrderQuotaReserveMultiplier = sdkmath.LegacyDec{}
+	params.RewardDistributionGasLimit = 0
+	params.OpeningAuctionMaxRecords = 0
+	require.NoError(t, dexKeeper.SetParams(ctx, params))
+
+	require.NoError(t, v2.MigrateParams(ctx, dexKeeper))
+
+	params, err := dexKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.ValidateBasic())
+}
@@ -28,7 +28,8 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -85,6 +86,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
+	if err := types.RegisterIndexerHandlerClient(context.Background(), mux, types.NewIndexerClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the module's root tx command.
@@ -138,6 +142,11 @@ func (am AppModule) IsOnePerModuleType() {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the module's invariants.
@@ -158,8 +167,24 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock returns the end blocker for the dex module.
+func (am AppModule) EndBlock(c context.Context) error {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := am.keeper.SweepExpiredOrders(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.UncrossOpeningAuctions(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.DistributeRewards(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.TransferCollectedFees(ctx)
+}
+
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // AppModuleSimulation functions
 
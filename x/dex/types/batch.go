@@ -0,0 +1,87 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// MaxBatchSize is the maximum number of items in the batch message.
+const MaxBatchSize = 100
+
+// Validate validates batch mode.
+func (m BatchMode) Validate() error {
+	switch m {
+	case BATCH_MODE_ALL_OR_NOTHING, BATCH_MODE_BEST_EFFORT:
+		return nil
+	default:
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"only %s and %s batch modes are allowed",
+			BATCH_MODE_ALL_OR_NOTHING.String(), BATCH_MODE_BEST_EFFORT.String(),
+		)
+	}
+}
+
+// NewOrdersFromMsgBatchPlaceOrders creates and validates Orders from MsgBatchPlaceOrders.
+func NewOrdersFromMsgBatchPlaceOrders(msg MsgBatchPlaceOrders) ([]Order, error) {
+	if err := validateBatchSize(len(msg.Orders)); err != nil {
+		return nil, err
+	}
+
+	orders := make([]Order, 0, len(msg.Orders))
+	ids := make(map[string]struct{}, len(msg.Orders))
+	for _, item := range msg.Orders {
+		if _, ok := ids[item.ID]; ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidInput, "duplicated order id %q", item.ID)
+		}
+		ids[item.ID] = struct{}{}
+
+		o, err := NewOrderFromMsgPlaceOrder(MsgPlaceOrder{
+			Sender:              msg.Sender,
+			Type:                item.Type,
+			ID:                  item.ID,
+			BaseDenom:           item.BaseDenom,
+			QuoteDenom:          item.QuoteDenom,
+			Price:               item.Price,
+			Quantity:            item.Quantity,
+			Side:                item.Side,
+			GoodTil:             item.GoodTil,
+			TimeInForce:         item.TimeInForce,
+			Trigger:             item.Trigger,
+			SelfTradePrevention: item.SelfTradePrevention,
+			DisplayQuantity:     item.DisplayQuantity,
+			AllOrNone:           item.AllOrNone,
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid order %q", item.ID)
+		}
+		orders = append(orders, o)
+	}
+
+	return orders, nil
+}
+
+// NewBatchOrderResult returns the result of the batch item.
+func NewBatchOrderResult(orderID string, err error) BatchOrderResult {
+	if err != nil {
+		return BatchOrderResult{
+			ID:    orderID,
+			Error: err.Error(),
+		}
+	}
+
+	return BatchOrderResult{
+		ID:      orderID,
+		Success: true,
+	}
+}
+
+func validateBatchSize(size int) error {
+	if size == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "batch must not be empty")
+	}
+	if size > MaxBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidInput, "batch size %d exceeds the limit %d", size, MaxBatchSize)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultOrderBookDepthLimit is the default number of price levels returned for each order book side.
+	DefaultOrderBookDepthLimit = 20
+	// MaxOrderBookDepthLimit is the max number of price levels returned for each order book side.
+	MaxOrderBookDepthLimit = 100
+)
+
+// NormalizeOrderBookDepthLimit validates the order book depth limit and returns the default limit if it isn't set.
+func NormalizeOrderBookDepthLimit(limit uint32) (uint32, error) {
+	if limit == 0 {
+		return DefaultOrderBookDepthLimit, nil
+	}
+	if limit > MaxOrderBookDepthLimit {
+		return 0, sdkerrors.Wrapf(ErrInvalidInput, "limit must be less than or equal to %d", MaxOrderBookDepthLimit)
+	}
+
+	return limit, nil
+}
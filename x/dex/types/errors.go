@@ -13,4 +13,13 @@ var (
 	ErrInvalidState = sdkerrors.Register(ModuleName, 3, "invalid state")
 	// ErrRecordNotFound is returned when record is not found in the store.
 	ErrRecordNotFound = sdkerrors.Register(ModuleName, 4, "record not found")
+	// ErrPostOnlyOrderMatched is returned when the post-only order would be matched immediately.
+	ErrPostOnlyOrderMatched = sdkerrors.Register(ModuleName, 5, "post-only order would be matched")
+	// ErrOrderBookHalted is returned when the order book is halted by the circuit breaker.
+	ErrOrderBookHalted = sdkerrors.Register(ModuleName, 6, "order book is halted")
+	// ErrOrderBookInAuction is returned when the order can't be placed to the order book in the opening auction.
+	ErrOrderBookInAuction = sdkerrors.Register(ModuleName, 7, "order book is in the opening auction")
+	// ErrAllOrNoneOrderNotFilled is returned when the all-or-none order crosses the order book, but can't be filled
+	// entirely.
+	ErrAllOrNoneOrderNotFilled = sdkerrors.Register(ModuleName, 8, "all-or-none order can't be filled entirely")
 )
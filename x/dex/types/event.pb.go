@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -101,6 +102,21 @@ type EventOrderReduced struct {
 	SentCoin github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=sent_coin,json=sentCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"sent_coin"`
 	// received_coin is coin received during matching.
 	ReceivedCoin github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=received_coin,json=receivedCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"received_coin"`
+	// fee is the trading fee charged from the received coin.
+	Fee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fee"`
+	// order_book_id is the order book ID of the order.
+	OrderBookID uint32 `protobuf:"varint,7,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,8,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,9,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// side is the order side.
+	Side Side `protobuf:"varint,10,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// price is the order price, empty for the market order.
+	Price *Price `protobuf:"bytes,11,opt,name=price,proto3,customtype=Price" json:"price,omitempty"`
+	// visible_base_quantity is the remaining base quantity of the order visible in the order book after the reduction,
+	// it differs from the remaining base quantity only for the iceberg orders.
+	VisibleBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=visible_base_quantity,json=visibleBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"visible_base_quantity"`
 }
 
 func (m *EventOrderReduced) Reset()         { *m = EventOrderReduced{} }
@@ -157,6 +173,34 @@ func (m *EventOrderReduced) GetSequence() uint64 {
 	return 0
 }
 
+func (m *EventOrderReduced) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *EventOrderReduced) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventOrderReduced) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *EventOrderReduced) GetSide() Side {
+	if m != nil {
+		return m.Side
+	}
+	return SIDE_UNSPECIFIED
+}
+
 // EventOrderCreated is emitted when the limit order is saved to the order book.
 type EventOrderCreated struct {
 	// creator is order creator address.
@@ -169,6 +213,19 @@ type EventOrderCreated struct {
 	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
 	// remaining_spendable_balance - is balance up to which user wants to spend to execute the order.
 	RemainingSpendableBalance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_spendable_balance,json=remainingSpendableBalance,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_spendable_balance"`
+	// order_book_id is the order book ID of the order.
+	OrderBookID uint32 `protobuf:"varint,6,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,7,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,8,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// side is the order side.
+	Side Side `protobuf:"varint,9,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// price is the order price.
+	Price Price `protobuf:"bytes,10,opt,name=price,proto3,customtype=Price" json:"price"`
+	// visible_base_quantity is the remaining base quantity of the order visible in the order book, it differs from the
+	// remaining base quantity only for the iceberg orders.
+	VisibleBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=visible_base_quantity,json=visibleBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"visible_base_quantity"`
 }
 
 func (m *EventOrderCreated) Reset()         { *m = EventOrderCreated{} }
@@ -225,6 +282,34 @@ func (m *EventOrderCreated) GetSequence() uint64 {
 	return 0
 }
 
+func (m *EventOrderCreated) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *EventOrderCreated) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventOrderCreated) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *EventOrderCreated) GetSide() Side {
+	if m != nil {
+		return m.Side
+	}
+	return SIDE_UNSPECIFIED
+}
+
 // EventOrderClosed is emitted when the order is closed during matching or manually, and removed from the order book.
 type EventOrderClosed struct {
 	// creator is order creator address.
@@ -237,6 +322,16 @@ type EventOrderClosed struct {
 	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
 	// remaining_spendable_balance - is balance up to which user wants to spend to execute the order.
 	RemainingSpendableBalance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_spendable_balance,json=remainingSpendableBalance,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_spendable_balance"`
+	// order_book_id is the order book ID of the order.
+	OrderBookID uint32 `protobuf:"varint,6,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,7,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,8,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// side is the order side.
+	Side Side `protobuf:"varint,9,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// price is the order price.
+	Price Price `protobuf:"bytes,10,opt,name=price,proto3,customtype=Price" json:"price"`
 }
 
 func (m *EventOrderClosed) Reset()         { *m = EventOrderClosed{} }
@@ -293,384 +388,6971 @@ func (m *EventOrderClosed) GetSequence() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*EventOrderPlaced)(nil), "coreum.dex.v1.EventOrderPlaced")
-	proto.RegisterType((*EventOrderReduced)(nil), "coreum.dex.v1.EventOrderReduced")
-	proto.RegisterType((*EventOrderCreated)(nil), "coreum.dex.v1.EventOrderCreated")
-	proto.RegisterType((*EventOrderClosed)(nil), "coreum.dex.v1.EventOrderClosed")
+func (m *EventOrderClosed) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("coreum/dex/v1/event.proto", fileDescriptor_cecfe712f14d2a81) }
+func (m *EventOrderClosed) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
 
-var fileDescriptor_cecfe712f14d2a81 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0x37, 0xa1, 0x2d, 0xd4, 0xa2, 0x12, 0x44, 0x14, 0xd2, 0x22, 0xb2, 0x55, 0x2e, 0xf4,
-	0x42, 0xac, 0x15, 0x12, 0x0f, 0x90, 0x00, 0x52, 0x05, 0x12, 0x10, 0xe0, 0x82, 0x84, 0x82, 0x63,
-	0x8f, 0x52, 0xab, 0x89, 0xbd, 0xb5, 0x9d, 0x68, 0xfb, 0x00, 0xdc, 0x39, 0xc1, 0x2b, 0xf5, 0xd8,
-	0x23, 0xe2, 0xb0, 0x42, 0xd9, 0x17, 0x41, 0x4e, 0xb2, 0xdb, 0x72, 0x43, 0x68, 0x8f, 0x3d, 0xc5,
-	0x13, 0xfb, 0xff, 0xec, 0xf9, 0x35, 0x33, 0x68, 0x8f, 0x4a, 0x05, 0x75, 0x85, 0x19, 0xcc, 0x70,
-	0x33, 0xc1, 0xd0, 0x80, 0x30, 0xd1, 0x54, 0x49, 0x23, 0xbd, 0x9d, 0x7e, 0x2b, 0x62, 0x30, 0x8b,
-	0x9a, 0xc9, 0xfe, 0xbd, 0x42, 0x16, 0xb2, 0xdb, 0xc1, 0x76, 0xd5, 0x1f, 0x0a, 0xbf, 0xa0, 0x3b,
-	0x2f, 0xac, 0xe6, 0x8d, 0x62, 0xa0, 0xde, 0x96, 0x84, 0x02, 0xf3, 0x7c, 0x74, 0x93, 0x2a, 0x20,
-	0x46, 0x2a, 0xdf, 0x39, 0x70, 0x0e, 0xb7, 0xd3, 0x65, 0xe8, 0xdd, 0x47, 0x2e, 0x67, 0xbe, 0x6b,
-	0x7f, 0xc6, 0x5b, 0xed, 0x7c, 0xec, 0x1e, 0x3d, 0x4f, 0x5d, 0xce, 0xbc, 0x7d, 0x74, 0x4b, 0xc3,
-	0x69, 0x0d, 0x82, 0x82, 0x7f, 0xe3, 0xc0, 0x39, 0xdc, 0x48, 0x57, 0x71, 0xf8, 0xd5, 0x45, 0x77,
-	0x2f, 0xaf, 0x48, 0x81, 0xd5, 0x6b, 0xbf, 0xc3, 0x7b, 0x8d, 0xb6, 0x35, 0x08, 0x93, 0x51, 0xc9,
-	0x85, 0xbf, 0xd1, 0x49, 0xf1, 0xf9, 0x7c, 0x3c, 0xfa, 0x35, 0x1f, 0x3f, 0x2e, 0xb8, 0x39, 0xae,
-	0xf3, 0x88, 0xca, 0x0a, 0x53, 0xa9, 0x2b, 0xa9, 0x87, 0xcf, 0x13, 0xcd, 0x4e, 0xb0, 0x39, 0x9b,
-	0x82, 0x8e, 0x12, 0xc9, 0x85, 0xa5, 0x09, 0x63, 0x57, 0xde, 0x07, 0xb4, 0xa3, 0x80, 0x02, 0x6f,
-	0x80, 0xf5, 0xc4, 0xcd, 0xff, 0x23, 0xde, 0x5e, 0x52, 0x6c, 0x14, 0xfe, 0xf8, 0xcb, 0x87, 0xc4,
-	0x66, 0xbb, 0x76, 0x1f, 0x3e, 0xa2, 0x07, 0x0a, 0x2a, 0xc2, 0x05, 0x17, 0x45, 0x96, 0x13, 0x0d,
-	0xd9, 0x69, 0x4d, 0x84, 0xe1, 0xe6, 0x6c, 0x70, 0xe5, 0xd1, 0x90, 0xc3, 0x6e, 0xff, 0x62, 0xcd,
-	0x4e, 0x22, 0x2e, 0x71, 0x45, 0xcc, 0x71, 0x74, 0x24, 0x4c, 0xba, 0xbb, 0x52, 0xc7, 0x44, 0xc3,
-	0xbb, 0x41, 0xeb, 0x7d, 0x46, 0x0f, 0x2f, 0xb1, 0x7a, 0x0a, 0x82, 0x91, 0xbc, 0x84, 0x2c, 0x27,
-	0x25, 0xb1, 0xaf, 0xd8, 0xfc, 0x17, 0xf4, 0xde, 0x8a, 0xf0, 0x7e, 0x09, 0x88, 0x7b, 0x7d, 0xf8,
-	0xdd, 0xbd, 0x5a, 0x84, 0x49, 0x29, 0xf5, 0xb5, 0x31, 0x56, 0x1f, 0xbf, 0x3a, 0x6f, 0x03, 0xe7,
-	0xa2, 0x0d, 0x9c, 0xdf, 0x6d, 0xe0, 0x7c, 0x5b, 0x04, 0xa3, 0x8b, 0x45, 0x30, 0xfa, 0xb9, 0x08,
-	0x46, 0x9f, 0x26, 0x57, 0x6a, 0x30, 0xe9, 0xda, 0xfc, 0xa5, 0xac, 0x05, 0x23, 0x86, 0x4b, 0x81,
-	0x87, 0x91, 0xd0, 0x3c, 0xc3, 0xb3, 0x6e, 0x2e, 0x74, 0x25, 0x99, 0x6f, 0x75, 0x0d, 0xff, 0xf4,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68, 0xa1, 0xfb, 0xe7, 0x32, 0x04, 0x00, 0x00,
+func (m *EventOrderClosed) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
 }
 
-func (m *EventOrderPlaced) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOrderClosed) GetSide() Side {
+	if m != nil {
+		return m.Side
 	}
-	return dAtA[:n], nil
+	return SIDE_UNSPECIFIED
 }
 
-func (m *EventOrderPlaced) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSelfTradePrevented is emitted when the taker order is matched against the maker order of the same creator, and
+// the self-trade prevention is applied instead of the trade.
+type EventSelfTradePrevented struct {
+	// creator is the creator of both orders.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// self_trade_prevention is the self-trade prevention mode of the taker order.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,2,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=coreum.dex.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// taker_order_id is the taker order ID.
+	TakerOrderID string `protobuf:"bytes,3,opt,name=taker_order_id,json=takerOrderId,proto3" json:"taker_order_id,omitempty"`
+	// taker_order_sequence is the taker order sequence.
+	TakerOrderSequence uint64 `protobuf:"varint,4,opt,name=taker_order_sequence,json=takerOrderSequence,proto3" json:"taker_order_sequence,omitempty"`
+	// maker_order_id is the maker order ID.
+	MakerOrderID string `protobuf:"bytes,5,opt,name=maker_order_id,json=makerOrderId,proto3" json:"maker_order_id,omitempty"`
+	// maker_order_sequence is the maker order sequence.
+	MakerOrderSequence uint64 `protobuf:"varint,6,opt,name=maker_order_sequence,json=makerOrderSequence,proto3" json:"maker_order_sequence,omitempty"`
+	// taker_canceled is true if the taker order is canceled.
+	TakerCanceled bool `protobuf:"varint,7,opt,name=taker_canceled,json=takerCanceled,proto3" json:"taker_canceled,omitempty"`
+	// maker_canceled is true if the maker order is canceled and removed from the order book.
+	MakerCanceled bool `protobuf:"varint,8,opt,name=maker_canceled,json=makerCanceled,proto3" json:"maker_canceled,omitempty"`
+	// decremented_base_quantity is the base quantity of the taker order both orders are decremented by, set only for
+	// the decrement-and-cancel mode.
+	DecrementedBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=decremented_base_quantity,json=decrementedBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"decremented_base_quantity"`
+	// maker_visible_base_quantity is the remaining base quantity of the maker order visible in the maker order book
+	// after the decrement, set only if the decremented maker order is kept in the order book.
+	MakerVisibleBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=maker_visible_base_quantity,json=makerVisibleBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"maker_visible_base_quantity"`
 }
 
-func (m *EventOrderPlaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventSelfTradePrevented) Reset()         { *m = EventSelfTradePrevented{} }
+func (m *EventSelfTradePrevented) String() string { return proto.CompactTextString(m) }
+func (*EventSelfTradePrevented) ProtoMessage()    {}
+func (*EventSelfTradePrevented) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{4}
+}
+func (m *EventSelfTradePrevented) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSelfTradePrevented) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSelfTradePrevented.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventSelfTradePrevented) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSelfTradePrevented.Merge(m, src)
+}
+func (m *EventSelfTradePrevented) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSelfTradePrevented) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSelfTradePrevented.DiscardUnknown(m)
 }
 
-func (m *EventOrderReduced) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSelfTradePrevented proto.InternalMessageInfo
+
+func (m *EventSelfTradePrevented) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderReduced) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSelfTradePrevented) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SELF_TRADE_PREVENTION_UNSPECIFIED
 }
 
-func (m *EventOrderReduced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ReceivedCoin.Size()
-		i -= size
-		if _, err := m.ReceivedCoin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.SentCoin.Size()
-		i -= size
-		if _, err := m.SentCoin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Sequence != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventSelfTradePrevented) GetTakerOrderID() string {
+	if m != nil {
+		return m.TakerOrderID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSelfTradePrevented) GetTakerOrderSequence() uint64 {
+	if m != nil {
+		return m.TakerOrderSequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventOrderCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSelfTradePrevented) GetMakerOrderID() string {
+	if m != nil {
+		return m.MakerOrderID
+	}
+	return ""
 }
 
-func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RemainingSpendableBalance.Size()
-		i -= size
-		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.RemainingBaseQuantity.Size()
-		i -= size
-		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Sequence != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventSelfTradePrevented) GetMakerOrderSequence() uint64 {
+	if m != nil {
+		return m.MakerOrderSequence
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventSelfTradePrevented) GetTakerCanceled() bool {
+	if m != nil {
+		return m.TakerCanceled
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *EventOrderClosed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSelfTradePrevented) GetMakerCanceled() bool {
+	if m != nil {
+		return m.MakerCanceled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventOrderClosed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOrderRefreshed is emitted when the visible quantity of the iceberg order is filled and refreshed from the hidden
+// quantity. The refreshed order gets the new sequence, so it loses its time priority.
+type EventOrderRefreshed struct {
+	// creator is order creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// previous_sequence is the order sequence before the refresh.
+	PreviousSequence uint64 `protobuf:"varint,3,opt,name=previous_sequence,json=previousSequence,proto3" json:"previous_sequence,omitempty"`
+	// sequence is the new order sequence.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// remaining_base_quantity is the refreshed visible quantity of the order.
+	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
+	// order_book_id is the order book ID of the order.
+	OrderBookID uint32 `protobuf:"varint,6,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,7,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,8,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// side is the order side.
+	Side Side `protobuf:"varint,9,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// price is the order price.
+	Price Price `protobuf:"bytes,10,opt,name=price,proto3,customtype=Price" json:"price"`
 }
 
-func (m *EventOrderClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RemainingSpendableBalance.Size()
-		i -= size
-		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventOrderRefreshed) Reset()         { *m = EventOrderRefreshed{} }
+func (m *EventOrderRefreshed) String() string { return proto.CompactTextString(m) }
+func (*EventOrderRefreshed) ProtoMessage()    {}
+func (*EventOrderRefreshed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{5}
+}
+func (m *EventOrderRefreshed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderRefreshed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderRefreshed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.RemainingBaseQuantity.Size()
-		i -= size
-		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *EventOrderRefreshed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderRefreshed.Merge(m, src)
+}
+func (m *EventOrderRefreshed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderRefreshed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderRefreshed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderRefreshed proto.InternalMessageInfo
+
+func (m *EventOrderRefreshed) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Sequence != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *EventOrderRefreshed) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventOrderRefreshed) GetPreviousSequence() uint64 {
+	if m != nil {
+		return m.PreviousSequence
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventOrderRefreshed) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventOrderRefreshed) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *EventOrderPlaced) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventOrderRefreshed) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventOrderRefreshed) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventOrderRefreshed) GetSide() Side {
+	if m != nil {
+		return m.Side
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+	return SIDE_UNSPECIFIED
+}
+
+// EventOrderReplaced is emitted when the order price and/or quantity is changed by the creator.
+type EventOrderReplaced struct {
+	// creator is order creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is the sequence of the replaced order.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// price is the new order price.
+	Price Price `protobuf:"bytes,4,opt,name=price,proto3,customtype=Price" json:"price"`
+	// remaining_base_quantity is the new remaining quantity of the order.
+	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
+	// priority_kept is true if the order was reduced in place, otherwise the order was closed and placed again with
+	// the new sequence.
+	PriorityKept bool `protobuf:"varint,6,opt,name=priority_kept,json=priorityKept,proto3" json:"priority_kept,omitempty"`
+	// order_book_id is the order book ID of the order.
+	OrderBookID uint32 `protobuf:"varint,7,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,8,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,9,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// side is the order side.
+	Side Side `protobuf:"varint,10,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+}
+
+func (m *EventOrderReplaced) Reset()         { *m = EventOrderReplaced{} }
+func (m *EventOrderReplaced) String() string { return proto.CompactTextString(m) }
+func (*EventOrderReplaced) ProtoMessage()    {}
+func (*EventOrderReplaced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{6}
+}
+func (m *EventOrderReplaced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderReplaced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderReplaced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventOrderReplaced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderReplaced.Merge(m, src)
+}
+func (m *EventOrderReplaced) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderReplaced) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderReplaced.DiscardUnknown(m)
 }
 
-func (m *EventOrderReduced) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventOrderReplaced proto.InternalMessageInfo
+
+func (m *EventOrderReplaced) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventOrderReplaced) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventOrderReplaced) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+	return 0
+}
+
+func (m *EventOrderReplaced) GetPriorityKept() bool {
+	if m != nil {
+		return m.PriorityKept
 	}
-	l = m.SentCoin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ReceivedCoin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return false
 }
 
-func (m *EventOrderCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOrderReplaced) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return 0
+}
+
+func (m *EventOrderReplaced) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventOrderReplaced) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+	return ""
+}
+
+func (m *EventOrderReplaced) GetSide() Side {
+	if m != nil {
+		return m.Side
 	}
-	l = m.RemainingBaseQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RemainingSpendableBalance.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return SIDE_UNSPECIFIED
 }
 
-func (m *EventOrderClosed) Size() (n int) {
-	if m == nil {
-		return 0
+// EventTriggerOrderCreated is emitted when the trigger order is saved to the trigger orders store.
+type EventTriggerOrderCreated struct {
+	// creator is order creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is unique trigger order sequence.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *EventTriggerOrderCreated) Reset()         { *m = EventTriggerOrderCreated{} }
+func (m *EventTriggerOrderCreated) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerOrderCreated) ProtoMessage()    {}
+func (*EventTriggerOrderCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{7}
+}
+func (m *EventTriggerOrderCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTriggerOrderCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTriggerOrderCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *EventTriggerOrderCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerOrderCreated.Merge(m, src)
+}
+func (m *EventTriggerOrderCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTriggerOrderCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerOrderCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTriggerOrderCreated proto.InternalMessageInfo
+
+func (m *EventTriggerOrderCreated) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventTriggerOrderCreated) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+	return ""
+}
+
+func (m *EventTriggerOrderCreated) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// EventTriggerOrderActivated is emitted when the trigger order condition is met, and the order is placed.
+type EventTriggerOrderActivated struct {
+	// creator is order creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is unique trigger order sequence.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// last_price is the last traded price which activated the order, in the rational number form.
+	LastPrice string `protobuf:"bytes,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+}
+
+func (m *EventTriggerOrderActivated) Reset()         { *m = EventTriggerOrderActivated{} }
+func (m *EventTriggerOrderActivated) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerOrderActivated) ProtoMessage()    {}
+func (*EventTriggerOrderActivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{8}
+}
+func (m *EventTriggerOrderActivated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTriggerOrderActivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTriggerOrderActivated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTriggerOrderActivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerOrderActivated.Merge(m, src)
+}
+func (m *EventTriggerOrderActivated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTriggerOrderActivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerOrderActivated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTriggerOrderActivated proto.InternalMessageInfo
+
+func (m *EventTriggerOrderActivated) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventTriggerOrderActivated) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *EventTriggerOrderActivated) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EventTriggerOrderActivated) GetLastPrice() string {
+	if m != nil {
+		return m.LastPrice
+	}
+	return ""
+}
+
+// EventTriggerOrderCanceled is emitted when the trigger order is canceled manually, or its placement failed.
+type EventTriggerOrderCanceled struct {
+	// creator is order creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is unique trigger order sequence.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// reason is the failed placement reason, empty if the order is canceled manually.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventTriggerOrderCanceled) Reset()         { *m = EventTriggerOrderCanceled{} }
+func (m *EventTriggerOrderCanceled) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerOrderCanceled) ProtoMessage()    {}
+func (*EventTriggerOrderCanceled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{9}
+}
+func (m *EventTriggerOrderCanceled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTriggerOrderCanceled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTriggerOrderCanceled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTriggerOrderCanceled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerOrderCanceled.Merge(m, src)
+}
+func (m *EventTriggerOrderCanceled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTriggerOrderCanceled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerOrderCanceled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTriggerOrderCanceled proto.InternalMessageInfo
+
+func (m *EventTriggerOrderCanceled) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventTriggerOrderCanceled) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *EventTriggerOrderCanceled) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EventTriggerOrderCanceled) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventTrade is emitted for each trade executed during the matching. The trade is expressed in the order book of the
+// maker order.
+type EventTrade struct {
+	// order_book_id is the maker order book ID.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the maker order book base denom.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the maker order book quote denom.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price is the trade price, the maker order price.
+	Price Price `protobuf:"bytes,4,opt,name=price,proto3,customtype=Price" json:"price"`
+	// base_quantity is the traded amount of the base denom.
+	BaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=base_quantity,json=baseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"base_quantity"`
+	// quote_quantity is the traded amount of the quote denom.
+	QuoteQuantity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=quote_quantity,json=quoteQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"quote_quantity"`
+	// taker_side is the side of the taker in the maker order book.
+	TakerSide Side `protobuf:"varint,7,opt,name=taker_side,json=takerSide,proto3,enum=coreum.dex.v1.Side" json:"taker_side,omitempty"`
+	// maker is the maker order creator address.
+	Maker string `protobuf:"bytes,8,opt,name=maker,proto3" json:"maker,omitempty"`
+	// maker_order_id is the maker order ID.
+	MakerOrderID string `protobuf:"bytes,9,opt,name=maker_order_id,json=makerOrderId,proto3" json:"maker_order_id,omitempty"`
+	// taker is the taker order creator address.
+	Taker string `protobuf:"bytes,10,opt,name=taker,proto3" json:"taker,omitempty"`
+	// taker_order_id is the taker order ID.
+	TakerOrderID string `protobuf:"bytes,11,opt,name=taker_order_id,json=takerOrderId,proto3" json:"taker_order_id,omitempty"`
+}
+
+func (m *EventTrade) Reset()         { *m = EventTrade{} }
+func (m *EventTrade) String() string { return proto.CompactTextString(m) }
+func (*EventTrade) ProtoMessage()    {}
+func (*EventTrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{10}
+}
+func (m *EventTrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTrade.Merge(m, src)
+}
+func (m *EventTrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTrade proto.InternalMessageInfo
+
+func (m *EventTrade) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *EventTrade) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventTrade) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *EventTrade) GetTakerSide() Side {
+	if m != nil {
+		return m.TakerSide
+	}
+	return SIDE_UNSPECIFIED
+}
+
+func (m *EventTrade) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *EventTrade) GetMakerOrderID() string {
+	if m != nil {
+		return m.MakerOrderID
+	}
+	return ""
+}
+
+func (m *EventTrade) GetTaker() string {
+	if m != nil {
+		return m.Taker
+	}
+	return ""
+}
+
+func (m *EventTrade) GetTakerOrderID() string {
+	if m != nil {
+		return m.TakerOrderID
+	}
+	return ""
+}
+
+// EventOrderBookUpdated is emitted when the order book is registered, or its settings or status are changed.
+type EventOrderBookUpdated struct {
+	// order_book_id is the order book ID.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// data is the updated order book data.
+	Data OrderBookData `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+}
+
+func (m *EventOrderBookUpdated) Reset()         { *m = EventOrderBookUpdated{} }
+func (m *EventOrderBookUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventOrderBookUpdated) ProtoMessage()    {}
+func (*EventOrderBookUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{11}
+}
+func (m *EventOrderBookUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderBookUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderBookUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderBookUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderBookUpdated.Merge(m, src)
+}
+func (m *EventOrderBookUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderBookUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderBookUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderBookUpdated proto.InternalMessageInfo
+
+func (m *EventOrderBookUpdated) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *EventOrderBookUpdated) GetData() OrderBookData {
+	if m != nil {
+		return m.Data
+	}
+	return OrderBookData{}
+}
+
+// EventDeadManSwitchTriggered is emitted when the dead man's switch of the account isn't refreshed in time, and the
+// orders of the account are canceled.
+type EventDeadManSwitchTriggered struct {
+	// creator is the account address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// expiration_height is the height after which the switch was triggered.
+	ExpirationHeight uint64 `protobuf:"varint,2,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *EventDeadManSwitchTriggered) Reset()         { *m = EventDeadManSwitchTriggered{} }
+func (m *EventDeadManSwitchTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventDeadManSwitchTriggered) ProtoMessage()    {}
+func (*EventDeadManSwitchTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{12}
+}
+func (m *EventDeadManSwitchTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeadManSwitchTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeadManSwitchTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeadManSwitchTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeadManSwitchTriggered.Merge(m, src)
+}
+func (m *EventDeadManSwitchTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeadManSwitchTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeadManSwitchTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeadManSwitchTriggered proto.InternalMessageInfo
+
+func (m *EventDeadManSwitchTriggered) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventDeadManSwitchTriggered) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// EventCircuitBreakerTriggered is emitted when the trade price deviates from the last traded price of the order book
+// more than allowed, and the order book pair is halted.
+type EventCircuitBreakerTriggered struct {
+	// order_book_id is the order book ID of the order which triggered the circuit breaker.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the base denom of the order book.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the quote denom of the order book.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// reference_price is the last traded price of the order book.
+	ReferencePrice string `protobuf:"bytes,4,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// price is the price of the rejected trade.
+	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	// halted_until_height is the height until which the order book pair is halted.
+	HaltedUntilHeight int64 `protobuf:"varint,6,opt,name=halted_until_height,json=haltedUntilHeight,proto3" json:"halted_until_height,omitempty"`
+}
+
+func (m *EventCircuitBreakerTriggered) Reset()         { *m = EventCircuitBreakerTriggered{} }
+func (m *EventCircuitBreakerTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerTriggered) ProtoMessage()    {}
+func (*EventCircuitBreakerTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{13}
+}
+func (m *EventCircuitBreakerTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCircuitBreakerTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCircuitBreakerTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCircuitBreakerTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerTriggered.Merge(m, src)
+}
+func (m *EventCircuitBreakerTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCircuitBreakerTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerTriggered proto.InternalMessageInfo
+
+func (m *EventCircuitBreakerTriggered) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *EventCircuitBreakerTriggered) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventCircuitBreakerTriggered) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *EventCircuitBreakerTriggered) GetReferencePrice() string {
+	if m != nil {
+		return m.ReferencePrice
+	}
+	return ""
+}
+
+func (m *EventCircuitBreakerTriggered) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventCircuitBreakerTriggered) GetHaltedUntilHeight() int64 {
+	if m != nil {
+		return m.HaltedUntilHeight
+	}
+	return 0
+}
+
+// EventSwap is emitted when the coin is swapped through the route of the order books.
+type EventSwap struct {
+	// creator is the swap creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// route is the list of the denoms the coin is swapped through, starting with the input denom and ending with the
+	// output denom.
+	Route []string `protobuf:"bytes,2,rep,name=route,proto3" json:"route,omitempty"`
+	// coin_in is the spent coin.
+	CoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in"`
+	// coin_out is the received coin.
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out"`
+}
+
+func (m *EventSwap) Reset()         { *m = EventSwap{} }
+func (m *EventSwap) String() string { return proto.CompactTextString(m) }
+func (*EventSwap) ProtoMessage()    {}
+func (*EventSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{14}
+}
+func (m *EventSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSwap.Merge(m, src)
+}
+func (m *EventSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSwap proto.InternalMessageInfo
+
+func (m *EventSwap) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventSwap) GetRoute() []string {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+// EventRewardProgramFunded is emitted when the reward program of the order book is created or funded.
+type EventRewardProgramFunded struct {
+	// sender is the address of the program funder.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount is the amount added to the program pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// program is the reward program after the funding.
+	Program RewardProgram `protobuf:"bytes,3,opt,name=program,proto3" json:"program"`
+}
+
+func (m *EventRewardProgramFunded) Reset()         { *m = EventRewardProgramFunded{} }
+func (m *EventRewardProgramFunded) String() string { return proto.CompactTextString(m) }
+func (*EventRewardProgramFunded) ProtoMessage()    {}
+func (*EventRewardProgramFunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{15}
+}
+func (m *EventRewardProgramFunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardProgramFunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardProgramFunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardProgramFunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardProgramFunded.Merge(m, src)
+}
+func (m *EventRewardProgramFunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardProgramFunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardProgramFunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardProgramFunded proto.InternalMessageInfo
+
+func (m *EventRewardProgramFunded) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventRewardProgramFunded) GetProgram() RewardProgram {
+	if m != nil {
+		return m.Program
+	}
+	return RewardProgram{}
+}
+
+// EventRewardsDistributed is emitted when the epoch reward of the program is distributed between the accounts of the
+// qualifying orders.
+type EventRewardsDistributed struct {
+	// base_denom is the base denom of the order book.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the quote denom of the order book.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// amount is the distributed reward.
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// points is the sum of the remaining quantities of the qualifying orders.
+	Points cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=points,proto3,customtype=cosmossdk.io/math.Int" json:"points"`
+}
+
+func (m *EventRewardsDistributed) Reset()         { *m = EventRewardsDistributed{} }
+func (m *EventRewardsDistributed) String() string { return proto.CompactTextString(m) }
+func (*EventRewardsDistributed) ProtoMessage()    {}
+func (*EventRewardsDistributed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{16}
+}
+func (m *EventRewardsDistributed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardsDistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardsDistributed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardsDistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardsDistributed.Merge(m, src)
+}
+func (m *EventRewardsDistributed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardsDistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardsDistributed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardsDistributed proto.InternalMessageInfo
+
+func (m *EventRewardsDistributed) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventRewardsDistributed) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+// EventRewardsClaimed is emitted when the account claims the accrued rewards.
+type EventRewardsClaimed struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// amount is the claimed rewards.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventRewardsClaimed) Reset()         { *m = EventRewardsClaimed{} }
+func (m *EventRewardsClaimed) String() string { return proto.CompactTextString(m) }
+func (*EventRewardsClaimed) ProtoMessage()    {}
+func (*EventRewardsClaimed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{17}
+}
+func (m *EventRewardsClaimed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardsClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardsClaimed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardsClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardsClaimed.Merge(m, src)
+}
+func (m *EventRewardsClaimed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardsClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardsClaimed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardsClaimed proto.InternalMessageInfo
+
+func (m *EventRewardsClaimed) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventRewardsClaimed) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EventOrderQuotaUpdated is emitted when the account updates its order quota.
+type EventOrderQuotaUpdated struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// extra_orders_per_denom is the additional number of orders per denom.
+	ExtraOrdersPerDenom uint64 `protobuf:"varint,2,opt,name=extra_orders_per_denom,json=extraOrdersPerDenom,proto3" json:"extra_orders_per_denom,omitempty"`
+	// reserve is the reserve locked for the additional orders.
+	Reserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=reserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reserve"`
+}
+
+func (m *EventOrderQuotaUpdated) Reset()         { *m = EventOrderQuotaUpdated{} }
+func (m *EventOrderQuotaUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventOrderQuotaUpdated) ProtoMessage()    {}
+func (*EventOrderQuotaUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{18}
+}
+func (m *EventOrderQuotaUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderQuotaUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderQuotaUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderQuotaUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderQuotaUpdated.Merge(m, src)
+}
+func (m *EventOrderQuotaUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderQuotaUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderQuotaUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderQuotaUpdated proto.InternalMessageInfo
+
+func (m *EventOrderQuotaUpdated) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventOrderQuotaUpdated) GetExtraOrdersPerDenom() uint64 {
+	if m != nil {
+		return m.ExtraOrdersPerDenom
+	}
+	return 0
+}
+
+// EventOpeningAuctionUncrossed is emitted when the opening auction of the order book pair is finished, and the crossed
+// orders are executed at the clearing price.
+type EventOpeningAuctionUncrossed struct {
+	// order_book_id is the order book ID the clearing price is expressed in.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the base denom of the order book.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the quote denom of the order book.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price is the clearing price, empty if the orders don't cross.
+	Price *Price `protobuf:"bytes,4,opt,name=price,proto3,customtype=Price" json:"price,omitempty"`
+	// base_quantity is the executed quantity of the base denom.
+	BaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=base_quantity,json=baseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"base_quantity"`
+}
+
+func (m *EventOpeningAuctionUncrossed) Reset()         { *m = EventOpeningAuctionUncrossed{} }
+func (m *EventOpeningAuctionUncrossed) String() string { return proto.CompactTextString(m) }
+func (*EventOpeningAuctionUncrossed) ProtoMessage()    {}
+func (*EventOpeningAuctionUncrossed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{19}
+}
+func (m *EventOpeningAuctionUncrossed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOpeningAuctionUncrossed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOpeningAuctionUncrossed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOpeningAuctionUncrossed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOpeningAuctionUncrossed.Merge(m, src)
+}
+func (m *EventOpeningAuctionUncrossed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOpeningAuctionUncrossed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOpeningAuctionUncrossed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOpeningAuctionUncrossed proto.InternalMessageInfo
+
+func (m *EventOpeningAuctionUncrossed) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *EventOpeningAuctionUncrossed) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventOpeningAuctionUncrossed) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventOrderPlaced)(nil), "coreum.dex.v1.EventOrderPlaced")
+	proto.RegisterType((*EventOrderReduced)(nil), "coreum.dex.v1.EventOrderReduced")
+	proto.RegisterType((*EventOrderCreated)(nil), "coreum.dex.v1.EventOrderCreated")
+	proto.RegisterType((*EventOrderClosed)(nil), "coreum.dex.v1.EventOrderClosed")
+	proto.RegisterType((*EventSelfTradePrevented)(nil), "coreum.dex.v1.EventSelfTradePrevented")
+	proto.RegisterType((*EventOrderRefreshed)(nil), "coreum.dex.v1.EventOrderRefreshed")
+	proto.RegisterType((*EventOrderReplaced)(nil), "coreum.dex.v1.EventOrderReplaced")
+	proto.RegisterType((*EventTriggerOrderCreated)(nil), "coreum.dex.v1.EventTriggerOrderCreated")
+	proto.RegisterType((*EventTriggerOrderActivated)(nil), "coreum.dex.v1.EventTriggerOrderActivated")
+	proto.RegisterType((*EventTriggerOrderCanceled)(nil), "coreum.dex.v1.EventTriggerOrderCanceled")
+	proto.RegisterType((*EventTrade)(nil), "coreum.dex.v1.EventTrade")
+	proto.RegisterType((*EventOrderBookUpdated)(nil), "coreum.dex.v1.EventOrderBookUpdated")
+	proto.RegisterType((*EventDeadManSwitchTriggered)(nil), "coreum.dex.v1.EventDeadManSwitchTriggered")
+	proto.RegisterType((*EventCircuitBreakerTriggered)(nil), "coreum.dex.v1.EventCircuitBreakerTriggered")
+	proto.RegisterType((*EventSwap)(nil), "coreum.dex.v1.EventSwap")
+	proto.RegisterType((*EventRewardProgramFunded)(nil), "coreum.dex.v1.EventRewardProgramFunded")
+	proto.RegisterType((*EventRewardsDistributed)(nil), "coreum.dex.v1.EventRewardsDistributed")
+	proto.RegisterType((*EventRewardsClaimed)(nil), "coreum.dex.v1.EventRewardsClaimed")
+	proto.RegisterType((*EventOrderQuotaUpdated)(nil), "coreum.dex.v1.EventOrderQuotaUpdated")
+	proto.RegisterType((*EventOpeningAuctionUncrossed)(nil), "coreum.dex.v1.EventOpeningAuctionUncrossed")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/event.proto", fileDescriptor_cecfe712f14d2a81) }
+
+var fileDescriptor_cecfe712f14d2a81 = []byte{
+	// 1538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0x67, 0xed, 0xb5, 0xc1, 0xcf, 0x98, 0xc0, 0xf2, 0x23, 0x0b, 0x49, 0x30, 0xda, 0xe8, 0xab,
+	0x20, 0x45, 0x5f, 0x3b, 0x10, 0x95, 0x53, 0x2f, 0x31, 0x6e, 0x54, 0x94, 0x46, 0x21, 0x0b, 0x44,
+	0x6a, 0xd5, 0xca, 0x1d, 0xef, 0x0c, 0xf6, 0x08, 0xef, 0xce, 0x66, 0x76, 0xd6, 0x21, 0xb7, 0xb6,
+	0xaa, 0x54, 0xf5, 0xd6, 0x43, 0xff, 0x81, 0x5e, 0x23, 0xf5, 0xff, 0xc8, 0xa5, 0x52, 0x0e, 0x3d,
+	0xb4, 0x39, 0xd0, 0x8a, 0x48, 0xfd, 0x0b, 0x7a, 0xe8, 0xb1, 0x9a, 0xd9, 0x5d, 0xff, 0x82, 0x80,
+	0x43, 0x41, 0xa9, 0xa2, 0x9e, 0xbc, 0xf3, 0x66, 0xde, 0xef, 0xcf, 0x7b, 0xf3, 0x3c, 0x30, 0xef,
+	0x30, 0x4e, 0x42, 0xb7, 0x8c, 0xc9, 0x7e, 0xb9, 0xbd, 0x52, 0x26, 0x6d, 0xe2, 0x89, 0x92, 0xcf,
+	0x99, 0x60, 0x46, 0x21, 0xda, 0x2a, 0x61, 0xb2, 0x5f, 0x6a, 0xaf, 0x2c, 0x0c, 0x9c, 0x64, 0x1c,
+	0x13, 0x1e, 0x9d, 0x5c, 0x58, 0x74, 0x58, 0xe0, 0xb2, 0xa0, 0x5c, 0x47, 0x01, 0x29, 0xb7, 0x57,
+	0xea, 0x44, 0xa0, 0x95, 0xb2, 0xc3, 0xa8, 0x17, 0xef, 0xcf, 0x34, 0x58, 0x83, 0xa9, 0xcf, 0xb2,
+	0xfc, 0x8a, 0xa8, 0xd6, 0xe7, 0x30, 0xf9, 0x81, 0x54, 0xf7, 0x40, 0x4a, 0xda, 0x6c, 0x21, 0x87,
+	0x60, 0xc3, 0x84, 0x51, 0x87, 0x13, 0x24, 0x18, 0x37, 0xb5, 0x25, 0x6d, 0x39, 0x67, 0x27, 0x4b,
+	0x63, 0x0e, 0x52, 0x14, 0x9b, 0x29, 0x49, 0xac, 0x64, 0x0f, 0x0f, 0x8a, 0xa9, 0x8d, 0xaa, 0x9d,
+	0xa2, 0xd8, 0x58, 0x80, 0xb1, 0x80, 0x3c, 0x0e, 0x89, 0xe7, 0x10, 0x33, 0xbd, 0xa4, 0x2d, 0xeb,
+	0x76, 0x67, 0x6d, 0xbd, 0xd4, 0x61, 0xaa, 0xab, 0xc2, 0x26, 0x38, 0x3c, 0x77, 0x1d, 0xc6, 0x47,
+	0x90, 0x0b, 0x88, 0x27, 0x6a, 0xd2, 0x5d, 0x53, 0x57, 0xac, 0xe5, 0xe7, 0x07, 0xc5, 0x91, 0x97,
+	0x07, 0xc5, 0x1b, 0x0d, 0x2a, 0x9a, 0x61, 0xbd, 0xe4, 0x30, 0xb7, 0x1c, 0x47, 0x28, 0xfa, 0xf9,
+	0x7f, 0x80, 0xf7, 0xca, 0xe2, 0xa9, 0x4f, 0x82, 0xd2, 0x3a, 0xa3, 0x9e, 0x94, 0xe6, 0x09, 0xf9,
+	0x65, 0x6c, 0x43, 0x81, 0x13, 0x87, 0xd0, 0x36, 0xc1, 0x91, 0xc4, 0xcc, 0xd9, 0x24, 0x8e, 0x27,
+	0x52, 0x94, 0xd4, 0x3b, 0x90, 0xde, 0x25, 0xc4, 0xcc, 0x9e, 0x4d, 0x96, 0xe4, 0x35, 0x6e, 0x43,
+	0x41, 0x65, 0xbc, 0x56, 0x67, 0x6c, 0xaf, 0x46, 0xb1, 0x39, 0xba, 0xa4, 0x2d, 0x17, 0x2a, 0x97,
+	0x0e, 0x0f, 0x8a, 0x79, 0x15, 0xdd, 0x0a, 0x63, 0x7b, 0x1b, 0x55, 0x3b, 0xcf, 0x3a, 0x0b, 0x6c,
+	0x5c, 0x03, 0x90, 0x90, 0xa8, 0x61, 0xe2, 0x31, 0xd7, 0x1c, 0x53, 0xc1, 0xce, 0x49, 0x4a, 0x55,
+	0x12, 0x8c, 0x22, 0xe4, 0x1f, 0x87, 0x4c, 0x24, 0xfb, 0x39, 0xb5, 0x0f, 0x8a, 0x14, 0x1d, 0xb8,
+	0x01, 0x7a, 0x40, 0x31, 0x31, 0x61, 0x49, 0x5b, 0x9e, 0x58, 0x9d, 0x2e, 0xf5, 0x01, 0xb2, 0xb4,
+	0x45, 0x31, 0xb1, 0xd5, 0x01, 0xa3, 0x08, 0x19, 0x9f, 0x53, 0x87, 0x98, 0x79, 0xe5, 0x62, 0xee,
+	0xe5, 0x41, 0x31, 0xb3, 0x29, 0x09, 0x76, 0x44, 0x37, 0x1e, 0xc2, 0x6c, 0x9b, 0x06, 0xb4, 0xde,
+	0x22, 0x35, 0x65, 0xd1, 0xe3, 0x10, 0x79, 0x82, 0x8a, 0xa7, 0xe6, 0xb8, 0x62, 0xb8, 0x16, 0xc7,
+	0x64, 0x36, 0x8a, 0x40, 0x80, 0xf7, 0x4a, 0x94, 0x95, 0x5d, 0x24, 0x9a, 0xa5, 0x0d, 0x4f, 0xd8,
+	0xd3, 0x31, 0x6f, 0x05, 0x05, 0xe4, 0x61, 0xcc, 0x69, 0xfd, 0xd8, 0x07, 0xae, 0x75, 0x09, 0xa1,
+	0x73, 0x07, 0xd7, 0x0e, 0x5c, 0xe6, 0xc4, 0x45, 0xd4, 0xa3, 0x5e, 0x63, 0xc0, 0x70, 0x7d, 0x18,
+	0xc3, 0x67, 0x3b, 0xdc, 0xbd, 0xa6, 0x1b, 0x9f, 0xc1, 0x95, 0xae, 0xd8, 0xc0, 0x27, 0x1e, 0x46,
+	0x51, 0x64, 0x5a, 0x48, 0x5a, 0x91, 0x19, 0x46, 0xf4, 0x7c, 0x47, 0xc2, 0x56, 0x22, 0xa0, 0x12,
+	0xf1, 0x1f, 0xc5, 0x4a, 0xf6, 0x8d, 0xb1, 0x32, 0x7a, 0x0a, 0x56, 0xc6, 0x5e, 0x8b, 0x95, 0xdc,
+	0x69, 0x58, 0xb9, 0x9e, 0x60, 0x05, 0x94, 0x9b, 0x85, 0xd8, 0xcd, 0x21, 0xf1, 0x92, 0x3f, 0x33,
+	0x5e, 0x7e, 0x4d, 0xf7, 0xf6, 0xbb, 0xf5, 0x16, 0x0b, 0xfe, 0x83, 0xcb, 0x3b, 0x02, 0x17, 0xeb,
+	0x0f, 0x1d, 0x2e, 0xab, 0xdc, 0x6e, 0x91, 0xd6, 0xee, 0x36, 0x47, 0x98, 0x6c, 0x72, 0x75, 0x95,
+	0x9e, 0x98, 0xe2, 0x47, 0x30, 0x1b, 0x90, 0xd6, 0x6e, 0x4d, 0x48, 0x86, 0x9a, 0x1f, 0x71, 0x50,
+	0xe6, 0xa9, 0xac, 0x4f, 0xac, 0x5a, 0x83, 0x46, 0x0d, 0xc8, 0xa6, 0xcc, 0xb3, 0xa7, 0x83, 0xa3,
+	0x44, 0x63, 0x0d, 0x26, 0x04, 0xda, 0x23, 0xbc, 0x16, 0x85, 0x95, 0x62, 0x05, 0x94, 0x5c, 0x65,
+	0xf2, 0xf0, 0xa0, 0x38, 0xbe, 0x2d, 0x77, 0x54, 0x58, 0x37, 0xaa, 0xf6, 0xb8, 0xe8, 0xae, 0xb0,
+	0x71, 0x0b, 0x66, 0x7a, 0xf9, 0x3a, 0x30, 0xd3, 0x15, 0xcc, 0x8c, 0xee, 0xd9, 0xad, 0x04, 0x70,
+	0x6b, 0x30, 0xe1, 0xf6, 0x6b, 0xca, 0x74, 0x35, 0xdd, 0xef, 0xd3, 0xe4, 0x0e, 0x68, 0x72, 0x8f,
+	0xd3, 0x94, 0x8d, 0x34, 0xb9, 0x47, 0x35, 0xfd, 0x2f, 0xf1, 0xc9, 0x91, 0x98, 0x69, 0x91, 0xe8,
+	0x02, 0x1a, 0xb3, 0x0b, 0x8a, 0xba, 0x1e, 0x13, 0xe5, 0x31, 0xb7, 0xff, 0xd8, 0x58, 0x74, 0xcc,
+	0xed, 0x3b, 0xf6, 0x31, 0xcc, 0x63, 0xe2, 0x70, 0xe2, 0xaa, 0x14, 0x0d, 0x94, 0x4a, 0x6e, 0x18,
+	0x3c, 0x5f, 0xee, 0xe1, 0xef, 0x2b, 0x96, 0x4f, 0xe1, 0x4a, 0x64, 0xc1, 0xf1, 0xfd, 0x03, 0x86,
+	0x11, 0x6e, 0x2a, 0x09, 0x8f, 0x8e, 0x69, 0x22, 0xcf, 0xd2, 0x30, 0xdd, 0x3b, 0xd1, 0xec, 0x72,
+	0x12, 0x34, 0xcf, 0xd4, 0x47, 0x6e, 0xc2, 0x94, 0x44, 0x1c, 0x65, 0x61, 0x50, 0x1b, 0x68, 0x28,
+	0x93, 0xc9, 0x46, 0x27, 0xfa, 0xbd, 0x4d, 0x47, 0x1f, 0xbe, 0xe9, 0x64, 0xfe, 0x41, 0xd3, 0x79,
+	0x07, 0xba, 0xc2, 0x0f, 0x69, 0x30, 0x7a, 0x93, 0xe5, 0x5f, 0xc0, 0x8c, 0xdb, 0xb5, 0x44, 0x3f,
+	0xe1, 0x3a, 0xbb, 0xa0, 0x1c, 0x5d, 0x87, 0x82, 0xcf, 0x29, 0xe3, 0x54, 0x3c, 0xad, 0xed, 0x11,
+	0x5f, 0xa8, 0x1c, 0x8d, 0xd9, 0xe3, 0x09, 0xf1, 0x1e, 0xf1, 0xc5, 0xbf, 0x7b, 0x72, 0xb4, 0x9a,
+	0x60, 0xaa, 0x14, 0x6d, 0x73, 0xda, 0x68, 0x10, 0x7e, 0x71, 0xb3, 0x9c, 0xf5, 0xad, 0x06, 0x0b,
+	0x47, 0x54, 0xdd, 0x71, 0x04, 0x6d, 0x5f, 0xc0, 0xe0, 0x78, 0x0d, 0xa0, 0x85, 0x02, 0x51, 0xeb,
+	0x81, 0x86, 0x9d, 0x93, 0x14, 0x05, 0x0b, 0xeb, 0x4b, 0x0d, 0xe6, 0x8f, 0xba, 0x9d, 0x74, 0xc7,
+	0xf3, 0x35, 0x65, 0x0e, 0xb2, 0x9c, 0xa0, 0x80, 0xc5, 0xff, 0x8e, 0xec, 0x78, 0x65, 0x7d, 0xa1,
+	0x03, 0xc4, 0x36, 0x20, 0x7c, 0xcc, 0x14, 0xa0, 0xbd, 0x31, 0x4c, 0x52, 0xa7, 0xc0, 0x24, 0x7d,
+	0x04, 0x26, 0x43, 0x15, 0x4f, 0x05, 0x0a, 0x67, 0x28, 0x99, 0xf1, 0x7a, 0x6f, 0xa5, 0x54, 0x61,
+	0x22, 0xb2, 0xa4, 0x23, 0x24, 0x3b, 0x8c, 0x90, 0x82, 0x62, 0xea, 0x48, 0x59, 0x05, 0x88, 0x2e,
+	0x41, 0x85, 0xed, 0xd1, 0xd7, 0x63, 0x3b, 0xa7, 0x8e, 0xc9, 0x4f, 0x63, 0x06, 0x32, 0xea, 0x36,
+	0x89, 0x8b, 0x28, 0x5a, 0x1c, 0x73, 0x71, 0xe7, 0x86, 0xba, 0xb8, 0x67, 0x20, 0xa3, 0x44, 0x47,
+	0x7d, 0xcf, 0xce, 0x88, 0x44, 0xda, 0xc0, 0xc0, 0x91, 0x1f, 0x66, 0xe0, 0xb0, 0xbe, 0xd6, 0x60,
+	0xb6, 0xdb, 0x20, 0x65, 0x4e, 0x77, 0x7c, 0xac, 0xaa, 0xe1, 0x4c, 0x68, 0x58, 0x03, 0x1d, 0x23,
+	0x81, 0x14, 0x0e, 0xf2, 0xab, 0x57, 0x07, 0x02, 0xd3, 0x61, 0xab, 0x22, 0x81, 0x2a, 0xba, 0x0c,
+	0xbc, 0xad, 0xce, 0x5b, 0x18, 0xae, 0x28, 0x2b, 0xaa, 0x04, 0xe1, 0xfb, 0xc8, 0xdb, 0x7a, 0x42,
+	0x85, 0xd3, 0x8c, 0x2b, 0xe3, 0xc4, 0x72, 0xb8, 0x09, 0x53, 0x64, 0xdf, 0xa7, 0x1c, 0xc9, 0xb1,
+	0xab, 0xd6, 0x24, 0xb4, 0xd1, 0x14, 0x4a, 0xbb, 0x6e, 0x4f, 0x76, 0x37, 0x3e, 0x54, 0x74, 0xeb,
+	0xab, 0x14, 0x5c, 0x55, 0x6a, 0xd6, 0x29, 0x77, 0x42, 0x2a, 0x2a, 0x9c, 0xc8, 0x58, 0x74, 0xf5,
+	0xbc, 0x95, 0x0a, 0xb8, 0x01, 0x97, 0x38, 0xd9, 0x25, 0x5c, 0x96, 0x6a, 0x5f, 0xb7, 0x98, 0xe8,
+	0x90, 0x55, 0x31, 0xc8, 0xcc, 0x47, 0xdb, 0x99, 0x28, 0xf3, 0x6a, 0x61, 0x94, 0x60, 0xba, 0x89,
+	0x5a, 0x72, 0x86, 0x0a, 0x3d, 0x41, 0x5b, 0x49, 0x0c, 0x24, 0xb8, 0xd3, 0xf6, 0x54, 0xb4, 0xb5,
+	0x23, 0x77, 0xe2, 0x20, 0x7c, 0x93, 0x82, 0x5c, 0x34, 0x28, 0x3f, 0x41, 0xfe, 0x09, 0x91, 0x9d,
+	0x81, 0x0c, 0x67, 0xa1, 0x20, 0x66, 0x6a, 0x29, 0x2d, 0xb5, 0xa9, 0x85, 0xe1, 0xc0, 0xa8, 0x7c,
+	0x14, 0xa9, 0x51, 0x4f, 0x79, 0x92, 0x5f, 0x9d, 0x2f, 0x45, 0x75, 0x53, 0x92, 0x1e, 0x97, 0xe2,
+	0x97, 0x27, 0xf5, 0x6a, 0xf1, 0xe6, 0xcf, 0x1c, 0x59, 0x29, 0x7a, 0xc3, 0x33, 0x08, 0x8c, 0x29,
+	0x25, 0x2c, 0x14, 0xa6, 0x7e, 0xee, 0x5a, 0x94, 0x03, 0x0f, 0x42, 0x61, 0xfd, 0xac, 0xc5, 0x37,
+	0x8f, 0x4d, 0x9e, 0x20, 0x8e, 0x37, 0x39, 0x6b, 0x70, 0xe4, 0xde, 0x0d, 0x3d, 0x4c, 0xb0, 0xec,
+	0x99, 0x01, 0xf1, 0x30, 0x49, 0xe2, 0x12, 0xaf, 0x8c, 0x3a, 0x64, 0x91, 0xcb, 0x42, 0x4f, 0x98,
+	0xa9, 0x73, 0xb7, 0x2c, 0x96, 0x6c, 0xbc, 0x0f, 0xa3, 0x7e, 0x64, 0x8c, 0x99, 0x3e, 0xb6, 0x90,
+	0xfa, 0x0c, 0x8e, 0x0b, 0x29, 0x61, 0xb1, 0xfe, 0xd4, 0xe2, 0x7f, 0x42, 0xd1, 0xa9, 0xa0, 0x4a,
+	0x03, 0xc1, 0x69, 0x3d, 0x14, 0x64, 0x10, 0xab, 0xda, 0x29, 0x58, 0x4d, 0x1d, 0xc1, 0x6a, 0xd7,
+	0xfb, 0xf4, 0x85, 0x79, 0xff, 0x1e, 0x64, 0x7d, 0x46, 0x3d, 0x11, 0x0c, 0xf7, 0x8f, 0x39, 0x3e,
+	0x6c, 0x7d, 0xaf, 0xc1, 0x74, 0xaf, 0xdb, 0xeb, 0x2d, 0x44, 0xdd, 0xa8, 0x77, 0x20, 0xc7, 0x51,
+	0x36, 0xc7, 0x08, 0x8f, 0x97, 0x86, 0xd3, 0x93, 0xca, 0xf4, 0xc9, 0xce, 0xdc, 0x92, 0x36, 0x3c,
+	0xfb, 0xad, 0xb8, 0x3c, 0xa4, 0x33, 0x41, 0xe2, 0x8d, 0xf5, 0x93, 0x06, 0x73, 0xdd, 0x06, 0xfb,
+	0x30, 0x64, 0x02, 0x25, 0x1d, 0xf6, 0xf5, 0x96, 0xdd, 0x86, 0x39, 0xb2, 0x2f, 0x38, 0x8a, 0xba,
+	0x79, 0x50, 0xf3, 0x09, 0xef, 0x49, 0x89, 0x6e, 0x4f, 0xab, 0x5d, 0x25, 0x31, 0xd8, 0x24, 0x3c,
+	0xca, 0x0d, 0x86, 0x51, 0x4e, 0x02, 0xc2, 0xdb, 0xe4, 0x02, 0x92, 0x93, 0x88, 0xb6, 0xfe, 0xd2,
+	0xe2, 0x1e, 0xfa, 0xc0, 0x27, 0x72, 0x1a, 0xbd, 0x13, 0x3a, 0xb2, 0xc3, 0xee, 0x78, 0x0e, 0x67,
+	0x41, 0xf0, 0xb6, 0x7a, 0x68, 0xb1, 0x7f, 0x8a, 0xc8, 0x5d, 0xc4, 0x04, 0x51, 0xb9, 0xf7, 0xfc,
+	0x70, 0x51, 0x7b, 0x71, 0xb8, 0xa8, 0xfd, 0x7e, 0xb8, 0xa8, 0x7d, 0xf7, 0x6a, 0x71, 0xe4, 0xc5,
+	0xab, 0xc5, 0x91, 0x5f, 0x5e, 0x2d, 0x8e, 0x7c, 0xb2, 0xd2, 0x13, 0xc6, 0x75, 0x55, 0xa9, 0x77,
+	0x59, 0xe8, 0x61, 0x75, 0xf7, 0x94, 0xe3, 0x47, 0xfb, 0xf6, 0x5a, 0x79, 0x5f, 0xbd, 0xdc, 0xab,
+	0xa8, 0xd6, 0xb3, 0xea, 0x05, 0xfe, 0xf6, 0xdf, 0x03, 0x00, 0xc6, 0x14, 0x37, 0xd4, 0xfe, 0x17,
+	0x00, 0x00,
+}
+
+func (m *EventOrderPlaced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderPlaced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderPlaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderReduced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderReduced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderReduced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VisibleBaseQuantity.Size()
+		i -= size
+		if _, err := m.VisibleBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Side != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ReceivedCoin.Size()
+		i -= size
+		if _, err := m.ReceivedCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SentCoin.Size()
+		i -= size
+		if _, err := m.SentCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VisibleBaseQuantity.Size()
+		i -= size
+		if _, err := m.VisibleBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Side != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RemainingSpendableBalance.Size()
+		i -= size
+		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderClosed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderClosed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Side != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RemainingSpendableBalance.Size()
+		i -= size
+		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSelfTradePrevented) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSelfTradePrevented) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSelfTradePrevented) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MakerVisibleBaseQuantity.Size()
+		i -= size
+		if _, err := m.MakerVisibleBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DecrementedBaseQuantity.Size()
+		i -= size
+		if _, err := m.DecrementedBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MakerCanceled {
+		i--
+		if m.MakerCanceled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TakerCanceled {
+		i--
+		if m.TakerCanceled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MakerOrderSequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MakerOrderSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MakerOrderID) > 0 {
+		i -= len(m.MakerOrderID)
+		copy(dAtA[i:], m.MakerOrderID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MakerOrderID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TakerOrderSequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TakerOrderSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TakerOrderID) > 0 {
+		i -= len(m.TakerOrderID)
+		copy(dAtA[i:], m.TakerOrderID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TakerOrderID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderRefreshed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderRefreshed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderRefreshed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Side != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousSequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PreviousSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderReplaced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderReplaced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderReplaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Side != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PriorityKept {
+		i--
+		if m.PriorityKept {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTriggerOrderCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTriggerOrderCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTriggerOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTriggerOrderActivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTriggerOrderActivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTriggerOrderActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastPrice) > 0 {
+		i -= len(m.LastPrice)
+		copy(dAtA[i:], m.LastPrice)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LastPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTriggerOrderCanceled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTriggerOrderCanceled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTriggerOrderCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TakerOrderID) > 0 {
+		i -= len(m.TakerOrderID)
+		copy(dAtA[i:], m.TakerOrderID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TakerOrderID)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Taker) > 0 {
+		i -= len(m.Taker)
+		copy(dAtA[i:], m.Taker)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Taker)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MakerOrderID) > 0 {
+		i -= len(m.MakerOrderID)
+		copy(dAtA[i:], m.MakerOrderID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MakerOrderID)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TakerSide != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TakerSide))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.QuoteQuantity.Size()
+		i -= size
+		if _, err := m.QuoteQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BaseQuantity.Size()
+		i -= size
+		if _, err := m.BaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderBookUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderBookUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderBookUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeadManSwitchTriggered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeadManSwitchTriggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeadManSwitchTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCircuitBreakerTriggered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCircuitBreakerTriggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCircuitBreakerTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltedUntilHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.HaltedUntilHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReferencePrice) > 0 {
+		i -= len(m.ReferencePrice)
+		copy(dAtA[i:], m.ReferencePrice)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReferencePrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Route[iNdEx])
+			copy(dAtA[i:], m.Route[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.Route[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardProgramFunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardProgramFunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardProgramFunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Program.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardsDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardsDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardsDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Points.Size()
+		i -= size
+		if _, err := m.Points.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardsClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardsClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardsClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderQuotaUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderQuotaUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderQuotaUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExtraOrdersPerDenom != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExtraOrdersPerDenom))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOpeningAuctionUncrossed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOpeningAuctionUncrossed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOpeningAuctionUncrossed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseQuantity.Size()
+		i -= size
+		if _, err := m.BaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventOrderPlaced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *EventOrderReduced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.SentCoin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovEvent(uint64(m.Side))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.VisibleBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovEvent(uint64(m.Side))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.VisibleBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderClosed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovEvent(uint64(m.Side))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventSelfTradePrevented) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovEvent(uint64(m.SelfTradePrevention))
+	}
+	l = len(m.TakerOrderID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.TakerOrderSequence != 0 {
+		n += 1 + sovEvent(uint64(m.TakerOrderSequence))
+	}
+	l = len(m.MakerOrderID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MakerOrderSequence != 0 {
+		n += 1 + sovEvent(uint64(m.MakerOrderSequence))
+	}
+	if m.TakerCanceled {
+		n += 2
+	}
+	if m.MakerCanceled {
+		n += 2
+	}
+	l = m.DecrementedBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MakerVisibleBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderRefreshed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PreviousSequence != 0 {
+		n += 1 + sovEvent(uint64(m.PreviousSequence))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovEvent(uint64(m.Side))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderReplaced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.PriorityKept {
+		n += 2
+	}
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovEvent(uint64(m.Side))
+	}
+	return n
+}
+
+func (m *EventTriggerOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *EventTriggerOrderActivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = len(m.LastPrice)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTriggerOrderCanceled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.QuoteQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.TakerSide != 0 {
+		n += 1 + sovEvent(uint64(m.TakerSide))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.MakerOrderID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Taker)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TakerOrderID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderBookUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventDeadManSwitchTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *EventCircuitBreakerTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ReferencePrice)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.HaltedUntilHeight != 0 {
+		n += 1 + sovEvent(uint64(m.HaltedUntilHeight))
+	}
+	return n
+}
+
+func (m *EventSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, s := range m.Route {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.CoinIn.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CoinOut.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventRewardProgramFunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Program.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventRewardsDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Points.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventRewardsClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventOrderQuotaUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ExtraOrdersPerDenom != 0 {
+		n += 1 + sovEvent(uint64(m.ExtraOrdersPerDenom))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOpeningAuctionUncrossed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovEvent(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.BaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderPlaced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderPlaced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderReduced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderReduced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VisibleBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VisibleBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderClosed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSelfTradePrevented) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSelfTradePrevented: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSelfTradePrevented: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerOrderSequence", wireType)
+			}
+			m.TakerOrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerOrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MakerOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderSequence", wireType)
+			}
+			m.MakerOrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerOrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCanceled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TakerCanceled = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCanceled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MakerCanceled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecrementedBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecrementedBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerVisibleBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerVisibleBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderRefreshed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderRefreshed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderRefreshed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSequence", wireType)
+			}
+			m.PreviousSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderReplaced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderReplaced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderReplaced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityKept", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PriorityKept = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerOrderActivated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerOrderActivated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerOrderActivated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerOrderCanceled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerOrderCanceled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerOrderCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerSide", wireType)
+			}
+			m.TakerSide = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerSide |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MakerOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderBookUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderBookUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderBookUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeadManSwitchTriggered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeadManSwitchTriggered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeadManSwitchTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCircuitBreakerTriggered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCircuitBreakerTriggered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCircuitBreakerTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferencePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedUntilHeight", wireType)
+			}
+			m.HaltedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.RemainingBaseQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RemainingSpendableBalance.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
+func (m *EventRewardProgramFunded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -693,15 +7375,15 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderPlaced: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRewardProgramFunded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderPlaced: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRewardProgramFunded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -729,13 +7411,13 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -745,29 +7427,30 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -777,11 +7460,25 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Program.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -803,7 +7500,7 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
+func (m *EventRewardsDistributed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -826,15 +7523,15 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderReduced: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRewardsDistributed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderReduced: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRewardsDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -862,11 +7559,11 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -894,32 +7591,13 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -929,29 +7607,28 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SentCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -979,7 +7656,7 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Points.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1004,7 +7681,7 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRewardsClaimed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,15 +7704,15 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRewardsClaimed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRewardsClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1063,13 +7740,13 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1079,46 +7756,79 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderQuotaUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderQuotaUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderQuotaUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1146,15 +7856,32 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOrdersPerDenom", wireType)
+			}
+			m.ExtraOrdersPerDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtraOrdersPerDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1164,23 +7891,22 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1205,7 +7931,7 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
+func (m *EventOpeningAuctionUncrossed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1228,17 +7954,17 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderClosed: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOpeningAuctionUncrossed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOpeningAuctionUncrossed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
 			}
-			var stringLen uint64
+			m.OrderBookID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1248,27 +7974,14 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderBookID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1296,13 +8009,13 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1312,14 +8025,27 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1347,13 +8073,15 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v Price
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1381,7 +8109,7 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14,6 +14,25 @@ import (
 // AccountKeeper defines the expected account keeper interface.
 type AccountKeeper interface {
 	GetAccount(ctx context.Context, address sdk.AccAddress) sdk.AccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected bank keeper interface.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(
+		ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+	) error
+	SendCoinsFromModuleToAccount(
+		ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+	) error
+}
+
+// DistributionKeeper defines the expected distribution keeper interface.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // AccountQueryServer defines the expected account query server interface.
@@ -26,17 +45,21 @@ type AccountQueryServer interface {
 // AssetFTKeeper represents required methods of asset ft keeper.
 type AssetFTKeeper interface {
 	DEXExecuteActions(ctx sdk.Context, actions dextypes.DEXActions) error
+	DEXIncreaseLimits(ctx sdk.Context, addr sdk.AccAddress, lockedCoin sdk.Coins, expectedToReceiveCoin sdk.Coin) error
 	DEXDecreaseLimits(ctx sdk.Context, addr sdk.AccAddress, lockedCoin sdk.Coins, expectedToReceiveCoin sdk.Coin) error
+	DEXCheckOrderAmounts(
+		ctx sdk.Context, order dextypes.DEXOrder, expectedToSpend, expectedToReceive sdk.Coin,
+	) error
 	GetSpendableBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
 	GetDEXSettings(ctx sdk.Context, denom string) (dextypes.DEXSettings, error)
 	ValidateDEXCancelOrdersByDenomIsAllowed(ctx sdk.Context, addr sdk.AccAddress, denom string) error
+	ValidateDEXOrderBookUpdateIsAllowed(ctx sdk.Context, addr sdk.AccAddress, denom string) error
 	HasSupply(ctx context.Context, denom string) bool
 }
 
 // DelayKeeper defines methods required from the delay keeper.
 type DelayKeeper interface {
 	ExecuteAfterBlock(ctx sdk.Context, id string, data proto.Message, height uint64) error
-	ExecuteAfter(ctx sdk.Context, id string, data proto.Message, time time.Time) error
 	RemoveExecuteAtBlock(ctx sdk.Context, id string, height uint64) error
 	RemoveExecuteAfter(ctx sdk.Context, id string, time time.Time) error
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +22,9 @@ func (gs GenesisState) Validate() error {
 	}
 	denoms := make(map[string]struct{})
 	for _, ob := range gs.OrderBooks {
+		if err := ob.Data.Validate(); err != nil {
+			return err
+		}
 		denoms[ob.Data.BaseDenom] = struct{}{}
 		denoms[ob.Data.QuoteDenom] = struct{}{}
 	}
@@ -40,6 +45,7 @@ func (gs GenesisState) Validate() error {
 		order.Sequence = 0
 		order.RemainingBaseQuantity = sdkmath.Int{}
 		order.RemainingSpendableBalance = sdkmath.Int{}
+		order.HiddenBaseQuantity = nil
 		order.Reserve = sdk.Coin{}
 
 		if err := order.Validate(); err != nil {
@@ -47,5 +53,133 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, order := range gs.TriggerOrders {
+		if _, ok := usedSequence[order.Sequence]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate order sequence %d", order.Sequence)
+		}
+		usedSequence[order.Sequence] = struct{}{}
+
+		if order.Trigger == nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "trigger order %s must have a trigger", order.ID)
+		}
+		if _, ok := denoms[order.BaseDenom]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "base denom %s does not exist in order books", order.BaseDenom)
+		}
+		if _, ok := denoms[order.QuoteDenom]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "quote denom %s does not exist in order books", order.QuoteDenom)
+		}
+
+		order.Sequence = 0
+		order.RemainingBaseQuantity = sdkmath.Int{}
+		order.RemainingSpendableBalance = sdkmath.Int{}
+		order.Reserve = sdk.Coin{}
+
+		if err := order.Validate(); err != nil {
+			return err
+		}
+	}
+
+	orderBookIDs := make(map[uint32]struct{})
+	for _, ob := range gs.OrderBooks {
+		orderBookIDs[ob.ID] = struct{}{}
+	}
+	for _, lastTrade := range gs.LastTrades {
+		if _, ok := orderBookIDs[lastTrade.OrderBookID]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "order book %d of the last trade does not exist", lastTrade.OrderBookID)
+		}
+		if lastTrade.Price.Rat().Sign() <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidInput, "last trade price of order book %d must be positive", lastTrade.OrderBookID)
+		}
+	}
+	for _, accumulator := range gs.PriceAccumulators {
+		if _, ok := orderBookIDs[accumulator.OrderBookID]; !ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "order book %d of the price accumulator does not exist", accumulator.OrderBookID,
+			)
+		}
+		if accumulator.LastPrice.IsNil() || !accumulator.LastPrice.IsPositive() {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "last price of the price accumulator of order book %d must be positive",
+				accumulator.OrderBookID,
+			)
+		}
+		if accumulator.CumulativePrice.IsNil() || accumulator.CumulativePrice.IsNegative() {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "cumulative price of the price accumulator of order book %d must not be negative",
+				accumulator.OrderBookID,
+			)
+		}
+	}
+
+	deadManSwitchCreators := make(map[string]struct{})
+	for _, deadManSwitch := range gs.DeadManSwitches {
+		if _, ok := deadManSwitchCreators[deadManSwitch.Creator]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate dead man's switch of %s", deadManSwitch.Creator)
+		}
+		deadManSwitchCreators[deadManSwitch.Creator] = struct{}{}
+
+		if err := deadManSwitch.Validate(); err != nil {
+			return err
+		}
+	}
+
+	tradingVolumes := make(map[string]struct{})
+	for _, tradingVolume := range gs.TradingVolumes {
+		key := fmt.Sprintf("%s/%d", tradingVolume.Account, tradingVolume.Day)
+		if _, ok := tradingVolumes[key]; ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicate trading volume of %s at day %d", tradingVolume.Account, tradingVolume.Day,
+			)
+		}
+		tradingVolumes[key] = struct{}{}
+
+		if err := tradingVolume.Validate(); err != nil {
+			return err
+		}
+	}
+
+	rewardPrograms := make(map[string]struct{})
+	for _, program := range gs.RewardPrograms {
+		key := fmt.Sprintf("%s/%s", program.BaseDenom, program.QuoteDenom)
+		if _, ok := rewardPrograms[key]; ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicate reward program of order book %s/%s", program.BaseDenom, program.QuoteDenom,
+			)
+		}
+		rewardPrograms[key] = struct{}{}
+
+		if err := program.Validate(); err != nil {
+			return err
+		}
+	}
+
+	accountRewards := make(map[string]struct{})
+	for _, accountReward := range gs.AccountRewards {
+		programKey := fmt.Sprintf("%s/%s", accountReward.BaseDenom, accountReward.QuoteDenom)
+		key := fmt.Sprintf("%s/%s", accountReward.Account, programKey)
+		if _, ok := accountRewards[key]; ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicate reward of %s in order book %s", accountReward.Account, programKey,
+			)
+		}
+		accountRewards[key] = struct{}{}
+
+		if err := accountReward.Validate(); err != nil {
+			return err
+		}
+	}
+
+	orderQuotas := make(map[string]struct{})
+	for _, orderQuota := range gs.OrderQuotas {
+		if _, ok := orderQuotas[orderQuota.Account]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate order quota of %s", orderQuota.Account)
+		}
+		orderQuotas[orderQuota.Account] = struct{}{}
+
+		if err := orderQuota.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
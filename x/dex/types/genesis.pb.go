@@ -33,6 +33,22 @@ type GenesisState struct {
 	OrderSequence              uint64                    `protobuf:"varint,4,opt,name=order_sequence,json=orderSequence,proto3" json:"order_sequence,omitempty"`
 	AccountsDenomsOrdersCounts []AccountDenomOrdersCount `protobuf:"bytes,5,rep,name=accounts_denoms_orders_counts,json=accountsDenomsOrdersCounts,proto3" json:"accounts_denoms_orders_counts"`
 	ReservedOrderIds           [][]byte                  `protobuf:"bytes,6,rep,name=reserved_order_ids,json=reservedOrderIds,proto3" json:"reserved_order_ids,omitempty"`
+	// trigger_orders is the list of not activated trigger orders.
+	TriggerOrders []Order `protobuf:"bytes,7,rep,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders"`
+	// last_trades is the list of order books last trades.
+	LastTrades []OrderBookLastTrade `protobuf:"bytes,8,rep,name=last_trades,json=lastTrades,proto3" json:"last_trades"`
+	// price_accumulators is the list of order books price accumulators within the TWAP retention period.
+	PriceAccumulators []PriceAccumulator `protobuf:"bytes,9,rep,name=price_accumulators,json=priceAccumulators,proto3" json:"price_accumulators"`
+	// dead_man_switches is the list of the armed accounts dead man's switches.
+	DeadManSwitches []DeadManSwitch `protobuf:"bytes,10,rep,name=dead_man_switches,json=deadManSwitches,proto3" json:"dead_man_switches"`
+	// trading_volumes is the list of the accounts daily trading volumes within the trading volume window.
+	TradingVolumes []TradingVolume `protobuf:"bytes,11,rep,name=trading_volumes,json=tradingVolumes,proto3" json:"trading_volumes"`
+	// reward_programs is the list of the order books reward programs.
+	RewardPrograms []RewardProgram `protobuf:"bytes,12,rep,name=reward_programs,json=rewardPrograms,proto3" json:"reward_programs"`
+	// account_rewards is the list of the rewards accrued by the accounts in the reward programs.
+	AccountRewards []AccountReward `protobuf:"bytes,13,rep,name=account_rewards,json=accountRewards,proto3" json:"account_rewards"`
+	// order_quotas is the list of the accounts order quotas.
+	OrderQuotas []OrderQuota `protobuf:"bytes,14,rep,name=order_quotas,json=orderQuotas,proto3" json:"order_quotas"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +126,62 @@ func (m *GenesisState) GetReservedOrderIds() [][]byte {
 	return nil
 }
 
+func (m *GenesisState) GetTriggerOrders() []Order {
+	if m != nil {
+		return m.TriggerOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTrades() []OrderBookLastTrade {
+	if m != nil {
+		return m.LastTrades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceAccumulators() []PriceAccumulator {
+	if m != nil {
+		return m.PriceAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeadManSwitches() []DeadManSwitch {
+	if m != nil {
+		return m.DeadManSwitches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradingVolumes() []TradingVolume {
+	if m != nil {
+		return m.TradingVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardPrograms() []RewardProgram {
+	if m != nil {
+		return m.RewardPrograms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountRewards() []AccountReward {
+	if m != nil {
+		return m.AccountRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderQuotas() []OrderQuota {
+	if m != nil {
+		return m.OrderQuotas
+	}
+	return nil
+}
+
 // OrderBookDataWithID is a order book data with it's corresponding ID.
 type OrderBookDataWithID struct {
 	// id is order book ID.
@@ -235,37 +307,50 @@ func init() {
 func init() { proto.RegisterFile("coreum/dex/v1/genesis.proto", fileDescriptor_a9d24a0566883c25) }
 
 var fileDescriptor_a9d24a0566883c25 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x6f, 0xfe, 0x6c, 0xc0, 0x69, 0x2b, 0x32, 0x56, 0x8d, 0x51, 0xb3, 0xb5, 0xa0, 0xf4, 0x20,
-	0x09, 0xed, 0xc2, 0xde, 0xed, 0x16, 0xa5, 0x08, 0x2a, 0xd9, 0x83, 0xe0, 0x25, 0x4c, 0x33, 0x43,
-	0x37, 0x2c, 0xc9, 0xab, 0x99, 0x49, 0xe9, 0x7e, 0x03, 0x8f, 0x7e, 0xac, 0x3d, 0xee, 0xd1, 0xd3,
-	0x22, 0xed, 0x17, 0x91, 0xbc, 0x99, 0xc2, 0x76, 0xa9, 0xde, 0x66, 0xde, 0xfb, 0xfd, 0x99, 0xf7,
-	0x9b, 0x47, 0x5e, 0x64, 0x50, 0x89, 0xba, 0x88, 0xb9, 0x58, 0xc7, 0xab, 0x51, 0xbc, 0x10, 0xa5,
-	0x90, 0xb9, 0x8c, 0x96, 0x15, 0x28, 0xa0, 0x5d, 0xdd, 0x8c, 0xb8, 0x58, 0x47, 0xab, 0x51, 0xf0,
-	0x7c, 0x1f, 0x0b, 0x15, 0x17, 0x95, 0x46, 0x06, 0xc1, 0x7e, 0x6b, 0xc9, 0x2a, 0x56, 0x18, 0x95,
-	0xa0, 0xb7, 0x80, 0x05, 0xe0, 0x31, 0x6e, 0x4e, 0xba, 0x3a, 0xf8, 0xe9, 0x90, 0xce, 0x47, 0xed,
-	0x76, 0xae, 0x98, 0x12, 0xf4, 0x84, 0x78, 0x9a, 0xe6, 0x5b, 0x7d, 0x6b, 0xd8, 0x1e, 0x3f, 0x89,
-	0xf6, 0xdc, 0xa3, 0xaf, 0xd8, 0x9c, 0xb8, 0xd7, 0xb7, 0xc7, 0xad, 0xc4, 0x40, 0xe9, 0x8c, 0xb4,
-	0xf1, 0x19, 0xe9, 0x1c, 0xe0, 0x52, 0xfa, 0x76, 0xdf, 0x19, 0xb6, 0xc7, 0x83, 0x7b, 0xcc, 0x2f,
-	0x0d, 0x62, 0x02, 0x70, 0x39, 0x65, 0x8a, 0x7d, 0xcb, 0xd5, 0xc5, 0x6c, 0x6a, 0x64, 0x08, 0xec,
-	0x5a, 0x92, 0x8e, 0x89, 0x87, 0x37, 0xe9, 0x3b, 0xa8, 0xd2, 0x3b, 0xa8, 0x62, 0xec, 0x35, 0x92,
-	0xbe, 0x21, 0x0f, 0xb5, 0xbd, 0x14, 0x3f, 0x6a, 0x51, 0x66, 0xc2, 0x77, 0xfb, 0xd6, 0xd0, 0x4d,
-	0xba, 0x58, 0x3d, 0x37, 0x45, 0x0a, 0xe4, 0x15, 0xcb, 0x32, 0xa8, 0x4b, 0x25, 0x53, 0x2e, 0x4a,
-	0x28, 0x64, 0xaa, 0x05, 0x52, 0x5d, 0xf4, 0x8f, 0xd0, 0xf1, 0xed, 0x3d, 0xc7, 0xf7, 0x9a, 0x33,
-	0x6d, 0x18, 0xe8, 0x2e, 0xcf, 0x9a, 0xbb, 0x79, 0x43, 0xb0, 0x93, 0xc4, 0xbe, 0xbc, 0x03, 0x90,
-	0xf4, 0x1d, 0xa1, 0x95, 0x90, 0xa2, 0x5a, 0x09, 0xae, 0x9d, 0xd2, 0x9c, 0x4b, 0xdf, 0xeb, 0x3b,
-	0xc3, 0x4e, 0xf2, 0x68, 0xd7, 0x41, 0xc6, 0x8c, 0xcb, 0x81, 0x20, 0x8f, 0x0f, 0x44, 0x44, 0x9f,
-	0x12, 0x3b, 0xe7, 0xf8, 0x19, 0xdd, 0x89, 0xb7, 0xb9, 0x3d, 0xb6, 0x67, 0xd3, 0xc4, 0xce, 0x39,
-	0x3d, 0x25, 0x2e, 0x67, 0x8a, 0xf9, 0x36, 0x7e, 0xd3, 0xcb, 0xff, 0x85, 0x6d, 0x9e, 0x8a, 0xf8,
-	0xc1, 0x15, 0x79, 0xf6, 0x8f, 0x89, 0x9a, 0x1c, 0xcd, 0x34, 0x69, 0x59, 0x17, 0x73, 0x51, 0xa1,
-	0xad, 0x9b, 0x74, 0x4d, 0xf5, 0x33, 0x16, 0x69, 0x8f, 0x1c, 0x61, 0x7c, 0x68, 0xfd, 0x20, 0xd1,
-	0x17, 0xfa, 0x9a, 0x74, 0xee, 0xa6, 0xe9, 0x3b, 0x48, 0xd5, 0x7b, 0x61, 0x12, 0xfb, 0x74, 0xbd,
-	0x09, 0xad, 0x9b, 0x4d, 0x68, 0xfd, 0xd9, 0x84, 0xd6, 0xaf, 0x6d, 0xd8, 0xba, 0xd9, 0x86, 0xad,
-	0xdf, 0xdb, 0xb0, 0xf5, 0x7d, 0xb4, 0xc8, 0xd5, 0x45, 0x3d, 0x8f, 0x32, 0x28, 0xe2, 0x33, 0x1c,
-	0xe4, 0x03, 0xd4, 0x25, 0x67, 0x2a, 0x87, 0x32, 0x36, 0x4b, 0xbd, 0x3a, 0x8d, 0xd7, 0xb8, 0xd9,
-	0xea, 0x6a, 0x29, 0xe4, 0xdc, 0xc3, 0x05, 0x3e, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x12,
-	0x37, 0x71, 0x3b, 0x03, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x5d, 0x4f, 0xdb, 0x3e,
+	0x14, 0xc6, 0xfb, 0x46, 0xf9, 0xe3, 0xbe, 0xf0, 0xc7, 0x63, 0x9b, 0xe9, 0xb6, 0x52, 0x2a, 0x6d,
+	0xea, 0xc5, 0xd4, 0x0a, 0x90, 0xb8, 0xa7, 0x54, 0xdb, 0x2a, 0x36, 0xc6, 0x0a, 0xda, 0xa4, 0xdd,
+	0x44, 0x6e, 0x6c, 0x85, 0x88, 0x26, 0x2e, 0xb6, 0x53, 0xe0, 0x5b, 0xec, 0x63, 0x71, 0xc9, 0xe5,
+	0xae, 0xd0, 0x54, 0xa4, 0x7d, 0x8e, 0x29, 0xc7, 0xce, 0x68, 0xab, 0xa0, 0xdd, 0x25, 0xcf, 0x79,
+	0xce, 0xef, 0x71, 0x62, 0x1f, 0xa3, 0x17, 0xae, 0x90, 0x3c, 0x0a, 0x3a, 0x8c, 0x5f, 0x75, 0x26,
+	0xdb, 0x1d, 0x8f, 0x87, 0x5c, 0xf9, 0xaa, 0x3d, 0x96, 0x42, 0x0b, 0x5c, 0x31, 0xc5, 0x36, 0xe3,
+	0x57, 0xed, 0xc9, 0x76, 0x6d, 0x63, 0xde, 0x2b, 0x24, 0xe3, 0xd2, 0x38, 0x6b, 0xb5, 0xf9, 0xd2,
+	0x98, 0x4a, 0x1a, 0x58, 0x4a, 0x6d, 0xdd, 0x13, 0x9e, 0x80, 0xc7, 0x4e, 0xfc, 0x64, 0xd4, 0xe6,
+	0xef, 0x65, 0x54, 0x7e, 0x6f, 0xd2, 0x4e, 0x34, 0xd5, 0x1c, 0xef, 0xa2, 0xa2, 0x69, 0x23, 0xd9,
+	0x46, 0xb6, 0x55, 0xda, 0x79, 0xda, 0x9e, 0x4b, 0x6f, 0x1f, 0x43, 0xb1, 0x5b, 0xb8, 0xb9, 0xdb,
+	0xcc, 0x0c, 0xac, 0x15, 0xf7, 0x51, 0x09, 0x96, 0xe1, 0x0c, 0x85, 0x38, 0x57, 0x24, 0xd7, 0xc8,
+	0xb7, 0x4a, 0x3b, 0xcd, 0x85, 0xce, 0xcf, 0xb1, 0xa3, 0x2b, 0xc4, 0x79, 0x8f, 0x6a, 0xfa, 0xcd,
+	0xd7, 0x67, 0xfd, 0x9e, 0xc5, 0x20, 0x91, 0x94, 0x14, 0xde, 0x41, 0x45, 0x78, 0x53, 0x24, 0x0f,
+	0x94, 0xf5, 0x54, 0x8a, 0x8d, 0x37, 0x4e, 0xfc, 0x1a, 0x55, 0x4d, 0xbc, 0xe2, 0x17, 0x11, 0x0f,
+	0x5d, 0x4e, 0x0a, 0x8d, 0x6c, 0xab, 0x30, 0xa8, 0x80, 0x7a, 0x62, 0x45, 0x2c, 0xd0, 0x2b, 0xea,
+	0xba, 0x22, 0x0a, 0xb5, 0x72, 0x18, 0x0f, 0x45, 0xa0, 0x1c, 0x03, 0x70, 0x8c, 0x48, 0x96, 0x20,
+	0xf1, 0xcd, 0x42, 0xe2, 0xbe, 0xe9, 0xe9, 0xc5, 0x1d, 0x90, 0xae, 0x0e, 0xe2, 0x77, 0xbb, 0x86,
+	0x5a, 0x82, 0x84, 0xba, 0x9a, 0x31, 0x28, 0xfc, 0x16, 0x61, 0xc9, 0x15, 0x97, 0x13, 0xce, 0x4c,
+	0x92, 0xe3, 0x33, 0x45, 0x8a, 0x8d, 0x7c, 0xab, 0x3c, 0xf8, 0x3f, 0xa9, 0x40, 0x47, 0x9f, 0x29,
+	0xbc, 0x8f, 0xaa, 0x5a, 0xfa, 0x9e, 0xc7, 0xa5, 0x5d, 0x16, 0x59, 0xfe, 0xe7, 0x1f, 0xa8, 0xd8,
+	0x0e, 0x13, 0x8b, 0x3f, 0xa0, 0xd2, 0x88, 0x2a, 0xed, 0x68, 0x49, 0x19, 0x57, 0xe4, 0x3f, 0xe8,
+	0xdf, 0x7a, 0x6c, 0x1f, 0x3e, 0x52, 0xa5, 0x4f, 0x63, 0x67, 0xb2, 0x0d, 0xa3, 0x44, 0x50, 0xf8,
+	0x14, 0xe1, 0xb1, 0xf4, 0x5d, 0xee, 0x50, 0xd7, 0x8d, 0x82, 0x68, 0x44, 0xb5, 0x90, 0x8a, 0xac,
+	0x00, 0x70, 0x73, 0xf1, 0x48, 0xc4, 0xc6, 0xfd, 0x07, 0x9f, 0xc5, 0xad, 0x8d, 0x17, 0x74, 0x85,
+	0x8f, 0xd0, 0x1a, 0xe3, 0x94, 0x39, 0x01, 0x0d, 0x1d, 0x75, 0xe9, 0x6b, 0xf7, 0x8c, 0x2b, 0x82,
+	0x00, 0xfa, 0x72, 0x01, 0xda, 0xe3, 0x94, 0x7d, 0xa2, 0xe1, 0x09, 0xb8, 0x2c, 0x71, 0x95, 0xcd,
+	0x8a, 0x5c, 0xe1, 0x43, 0xb4, 0x1a, 0x7f, 0xaa, 0x1f, 0x7a, 0xce, 0x44, 0x8c, 0xa2, 0x80, 0x2b,
+	0x52, 0x4a, 0xa5, 0x9d, 0x1a, 0xd7, 0x57, 0x30, 0x59, 0x5a, 0x55, 0xcf, 0x8a, 0x00, 0x93, 0xfc,
+	0x92, 0x4a, 0xe6, 0x8c, 0xa5, 0xf0, 0x60, 0x04, 0xca, 0xa9, 0xb0, 0x01, 0xb8, 0x8e, 0x8d, 0x29,
+	0x81, 0xc9, 0x59, 0x11, 0x60, 0xf6, 0x60, 0x38, 0xa6, 0xa2, 0x48, 0x25, 0x15, 0x66, 0x4f, 0x97,
+	0x61, 0x26, 0x30, 0x3a, 0x2b, 0x2a, 0xdc, 0x45, 0x65, 0x73, 0x7c, 0x2e, 0x22, 0xa1, 0xa9, 0x22,
+	0x55, 0x20, 0x6d, 0xa4, 0xed, 0xeb, 0x97, 0xd8, 0x61, 0x31, 0x25, 0xf1, 0x57, 0x51, 0x4d, 0x8e,
+	0x9e, 0xa4, 0x0c, 0x20, 0x7e, 0x86, 0x72, 0x3e, 0x83, 0x51, 0xaf, 0x74, 0x8b, 0xd3, 0xbb, 0xcd,
+	0x5c, 0xbf, 0x37, 0xc8, 0xf9, 0x0c, 0xef, 0xa1, 0x02, 0xa3, 0x9a, 0x92, 0x5c, 0x23, 0x9b, 0xb2,
+	0xe8, 0x39, 0x92, 0x4d, 0x03, 0x7f, 0xf3, 0x1a, 0x3d, 0x7f, 0x64, 0x5e, 0xe2, 0x29, 0x4d, 0x7e,
+	0x49, 0x18, 0x05, 0x43, 0x2e, 0x21, 0xb6, 0x30, 0xa8, 0x58, 0xf5, 0x08, 0x44, 0xbc, 0x8e, 0x96,
+	0x60, 0x38, 0x21, 0x7a, 0x65, 0x60, 0x5e, 0xf0, 0x16, 0x2a, 0xcf, 0xce, 0x2a, 0xc9, 0x43, 0x6b,
+	0x49, 0x3c, 0xf0, 0xbb, 0x87, 0x37, 0xd3, 0x7a, 0xf6, 0x76, 0x5a, 0xcf, 0xfe, 0x9a, 0xd6, 0xb3,
+	0x3f, 0xee, 0xeb, 0x99, 0xdb, 0xfb, 0x7a, 0xe6, 0xe7, 0x7d, 0x3d, 0xf3, 0x7d, 0xdb, 0xf3, 0xf5,
+	0x59, 0x34, 0x6c, 0xbb, 0x22, 0xe8, 0x1c, 0xc0, 0x87, 0xbc, 0x13, 0x51, 0xc8, 0xa8, 0xf6, 0x45,
+	0xd8, 0xb1, 0x57, 0xe6, 0x64, 0xaf, 0x73, 0x05, 0xf7, 0xa6, 0xbe, 0x1e, 0x73, 0x35, 0x2c, 0xc2,
+	0xf5, 0xb8, 0xfb, 0x67, 0x00, 0x50, 0x01, 0xb4, 0x3d, 0x99, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -288,6 +373,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderQuotas) > 0 {
+		for iNdEx := len(m.OrderQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AccountRewards) > 0 {
+		for iNdEx := len(m.AccountRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RewardPrograms) > 0 {
+		for iNdEx := len(m.RewardPrograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPrograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TradingVolumes) > 0 {
+		for iNdEx := len(m.TradingVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradingVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DeadManSwitches) > 0 {
+		for iNdEx := len(m.DeadManSwitches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadManSwitches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PriceAccumulators) > 0 {
+		for iNdEx := len(m.PriceAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LastTrades) > 0 {
+		for iNdEx := len(m.LastTrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastTrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TriggerOrders) > 0 {
+		for iNdEx := len(m.TriggerOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ReservedOrderIds) > 0 {
 		for iNdEx := len(m.ReservedOrderIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReservedOrderIds[iNdEx])
@@ -481,6 +678,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TriggerOrders) > 0 {
+		for _, e := range m.TriggerOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastTrades) > 0 {
+		for _, e := range m.LastTrades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceAccumulators) > 0 {
+		for _, e := range m.PriceAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeadManSwitches) > 0 {
+		for _, e := range m.DeadManSwitches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TradingVolumes) > 0 {
+		for _, e := range m.TradingVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardPrograms) > 0 {
+		for _, e := range m.RewardPrograms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountRewards) > 0 {
+		for _, e := range m.AccountRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderQuotas) > 0 {
+		for _, e := range m.OrderQuotas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -738,6 +983,278 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			m.ReservedOrderIds = append(m.ReservedOrderIds, make([]byte, postIndex-iNdEx))
 			copy(m.ReservedOrderIds[len(m.ReservedOrderIds)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrders = append(m.TriggerOrders, Order{})
+			if err := m.TriggerOrders[len(m.TriggerOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastTrades = append(m.LastTrades, OrderBookLastTrade{})
+			if err := m.LastTrades[len(m.LastTrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceAccumulators = append(m.PriceAccumulators, PriceAccumulator{})
+			if err := m.PriceAccumulators[len(m.PriceAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadManSwitches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadManSwitches = append(m.DeadManSwitches, DeadManSwitch{})
+			if err := m.DeadManSwitches[len(m.DeadManSwitches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradingVolumes = append(m.TradingVolumes, TradingVolume{})
+			if err := m.TradingVolumes[len(m.TradingVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPrograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPrograms = append(m.RewardPrograms, RewardProgram{})
+			if err := m.RewardPrograms[len(m.RewardPrograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountRewards = append(m.AccountRewards, AccountReward{})
+			if err := m.AccountRewards[len(m.AccountRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderQuotas = append(m.OrderQuotas, OrderQuota{})
+			if err := m.OrderQuotas[len(m.OrderQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
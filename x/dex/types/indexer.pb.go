@@ -0,0 +1,3689 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/dex/v1/indexer.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IndexedTrade is a trade stored by the indexer. Each trade is stored in both the maker order book, and the opposite
+// order book with the inverted price rounded down to the max price precision.
+type IndexedTrade struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price is the trade price.
+	Price Price `protobuf:"bytes,3,opt,name=price,proto3,customtype=Price" json:"price"`
+	// base_quantity is the traded amount of the base denom.
+	BaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=base_quantity,json=baseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"base_quantity"`
+	// quote_quantity is the traded amount of the quote denom.
+	QuoteQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=quote_quantity,json=quoteQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"quote_quantity"`
+	// taker_side is the side of the taker in the order book.
+	TakerSide Side `protobuf:"varint,6,opt,name=taker_side,json=takerSide,proto3,enum=coreum.dex.v1.Side" json:"taker_side,omitempty"`
+	// maker is the maker order creator address.
+	Maker string `protobuf:"bytes,7,opt,name=maker,proto3" json:"maker,omitempty"`
+	// maker_order_id is the maker order ID.
+	MakerOrderID string `protobuf:"bytes,8,opt,name=maker_order_id,json=makerOrderId,proto3" json:"maker_order_id,omitempty"`
+	// taker is the taker order creator address.
+	Taker string `protobuf:"bytes,9,opt,name=taker,proto3" json:"taker,omitempty"`
+	// taker_order_id is the taker order ID.
+	TakerOrderID string `protobuf:"bytes,10,opt,name=taker_order_id,json=takerOrderId,proto3" json:"taker_order_id,omitempty"`
+	// height is the block height of the trade.
+	Height int64 `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the trade.
+	Time time.Time `protobuf:"bytes,12,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *IndexedTrade) Reset()         { *m = IndexedTrade{} }
+func (m *IndexedTrade) String() string { return proto.CompactTextString(m) }
+func (*IndexedTrade) ProtoMessage()    {}
+func (*IndexedTrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{0}
+}
+func (m *IndexedTrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedTrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedTrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedTrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedTrade.Merge(m, src)
+}
+func (m *IndexedTrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedTrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedTrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedTrade proto.InternalMessageInfo
+
+func (m *IndexedTrade) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *IndexedTrade) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *IndexedTrade) GetTakerSide() Side {
+	if m != nil {
+		return m.TakerSide
+	}
+	return SIDE_UNSPECIFIED
+}
+
+func (m *IndexedTrade) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *IndexedTrade) GetMakerOrderID() string {
+	if m != nil {
+		return m.MakerOrderID
+	}
+	return ""
+}
+
+func (m *IndexedTrade) GetTaker() string {
+	if m != nil {
+		return m.Taker
+	}
+	return ""
+}
+
+func (m *IndexedTrade) GetTakerOrderID() string {
+	if m != nil {
+		return m.TakerOrderID
+	}
+	return ""
+}
+
+func (m *IndexedTrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IndexedTrade) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// Candle is an OHLCV candle of the order book.
+type Candle struct {
+	// open_time is the start time of the candle interval.
+	OpenTime time.Time `protobuf:"bytes,1,opt,name=open_time,json=openTime,proto3,stdtime" json:"open_time"`
+	// open is the price of the first trade in the interval.
+	Open Price `protobuf:"bytes,2,opt,name=open,proto3,customtype=Price" json:"open"`
+	// high is the highest trade price in the interval.
+	High Price `protobuf:"bytes,3,opt,name=high,proto3,customtype=Price" json:"high"`
+	// low is the lowest trade price in the interval.
+	Low Price `protobuf:"bytes,4,opt,name=low,proto3,customtype=Price" json:"low"`
+	// close is the price of the last trade in the interval.
+	Close Price `protobuf:"bytes,5,opt,name=close,proto3,customtype=Price" json:"close"`
+	// base_volume is the traded amount of the base denom in the interval.
+	BaseVolume cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=base_volume,json=baseVolume,proto3,customtype=cosmossdk.io/math.Int" json:"base_volume"`
+	// quote_volume is the traded amount of the quote denom in the interval.
+	QuoteVolume cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=quote_volume,json=quoteVolume,proto3,customtype=cosmossdk.io/math.Int" json:"quote_volume"`
+	// trades_count is the number of trades in the interval.
+	TradesCount uint64 `protobuf:"varint,8,opt,name=trades_count,json=tradesCount,proto3" json:"trades_count,omitempty"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{1}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetOpenTime() time.Time {
+	if m != nil {
+		return m.OpenTime
+	}
+	return time.Time{}
+}
+
+func (m *Candle) GetTradesCount() uint64 {
+	if m != nil {
+		return m.TradesCount
+	}
+	return 0
+}
+
+// QueryTradesRequest defines the request type for the `Trades` query.
+type QueryTradesRequest struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradesRequest) Reset()         { *m = QueryTradesRequest{} }
+func (m *QueryTradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesRequest) ProtoMessage()    {}
+func (*QueryTradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{2}
+}
+func (m *QueryTradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesRequest.Merge(m, src)
+}
+func (m *QueryTradesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradesRequest proto.InternalMessageInfo
+
+func (m *QueryTradesRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *QueryTradesRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryTradesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTradesResponse defines the response type for the `Trades` query.
+type QueryTradesResponse struct {
+	// trades are the order book trades.
+	Trades []IndexedTrade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradesResponse) Reset()         { *m = QueryTradesResponse{} }
+func (m *QueryTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesResponse) ProtoMessage()    {}
+func (*QueryTradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{3}
+}
+func (m *QueryTradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesResponse.Merge(m, src)
+}
+func (m *QueryTradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradesResponse proto.InternalMessageInfo
+
+func (m *QueryTradesResponse) GetTrades() []IndexedTrade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *QueryTradesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCandlesRequest defines the request type for the `Candles` query.
+type QueryCandlesRequest struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// interval is the candle interval, must be one of the intervals configured on the node.
+	Interval time.Duration `protobuf:"bytes,3,opt,name=interval,proto3,stdduration" json:"interval"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCandlesRequest) Reset()         { *m = QueryCandlesRequest{} }
+func (m *QueryCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesRequest) ProtoMessage()    {}
+func (*QueryCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{4}
+}
+func (m *QueryCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesRequest.Merge(m, src)
+}
+func (m *QueryCandlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesRequest proto.InternalMessageInfo
+
+func (m *QueryCandlesRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *QueryCandlesRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryCandlesRequest) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *QueryCandlesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCandlesResponse defines the response type for the `Candles` query.
+type QueryCandlesResponse struct {
+	// candles are the order book candles.
+	Candles []Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCandlesResponse) Reset()         { *m = QueryCandlesResponse{} }
+func (m *QueryCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesResponse) ProtoMessage()    {}
+func (*QueryCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{5}
+}
+func (m *QueryCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesResponse.Merge(m, src)
+}
+func (m *QueryCandlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesResponse proto.InternalMessageInfo
+
+func (m *QueryCandlesResponse) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+func (m *QueryCandlesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// IndexedOrderBookOrder is the order book order tracked by the indexer to aggregate the price levels.
+type IndexedOrderBookOrder struct {
+	// order_book_id is the order book ID of the order.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// side is the order side.
+	Side Side `protobuf:"varint,2,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// price is the order price.
+	Price Price `protobuf:"bytes,3,opt,name=price,proto3,customtype=Price" json:"price"`
+	// visible_base_quantity is the remaining base quantity of the order visible in the order book.
+	VisibleBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=visible_base_quantity,json=visibleBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"visible_base_quantity"`
+}
+
+func (m *IndexedOrderBookOrder) Reset()         { *m = IndexedOrderBookOrder{} }
+func (m *IndexedOrderBookOrder) String() string { return proto.CompactTextString(m) }
+func (*IndexedOrderBookOrder) ProtoMessage()    {}
+func (*IndexedOrderBookOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{6}
+}
+func (m *IndexedOrderBookOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedOrderBookOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedOrderBookOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedOrderBookOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedOrderBookOrder.Merge(m, src)
+}
+func (m *IndexedOrderBookOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedOrderBookOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedOrderBookOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedOrderBookOrder proto.InternalMessageInfo
+
+func (m *IndexedOrderBookOrder) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *IndexedOrderBookOrder) GetSide() Side {
+	if m != nil {
+		return m.Side
+	}
+	return SIDE_UNSPECIFIED
+}
+
+// StreamOrderBookRequest defines the request type for the `StreamOrderBook` stream.
+type StreamOrderBookRequest struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+}
+
+func (m *StreamOrderBookRequest) Reset()         { *m = StreamOrderBookRequest{} }
+func (m *StreamOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderBookRequest) ProtoMessage()    {}
+func (*StreamOrderBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{7}
+}
+func (m *StreamOrderBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderBookRequest.Merge(m, src)
+}
+func (m *StreamOrderBookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderBookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamOrderBookRequest proto.InternalMessageInfo
+
+func (m *StreamOrderBookRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *StreamOrderBookRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+// OrderBookUpdate is the update of the order book price levels.
+type OrderBookUpdate struct {
+	// height is the block height the update is built for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// order_book_id is the order book ID, zero if the order book hasn't been indexed yet.
+	OrderBookID uint32 `protobuf:"varint,2,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,4,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// snapshot is true if the update contains all price levels of the order book, otherwise it contains only the levels
+	// changed in the block, and the removed levels have the zero quantity and orders count.
+	Snapshot bool `protobuf:"varint,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// bids are the buy side price levels sorted by price descending.
+	Bids []PriceLevel `protobuf:"bytes,6,rep,name=bids,proto3" json:"bids"`
+	// asks are the sell side price levels sorted by price ascending.
+	Asks []PriceLevel `protobuf:"bytes,7,rep,name=asks,proto3" json:"asks"`
+}
+
+func (m *OrderBookUpdate) Reset()         { *m = OrderBookUpdate{} }
+func (m *OrderBookUpdate) String() string { return proto.CompactTextString(m) }
+func (*OrderBookUpdate) ProtoMessage()    {}
+func (*OrderBookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928ff1d37cde2672, []int{8}
+}
+func (m *OrderBookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookUpdate.Merge(m, src)
+}
+func (m *OrderBookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookUpdate proto.InternalMessageInfo
+
+func (m *OrderBookUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *OrderBookUpdate) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *OrderBookUpdate) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *OrderBookUpdate) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *OrderBookUpdate) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
+}
+
+func (m *OrderBookUpdate) GetBids() []PriceLevel {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *OrderBookUpdate) GetAsks() []PriceLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*IndexedTrade)(nil), "coreum.dex.v1.IndexedTrade")
+	proto.RegisterType((*Candle)(nil), "coreum.dex.v1.Candle")
+	proto.RegisterType((*QueryTradesRequest)(nil), "coreum.dex.v1.QueryTradesRequest")
+	proto.RegisterType((*QueryTradesResponse)(nil), "coreum.dex.v1.QueryTradesResponse")
+	proto.RegisterType((*QueryCandlesRequest)(nil), "coreum.dex.v1.QueryCandlesRequest")
+	proto.RegisterType((*QueryCandlesResponse)(nil), "coreum.dex.v1.QueryCandlesResponse")
+	proto.RegisterType((*IndexedOrderBookOrder)(nil), "coreum.dex.v1.IndexedOrderBookOrder")
+	proto.RegisterType((*StreamOrderBookRequest)(nil), "coreum.dex.v1.StreamOrderBookRequest")
+	proto.RegisterType((*OrderBookUpdate)(nil), "coreum.dex.v1.OrderBookUpdate")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/indexer.proto", fileDescriptor_928ff1d37cde2672) }
+
+var fileDescriptor_928ff1d37cde2672 = []byte{
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xda, 0x1b, 0xdb, 0x79, 0x6d, 0x27, 0x3f, 0x4d, 0x92, 0x6a, 0xe3, 0xfe, 0x6a, 0x27,
+	0xae, 0xa0, 0x11, 0x87, 0x5d, 0xe2, 0x88, 0xaa, 0x05, 0x09, 0x5a, 0x27, 0x2a, 0x8a, 0x00, 0xd1,
+	0x6c, 0x03, 0x42, 0xbd, 0x58, 0x6b, 0xef, 0x60, 0xaf, 0xe2, 0xdd, 0xd9, 0xec, 0xcc, 0x9a, 0x44,
+	0x55, 0x2f, 0x7c, 0x82, 0x56, 0x08, 0x04, 0x12, 0x12, 0x17, 0x3e, 0x4c, 0x8f, 0x95, 0xb8, 0x20,
+	0x0e, 0x01, 0x25, 0x5c, 0xf9, 0x06, 0x1c, 0xd0, 0xfc, 0xb1, 0xe3, 0xdd, 0xb8, 0x89, 0x29, 0xb9,
+	0xcd, 0xcc, 0xfb, 0xbc, 0xcf, 0xbe, 0xf3, 0xce, 0xf3, 0x3e, 0x36, 0x5c, 0xef, 0x90, 0x08, 0xc7,
+	0xbe, 0xe5, 0xe2, 0x43, 0x6b, 0xb0, 0x61, 0x79, 0x81, 0x8b, 0x0f, 0x71, 0x64, 0x86, 0x11, 0x61,
+	0x04, 0x95, 0x65, 0xd0, 0x74, 0xf1, 0xa1, 0x39, 0xd8, 0xa8, 0xac, 0x24, 0xb1, 0x24, 0x72, 0x87,
+	0xc8, 0x74, 0xe8, 0x20, 0xc6, 0xd1, 0x91, 0x0a, 0xbd, 0xd5, 0x21, 0xd4, 0x27, 0xd4, 0x6a, 0x3b,
+	0x14, 0xcb, 0x80, 0x35, 0xd8, 0x68, 0x63, 0xe6, 0x6c, 0x58, 0xa1, 0xd3, 0xf5, 0x02, 0x87, 0x79,
+	0x24, 0x50, 0xd8, 0xa5, 0x2e, 0xe9, 0x12, 0xb1, 0xb4, 0xf8, 0x4a, 0x9d, 0xfe, 0xbf, 0x4b, 0x48,
+	0xb7, 0x8f, 0x2d, 0x27, 0xf4, 0x2c, 0x27, 0x08, 0x08, 0x13, 0x29, 0x54, 0x45, 0xab, 0x2a, 0x2a,
+	0x76, 0xed, 0xf8, 0x4b, 0xcb, 0x8d, 0xa3, 0x71, 0xce, 0x5a, 0x3a, 0xce, 0x3c, 0x1f, 0x53, 0xe6,
+	0xf8, 0xa1, 0x04, 0xd4, 0x7f, 0xd2, 0xa1, 0xb4, 0x23, 0xee, 0xed, 0xee, 0x45, 0x8e, 0x8b, 0xd1,
+	0x0d, 0x00, 0x5e, 0x6c, 0xcb, 0xc5, 0x01, 0xf1, 0x0d, 0x6d, 0x55, 0x5b, 0x9f, 0xb3, 0xe7, 0xf8,
+	0xc9, 0x36, 0x3f, 0x40, 0x35, 0x28, 0x1e, 0xc4, 0x84, 0x0d, 0xe3, 0x19, 0x11, 0x07, 0x71, 0x24,
+	0x01, 0x37, 0x61, 0x36, 0x8c, 0xbc, 0x0e, 0x36, 0xb2, 0x3c, 0xd4, 0x2c, 0xbf, 0x38, 0xae, 0xcd,
+	0xfc, 0x76, 0x5c, 0x9b, 0x7d, 0xc8, 0x0f, 0x6d, 0x19, 0x43, 0x4d, 0x28, 0x8b, 0x8f, 0x1c, 0xc4,
+	0x4e, 0xc0, 0x3c, 0x76, 0x64, 0xe8, 0x02, 0x7c, 0x43, 0x81, 0x97, 0x65, 0xd7, 0xa8, 0xbb, 0x6f,
+	0x7a, 0xc4, 0xf2, 0x1d, 0xd6, 0x33, 0x77, 0x02, 0x66, 0x97, 0x78, 0xce, 0xae, 0x4a, 0x41, 0xdb,
+	0x30, 0x2f, 0x2b, 0x19, 0x91, 0xcc, 0x4e, 0x43, 0x52, 0x16, 0x49, 0x23, 0x96, 0x06, 0x00, 0x73,
+	0xf6, 0x71, 0xd4, 0xa2, 0x9e, 0x8b, 0x8d, 0xdc, 0xaa, 0xb6, 0x3e, 0xdf, 0x58, 0x34, 0x13, 0x4f,
+	0x6f, 0x3e, 0xf2, 0x5c, 0x6c, 0xcf, 0x09, 0x18, 0x5f, 0xa2, 0x25, 0x98, 0xf5, 0xf9, 0xc6, 0xc8,
+	0x8b, 0xdb, 0xcb, 0x0d, 0xba, 0x0d, 0xf3, 0x62, 0xd1, 0x12, 0xd2, 0x68, 0x79, 0xae, 0x51, 0x10,
+	0xf5, 0xfc, 0xef, 0xe4, 0xb8, 0x56, 0xfa, 0x84, 0x47, 0x3e, 0xe5, 0x81, 0x9d, 0x6d, 0xbb, 0xe4,
+	0x9f, 0xed, 0x5c, 0xce, 0x26, 0xa8, 0x8d, 0x39, 0xc9, 0xc6, 0x86, 0x6c, 0x2c, 0xc9, 0x06, 0x67,
+	0x6c, 0x7b, 0x09, 0x36, 0x36, 0xce, 0x76, 0x0d, 0x72, 0x3d, 0xec, 0x75, 0x7b, 0xcc, 0x28, 0xae,
+	0x6a, 0xeb, 0x59, 0x5b, 0xed, 0xd0, 0x1d, 0xd0, 0xf9, 0xd3, 0x1b, 0xa5, 0x55, 0x6d, 0xbd, 0xd8,
+	0xa8, 0x98, 0x52, 0x17, 0xe6, 0x50, 0x17, 0xe6, 0xde, 0x50, 0x17, 0xcd, 0x02, 0xef, 0xdf, 0xb3,
+	0xdf, 0x6b, 0x9a, 0x2d, 0x32, 0xea, 0x7f, 0x67, 0x20, 0xb7, 0xe5, 0x04, 0x6e, 0x1f, 0xa3, 0xfb,
+	0x30, 0x47, 0x42, 0x1c, 0xb4, 0x04, 0x93, 0xf6, 0x2f, 0x98, 0x0a, 0x3c, 0x8d, 0x07, 0xd0, 0x1a,
+	0xe8, 0x7c, 0x6d, 0x64, 0x26, 0xa9, 0x43, 0x84, 0x38, 0xa4, 0xe7, 0x75, 0x7b, 0x93, 0x05, 0x24,
+	0x42, 0xa8, 0x06, 0xd9, 0x3e, 0xf9, 0xca, 0xd0, 0x27, 0x21, 0x78, 0x84, 0xab, 0xb0, 0xd3, 0x27,
+	0x14, 0x1b, 0xb3, 0x93, 0x20, 0x32, 0x86, 0xde, 0x87, 0xa2, 0x50, 0xe1, 0x80, 0xf4, 0x63, 0x5f,
+	0x3e, 0xfe, 0xa5, 0xf2, 0x11, 0xc3, 0xf1, 0xb9, 0x48, 0x40, 0xf7, 0xa0, 0x24, 0x15, 0xa8, 0x08,
+	0xf2, 0xd3, 0x10, 0xc8, 0xf1, 0x51, 0x0c, 0x6b, 0x50, 0x62, 0x7c, 0xea, 0x68, 0xab, 0x43, 0xe2,
+	0x80, 0x09, 0xc5, 0xe8, 0x76, 0x51, 0x9e, 0x6d, 0xf1, 0xa3, 0xfa, 0x8f, 0x1a, 0xa0, 0x5d, 0x6e,
+	0x1c, 0x62, 0x3c, 0xa9, 0x8d, 0x0f, 0x62, 0x4c, 0xd9, 0x7f, 0x1e, 0xd3, 0x07, 0x00, 0x67, 0x06,
+	0x24, 0x5a, 0x5d, 0x6c, 0xbc, 0x69, 0xca, 0x92, 0x4d, 0xce, 0x63, 0x4a, 0x1b, 0x53, 0x6e, 0x65,
+	0x3e, 0x74, 0xba, 0x58, 0x7d, 0xdb, 0x1e, 0xcb, 0xac, 0xff, 0xa0, 0xc1, 0x62, 0xa2, 0x3c, 0x1a,
+	0x92, 0x80, 0x62, 0x74, 0x17, 0x72, 0xf2, 0x16, 0x86, 0xb6, 0x9a, 0x5d, 0x2f, 0x36, 0xae, 0xa7,
+	0x66, 0x6a, 0xdc, 0x73, 0x9a, 0x3a, 0x6f, 0x99, 0xad, 0x12, 0xd0, 0x87, 0x89, 0xd2, 0x32, 0xa2,
+	0xb4, 0x5b, 0x97, 0x96, 0x26, 0xbf, 0x9b, 0xa8, 0xed, 0x78, 0x58, 0x9b, 0x94, 0xef, 0x95, 0xf5,
+	0xee, 0x03, 0x28, 0x78, 0x01, 0xc3, 0xd1, 0xc0, 0xe9, 0xab, 0xce, 0xad, 0x9c, 0x9b, 0x82, 0x6d,
+	0xe5, 0xc3, 0x72, 0x08, 0xbe, 0x17, 0x43, 0x30, 0x4c, 0x4a, 0x35, 0x5f, 0x7f, 0xed, 0xe6, 0x7f,
+	0xa7, 0xc1, 0x52, 0xf2, 0x82, 0xaa, 0xfb, 0xef, 0x40, 0xbe, 0x23, 0x8f, 0x54, 0xfb, 0x97, 0x53,
+	0xed, 0x97, 0x09, 0xaa, 0xf1, 0x43, 0xec, 0xd5, 0x75, 0xfe, 0x2f, 0x0d, 0x96, 0xd5, 0x0b, 0x0b,
+	0x63, 0x6a, 0x12, 0xb2, 0x2f, 0x16, 0x68, 0x13, 0xca, 0xd2, 0xd1, 0xda, 0x84, 0xec, 0x73, 0x5b,
+	0xe3, 0xed, 0x2f, 0x37, 0x17, 0x4e, 0x8e, 0x6b, 0xc5, 0x11, 0x74, 0x67, 0xdb, 0x2e, 0x92, 0xd1,
+	0xc6, 0x45, 0xb7, 0x40, 0x17, 0xf6, 0x9c, 0x79, 0xb5, 0x3d, 0x0b, 0xc0, 0x74, 0x3f, 0x3e, 0xbb,
+	0xb0, 0x3c, 0xf0, 0xa8, 0xd7, 0xee, 0xe3, 0xd6, 0x6b, 0xfc, 0x08, 0x2d, 0xaa, 0xdc, 0xe6, 0xd8,
+	0x6f, 0x51, 0xfd, 0x0b, 0xb8, 0xf6, 0x88, 0x45, 0xd8, 0xf1, 0x47, 0x57, 0xb8, 0x22, 0xad, 0xd5,
+	0x7f, 0xce, 0xc0, 0xc2, 0x88, 0xf4, 0xb3, 0xd0, 0x75, 0x18, 0x1e, 0xf3, 0x78, 0x2d, 0xe1, 0xf1,
+	0xe7, 0x7a, 0x9b, 0x99, 0xa2, 0xb7, 0xc9, 0x02, 0xb3, 0x97, 0x14, 0xa8, 0x9f, 0x1b, 0x86, 0x0a,
+	0x14, 0x68, 0xe0, 0x84, 0xb4, 0x47, 0x98, 0x30, 0xdb, 0x82, 0x3d, 0xda, 0xa3, 0x4d, 0xd0, 0xdb,
+	0x9e, 0x4b, 0x8d, 0x9c, 0xd0, 0xe0, 0x4a, 0xea, 0xdd, 0xc4, 0xa3, 0x7c, 0x8c, 0x07, 0xb8, 0xaf,
+	0x74, 0x28, 0xc0, 0x3c, 0xc9, 0xa1, 0xfb, 0xd4, 0xc8, 0x4f, 0x99, 0xc4, 0xc1, 0x8d, 0xe7, 0x59,
+	0xc8, 0x4b, 0xc1, 0x45, 0xe8, 0xb9, 0x06, 0x39, 0xe9, 0x46, 0x68, 0x2d, 0x95, 0x7d, 0xde, 0x48,
+	0x2b, 0xf5, 0x8b, 0x20, 0x52, 0xda, 0xf5, 0x7b, 0x5f, 0xff, 0xf2, 0xe7, 0x37, 0x99, 0x77, 0xd1,
+	0x1d, 0x6b, 0xe2, 0x1f, 0x46, 0x4b, 0x1a, 0x97, 0xf5, 0xe4, 0xac, 0x91, 0x4f, 0xad, 0x27, 0x63,
+	0x6d, 0x7b, 0x8a, 0xbe, 0xd5, 0x20, 0xaf, 0x86, 0x14, 0x4d, 0xfc, 0x62, 0xd2, 0xa2, 0x2a, 0x37,
+	0x2f, 0xc4, 0xa8, 0xb2, 0xee, 0x8b, 0xb2, 0xde, 0x43, 0x77, 0x5f, 0x51, 0x96, 0x1a, 0xeb, 0x8b,
+	0xea, 0x7a, 0x0c, 0x0b, 0x29, 0xe1, 0xa2, 0x37, 0xd2, 0xe3, 0x35, 0x51, 0xd8, 0x95, 0x6a, 0x0a,
+	0x96, 0x12, 0xe9, 0xdb, 0x5a, 0xf3, 0xa3, 0x17, 0x27, 0x55, 0xed, 0xe5, 0x49, 0x55, 0xfb, 0xe3,
+	0xa4, 0xaa, 0x3d, 0x3b, 0xad, 0xce, 0xbc, 0x3c, 0xad, 0xce, 0xfc, 0x7a, 0x5a, 0x9d, 0x79, 0xbc,
+	0xd1, 0xf5, 0x58, 0x2f, 0x6e, 0x9b, 0x1d, 0xe2, 0x5b, 0x5b, 0x82, 0xe5, 0x01, 0x89, 0x03, 0x57,
+	0x78, 0xc7, 0xf0, 0x2e, 0x83, 0xdb, 0xd6, 0xa1, 0xb8, 0x10, 0x3b, 0x0a, 0x31, 0x6d, 0xe7, 0x84,
+	0xb3, 0x6e, 0xfe, 0x33, 0x00, 0xd6, 0x2f, 0x05, 0x74, 0xb3, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IndexerClient is the client API for Indexer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IndexerClient interface {
+	// Trades queries the executed trades of the order book in the chronological order.
+	Trades(ctx context.Context, in *QueryTradesRequest, opts ...grpc.CallOption) (*QueryTradesResponse, error)
+	// Candles queries the OHLCV candles of the order book in the chronological order.
+	Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
+	// StreamOrderBook streams the price levels of the order book. The stream starts with the full snapshot, followed by
+	// the per-block diffs and the periodic full snapshots. The stream is available over gRPC only.
+	StreamOrderBook(ctx context.Context, in *StreamOrderBookRequest, opts ...grpc.CallOption) (Indexer_StreamOrderBookClient, error)
+}
+
+type indexerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewIndexerClient(cc grpc1.ClientConn) IndexerClient {
+	return &indexerClient{cc}
+}
+
+func (c *indexerClient) Trades(ctx context.Context, in *QueryTradesRequest, opts ...grpc.CallOption) (*QueryTradesResponse, error) {
+	out := new(QueryTradesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Indexer/Trades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Indexer/Candles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) StreamOrderBook(ctx context.Context, in *StreamOrderBookRequest, opts ...grpc.CallOption) (Indexer_StreamOrderBookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Indexer_serviceDesc.Streams[0], "/coreum.dex.v1.Indexer/StreamOrderBook", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamOrderBookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Indexer_StreamOrderBookClient interface {
+	Recv() (*OrderBookUpdate, error)
+	grpc.ClientStream
+}
+
+type indexerStreamOrderBookClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamOrderBookClient) Recv() (*OrderBookUpdate, error) {
+	m := new(OrderBookUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IndexerServer is the server API for Indexer service.
+type IndexerServer interface {
+	// Trades queries the executed trades of the order book in the chronological order.
+	Trades(context.Context, *QueryTradesRequest) (*QueryTradesResponse, error)
+	// Candles queries the OHLCV candles of the order book in the chronological order.
+	Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
+	// StreamOrderBook streams the price levels of the order book. The stream starts with the full snapshot, followed by
+	// the per-block diffs and the periodic full snapshots. The stream is available over gRPC only.
+	StreamOrderBook(*StreamOrderBookRequest, Indexer_StreamOrderBookServer) error
+}
+
+// UnimplementedIndexerServer can be embedded to have forward compatible implementations.
+type UnimplementedIndexerServer struct {
+}
+
+func (*UnimplementedIndexerServer) Trades(ctx context.Context, req *QueryTradesRequest) (*QueryTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trades not implemented")
+}
+func (*UnimplementedIndexerServer) Candles(ctx context.Context, req *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candles not implemented")
+}
+func (*UnimplementedIndexerServer) StreamOrderBook(req *StreamOrderBookRequest, srv Indexer_StreamOrderBookServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderBook not implemented")
+}
+
+func RegisterIndexerServer(s grpc1.Server, srv IndexerServer) {
+	s.RegisterService(&_Indexer_serviceDesc, srv)
+}
+
+func _Indexer_Trades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Trades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Indexer/Trades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Trades(ctx, req.(*QueryTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_Candles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Candles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Indexer/Candles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Candles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_StreamOrderBook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrderBookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerServer).StreamOrderBook(m, &indexerStreamOrderBookServer{stream})
+}
+
+type Indexer_StreamOrderBookServer interface {
+	Send(*OrderBookUpdate) error
+	grpc.ServerStream
+}
+
+type indexerStreamOrderBookServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamOrderBookServer) Send(m *OrderBookUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Indexer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.dex.v1.Indexer",
+	HandlerType: (*IndexerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Trades",
+			Handler:    _Indexer_Trades_Handler,
+		},
+		{
+			MethodName: "Candles",
+			Handler:    _Indexer_Candles_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrderBook",
+			Handler:       _Indexer_StreamOrderBook_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "coreum/dex/v1/indexer.proto",
+}
+
+func (m *IndexedTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIndexer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if m.Height != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TakerOrderID) > 0 {
+		i -= len(m.TakerOrderID)
+		copy(dAtA[i:], m.TakerOrderID)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.TakerOrderID)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Taker) > 0 {
+		i -= len(m.Taker)
+		copy(dAtA[i:], m.Taker)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Taker)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MakerOrderID) > 0 {
+		i -= len(m.MakerOrderID)
+		copy(dAtA[i:], m.MakerOrderID)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.MakerOrderID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TakerSide != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.TakerSide))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.QuoteQuantity.Size()
+		i -= size
+		if _, err := m.QuoteQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BaseQuantity.Size()
+		i -= size
+		if _, err := m.BaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradesCount != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.TradesCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OpenTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIndexer(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndexer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndexer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndexer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintIndexer(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndexer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedOrderBookOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedOrderBookOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedOrderBookOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VisibleBaseQuantity.Size()
+		i -= size
+		if _, err := m.VisibleBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Side != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderBookID != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIndexer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIndexer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.BaseQuantity.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.QuoteQuantity.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	if m.TakerSide != 0 {
+		n += 1 + sovIndexer(uint64(m.TakerSide))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.MakerOrderID)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.Taker)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.TakerOrderID)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovIndexer(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovIndexer(uint64(l))
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenTime)
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	if m.TradesCount != 0 {
+		n += 1 + sovIndexer(uint64(m.TradesCount))
+	}
+	return n
+}
+
+func (m *QueryTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovIndexer(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexedOrderBookOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovIndexer(uint64(m.OrderBookID))
+	}
+	if m.Side != 0 {
+		n += 1 + sovIndexer(uint64(m.Side))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.VisibleBaseQuantity.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	return n
+}
+
+func (m *StreamOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderBookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovIndexer(uint64(m.Height))
+	}
+	if m.OrderBookID != 0 {
+		n += 1 + sovIndexer(uint64(m.OrderBookID))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIndexer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIndexer(x uint64) (n int) {
+	return sovIndexer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IndexedTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerSide", wireType)
+			}
+			m.TakerSide = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerSide |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MakerOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OpenTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradesCount", wireType)
+			}
+			m.TradesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradesCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, IndexedTrade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCandlesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCandlesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCandlesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCandlesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedOrderBookOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedOrderBookOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedOrderBookOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VisibleBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamOrderBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamOrderBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshot = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, PriceLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, PriceLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIndexer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIndexer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIndexer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIndexer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIndexer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIndexer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIndexer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -3,6 +3,7 @@ package types
 import (
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 
@@ -43,6 +44,36 @@ var (
 	// ReserveOrderIDKeyPrefix defines the key prefix for all order ids used by an account to ensure
 	// global uniqueness per account.
 	ReserveOrderIDKeyPrefix = []byte{0x11}
+	// TriggerOrderKeyPrefix defines the key prefix for the trigger order.
+	TriggerOrderKeyPrefix = []byte{0x12}
+	// TriggerOrderBookKeyPrefix defines the key prefix for the trigger order index sorted by the trigger price.
+	TriggerOrderBookKeyPrefix = []byte{0x13}
+	// OrderBookLastTradeKeyPrefix defines the key prefix for the order book last trade.
+	OrderBookLastTradeKeyPrefix = []byte{0x14}
+	// PriceAccumulatorKeyPrefix defines the key prefix for the order book price accumulator sorted by time.
+	PriceAccumulatorKeyPrefix = []byte{0x15}
+	// OrderExpirationByHeightKeyPrefix defines the key prefix for the order expiration index sorted by the good til
+	// block height.
+	OrderExpirationByHeightKeyPrefix = []byte{0x16}
+	// OrderExpirationByTimeKeyPrefix defines the key prefix for the order expiration index sorted by the good til
+	// block time.
+	OrderExpirationByTimeKeyPrefix = []byte{0x17}
+	// DeadManSwitchKeyPrefix defines the key prefix for the account dead man's switch.
+	DeadManSwitchKeyPrefix = []byte{0x18}
+	// TradingVolumeKeyPrefix defines the key prefix for the account daily trading volume sorted by day.
+	TradingVolumeKeyPrefix = []byte{0x19}
+	// RewardProgramKeyPrefix defines the key prefix for the order book reward program.
+	RewardProgramKeyPrefix = []byte{0x1a}
+	// AccountRewardKeyPrefix defines the key prefix for the reward accrued by the account in the reward program.
+	AccountRewardKeyPrefix = []byte{0x1b}
+	// OrderQuotaKeyPrefix defines the key prefix for the account order quota.
+	OrderQuotaKeyPrefix = []byte{0x1c}
+	// OpeningAuctionKeyPrefix defines the key prefix for the order book opening auction index sorted by the auction
+	// end height.
+	OpeningAuctionKeyPrefix = []byte{0x1d}
+	// RewardDistributionCursorKey defines the key for the order book ID of the next reward program distributed in the
+	// current epoch.
+	RewardDistributionCursorKey = []byte{0x1e}
 )
 
 // StoreTrue keeps a value used by stores to indicate that key is present.
@@ -180,6 +211,71 @@ func BuildGoodTilBlockTimeDelayKey(orderSequence uint64) string {
 	return fmt.Sprintf("%stlt%d", ModuleName, orderSequence)
 }
 
+// BuildDeadManSwitchDelayKey builds the key for the dead man's switch delay store.
+func BuildDeadManSwitchDelayKey(accNumber uint64) string {
+	// the string will be store the delay store and must be unique for the app
+	return fmt.Sprintf("%sdms%d", ModuleName, accNumber)
+}
+
+// BuildDeadManSwitchCancellationDelayKey builds the key for the triggered dead man's switch remaining orders
+// cancellation in the delay store.
+func BuildDeadManSwitchCancellationDelayKey(accNumber uint64) string {
+	// the string will be store the delay store and must be unique for the app
+	return fmt.Sprintf("%sdmc%d", ModuleName, accNumber)
+}
+
+// CreateDeadManSwitchKey creates the account dead man's switch key.
+func CreateDeadManSwitchKey(accNumber uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, accNumber)
+	return store.JoinKeys(DeadManSwitchKeyPrefix, key)
+}
+
+// CreateTradingVolumeKey creates the account daily trading volume key.
+func CreateTradingVolumeKey(accNumber, day uint64) []byte {
+	return store.AppendUint64ToOrderedBytes(CreateAccountTradingVolumesKey(accNumber), day)
+}
+
+// CreateAccountTradingVolumesKey creates the key prefix of the account daily trading volumes.
+func CreateAccountTradingVolumesKey(accNumber uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, accNumber)
+	return store.JoinKeys(TradingVolumeKeyPrefix, key)
+}
+
+// CreateRewardProgramKey creates the order book reward program key.
+func CreateRewardProgramKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(RewardProgramKeyPrefix, key)
+}
+
+// CreateAccountRewardKey creates the key of the reward accrued by the account in the reward program of the order book.
+func CreateAccountRewardKey(accNumber uint64, orderBookID uint32) []byte {
+	return store.AppendUint32ToOrderedBytes(CreateAccountRewardsKey(accNumber), orderBookID)
+}
+
+// CreateAccountRewardsKey creates the key prefix of the rewards accrued by the account.
+func CreateAccountRewardsKey(accNumber uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, accNumber)
+	return store.JoinKeys(AccountRewardKeyPrefix, key)
+}
+
+// CreateOrderQuotaKey creates the account order quota key.
+func CreateOrderQuotaKey(accNumber uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, accNumber)
+	return store.JoinKeys(OrderQuotaKeyPrefix, key)
+}
+
+// CreateAccountDenomOrdersCountKeyPrefix creates the key prefix of the account orders counts of all denoms.
+func CreateAccountDenomOrdersCountKeyPrefix(accNumber uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, accNumber)
+	return store.JoinKeys(AccountDenomOrdersCountKeyPrefix, key)
+}
+
 // CreateAccountDenomOrdersCountKey creates account denom orders count key.
 func CreateAccountDenomOrdersCountKey(accNumber uint64, denom string) ([]byte, error) {
 	key := make([]byte, 0)
@@ -241,3 +337,119 @@ func DecodeAccountDenomKeyOrderSequence(key []byte) (uint64, error) {
 
 	return orderSequence, nil
 }
+
+// CreateTriggerOrderKey creates trigger order key.
+func CreateTriggerOrderKey(accNumber uint64, orderID string) []byte {
+	return store.JoinKeys(CreateTriggerOrderKeyPrefix(accNumber), []byte(orderID))
+}
+
+// DecodeTriggerOrderKey decodes trigger order key with the prefix and returns the account number and order ID.
+func DecodeTriggerOrderKey(key []byte) (uint64, string, error) {
+	if len(key) <= len(TriggerOrderKeyPrefix) {
+		return 0, "", sdkerrors.Wrapf(ErrInvalidKey, "invalid trigger order key length %d", len(key))
+	}
+	accNumber, orderID, err := store.ReadOrderedBytesToUint64(key[len(TriggerOrderKeyPrefix):])
+	if err != nil {
+		return 0, "", err
+	}
+
+	return accNumber, string(orderID), nil
+}
+
+// CreateTriggerOrderKeyPrefix creates trigger order key prefix.
+func CreateTriggerOrderKeyPrefix(accNumber uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, accNumber)
+	return store.JoinKeys(TriggerOrderKeyPrefix, key)
+}
+
+// CreateTriggerOrderBookKey creates trigger order book key with fixed key length to support the correct ordering
+// by the trigger price.
+func CreateTriggerOrderBookKey(
+	orderBookID uint32, condition TriggerCondition, price Price, orderSequence uint64,
+) ([]byte, error) {
+	return CreateOrderBookSideRecordKey(CreateTriggerOrderBookConditionKey(orderBookID, condition), price, orderSequence)
+}
+
+// CreateTriggerOrderBookConditionKey creates trigger order book condition key.
+func CreateTriggerOrderBookConditionKey(orderBookID uint32, condition TriggerCondition) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	key = store.AppendUint8ToOrderedBytes(key, uint8(condition))
+
+	return store.JoinKeys(TriggerOrderBookKeyPrefix, key)
+}
+
+// CreateOrderBookLastTradeKey creates order book last trade key.
+func CreateOrderBookLastTradeKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookLastTradeKeyPrefix, key)
+}
+
+// CreatePriceAccumulatorKey creates order book price accumulator key.
+func CreatePriceAccumulatorKey(orderBookID uint32, t time.Time) []byte {
+	return store.AppendUint64ToOrderedBytes(CreateOrderBookPriceAccumulatorsKey(orderBookID), uint64(t.UnixNano()))
+}
+
+// CreateOrderBookPriceAccumulatorsKey creates the key prefix of the order book price accumulators.
+func CreateOrderBookPriceAccumulatorsKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(PriceAccumulatorKeyPrefix, key)
+}
+
+// CreateOrderExpirationByHeightKey creates the order expiration key sorted by the good til block height.
+func CreateOrderExpirationByHeightKey(height, orderSequence uint64) []byte {
+	return createOrderExpirationKey(OrderExpirationByHeightKeyPrefix, height, orderSequence)
+}
+
+// CreateOrderExpirationByTimeKey creates the order expiration key sorted by the good til block time in seconds.
+func CreateOrderExpirationByTimeKey(t time.Time, orderSequence uint64) []byte {
+	return createOrderExpirationKey(OrderExpirationByTimeKeyPrefix, uint64(t.Unix()), orderSequence)
+}
+
+// DecodeOrderExpirationKey decodes the order expiration key without the prefix and returns the expiration height or
+// unix time and the order sequence.
+func DecodeOrderExpirationKey(key []byte) (uint64, uint64, error) {
+	expiration, nextKeyPart, err := store.ReadOrderedBytesToUint64(key)
+	if err != nil {
+		return 0, 0, err
+	}
+	orderSequence, _, err := store.ReadOrderedBytesToUint64(nextKeyPart)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return expiration, orderSequence, nil
+}
+
+// CreateOpeningAuctionKey creates the order book opening auction key sorted by the auction end height.
+func CreateOpeningAuctionKey(height uint64, orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, height)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OpeningAuctionKeyPrefix, key)
+}
+
+// DecodeOpeningAuctionKey decodes the opening auction key without the prefix and returns the auction end height and
+// the order book ID.
+func DecodeOpeningAuctionKey(key []byte) (uint64, uint32, error) {
+	height, nextKeyPart, err := store.ReadOrderedBytesToUint64(key)
+	if err != nil {
+		return 0, 0, err
+	}
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(nextKeyPart)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return height, orderBookID, nil
+}
+
+func createOrderExpirationKey(prefix []byte, expiration, orderSequence uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, expiration)
+	key = store.AppendUint64ToOrderedBytes(key, orderSequence)
+	return store.JoinKeys(prefix, key)
+}
@@ -16,16 +16,44 @@ type extendedMsg interface {
 var (
 	_ extendedMsg = &MsgUpdateParams{}
 	_ extendedMsg = &MsgPlaceOrder{}
+	_ extendedMsg = &MsgReplaceOrder{}
 	_ extendedMsg = &MsgCancelOrder{}
 	_ extendedMsg = &MsgCancelOrdersByDenom{}
+	_ extendedMsg = &MsgBatchPlaceOrders{}
+	_ extendedMsg = &MsgBatchCancelOrders{}
+	_ extendedMsg = &MsgCancelAllOrders{}
+	_ extendedMsg = &MsgSetDeadManSwitch{}
+	_ extendedMsg = &MsgHeartbeat{}
+	_ extendedMsg = &MsgCreateOrderBook{}
+	_ extendedMsg = &MsgUpdateOrderBook{}
+	_ extendedMsg = &MsgUpdateOrderBookStatus{}
+	_ extendedMsg = &MsgSwapExactIn{}
+	_ extendedMsg = &MsgSwapExactOut{}
+	_ extendedMsg = &MsgFundRewardProgram{}
+	_ extendedMsg = &MsgClaimRewards{}
+	_ extendedMsg = &MsgSetOrderQuota{}
 )
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPlaceOrder{}, ModuleName+"/MsgPlaceOrder")
+	legacy.RegisterAminoMsg(cdc, &MsgReplaceOrder{}, ModuleName+"/MsgReplaceOrder")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelOrder{}, ModuleName+"/MsgCancelOrder")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, ModuleName+"/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelOrdersByDenom{}, ModuleName+"/MsgCancelOrdersByDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchPlaceOrders{}, ModuleName+"/MsgBatchPlaceOrders")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchCancelOrders{}, ModuleName+"/MsgBatchCancelOrders")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelAllOrders{}, ModuleName+"/MsgCancelAllOrders")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDeadManSwitch{}, ModuleName+"/MsgSetDeadManSwitch")
+	legacy.RegisterAminoMsg(cdc, &MsgHeartbeat{}, ModuleName+"/MsgHeartbeat")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateOrderBook{}, ModuleName+"/MsgCreateOrderBook")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateOrderBook{}, ModuleName+"/MsgUpdateOrderBook")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateOrderBookStatus{}, ModuleName+"/MsgUpdateOrderBookStatus")
+	legacy.RegisterAminoMsg(cdc, &MsgSwapExactIn{}, ModuleName+"/MsgSwapExactIn")
+	legacy.RegisterAminoMsg(cdc, &MsgSwapExactOut{}, ModuleName+"/MsgSwapExactOut")
+	legacy.RegisterAminoMsg(cdc, &MsgFundRewardProgram{}, ModuleName+"/MsgFundRewardProgram")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimRewards{}, ModuleName+"/MsgClaimRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetOrderQuota{}, ModuleName+"/MsgSetOrderQuota")
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -46,6 +74,32 @@ func (m MsgPlaceOrder) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic validates the message.
+func (m MsgReplaceOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := validateOrderID(m.ID); err != nil {
+		return err
+	}
+
+	hasQuantity := !m.Quantity.IsNil() && !m.Quantity.IsZero()
+	if m.Price == nil && !hasQuantity {
+		return sdkerrors.Wrap(ErrInvalidInput, "price or quantity must be provided")
+	}
+
+	if m.Price != nil && m.Price.Rat().Sign() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "price must be positive")
+	}
+
+	if hasQuantity && m.Quantity.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "quantity must be positive")
+	}
+
+	return nil
+}
+
 // ValidateBasic validates the message.
 func (m MsgCancelOrder) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -71,3 +125,247 @@ func (m MsgCancelOrdersByDenom) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic validates the message.
+func (m MsgBatchPlaceOrders) ValidateBasic() error {
+	if err := m.Mode.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := NewOrdersFromMsgBatchPlaceOrders(m); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateBasic validates the message.
+func (m MsgBatchCancelOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := m.Mode.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateBatchSize(len(m.IDs)); err != nil {
+		return err
+	}
+
+	ids := make(map[string]struct{}, len(m.IDs))
+	for _, id := range m.IDs {
+		if err := validateOrderID(id); err != nil {
+			return err
+		}
+		if _, ok := ids[id]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated order id %q", id)
+		}
+		ids[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic validates the message.
+func (m MsgCancelAllOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if m.Limit == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "limit must be positive")
+	}
+	if m.Limit > MaxBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidInput, "limit %d exceeds the max limit %d", m.Limit, MaxBatchSize)
+	}
+
+	if (m.BaseDenom == "") != (m.QuoteDenom == "") {
+		return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be set together")
+	}
+	if m.BaseDenom != "" {
+		if err := sdk.ValidateDenom(m.BaseDenom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid base denom: %s", m.BaseDenom)
+		}
+		if err := sdk.ValidateDenom(m.QuoteDenom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid quote denom: %s", m.QuoteDenom)
+		}
+		if m.BaseDenom == m.QuoteDenom {
+			return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be different")
+		}
+	}
+
+	if m.Side != SIDE_UNSPECIFIED {
+		if err := m.Side.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if m.MinPrice != nil || m.MaxPrice != nil {
+		// the prices of the different order books aren't comparable
+		if m.BaseDenom == "" {
+			return sdkerrors.Wrap(ErrInvalidInput, "price range requires the base and quote denoms")
+		}
+		if m.MinPrice != nil && m.MaxPrice != nil && m.MinPrice.Rat().Cmp(m.MaxPrice.Rat()) > 0 {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "min price %s must not be greater than max price %s", m.MinPrice, m.MaxPrice,
+			)
+		}
+	}
+
+	return nil
+}
+
+// MatchesOrder returns true if the order matches the filters of the message.
+func (m MsgCancelAllOrders) MatchesOrder(order Order) bool {
+	if m.BaseDenom != "" && (order.BaseDenom != m.BaseDenom || order.QuoteDenom != m.QuoteDenom) {
+		return false
+	}
+	if m.Side != SIDE_UNSPECIFIED && order.Side != m.Side {
+		return false
+	}
+	if m.MinPrice == nil && m.MaxPrice == nil {
+		return true
+	}
+	// the market trigger orders don't have the price
+	if order.Price == nil {
+		return false
+	}
+	if m.MinPrice != nil && order.Price.Rat().Cmp(m.MinPrice.Rat()) < 0 {
+		return false
+	}
+	if m.MaxPrice != nil && order.Price.Rat().Cmp(m.MaxPrice.Rat()) > 0 {
+		return false
+	}
+
+	return true
+}
+
+// ValidateBasic validates the message.
+func (m MsgSetDeadManSwitch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	return nil
+}
+
+// ValidateBasic validates the message.
+func (m MsgHeartbeat) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	return nil
+}
+
+// ValidateBasic validates the message.
+func (m MsgCreateOrderBook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom); err != nil {
+		return err
+	}
+
+	return validateOrderBookOverrides(m.PriceTick, m.QuantityStep, m.MinQuantity)
+}
+
+// ValidateBasic validates the message.
+func (m MsgUpdateOrderBook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom); err != nil {
+		return err
+	}
+
+	return validateOrderBookOverrides(m.PriceTick, m.QuantityStep, m.MinQuantity)
+}
+
+// ValidateBasic validates the message.
+func (m MsgUpdateOrderBookStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom); err != nil {
+		return err
+	}
+
+	return m.Status.Validate()
+}
+
+// ValidateBasic validates the message.
+func (m MsgSwapExactIn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := m.CoinIn.Validate(); err != nil || !m.CoinIn.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "input coin must be positive: %s", m.CoinIn)
+	}
+	if err := validateSwapDenoms(m.CoinIn.Denom, m.DenomOut); err != nil {
+		return err
+	}
+	if m.MinAmountOut.IsNil() || !m.MinAmountOut.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "min amount out must be positive")
+	}
+
+	return ValidateSwapRoute(m.Route, m.CoinIn.Denom, m.DenomOut)
+}
+
+// ValidateBasic validates the message.
+func (m MsgSwapExactOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := m.CoinOut.Validate(); err != nil || !m.CoinOut.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "output coin must be positive: %s", m.CoinOut)
+	}
+	if err := validateSwapDenoms(m.DenomIn, m.CoinOut.Denom); err != nil {
+		return err
+	}
+	if m.MaxAmountIn.IsNil() || !m.MaxAmountIn.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "max amount in must be positive")
+	}
+
+	return ValidateSwapRoute(m.Route, m.DenomIn, m.CoinOut.Denom)
+}
+
+// ValidateBasic validates the message.
+func (m MsgFundRewardProgram) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom); err != nil {
+		return err
+	}
+	if err := m.Amount.Validate(); err != nil || !m.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "amount must be positive: %s", m.Amount)
+	}
+
+	return ValidateRewardProgramSettings(m.RewardPerEpoch, m.MaxTicks, m.MinQuantity)
+}
+
+// ValidateBasic validates the message.
+func (m MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	return nil
+}
+
+// ValidateBasic validates the message.
+func (m MsgSetOrderQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	return nil
+}
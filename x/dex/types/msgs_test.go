@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -105,6 +107,979 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
+		{
+			name: "valid_fees",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+				msg.Params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.002")
+				msg.Params.FeeCollector = "fee_collector"
+				msg.Params.OrderBookFeeRates = []types.OrderBookFeeRates{
+					{
+						BaseDenom:    "denom1",
+						QuoteDenom:   "denom2",
+						MakerFeeRate: sdkmath.LegacyZeroDec(),
+						TakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.01"),
+					},
+				}
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_negative_maker_fee_rate",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("-0.001")
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_taker_fee_rate_one",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.TakerFeeRate = sdkmath.LegacyOneDec()
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_fee_collector",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.FeeCollector = " fee_collector"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_order_book_fee_rates_same_denoms",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.OrderBookFeeRates = []types.OrderBookFeeRates{
+					{
+						BaseDenom:    "denom1",
+						QuoteDenom:   "denom1",
+						MakerFeeRate: sdkmath.LegacyZeroDec(),
+						TakerFeeRate: sdkmath.LegacyZeroDec(),
+					},
+				}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_order_book_fee_rates_duplicated_inverted_order_book",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.OrderBookFeeRates = []types.OrderBookFeeRates{
+					{
+						BaseDenom:    "denom1",
+						QuoteDenom:   "denom2",
+						MakerFeeRate: sdkmath.LegacyZeroDec(),
+						TakerFeeRate: sdkmath.LegacyZeroDec(),
+					},
+					{
+						BaseDenom:    "denom2",
+						QuoteDenom:   "denom1",
+						MakerFeeRate: sdkmath.LegacyZeroDec(),
+						TakerFeeRate: sdkmath.LegacyZeroDec(),
+					},
+				}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_order_book_maker_fee_rate",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.OrderBookFeeRates = []types.OrderBookFeeRates{
+					{
+						BaseDenom:    "denom1",
+						QuoteDenom:   "denom2",
+						MakerFeeRate: sdkmath.LegacyMustNewDecFromStr("1.5"),
+						TakerFeeRate: sdkmath.LegacyZeroDec(),
+					},
+				}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_circuit_breaker",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.CircuitBreakerMaxPriceDeviation = sdkmath.LegacyMustNewDecFromStr("0.1")
+				msg.Params.CircuitBreakerCooldownBlocks = 100
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_negative_circuit_breaker_max_price_deviation",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.CircuitBreakerMaxPriceDeviation = sdkmath.LegacyMustNewDecFromStr("-0.1")
+				msg.Params.CircuitBreakerCooldownBlocks = 100
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_circuit_breaker_zero_cooldown_blocks",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.CircuitBreakerMaxPriceDeviation = sdkmath.LegacyMustNewDecFromStr("0.1")
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_twap_retention_period",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.TWAPRetentionPeriod = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_max_order_lifetime",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.MaxOrderLifetime = 30 * 24 * time.Hour
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_negative_max_order_lifetime",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.MaxOrderLifetime = -time.Hour
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_order_expiration_sweep_gas_limit",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.OrderExpirationSweepGasLimit = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_volume_tiers",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.VolumeTiers = []types.VolumeTier{
+					types.VolumeTier{
+						MinVolume:         sdkmath.LegacyMustNewDecFromStr("1000"),
+						MaxOrdersPerDenom: 200,
+						FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("0.1"),
+					},
+					types.VolumeTier{
+						MinVolume:         sdkmath.LegacyMustNewDecFromStr("10000"),
+						MaxOrdersPerDenom: 500,
+						FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("1"),
+					},
+				}
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_zero_trading_volume_window_days",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.TradingVolumeWindowDays = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_too_big_trading_volume_window_days",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.TradingVolumeWindowDays = types.MaxTradingVolumeWindowDays + 1
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_volume_tier_zero_min_volume",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.VolumeTiers = []types.VolumeTier{
+					types.VolumeTier{
+						MinVolume:         sdkmath.LegacyMustNewDecFromStr("0"),
+						MaxOrdersPerDenom: 200,
+						FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("0.1"),
+					},
+				}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_volume_tiers_not_sorted",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.VolumeTiers = []types.VolumeTier{
+					types.VolumeTier{
+						MinVolume:         sdkmath.LegacyMustNewDecFromStr("1000"),
+						MaxOrdersPerDenom: 200,
+						FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("0.1"),
+					},
+					types.VolumeTier{
+						MinVolume:         sdkmath.LegacyMustNewDecFromStr("1000"),
+						MaxOrdersPerDenom: 500,
+						FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("0.2"),
+					},
+				}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_volume_tier_zero_max_orders_per_denom",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.VolumeTiers = []types.VolumeTier{
+					types.VolumeTier{
+						MinVolume:         sdkmath.LegacyMustNewDecFromStr("1000"),
+						MaxOrdersPerDenom: 0,
+						FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("0.1"),
+					},
+				}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_volume_tier_fee_discount",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.VolumeTiers = []types.VolumeTier{
+					types.VolumeTier{
+						MinVolume:         sdkmath.LegacyMustNewDecFromStr("1000"),
+						MaxOrdersPerDenom: 200,
+						FeeDiscount:       sdkmath.LegacyMustNewDecFromStr("1.1"),
+					},
+				}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_swap_route_denoms",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.SwapRouteDenoms = []string{"denom1", "denom2"}
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_swap_route_denoms_duplicated",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.SwapRouteDenoms = []string{"denom1", "denom1"}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_swap_route_denoms_denom",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.SwapRouteDenoms = []string{"1"}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_reward_epoch_blocks",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.RewardEpochBlocks = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_reward_distribution_gas_limit",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.RewardDistributionGasLimit = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_opening_auction_max_records",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.OpeningAuctionMaxRecords = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_negative_order_quota_reserve_multiplier",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.OrderQuotaReserveMultiplier = sdkmath.LegacyMustNewDecFromStr("-1")
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
+	// single case just to test that we call the Order.Validate
+	m := types.MsgPlaceOrder{}
+	require.Error(t, m.ValidateBasic())
+}
+
+func TestMsgReplaceOrder_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgReplaceOrder {
+		return types.MsgReplaceOrder{
+			Sender:   sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			ID:       "aA09+:._-",
+			Price:    lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+			Quantity: sdkmath.NewInt(100),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgReplaceOrder
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "valid_only_price",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.Quantity = sdkmath.Int{}
+				return msg
+			}(),
+		},
+		{
+			name: "valid_only_quantity",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.Price = nil
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.Sender = "inv_acc"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_id",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.ID = strings.Repeat("a", 41)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_nothing_to_replace",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.Price = nil
+				msg.Quantity = sdkmath.ZeroInt()
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_negative_quantity",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.Quantity = sdkmath.NewInt(-1)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCancelOrder {
+		return types.MsgCancelOrder{
+			Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			ID:     "aA09+:._-",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgCancelOrder
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgCancelOrder {
+				msg := validMsg()
+				msg.Sender = "inv_acc"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_id",
+			msg: func() types.MsgCancelOrder {
+				msg := validMsg()
+				msg.ID = strings.Repeat("a", 41)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCancelOrdersByDenom {
+		return types.MsgCancelOrdersByDenom{
+			Sender:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Account: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Denom:   "denom1",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgCancelOrdersByDenom
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgCancelOrdersByDenom {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgCancelOrdersByDenom {
+				msg := validMsg()
+				msg.Account = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_denom",
+			msg: func() types.MsgCancelOrdersByDenom {
+				msg := validMsg()
+				msg.Denom = "1@1"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+//nolint:lll // assertion strings
+func TestMsgBatchPlaceOrders_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgBatchPlaceOrders {
+		return types.MsgBatchPlaceOrders{
+			Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Orders: []types.BatchOrder{
+				{
+					Type:        types.ORDER_TYPE_LIMIT,
+					ID:          "id1",
+					BaseDenom:   "denom1",
+					QuoteDenom:  "denom2",
+					Price:       lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+					Quantity:    sdkmath.NewInt(100),
+					Side:        types.SIDE_SELL,
+					TimeInForce: types.TIME_IN_FORCE_GTC,
+				},
+				{
+					Type:        types.ORDER_TYPE_MARKET,
+					ID:          "id2",
+					BaseDenom:   "denom1",
+					QuoteDenom:  "denom2",
+					Quantity:    sdkmath.NewInt(100),
+					Side:        types.SIDE_BUY,
+					TimeInForce: types.TIME_IN_FORCE_IOC,
+				},
+			},
+			Mode: types.BATCH_MODE_ALL_OR_NOTHING,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgBatchPlaceOrders
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgBatchPlaceOrders {
+				msg := validMsg()
+				msg.Sender = "inv_acc"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_mode",
+			msg: func() types.MsgBatchPlaceOrders {
+				msg := validMsg()
+				msg.Mode = types.BATCH_MODE_UNSPECIFIED
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_empty",
+			msg: func() types.MsgBatchPlaceOrders {
+				msg := validMsg()
+				msg.Orders = nil
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_too_many_orders",
+			msg: func() types.MsgBatchPlaceOrders {
+				msg := validMsg()
+				msg.Orders = lo.RepeatBy(types.MaxBatchSize+1, func(i int) types.BatchOrder {
+					order := msg.Orders[0]
+					order.ID = fmt.Sprintf("id%d", i)
+					return order
+				})
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_duplicated_id",
+			msg: func() types.MsgBatchPlaceOrders {
+				msg := validMsg()
+				msg.Orders[1].ID = msg.Orders[0].ID
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_order",
+			msg: func() types.MsgBatchPlaceOrders {
+				msg := validMsg()
+				msg.Orders[1].Price = lo.ToPtr(types.MustNewPriceFromString("1e-1"))
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgBatchCancelOrders_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgBatchCancelOrders {
+		return types.MsgBatchCancelOrders{
+			Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			IDs:    []string{"id1", "aA09+:._-"},
+			Mode:   types.BATCH_MODE_BEST_EFFORT,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgBatchCancelOrders
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgBatchCancelOrders {
+				msg := validMsg()
+				msg.Sender = "inv_acc"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_mode",
+			msg: func() types.MsgBatchCancelOrders {
+				msg := validMsg()
+				msg.Mode = types.BatchMode(10)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_empty",
+			msg: func() types.MsgBatchCancelOrders {
+				msg := validMsg()
+				msg.IDs = nil
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_id",
+			msg: func() types.MsgBatchCancelOrders {
+				msg := validMsg()
+				msg.IDs[1] = strings.Repeat("a", 41)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_duplicated_id",
+			msg: func() types.MsgBatchCancelOrders {
+				msg := validMsg()
+				msg.IDs[1] = msg.IDs[0]
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgCancelAllOrders_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCancelAllOrders {
+		return types.MsgCancelAllOrders{
+			Sender:     sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:  "denom1",
+			QuoteDenom: "denom2",
+			Side:       types.SIDE_BUY,
+			MinPrice:   lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+			MaxPrice:   lo.ToPtr(types.MustNewPriceFromString("2e-1")),
+			Limit:      10,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgCancelAllOrders
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "valid_without_filters",
+			msg: types.MsgCancelAllOrders{
+				Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+				Limit:  types.MaxBatchSize,
+			},
+		},
+		{
+			name: "valid_equal_prices",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.MaxPrice = msg.MinPrice
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.Sender = "inv_acc"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_limit",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.Limit = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_too_big_limit",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.Limit = types.MaxBatchSize + 1
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_only_base_denom",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.QuoteDenom = ""
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_same_denoms",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.QuoteDenom = msg.BaseDenom
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_side",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.Side = types.Side(10)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_price_range_without_order_book",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.BaseDenom = ""
+				msg.QuoteDenom = ""
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_min_price_greater_than_max_price",
+			msg: func() types.MsgCancelAllOrders {
+				msg := validMsg()
+				msg.MinPrice, msg.MaxPrice = msg.MaxPrice, msg.MinPrice
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgCancelAllOrders_MatchesOrder(t *testing.T) {
+	order := types.Order{
+		BaseDenom:  "denom1",
+		QuoteDenom: "denom2",
+		Side:       types.SIDE_BUY,
+		Price:      lo.ToPtr(types.MustNewPriceFromString("15e-2")),
+	}
+
+	tests := []struct {
+		name  string
+		msg   types.MsgCancelAllOrders
+		order types.Order
+		want  bool
+	}{
+		{
+			name:  "no_filters",
+			msg:   types.MsgCancelAllOrders{},
+			order: order,
+			want:  true,
+		},
+		{
+			name: "all_filters",
+			msg: types.MsgCancelAllOrders{
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+				Side:       types.SIDE_BUY,
+				MinPrice:   lo.ToPtr(types.MustNewPriceFromString("15e-2")),
+				MaxPrice:   lo.ToPtr(types.MustNewPriceFromString("15e-2")),
+			},
+			order: order,
+			want:  true,
+		},
+		{
+			name: "inverted_order_book",
+			msg: types.MsgCancelAllOrders{
+				BaseDenom:  "denom2",
+				QuoteDenom: "denom1",
+			},
+			order: order,
+			want:  false,
+		},
+		{
+			name: "other_side",
+			msg: types.MsgCancelAllOrders{
+				Side: types.SIDE_SELL,
+			},
+			order: order,
+			want:  false,
+		},
+		{
+			name: "price_below_min_price",
+			msg: types.MsgCancelAllOrders{
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+				MinPrice:   lo.ToPtr(types.MustNewPriceFromString("16e-2")),
+			},
+			order: order,
+			want:  false,
+		},
+		{
+			name: "price_above_max_price",
+			msg: types.MsgCancelAllOrders{
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+				MaxPrice:   lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+			},
+			order: order,
+			want:  false,
+		},
+		{
+			name: "order_without_price",
+			msg: types.MsgCancelAllOrders{
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+				MinPrice:   lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+			},
+			order: func() types.Order {
+				o := order
+				o.Price = nil
+				return o
+			}(),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.msg.MatchesOrder(tt.order))
+		})
+	}
+}
+
+func TestMsgSetDeadManSwitchAndHeartbeat_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name    string
+		msg     sdk.HasValidateBasic
+		wantErr error
+	}{
+		{
+			name: "valid_set_dead_man_switch",
+			msg:  &types.MsgSetDeadManSwitch{Sender: sender, TimeoutBlocks: 10},
+		},
+		{
+			name: "valid_disarm_dead_man_switch",
+			msg:  &types.MsgSetDeadManSwitch{Sender: sender},
+		},
+		{
+			name:    "invalid_set_dead_man_switch_account",
+			msg:     &types.MsgSetDeadManSwitch{Sender: "inv_acc", TimeoutBlocks: 10},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_heartbeat",
+			msg:  &types.MsgHeartbeat{Sender: sender},
+		},
+		{
+			name:    "invalid_heartbeat_account",
+			msg:     &types.MsgHeartbeat{Sender: "inv_acc"},
+			wantErr: types.ErrInvalidInput,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -119,23 +1094,166 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
-	// single case just to test that we call the Order.Validate
-	m := types.MsgPlaceOrder{}
-	require.Error(t, m.ValidateBasic())
+func TestMsgSwap_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name    string
+		msg     sdk.HasValidateBasic
+		wantErr error
+	}{
+		{
+			name: "valid_swap_exact_in",
+			msg: &types.MsgSwapExactIn{
+				Sender:       sender,
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom3",
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+		},
+		{
+			name: "valid_swap_exact_in_with_route",
+			msg: &types.MsgSwapExactIn{
+				Sender:       sender,
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom3",
+				Route:        []string{"denom1", "denom2", "denom3"},
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+		},
+		{
+			name: "invalid_swap_exact_in_account",
+			msg: &types.MsgSwapExactIn{
+				Sender:       "inv_acc",
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom3",
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_swap_exact_in_zero_coin",
+			msg: &types.MsgSwapExactIn{
+				Sender:       sender,
+				CoinIn:       sdk.NewInt64Coin("denom1", 0),
+				DenomOut:     "denom3",
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_swap_exact_in_same_denoms",
+			msg: &types.MsgSwapExactIn{
+				Sender:       sender,
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom1",
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_swap_exact_in_min_amount_out",
+			msg: &types.MsgSwapExactIn{
+				Sender:       sender,
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom3",
+				MinAmountOut: sdkmath.ZeroInt(),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_swap_exact_in_route_start",
+			msg: &types.MsgSwapExactIn{
+				Sender:       sender,
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom3",
+				Route:        []string{"denom2", "denom3"},
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_swap_exact_in_route_duplicated_denom",
+			msg: &types.MsgSwapExactIn{
+				Sender:       sender,
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom3",
+				Route:        []string{"denom1", "denom2", "denom1", "denom3"},
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_swap_exact_in_route_too_long",
+			msg: &types.MsgSwapExactIn{
+				Sender:       sender,
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom6",
+				Route:        []string{"denom1", "denom2", "denom3", "denom4", "denom5", "denom6"},
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_swap_exact_out",
+			msg: &types.MsgSwapExactOut{
+				Sender:      sender,
+				DenomIn:     "denom1",
+				CoinOut:     sdk.NewInt64Coin("denom3", 100),
+				Route:       []string{"denom1", "denom2", "denom3"},
+				MaxAmountIn: sdkmath.NewInt(110),
+			},
+		},
+		{
+			name: "invalid_swap_exact_out_route_end",
+			msg: &types.MsgSwapExactOut{
+				Sender:      sender,
+				DenomIn:     "denom1",
+				CoinOut:     sdk.NewInt64Coin("denom3", 100),
+				Route:       []string{"denom1", "denom2"},
+				MaxAmountIn: sdkmath.NewInt(110),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_swap_exact_out_max_amount_in",
+			msg: &types.MsgSwapExactOut{
+				Sender:      sender,
+				DenomIn:     "denom1",
+				CoinOut:     sdk.NewInt64Coin("denom3", 100),
+				MaxAmountIn: sdkmath.ZeroInt(),
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
 }
 
-func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
-	validMsg := func() types.MsgCancelOrder {
-		return types.MsgCancelOrder{
-			Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
-			ID:     "aA09+:._-",
+func TestMsgCreateOrderBook_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCreateOrderBook {
+		return types.MsgCreateOrderBook{
+			Sender:       sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:    "denom1",
+			QuoteDenom:   "denom2",
+			PriceTick:    lo.ToPtr(types.MustNewPriceFromString("1e-3")),
+			QuantityStep: lo.ToPtr(sdkmath.NewInt(1000)),
+			MinQuantity:  lo.ToPtr(sdkmath.NewInt(10000)),
 		}
 	}
 
 	tests := []struct {
 		name    string
-		msg     types.MsgCancelOrder
+		msg     types.MsgCreateOrderBook
 		wantErr error
 	}{
 		{
@@ -143,19 +1261,56 @@ func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
 			msg:  validMsg(),
 		},
 		{
-			name: "invalid_account",
-			msg: func() types.MsgCancelOrder {
+			name: "valid_without_overrides",
+			msg: func() types.MsgCreateOrderBook {
 				msg := validMsg()
-				msg.Sender = "inv_acc"
+				msg.PriceTick = nil
+				msg.QuantityStep = nil
+				msg.MinQuantity = nil
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgCreateOrderBook {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
 		{
-			name: "invalid_id",
-			msg: func() types.MsgCancelOrder {
+			name: "invalid_same_denoms",
+			msg: func() types.MsgCreateOrderBook {
 				msg := validMsg()
-				msg.ID = strings.Repeat("a", 41)
+				msg.QuoteDenom = msg.BaseDenom
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_price_tick",
+			msg: func() types.MsgCreateOrderBook {
+				msg := validMsg()
+				msg.PriceTick = &types.Price{}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_quantity_step",
+			msg: func() types.MsgCreateOrderBook {
+				msg := validMsg()
+				msg.QuantityStep = lo.ToPtr(sdkmath.ZeroInt())
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_min_quantity",
+			msg: func() types.MsgCreateOrderBook {
+				msg := validMsg()
+				msg.MinQuantity = lo.ToPtr(sdkmath.NewInt(-1))
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
@@ -174,18 +1329,73 @@ func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
-	validMsg := func() types.MsgCancelOrdersByDenom {
-		return types.MsgCancelOrdersByDenom{
-			Sender:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
-			Account: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
-			Denom:   "denom1",
+func TestMsgUpdateOrderBookStatus_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgUpdateOrderBookStatus {
+		return types.MsgUpdateOrderBookStatus{
+			Sender:     sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:  "denom1",
+			QuoteDenom: "denom2",
+			Status:     types.ORDER_BOOK_STATUS_DELISTED,
 		}
 	}
 
 	tests := []struct {
 		name    string
-		msg     types.MsgCancelOrdersByDenom
+		msg     types.MsgUpdateOrderBookStatus
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_base_denom",
+			msg: func() types.MsgUpdateOrderBookStatus {
+				msg := validMsg()
+				msg.BaseDenom = "1@1"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_unspecified_status",
+			msg: func() types.MsgUpdateOrderBookStatus {
+				msg := validMsg()
+				msg.Status = types.ORDER_BOOK_STATUS_UNSPECIFIED
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgFundRewardProgram_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgFundRewardProgram {
+		return types.MsgFundRewardProgram{
+			Sender:         sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:      "denom1",
+			QuoteDenom:     "denom2",
+			Amount:         sdk.NewInt64Coin("denom3", 1000),
+			RewardPerEpoch: sdkmath.NewInt(10),
+			MaxTicks:       5,
+			MinQuantity:    sdkmath.ZeroInt(),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgFundRewardProgram
 		wantErr error
 	}{
 		{
@@ -194,27 +1404,54 @@ func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
 		},
 		{
 			name: "invalid_sender",
-			msg: func() types.MsgCancelOrdersByDenom {
+			msg: func() types.MsgFundRewardProgram {
 				msg := validMsg()
-				msg.Sender = "inv_sender"
+				msg.Sender = "invalid"
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
 		{
-			name: "invalid_account",
-			msg: func() types.MsgCancelOrdersByDenom {
+			name: "invalid_same_denoms",
+			msg: func() types.MsgFundRewardProgram {
 				msg := validMsg()
-				msg.Account = "inv_sender"
+				msg.QuoteDenom = msg.BaseDenom
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
 		{
-			name: "invalid_denom",
-			msg: func() types.MsgCancelOrdersByDenom {
+			name: "invalid_zero_amount",
+			msg: func() types.MsgFundRewardProgram {
 				msg := validMsg()
-				msg.Denom = "1@1"
+				msg.Amount = sdk.NewInt64Coin("denom3", 0)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_reward_per_epoch",
+			msg: func() types.MsgFundRewardProgram {
+				msg := validMsg()
+				msg.RewardPerEpoch = sdkmath.ZeroInt()
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_max_ticks",
+			msg: func() types.MsgFundRewardProgram {
+				msg := validMsg()
+				msg.MaxTicks = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_negative_min_quantity",
+			msg: func() types.MsgFundRewardProgram {
+				msg := validMsg()
+				msg.MinQuantity = sdkmath.NewInt(-1)
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
@@ -233,7 +1470,6 @@ func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
 	}
 }
 
-//nolint:lll // assertion strings
 func TestAmino(t *testing.T) {
 	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
 
@@ -248,7 +1484,7 @@ func TestAmino(t *testing.T) {
 				Authority: address,
 				Params:    types.DefaultParams(),
 			},
-			wantAminoJSON: `{"type":"dex/MsgUpdateParams","value":{"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","params":{"default_unified_ref_amount":"1000000.000000000000000000","max_orders_per_denom":"100","order_reserve":{"amount":"10000000","denom":"stake"},"price_tick_exponent":-6,"quantity_step_exponent":-2}}}`,
+			wantAminoJSON: `{"type":"dex/MsgUpdateParams","value":{"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","params":{"circuit_breaker_max_price_deviation":"0.000000000000000000","default_unified_ref_amount":"1000000.000000000000000000","maker_fee_rate":"0.000000000000000000","max_order_lifetime":"0","max_orders_per_denom":"100","opening_auction_max_records":"1000","order_book_fee_rates":null,"order_expiration_sweep_gas_limit":"20000000","order_quota_reserve_multiplier":"1.000000000000000000","order_reserve":{"amount":"10000000","denom":"stake"},"price_tick_exponent":-6,"quantity_step_exponent":-2,"reward_distribution_gas_limit":"20000000","reward_epoch_blocks":"100","taker_fee_rate":"0.000000000000000000","trading_volume_window_days":30,"twap_retention_period":"172800000000000","volume_tiers":null}}}`,
 		},
 		{
 			name: sdk.MsgTypeURL(&types.MsgPlaceOrder{}),
@@ -265,6 +1501,16 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"dex/MsgPlaceOrder","value":{"base_denom":"denom1","id":"id1","price":"1e-1","quantity":"100","quote_denom":"denom2","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","side":2,"time_in_force":1,"type":1}}`,
 		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgReplaceOrder{}),
+			msg: &types.MsgReplaceOrder{
+				Sender:   address,
+				ID:       "id1",
+				Price:    lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+				Quantity: sdkmath.NewInt(100),
+			},
+			wantAminoJSON: `{"type":"dex/MsgReplaceOrder","value":{"id":"id1","price":"1e-1","quantity":"100","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 		{
 			name: sdk.MsgTypeURL(&types.MsgCancelOrder{}),
 			msg: &types.MsgCancelOrder{
@@ -282,6 +1528,131 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"dex/MsgCancelOrdersByDenom","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"denom1","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgBatchPlaceOrders{}),
+			msg: &types.MsgBatchPlaceOrders{
+				Sender: address,
+				Orders: []types.BatchOrder{
+					{
+						Type:        types.ORDER_TYPE_LIMIT,
+						ID:          "id1",
+						BaseDenom:   "denom1",
+						QuoteDenom:  "denom2",
+						Price:       lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+						Quantity:    sdkmath.NewInt(100),
+						Side:        types.SIDE_SELL,
+						TimeInForce: types.TIME_IN_FORCE_GTC,
+					},
+				},
+				Mode: types.BATCH_MODE_BEST_EFFORT,
+			},
+			wantAminoJSON: `{"type":"dex/MsgBatchPlaceOrders","value":{"mode":2,"orders":[{"base_denom":"denom1","id":"id1","price":"1e-1","quantity":"100","quote_denom":"denom2","side":2,"time_in_force":1,"type":1}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgBatchCancelOrders{}),
+			msg: &types.MsgBatchCancelOrders{
+				Sender: address,
+				IDs:    []string{"id1", "id2"},
+				Mode:   types.BATCH_MODE_ALL_OR_NOTHING,
+			},
+			wantAminoJSON: `{"type":"dex/MsgBatchCancelOrders","value":{"ids":["id1","id2"],"mode":1,"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgCancelAllOrders{}),
+			msg: &types.MsgCancelAllOrders{
+				Sender:     address,
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+				Side:       types.SIDE_BUY,
+				MinPrice:   lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+				Limit:      10,
+			},
+			wantAminoJSON: `{"type":"dex/MsgCancelAllOrders","value":{"base_denom":"denom1","limit":10,"min_price":"1e-1","quote_denom":"denom2","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","side":1}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSetDeadManSwitch{}),
+			msg: &types.MsgSetDeadManSwitch{
+				Sender:        address,
+				TimeoutBlocks: 100,
+			},
+			wantAminoJSON: `{"type":"dex/MsgSetDeadManSwitch","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","timeout_blocks":"100"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgHeartbeat{}),
+			msg: &types.MsgHeartbeat{
+				Sender: address,
+			},
+			wantAminoJSON: `{"type":"dex/MsgHeartbeat","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgCreateOrderBook{}),
+			msg: &types.MsgCreateOrderBook{
+				Sender:      address,
+				BaseDenom:   "denom1",
+				QuoteDenom:  "denom2",
+				PriceTick:   lo.ToPtr(types.MustNewPriceFromString("1e-3")),
+				MinQuantity: lo.ToPtr(sdkmath.NewInt(1000)),
+			},
+			wantAminoJSON: `{"type":"dex/MsgCreateOrderBook","value":{"base_denom":"denom1","min_quantity":"1000","price_tick":"1e-3","quote_denom":"denom2","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgUpdateOrderBookStatus{}),
+			msg: &types.MsgUpdateOrderBookStatus{
+				Sender:     address,
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+				Status:     types.ORDER_BOOK_STATUS_PAUSED,
+			},
+			wantAminoJSON: `{"type":"dex/MsgUpdateOrderBookStatus","value":{"base_denom":"denom1","quote_denom":"denom2","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","status":2}}`,
+		}, {
+			name: sdk.MsgTypeURL(&types.MsgSwapExactIn{}),
+			msg: &types.MsgSwapExactIn{
+				Sender:       address,
+				CoinIn:       sdk.NewInt64Coin("denom1", 100),
+				DenomOut:     "denom3",
+				Route:        []string{"denom1", "denom2", "denom3"},
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+			wantAminoJSON: `{"type":"dex/MsgSwapExactIn","value":{"coin_in":{"amount":"100","denom":"denom1"},"denom_out":"denom3","min_amount_out":"90","route":["denom1","denom2","denom3"],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSwapExactOut{}),
+			msg: &types.MsgSwapExactOut{
+				Sender:      address,
+				DenomIn:     "denom1",
+				CoinOut:     sdk.NewInt64Coin("denom3", 100),
+				MaxAmountIn: sdkmath.NewInt(110),
+			},
+			wantAminoJSON: `{"type":"dex/MsgSwapExactOut","value":{"coin_out":{"amount":"100","denom":"denom3"},"denom_in":"denom1","max_amount_in":"110","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgFundRewardProgram{}),
+			msg: &types.MsgFundRewardProgram{
+				Sender:         address,
+				BaseDenom:      "denom1",
+				QuoteDenom:     "denom2",
+				Amount:         sdk.NewInt64Coin("denom3", 1000),
+				RewardPerEpoch: sdkmath.NewInt(10),
+				MaxTicks:       5,
+				MinQuantity:    sdkmath.NewInt(100),
+			},
+			wantAminoJSON: `{"type":"dex/MsgFundRewardProgram","value":{"amount":{"amount":"1000","denom":"denom3"},"base_denom":"denom1","max_ticks":5,"min_quantity":"100","quote_denom":"denom2","reward_per_epoch":"10","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgClaimRewards{}),
+			msg: &types.MsgClaimRewards{
+				Sender: address,
+			},
+			wantAminoJSON: `{"type":"dex/MsgClaimRewards","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSetOrderQuota{}),
+			msg: &types.MsgSetOrderQuota{
+				Sender:              address,
+				ExtraOrdersPerDenom: 100,
+			},
+			wantAminoJSON: `{"type":"dex/MsgSetOrderQuota","value":{"extra_orders_per_denom":"100","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 	}
 
 	legacyAmino := codec.NewLegacyAmino()
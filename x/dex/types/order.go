@@ -59,19 +59,118 @@ func (t TimeInForce) Validate() error {
 	return nil
 }
 
+// Validate validates self-trade prevention.
+func (s SelfTradePrevention) Validate() error {
+	if _, exists := SelfTradePrevention_name[int32(s)]; !exists {
+		return sdkerrors.Wrapf(ErrInvalidInput, "non-existing self-trade prevention provided: %d", s)
+	}
+
+	return nil
+}
+
+// Validate validates trigger condition.
+func (c TriggerCondition) Validate() error {
+	switch c {
+	case TRIGGER_CONDITION_PRICE_GTE, TRIGGER_CONDITION_PRICE_LTE:
+		return nil
+	default:
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"only %s and %s trigger conditions are allowed",
+			TRIGGER_CONDITION_PRICE_GTE.String(), TRIGGER_CONDITION_PRICE_LTE.String(),
+		)
+	}
+}
+
+// Validate validates the trigger.
+func (t Trigger) Validate() error {
+	if t.Price.Rat().Sign() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "trigger price must be positive")
+	}
+
+	return t.Condition.Validate()
+}
+
+// IsActivatedBy returns true if the trigger condition is met by the last traded price.
+func (t Trigger) IsActivatedBy(lastPrice *big.Rat) bool {
+	switch t.Condition {
+	case TRIGGER_CONDITION_PRICE_GTE:
+		return cbig.RatGTE(lastPrice, t.Price.Rat())
+	case TRIGGER_CONDITION_PRICE_LTE:
+		return cbig.RatLTE(lastPrice, t.Price.Rat())
+	default:
+		return false
+	}
+}
+
+// Validate validates the good til settings.
+func (g GoodTil) Validate() error {
+	// if the good til provided at least one setting should be set
+	if g.GoodTilBlockHeight == 0 && g.GoodTilBlockTime == nil && g.GoodTilBlocks == 0 && g.GoodTilDuration == nil {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"good til block height, time, blocks or duration must be provided if good til is not nil",
+		)
+	}
+	if g.GoodTilBlockHeight > 0 && g.GoodTilBlocks > 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "good til block height and blocks can't be provided together")
+	}
+	if g.GoodTilDuration != nil {
+		if g.GoodTilBlockTime != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, "good til block time and duration can't be provided together")
+		}
+		if *g.GoodTilDuration <= 0 {
+			return sdkerrors.Wrap(ErrInvalidInput, "good til duration must be positive")
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the dead man's switch.
+func (s DeadManSwitch) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Creator); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", s.Creator)
+	}
+	if s.TimeoutBlocks == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "dead man's switch timeout blocks must be positive")
+	}
+	if s.ExpirationHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "dead man's switch expiration height must be positive")
+	}
+
+	return nil
+}
+
+// Validate validates the trading volume.
+func (v TradingVolume) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", v.Account)
+	}
+	if v.Volume.IsNil() || !v.Volume.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "trading volume of %s must be positive", v.Account)
+	}
+
+	return nil
+}
+
 // NewOrderFromMsgPlaceOrder creates and validates Order from MsgPlaceOrder.
 func NewOrderFromMsgPlaceOrder(msg MsgPlaceOrder) (Order, error) {
 	o := Order{
-		Creator:     msg.Sender,
-		Type:        msg.Type,
-		ID:          msg.ID,
-		BaseDenom:   msg.BaseDenom,
-		QuoteDenom:  msg.QuoteDenom,
-		Price:       msg.Price,
-		Quantity:    msg.Quantity,
-		Side:        msg.Side,
-		GoodTil:     msg.GoodTil,
-		TimeInForce: msg.TimeInForce,
+		Creator:             msg.Sender,
+		Type:                msg.Type,
+		ID:                  msg.ID,
+		BaseDenom:           msg.BaseDenom,
+		QuoteDenom:          msg.QuoteDenom,
+		Price:               msg.Price,
+		Quantity:            msg.Quantity,
+		Side:                msg.Side,
+		GoodTil:             msg.GoodTil,
+		TimeInForce:         msg.TimeInForce,
+		Trigger:             msg.Trigger,
+		SelfTradePrevention: msg.SelfTradePrevention,
+		DisplayQuantity:     msg.DisplayQuantity,
+		AllOrNone:           msg.AllOrNone,
 	}
 	if err := o.Validate(); err != nil {
 		return Order{}, err
@@ -120,15 +219,15 @@ func (o Order) Validate() error {
 		return err
 	}
 
+	if err := o.SelfTradePrevention.Validate(); err != nil {
+		return err
+	}
+
 	switch o.Type {
 	case ORDER_TYPE_LIMIT:
 		if o.GoodTil != nil {
-			// if the good til provided at least one setting should be set
-			if o.GoodTil.GoodTilBlockHeight == 0 && o.GoodTil.GoodTilBlockTime == nil {
-				return sdkerrors.Wrap(
-					ErrInvalidInput,
-					"good til block height or time must be provided if good til is not nil",
-				)
+			if err := o.GoodTil.Validate(); err != nil {
+				return err
 			}
 		}
 		if o.TimeInForce == TIME_IN_FORCE_UNSPECIFIED {
@@ -174,6 +273,28 @@ func (o Order) Validate() error {
 		)
 	}
 
+	if o.Trigger != nil {
+		if err := o.Trigger.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if o.DisplayQuantity != nil {
+		if err := o.validateDisplayQuantity(); err != nil {
+			return err
+		}
+	}
+
+	if o.AllOrNone {
+		if err := o.validateAllOrNone(); err != nil {
+			return err
+		}
+	}
+
+	if o.HiddenBaseQuantity != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, "initial hidden quantity must be nil")
+	}
+
 	if !o.RemainingBaseQuantity.IsNil() {
 		return sdkerrors.Wrap(ErrInvalidInput, "initial remaining quantity must be nil")
 	}
@@ -189,6 +310,45 @@ func (o Order) Validate() error {
 	return nil
 }
 
+func (o Order) validateDisplayQuantity() error {
+	if o.Type != ORDER_TYPE_LIMIT {
+		return sdkerrors.Wrap(ErrInvalidInput, "display quantity is supported only for the limit order")
+	}
+	if o.TimeInForce != TIME_IN_FORCE_GTC && o.TimeInForce != TIME_IN_FORCE_POST_ONLY {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"display quantity is supported only for %s and %s time in force",
+			TIME_IN_FORCE_GTC.String(), TIME_IN_FORCE_POST_ONLY.String(),
+		)
+	}
+	if o.DisplayQuantity.IsNil() || !o.DisplayQuantity.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "display quantity must be positive")
+	}
+	if o.DisplayQuantity.GTE(o.Quantity) {
+		return sdkerrors.Wrap(ErrInvalidInput, "display quantity must be less than quantity")
+	}
+
+	return nil
+}
+
+func (o Order) validateAllOrNone() error {
+	if o.Type != ORDER_TYPE_LIMIT {
+		return sdkerrors.Wrap(ErrInvalidInput, "all-or-none is supported only for the limit order")
+	}
+	if o.TimeInForce != TIME_IN_FORCE_GTC && o.TimeInForce != TIME_IN_FORCE_POST_ONLY {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"all-or-none is supported only for %s and %s time in force",
+			TIME_IN_FORCE_GTC.String(), TIME_IN_FORCE_POST_ONLY.String(),
+		)
+	}
+	if o.DisplayQuantity != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, "all-or-none isn't supported for the iceberg order")
+	}
+
+	return nil
+}
+
 // ComputeLimitOrderLockedBalance computes the order locked balance.
 func (o Order) ComputeLimitOrderLockedBalance() (sdk.Coin, error) {
 	if o.Price == nil {
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -94,6 +95,42 @@ func (OrderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_302bb6c9a553771c, []int{1}
 }
 
+// OrderBookStatus is order book status.
+type OrderBookStatus int32
+
+const (
+	// order_book_status_unspecified means that the order book is created implicitly by the first order and is active.
+	ORDER_BOOK_STATUS_UNSPECIFIED OrderBookStatus = 0
+	// order_book_status_active means that the order book is registered and is active.
+	ORDER_BOOK_STATUS_ACTIVE OrderBookStatus = 1
+	// order_book_status_paused means that new orders are rejected, but the existing orders are kept and can be canceled.
+	ORDER_BOOK_STATUS_PAUSED OrderBookStatus = 2
+	// order_book_status_delisted means that new orders are rejected and the existing orders are canceled.
+	ORDER_BOOK_STATUS_DELISTED OrderBookStatus = 3
+)
+
+var OrderBookStatus_name = map[int32]string{
+	0: "ORDER_BOOK_STATUS_UNSPECIFIED",
+	1: "ORDER_BOOK_STATUS_ACTIVE",
+	2: "ORDER_BOOK_STATUS_PAUSED",
+	3: "ORDER_BOOK_STATUS_DELISTED",
+}
+
+var OrderBookStatus_value = map[string]int32{
+	"ORDER_BOOK_STATUS_UNSPECIFIED": 0,
+	"ORDER_BOOK_STATUS_ACTIVE":      1,
+	"ORDER_BOOK_STATUS_PAUSED":      2,
+	"ORDER_BOOK_STATUS_DELISTED":    3,
+}
+
+func (x OrderBookStatus) String() string {
+	return proto.EnumName(OrderBookStatus_name, int32(x))
+}
+
+func (OrderBookStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{2}
+}
+
 // TimeInForce is order time in force.
 type TimeInForce int32
 
@@ -107,6 +144,9 @@ const (
 	TIME_IN_FORCE_IOC TimeInForce = 2
 	// time_in_force_fok means that order must be fully executed or canceled.
 	TIME_IN_FORCE_FOK TimeInForce = 3
+	// time_in_force_post_only means that the order must be added to the order book without any execution. The order
+	//  which would be matched immediately is rejected.
+	TIME_IN_FORCE_POST_ONLY TimeInForce = 4
 )
 
 var TimeInForce_name = map[int32]string{
@@ -114,6 +154,7 @@ var TimeInForce_name = map[int32]string{
 	1: "TIME_IN_FORCE_GTC",
 	2: "TIME_IN_FORCE_IOC",
 	3: "TIME_IN_FORCE_FOK",
+	4: "TIME_IN_FORCE_POST_ONLY",
 }
 
 var TimeInForce_value = map[string]int32{
@@ -121,6 +162,7 @@ var TimeInForce_value = map[string]int32{
 	"TIME_IN_FORCE_GTC":         1,
 	"TIME_IN_FORCE_IOC":         2,
 	"TIME_IN_FORCE_FOK":         3,
+	"TIME_IN_FORCE_POST_ONLY":   4,
 }
 
 func (x TimeInForce) String() string {
@@ -128,7 +170,83 @@ func (x TimeInForce) String() string {
 }
 
 func (TimeInForce) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{2}
+	return fileDescriptor_302bb6c9a553771c, []int{3}
+}
+
+// SelfTradePrevention defines what happens when the order would be matched against the order of the same creator.
+type SelfTradePrevention int32
+
+const (
+	// self_trade_prevention_unspecified means that the self-trade prevention is disabled and the orders of the same
+	//  creator are matched.
+	SELF_TRADE_PREVENTION_UNSPECIFIED SelfTradePrevention = 0
+	// self_trade_prevention_cancel_newest means that the taker order is canceled and the maker order is kept.
+	SELF_TRADE_PREVENTION_CANCEL_NEWEST SelfTradePrevention = 1
+	// self_trade_prevention_cancel_oldest means that the maker order is canceled and the taker order matching continues.
+	SELF_TRADE_PREVENTION_CANCEL_OLDEST SelfTradePrevention = 2
+	// self_trade_prevention_cancel_both means that both the taker and the maker orders are canceled.
+	SELF_TRADE_PREVENTION_CANCEL_BOTH SelfTradePrevention = 3
+	// self_trade_prevention_decrement_and_cancel means that both orders are decremented by the quantity which would be
+	//  executed, without any transfers, and the order which is fully decremented is canceled.
+	SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL SelfTradePrevention = 4
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_CANCEL_NEWEST",
+	2: "SELF_TRADE_PREVENTION_CANCEL_OLDEST",
+	3: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+	4: "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_UNSPECIFIED":          0,
+	"SELF_TRADE_PREVENTION_CANCEL_NEWEST":        1,
+	"SELF_TRADE_PREVENTION_CANCEL_OLDEST":        2,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":          3,
+	"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL": 4,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{4}
+}
+
+// TriggerCondition is the condition against the last traded price which activates a trigger order.
+type TriggerCondition int32
+
+const (
+	// trigger_condition_unspecified reserves the default value, to protect against unexpected settings.
+	TRIGGER_CONDITION_UNSPECIFIED TriggerCondition = 0
+	// trigger_condition_price_gte means that the order is activated when the last traded price is greater than or
+	// equal to the trigger price.
+	TRIGGER_CONDITION_PRICE_GTE TriggerCondition = 1
+	// trigger_condition_price_lte means that the order is activated when the last traded price is less than or
+	// equal to the trigger price.
+	TRIGGER_CONDITION_PRICE_LTE TriggerCondition = 2
+)
+
+var TriggerCondition_name = map[int32]string{
+	0: "TRIGGER_CONDITION_UNSPECIFIED",
+	1: "TRIGGER_CONDITION_PRICE_GTE",
+	2: "TRIGGER_CONDITION_PRICE_LTE",
+}
+
+var TriggerCondition_value = map[string]int32{
+	"TRIGGER_CONDITION_UNSPECIFIED": 0,
+	"TRIGGER_CONDITION_PRICE_GTE":   1,
+	"TRIGGER_CONDITION_PRICE_LTE":   2,
+}
+
+func (x TriggerCondition) String() string {
+	return proto.EnumName(TriggerCondition_name, int32(x))
+}
+
+func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{5}
 }
 
 // GoodTil is a good til order settings.
@@ -137,6 +255,12 @@ type GoodTil struct {
 	GoodTilBlockHeight uint64 `protobuf:"varint,1,opt,name=good_til_block_height,json=goodTilBlockHeight,proto3" json:"good_til_block_height,omitempty"`
 	// good_til_block_time means that order remains active until a specific blockchain block time is reached.
 	GoodTilBlockTime *time.Time `protobuf:"bytes,2,opt,name=good_til_block_time,json=goodTilBlockTime,proto3,stdtime" json:"good_til_block_time,omitempty"`
+	// good_til_blocks means that order remains active for the number of blocks after the placement, it's converted to
+	// the good_til_block_height when the order is placed.
+	GoodTilBlocks uint64 `protobuf:"varint,3,opt,name=good_til_blocks,json=goodTilBlocks,proto3" json:"good_til_blocks,omitempty"`
+	// good_til_duration means that order remains active for the duration after the placement, it's converted to the
+	// good_til_block_time when the order is placed.
+	GoodTilDuration *time.Duration `protobuf:"bytes,4,opt,name=good_til_duration,json=goodTilDuration,proto3,stdduration" json:"good_til_duration,omitempty"`
 }
 
 func (m *GoodTil) Reset()         { *m = GoodTil{} }
@@ -213,6 +337,137 @@ func (m *CancelGoodTil) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelGoodTil proto.InternalMessageInfo
 
+// DeadManSwitch is the account timer canceling all orders of the account if it isn't refreshed in time.
+type DeadManSwitch struct {
+	// creator is the account address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// timeout_blocks is the number of blocks the switch is extended by on each refresh.
+	TimeoutBlocks uint64 `protobuf:"varint,2,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+	// expiration_height is the height after which the orders of the account are canceled.
+	ExpirationHeight uint64 `protobuf:"varint,3,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *DeadManSwitch) Reset()         { *m = DeadManSwitch{} }
+func (m *DeadManSwitch) String() string { return proto.CompactTextString(m) }
+func (*DeadManSwitch) ProtoMessage()    {}
+func (*DeadManSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{2}
+}
+func (m *DeadManSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadManSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadManSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadManSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadManSwitch.Merge(m, src)
+}
+func (m *DeadManSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadManSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadManSwitch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadManSwitch proto.InternalMessageInfo
+
+// CancelDeadManSwitchOrders is a dead man's switch orders cancellation message for the delay router.
+type CancelDeadManSwitchOrders struct {
+	// creator is the account address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// order_sequence is the max sequence of the orders canceled by the triggered switch, it's zero until the switch is
+	// triggered.
+	OrderSequence uint64 `protobuf:"varint,2,opt,name=order_sequence,json=orderSequence,proto3" json:"order_sequence,omitempty"`
+	// order_id_cursor is the order ID the remaining orders cancellation is resumed from.
+	OrderIDCursor string `protobuf:"bytes,3,opt,name=order_id_cursor,json=orderIdCursor,proto3" json:"order_id_cursor,omitempty"`
+	// trigger_orders defines whether the orders are canceled and the trigger orders are being canceled.
+	TriggerOrders bool `protobuf:"varint,4,opt,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders,omitempty"`
+}
+
+func (m *CancelDeadManSwitchOrders) Reset()         { *m = CancelDeadManSwitchOrders{} }
+func (m *CancelDeadManSwitchOrders) String() string { return proto.CompactTextString(m) }
+func (*CancelDeadManSwitchOrders) ProtoMessage()    {}
+func (*CancelDeadManSwitchOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{3}
+}
+func (m *CancelDeadManSwitchOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelDeadManSwitchOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelDeadManSwitchOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelDeadManSwitchOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelDeadManSwitchOrders.Merge(m, src)
+}
+func (m *CancelDeadManSwitchOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelDeadManSwitchOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelDeadManSwitchOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelDeadManSwitchOrders proto.InternalMessageInfo
+
+// Trigger is a trigger order settings. The order with the trigger is kept outside the order book until the last
+// traded price of the order book meets the condition, and then it is placed as a regular limit or market order.
+type Trigger struct {
+	// price is the order book price which is compared with the last traded price.
+	Price Price `protobuf:"bytes,1,opt,name=price,proto3,customtype=Price" json:"price"`
+	// condition is the condition against the last traded price which activates the order.
+	Condition TriggerCondition `protobuf:"varint,2,opt,name=condition,proto3,enum=coreum.dex.v1.TriggerCondition" json:"condition,omitempty"`
+}
+
+func (m *Trigger) Reset()         { *m = Trigger{} }
+func (m *Trigger) String() string { return proto.CompactTextString(m) }
+func (*Trigger) ProtoMessage()    {}
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{4}
+}
+func (m *Trigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trigger.Merge(m, src)
+}
+func (m *Trigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trigger proto.InternalMessageInfo
+
 // Order represents a DEX order, encapsulating both limit and market orders. It contains comprehensive information about
 // the order's state.
 type Order struct {
@@ -244,13 +499,26 @@ type Order struct {
 	TimeInForce TimeInForce `protobuf:"varint,13,opt,name=time_in_force,json=timeInForce,proto3,enum=coreum.dex.v1.TimeInForce" json:"time_in_force,omitempty"`
 	// reserve is the reserve required to save the order in the order book
 	Reserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,14,opt,name=reserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reserve"`
+	// trigger is order trigger, the order is placed to the order book only when the trigger is activated.
+	Trigger *Trigger `protobuf:"bytes,15,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// self_trade_prevention defines what happens when the order is matched as a taker against the order of the same
+	// creator.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,16,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=coreum.dex.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// display_quantity is the visible quantity of the iceberg order. Once the visible quantity is filled, it's refreshed
+	// from the hidden quantity, and the order loses its time priority.
+	DisplayQuantity *cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=display_quantity,json=displayQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"display_quantity,omitempty"`
+	// hidden_base_quantity is the remaining quantity of the iceberg order which isn't visible in the order book.
+	HiddenBaseQuantity *cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=hidden_base_quantity,json=hiddenBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"hidden_base_quantity,omitempty"`
+	// all_or_none defines that the order resting in the order book is matched only when a single taker order can fill
+	// it entirely.
+	AllOrNone bool `protobuf:"varint,19,opt,name=all_or_none,json=allOrNone,proto3" json:"all_or_none,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{2}
+	return fileDescriptor_302bb6c9a553771c, []int{5}
 }
 func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,13 +563,19 @@ type OrderData struct {
 	GoodTil *GoodTil `protobuf:"bytes,6,opt,name=good_til,json=goodTil,proto3" json:"good_til,omitempty"`
 	// reserve is the reserve required to save the order in the order book
 	Reserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=reserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reserve"`
+	// display_quantity is the visible quantity of the iceberg order.
+	DisplayQuantity *cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=display_quantity,json=displayQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"display_quantity,omitempty"`
+	// time_in_force is the time in force the order is placed with.
+	TimeInForce TimeInForce `protobuf:"varint,9,opt,name=time_in_force,json=timeInForce,proto3,enum=coreum.dex.v1.TimeInForce" json:"time_in_force,omitempty"`
+	// placement_sequence is the sequence the order is placed with, it's kept when the iceberg order is refreshed.
+	PlacementSequence uint64 `protobuf:"varint,10,opt,name=placement_sequence,json=placementSequence,proto3" json:"placement_sequence,omitempty"`
 }
 
 func (m *OrderData) Reset()         { *m = OrderData{} }
 func (m *OrderData) String() string { return proto.CompactTextString(m) }
 func (*OrderData) ProtoMessage()    {}
 func (*OrderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{3}
+	return fileDescriptor_302bb6c9a553771c, []int{6}
 }
 func (m *OrderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,13 +610,25 @@ type OrderBookData struct {
 	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
 	// quote_denom is quote order book denom
 	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// status is order book status.
+	Status OrderBookStatus `protobuf:"varint,3,opt,name=status,proto3,enum=coreum.dex.v1.OrderBookStatus" json:"status,omitempty"`
+	// price_tick overrides the price tick computed from the unified ref amounts.
+	PriceTick *Price `protobuf:"bytes,4,opt,name=price_tick,json=priceTick,proto3,customtype=Price" json:"price_tick,omitempty"`
+	// quantity_step overrides the quantity step computed from the base denom unified ref amount.
+	QuantityStep *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=quantity_step,json=quantityStep,proto3,customtype=cosmossdk.io/math.Int" json:"quantity_step,omitempty"`
+	// min_quantity is the min quantity of the order placed to the order book.
+	MinQuantity *cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=min_quantity,json=minQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"min_quantity,omitempty"`
+	// halted_until_height is the height until which the order book is halted by the circuit breaker.
+	HaltedUntilHeight int64 `protobuf:"varint,7,opt,name=halted_until_height,json=haltedUntilHeight,proto3" json:"halted_until_height,omitempty"`
+	// auction_until_height is the height at the end of which the opening auction of the order book is uncrossed.
+	AuctionUntilHeight int64 `protobuf:"varint,8,opt,name=auction_until_height,json=auctionUntilHeight,proto3" json:"auction_until_height,omitempty"`
 }
 
 func (m *OrderBookData) Reset()         { *m = OrderBookData{} }
 func (m *OrderBookData) String() string { return proto.CompactTextString(m) }
 func (*OrderBookData) ProtoMessage()    {}
 func (*OrderBookData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{4}
+	return fileDescriptor_302bb6c9a553771c, []int{7}
 }
 func (m *OrderBookData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,13 +667,17 @@ type OrderBookRecordData struct {
 	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
 	// remaining_spendable_balance - is balance up to which user wants to spend to execute the order.
 	RemainingSpendableBalance cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_spendable_balance,json=remainingSpendableBalance,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_spendable_balance"`
+	// hidden_base_quantity is the remaining quantity of the iceberg order which isn't visible in the order book.
+	HiddenBaseQuantity *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=hidden_base_quantity,json=hiddenBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"hidden_base_quantity,omitempty"`
+	// all_or_none defines that the record is matched only when it can be filled entirely.
+	AllOrNone bool `protobuf:"varint,6,opt,name=all_or_none,json=allOrNone,proto3" json:"all_or_none,omitempty"`
 }
 
 func (m *OrderBookRecordData) Reset()         { *m = OrderBookRecordData{} }
 func (m *OrderBookRecordData) String() string { return proto.CompactTextString(m) }
 func (*OrderBookRecordData) ProtoMessage()    {}
 func (*OrderBookRecordData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{5}
+	return fileDescriptor_302bb6c9a553771c, []int{8}
 }
 func (m *OrderBookRecordData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,83 +706,430 @@ func (m *OrderBookRecordData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrderBookRecordData proto.InternalMessageInfo
 
+// OrderBookLastTrade is the last trade executed in the order book.
+type OrderBookLastTrade struct {
+	// order_book_id is order book ID the trade price is expressed in.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// price is the trade price.
+	Price Price `protobuf:"bytes,2,opt,name=price,proto3,customtype=Price" json:"price"`
+	// order_sequence is the sequence of the taker order of the trade.
+	OrderSequence uint64 `protobuf:"varint,3,opt,name=order_sequence,json=orderSequence,proto3" json:"order_sequence,omitempty"`
+}
+
+func (m *OrderBookLastTrade) Reset()         { *m = OrderBookLastTrade{} }
+func (m *OrderBookLastTrade) String() string { return proto.CompactTextString(m) }
+func (*OrderBookLastTrade) ProtoMessage()    {}
+func (*OrderBookLastTrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{9}
+}
+func (m *OrderBookLastTrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookLastTrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookLastTrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookLastTrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookLastTrade.Merge(m, src)
+}
+func (m *OrderBookLastTrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookLastTrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookLastTrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookLastTrade proto.InternalMessageInfo
+
+// PriceAccumulator is the snapshot of the cumulative price of the order book used to compute the time-weighted average
+// price.
+type PriceAccumulator struct {
+	// order_book_id is order book ID the prices are expressed in.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// time is the block time of the snapshot.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// last_price is the last traded price at the time of the snapshot.
+	LastPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=last_price,json=lastPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"last_price"`
+	// cumulative_price is the sum of the traded prices multiplied by the number of seconds they were the last prices.
+	CumulativePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cumulative_price"`
+}
+
+func (m *PriceAccumulator) Reset()         { *m = PriceAccumulator{} }
+func (m *PriceAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PriceAccumulator) ProtoMessage()    {}
+func (*PriceAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{10}
+}
+func (m *PriceAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAccumulator.Merge(m, src)
+}
+func (m *PriceAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAccumulator proto.InternalMessageInfo
+
+// TradingVolume is the trading volume of the account within the day, normalized by the unified ref amount of the base
+// denom of the trades.
+type TradingVolume struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// day is the number of the day since the unix epoch.
+	Day uint64 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// volume is the trading volume of the account within the day.
+	Volume cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volume"`
+}
+
+func (m *TradingVolume) Reset()         { *m = TradingVolume{} }
+func (m *TradingVolume) String() string { return proto.CompactTextString(m) }
+func (*TradingVolume) ProtoMessage()    {}
+func (*TradingVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{11}
+}
+func (m *TradingVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingVolume.Merge(m, src)
+}
+func (m *TradingVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingVolume proto.InternalMessageInfo
+
+// RewardProgram is the liquidity mining program rewarding the resting orders of the order book close to the mid price.
+type RewardProgram struct {
+	// base_denom is the base denom of the order book.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the quote denom of the order book.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// pool is the not distributed reward of the program.
+	Pool github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=pool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"pool"`
+	// reward_per_epoch is the amount of the pool denom distributed each epoch.
+	RewardPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=reward_per_epoch,json=rewardPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"reward_per_epoch"`
+	// max_ticks is the max distance of the qualifying order price from the mid price in price ticks.
+	MaxTicks uint32 `protobuf:"varint,5,opt,name=max_ticks,json=maxTicks,proto3" json:"max_ticks,omitempty"`
+	// min_quantity is the min remaining quantity of the qualifying order.
+	MinQuantity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=min_quantity,json=minQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"min_quantity"`
+}
+
+func (m *RewardProgram) Reset()         { *m = RewardProgram{} }
+func (m *RewardProgram) String() string { return proto.CompactTextString(m) }
+func (*RewardProgram) ProtoMessage()    {}
+func (*RewardProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{12}
+}
+func (m *RewardProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardProgram.Merge(m, src)
+}
+func (m *RewardProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardProgram proto.InternalMessageInfo
+
+// AccountReward is the reward accrued by the account in the reward program of the order book.
+type AccountReward struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// base_denom is the base denom of the order book.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the quote denom of the order book.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// points is the sum of the remaining quantities of the account qualifying orders over all samplings.
+	Points cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=points,proto3,customtype=cosmossdk.io/math.Int" json:"points"`
+	// reward is the accrued and not claimed reward.
+	Reward github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=reward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reward"`
+}
+
+func (m *AccountReward) Reset()         { *m = AccountReward{} }
+func (m *AccountReward) String() string { return proto.CompactTextString(m) }
+func (*AccountReward) ProtoMessage()    {}
+func (*AccountReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{13}
+}
+func (m *AccountReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountReward.Merge(m, src)
+}
+func (m *AccountReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountReward proto.InternalMessageInfo
+
+// OrderQuota is the additional number of orders per denom the account can have on top of the max_orders_per_denom of
+// the account, and the reserve locked for it.
+type OrderQuota struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// extra_orders_per_denom is the additional number of orders per denom.
+	ExtraOrdersPerDenom uint64 `protobuf:"varint,2,opt,name=extra_orders_per_denom,json=extraOrdersPerDenom,proto3" json:"extra_orders_per_denom,omitempty"`
+	// reserve is the reserve locked for the additional orders.
+	Reserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=reserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reserve"`
+}
+
+func (m *OrderQuota) Reset()         { *m = OrderQuota{} }
+func (m *OrderQuota) String() string { return proto.CompactTextString(m) }
+func (*OrderQuota) ProtoMessage()    {}
+func (*OrderQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{14}
+}
+func (m *OrderQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderQuota.Merge(m, src)
+}
+func (m *OrderQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderQuota proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("coreum.dex.v1.Side", Side_name, Side_value)
 	proto.RegisterEnum("coreum.dex.v1.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterEnum("coreum.dex.v1.OrderBookStatus", OrderBookStatus_name, OrderBookStatus_value)
 	proto.RegisterEnum("coreum.dex.v1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("coreum.dex.v1.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
+	proto.RegisterEnum("coreum.dex.v1.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
 	proto.RegisterType((*GoodTil)(nil), "coreum.dex.v1.GoodTil")
 	proto.RegisterType((*CancelGoodTil)(nil), "coreum.dex.v1.CancelGoodTil")
+	proto.RegisterType((*DeadManSwitch)(nil), "coreum.dex.v1.DeadManSwitch")
+	proto.RegisterType((*CancelDeadManSwitchOrders)(nil), "coreum.dex.v1.CancelDeadManSwitchOrders")
+	proto.RegisterType((*Trigger)(nil), "coreum.dex.v1.Trigger")
 	proto.RegisterType((*Order)(nil), "coreum.dex.v1.Order")
 	proto.RegisterType((*OrderData)(nil), "coreum.dex.v1.OrderData")
 	proto.RegisterType((*OrderBookData)(nil), "coreum.dex.v1.OrderBookData")
 	proto.RegisterType((*OrderBookRecordData)(nil), "coreum.dex.v1.OrderBookRecordData")
+	proto.RegisterType((*OrderBookLastTrade)(nil), "coreum.dex.v1.OrderBookLastTrade")
+	proto.RegisterType((*PriceAccumulator)(nil), "coreum.dex.v1.PriceAccumulator")
+	proto.RegisterType((*TradingVolume)(nil), "coreum.dex.v1.TradingVolume")
+	proto.RegisterType((*RewardProgram)(nil), "coreum.dex.v1.RewardProgram")
+	proto.RegisterType((*AccountReward)(nil), "coreum.dex.v1.AccountReward")
+	proto.RegisterType((*OrderQuota)(nil), "coreum.dex.v1.OrderQuota")
 }
 
 func init() { proto.RegisterFile("coreum/dex/v1/order.proto", fileDescriptor_302bb6c9a553771c) }
 
 var fileDescriptor_302bb6c9a553771c = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x15, 0x65, 0xca, 0x92, 0x46, 0x91, 0xab, 0x6c, 0x6c, 0x97, 0x56, 0x61, 0x29, 0x50, 0x91,
-	0x26, 0x08, 0x5a, 0x12, 0x4a, 0x80, 0x02, 0xbd, 0xb4, 0x28, 0xf5, 0x91, 0x12, 0xb1, 0x2d, 0x97,
-	0x92, 0x0f, 0x09, 0x50, 0x10, 0x24, 0x77, 0x23, 0x2f, 0x24, 0x71, 0x65, 0x92, 0x52, 0xed, 0x7f,
-	0x50, 0x14, 0x28, 0x90, 0x73, 0xaf, 0xfd, 0x33, 0x3e, 0xe6, 0xd6, 0x22, 0x07, 0xb5, 0x95, 0xff,
-	0x48, 0xb1, 0xcb, 0x8f, 0xc8, 0x2a, 0x9a, 0xb8, 0x2d, 0x72, 0x12, 0xf7, 0xcd, 0xec, 0xbc, 0xd9,
-	0x9d, 0xf7, 0x28, 0xc2, 0x9e, 0xcb, 0x7c, 0x32, 0x9b, 0x68, 0x98, 0x9c, 0x6b, 0xf3, 0xa6, 0xc6,
-	0x7c, 0x4c, 0x7c, 0x75, 0xea, 0xb3, 0x90, 0xa1, 0x72, 0x14, 0x52, 0x31, 0x39, 0x57, 0xe7, 0xcd,
-	0x6a, 0xcd, 0x65, 0xc1, 0x84, 0x05, 0x9a, 0x63, 0x07, 0x44, 0x9b, 0x37, 0x1d, 0x12, 0xda, 0x4d,
-	0xcd, 0x65, 0xd4, 0x8b, 0xd2, 0xab, 0xdb, 0x43, 0x36, 0x64, 0xe2, 0x51, 0xe3, 0x4f, 0x31, 0x5a,
-	0x1f, 0x32, 0x36, 0x1c, 0x13, 0x4d, 0xac, 0x9c, 0xd9, 0x0b, 0x2d, 0xa4, 0x13, 0x12, 0x84, 0xf6,
-	0x64, 0x1a, 0x25, 0x34, 0x7e, 0x92, 0x20, 0xff, 0x84, 0x31, 0x3c, 0xa0, 0x63, 0xd4, 0x84, 0x9d,
-	0x21, 0x63, 0xd8, 0x0a, 0xe9, 0xd8, 0x72, 0xc6, 0xcc, 0x1d, 0x59, 0xa7, 0x84, 0x0e, 0x4f, 0x43,
-	0x45, 0xba, 0x2b, 0x3d, 0x90, 0x4d, 0x34, 0x8c, 0xf2, 0x74, 0x1e, 0xfa, 0x46, 0x44, 0x50, 0x0f,
-	0xee, 0xac, 0x6d, 0xe1, 0x04, 0x4a, 0xf6, 0xae, 0xf4, 0xa0, 0xf4, 0xa8, 0xaa, 0x46, 0xec, 0x6a,
-	0xc2, 0xae, 0x0e, 0x12, 0x76, 0x5d, 0x7e, 0xf9, 0x7b, 0x5d, 0x32, 0x2b, 0xab, 0x25, 0x79, 0xb0,
-	0x71, 0x0c, 0xe5, 0x96, 0xed, 0xb9, 0x64, 0x9c, 0x34, 0xa5, 0x40, 0xde, 0xf5, 0x89, 0x1d, 0x32,
-	0x5f, 0xb4, 0x51, 0x34, 0x93, 0x25, 0xba, 0x07, 0x5b, 0xe2, 0xbe, 0xac, 0x80, 0x9c, 0xcd, 0x88,
-	0xe7, 0x46, 0xb4, 0xb2, 0x59, 0x16, 0x68, 0x3f, 0x06, 0x1b, 0xbf, 0xe6, 0x20, 0xd7, 0xe3, 0xc8,
-	0x5b, 0x4a, 0x7d, 0x0a, 0x72, 0x78, 0x31, 0x8d, 0x0a, 0x6c, 0x3d, 0x52, 0xd4, 0x6b, 0x57, 0xaf,
-	0x8a, 0xdd, 0x83, 0x8b, 0x29, 0x31, 0x45, 0x16, 0xda, 0x85, 0x2c, 0xc5, 0xca, 0x06, 0x2f, 0xa1,
-	0x6f, 0x2e, 0x17, 0xf5, 0xac, 0xd1, 0x36, 0xb3, 0x14, 0xa3, 0x2a, 0x14, 0xd2, 0x56, 0x64, 0xd1,
-	0x4a, 0xba, 0x46, 0xfb, 0x00, 0x7c, 0x72, 0x16, 0x26, 0x1e, 0x9b, 0x28, 0x39, 0x41, 0x5f, 0xe4,
-	0x48, 0x9b, 0x03, 0xa8, 0x0e, 0xa5, 0xb3, 0x19, 0x0b, 0x93, 0xf8, 0xa6, 0x88, 0x83, 0x80, 0x92,
-	0x84, 0xdc, 0xd4, 0xa7, 0x2e, 0x51, 0xf2, 0x82, 0xb6, 0xf8, 0x7a, 0x51, 0xcf, 0x1d, 0x73, 0xc0,
-	0x8c, 0x70, 0xf4, 0x05, 0x14, 0xce, 0x66, 0xb6, 0x17, 0xd2, 0xf0, 0x42, 0x29, 0x88, 0x9c, 0xfd,
-	0xcb, 0x45, 0x3d, 0xf3, 0x7a, 0x51, 0xdf, 0x89, 0x94, 0x13, 0xe0, 0x91, 0x4a, 0x99, 0x36, 0xb1,
-	0xc3, 0x53, 0xd5, 0xf0, 0x42, 0x33, 0x4d, 0x47, 0xf7, 0x41, 0x0e, 0x28, 0x26, 0x4a, 0x51, 0x9c,
-	0xfe, 0xce, 0xda, 0xe9, 0xfb, 0x14, 0x13, 0x53, 0x24, 0xa0, 0x13, 0xf8, 0xd0, 0x27, 0x13, 0x9b,
-	0x7a, 0xd4, 0x1b, 0x5a, 0xe2, 0x38, 0x29, 0x25, 0xdc, 0x84, 0x72, 0x27, 0xdd, 0xad, 0xdb, 0x01,
-	0xf9, 0x36, 0xe1, 0xff, 0x0e, 0x3e, 0x7a, 0x53, 0x36, 0x98, 0x12, 0x0f, 0xdb, 0xce, 0x98, 0x58,
-	0x8e, 0x3d, 0xe6, 0x4a, 0x50, 0x4a, 0x37, 0x29, 0xbd, 0x97, 0x56, 0xe8, 0x27, 0x05, 0xf4, 0x68,
-	0x3f, 0x6a, 0x42, 0x21, 0xd1, 0xa8, 0x72, 0x4b, 0x08, 0x73, 0x77, 0xed, 0x88, 0xb1, 0xd6, 0xcc,
-	0x7c, 0x2c, 0x47, 0xf4, 0x25, 0x94, 0xb9, 0x8e, 0x2d, 0xea, 0x59, 0x2f, 0x98, 0xef, 0x12, 0xa5,
-	0x2c, 0xae, 0xa6, 0xba, 0xb6, 0x8f, 0x2b, 0xd6, 0xf0, 0xba, 0x3c, 0xc3, 0x2c, 0x85, 0x6f, 0x16,
-	0x08, 0x43, 0xde, 0x27, 0x01, 0xf1, 0xe7, 0x44, 0xd9, 0x12, 0x8c, 0x7b, 0x6a, 0xd4, 0xb6, 0xca,
-	0x6f, 0x4d, 0x8d, 0xed, 0xab, 0xb6, 0x18, 0xf5, 0x74, 0x2d, 0x3e, 0xd8, 0xfd, 0x21, 0x0d, 0x4f,
-	0x67, 0x8e, 0xea, 0xb2, 0x89, 0x16, 0x7b, 0x3d, 0xfa, 0xf9, 0x2c, 0xc0, 0x23, 0x8d, 0x0b, 0x2f,
-	0x10, 0x1b, 0xcc, 0xa4, 0x74, 0xe3, 0xc7, 0x0d, 0x28, 0x0a, 0x6d, 0xb6, 0xed, 0xd0, 0x46, 0x9f,
-	0x40, 0x21, 0xb2, 0x03, 0xc5, 0x91, 0xbc, 0xf5, 0xd2, 0x72, 0x51, 0xcf, 0x8b, 0x04, 0xa3, 0x6d,
-	0xe6, 0x45, 0xd0, 0xc0, 0xe8, 0x31, 0x44, 0x06, 0xb1, 0x1c, 0xc6, 0x46, 0x3c, 0x99, 0x8b, 0xbe,
-	0xac, 0x7f, 0xb0, 0x5c, 0xd4, 0x4b, 0x22, 0x59, 0x67, 0x6c, 0x64, 0xb4, 0xcd, 0x12, 0x4b, 0x17,
-	0x18, 0x7d, 0x9c, 0xc8, 0x2f, 0x52, 0x7d, 0x39, 0xee, 0xf9, 0x9f, 0x25, 0x28, 0xff, 0x37, 0x09,
-	0xe6, 0xde, 0x25, 0xc1, 0xd5, 0x61, 0x6e, 0xde, 0x6c, 0x98, 0x2b, 0xc3, 0xc8, 0xbf, 0xbf, 0x61,
-	0xf4, 0xa0, 0x9c, 0xde, 0x9e, 0x98, 0xc7, 0x75, 0xc7, 0x4b, 0xef, 0x70, 0x7c, 0x76, 0xdd, 0xf1,
-	0x8d, 0x9f, 0xb3, 0x70, 0x27, 0xad, 0x68, 0x12, 0x97, 0xf9, 0xf8, 0x5f, 0xcd, 0xf9, 0x1e, 0x6c,
-	0xd9, 0xae, 0xcb, 0x66, 0x5e, 0x68, 0x79, 0xb3, 0x89, 0x43, 0xfc, 0xe4, 0xf5, 0x18, 0xa3, 0x47,
-	0x02, 0x7c, 0x9b, 0xa7, 0x37, 0xde, 0x9f, 0xa7, 0xe5, 0xff, 0xe7, 0xe9, 0x87, 0x5f, 0x81, 0xcc,
-	0x45, 0x81, 0xb6, 0xa1, 0xd2, 0x37, 0xda, 0x1d, 0xeb, 0xe4, 0xa8, 0x7f, 0xdc, 0x69, 0x19, 0x5d,
-	0xa3, 0xd3, 0xae, 0x64, 0xd0, 0x2d, 0x28, 0x08, 0x54, 0x3f, 0x79, 0x56, 0x91, 0x50, 0x19, 0x8a,
-	0x62, 0xd5, 0xef, 0x1c, 0x1c, 0x54, 0xb2, 0x55, 0xf9, 0x87, 0x5f, 0x6a, 0x99, 0x87, 0xcf, 0x63,
-	0xeb, 0xf0, 0xd7, 0x3a, 0xaa, 0xc2, 0x6e, 0xcf, 0x6c, 0x77, 0x4c, 0x6b, 0xf0, 0xec, 0x78, 0xbd,
-	0xd6, 0x36, 0x54, 0x56, 0x62, 0x07, 0xc6, 0xa1, 0x31, 0xa8, 0x48, 0x68, 0x07, 0x6e, 0xaf, 0xa0,
-	0x87, 0x5f, 0x9b, 0x4f, 0x3b, 0x83, 0xb4, 0xf6, 0xf7, 0x50, 0x5a, 0x79, 0x33, 0xa0, 0x7d, 0xd8,
-	0x1b, 0x18, 0x87, 0x1d, 0xcb, 0x38, 0xb2, 0xba, 0x3d, 0xb3, 0xb5, 0x4e, 0xb0, 0x03, 0xb7, 0xaf,
-	0x87, 0x9f, 0x0c, 0x5a, 0x11, 0xc3, 0x75, 0xd8, 0xe8, 0xb5, 0x2a, 0xd9, 0xbf, 0xc3, 0xdd, 0xde,
-	0xd3, 0xca, 0x46, 0x44, 0xac, 0xf7, 0x2e, 0xff, 0xac, 0x65, 0x2e, 0x97, 0x35, 0xe9, 0xd5, 0xb2,
-	0x26, 0xfd, 0xb1, 0xac, 0x49, 0x2f, 0xaf, 0x6a, 0x99, 0x57, 0x57, 0xb5, 0xcc, 0x6f, 0x57, 0xb5,
-	0xcc, 0xf3, 0xe6, 0x8a, 0xa6, 0x5b, 0xc2, 0x32, 0x5d, 0x36, 0xf3, 0xb0, 0x1d, 0x52, 0xe6, 0x69,
-	0xf1, 0x77, 0xc8, 0xfc, 0x73, 0xed, 0x5c, 0x7c, 0x8c, 0x08, 0x89, 0x3b, 0x9b, 0xe2, 0x9f, 0xfb,
-	0xf1, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x1b, 0x46, 0x42, 0xa7, 0x08, 0x00, 0x00,
+	// 1966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0xdb, 0xd8,
+	0xf5, 0xb7, 0x1e, 0xd6, 0xe3, 0x28, 0xb2, 0xe9, 0xeb, 0x38, 0x43, 0xdb, 0xff, 0x48, 0x33, 0x0a,
+	0x32, 0x09, 0xf2, 0xef, 0x48, 0xe3, 0x04, 0x1d, 0x74, 0xd0, 0xa7, 0x25, 0xd2, 0x1e, 0xc2, 0xb2,
+	0xa4, 0xa1, 0xe8, 0x14, 0x19, 0xa0, 0x25, 0x28, 0xf2, 0x46, 0x26, 0x4c, 0xf2, 0x2a, 0x7c, 0x78,
+	0xec, 0xc5, 0x6c, 0x8a, 0x02, 0xed, 0x72, 0x0a, 0xcc, 0x14, 0xdd, 0xf7, 0x7b, 0xb4, 0xe8, 0x2e,
+	0xcb, 0xd9, 0xb5, 0xe8, 0xc2, 0x6d, 0x9d, 0x65, 0xbf, 0x44, 0xc1, 0xcb, 0x4b, 0x4a, 0x96, 0x14,
+	0x3f, 0x26, 0xc9, 0xca, 0xbe, 0xe7, 0xfc, 0xce, 0x39, 0xf7, 0x3c, 0xef, 0xa1, 0x60, 0x5d, 0x27,
+	0x2e, 0x0e, 0xec, 0x86, 0x81, 0x4f, 0x1a, 0xc7, 0x5b, 0x0d, 0xe2, 0x1a, 0xd8, 0xad, 0x8f, 0x5c,
+	0xe2, 0x13, 0x54, 0x8e, 0x58, 0x75, 0x03, 0x9f, 0xd4, 0x8f, 0xb7, 0x36, 0x2a, 0x3a, 0xf1, 0x6c,
+	0xe2, 0x35, 0x06, 0x9a, 0x87, 0x1b, 0xc7, 0x5b, 0x03, 0xec, 0x6b, 0x5b, 0x0d, 0x9d, 0x98, 0x4e,
+	0x04, 0xdf, 0xb8, 0x3d, 0x24, 0x43, 0x42, 0xff, 0x6d, 0x84, 0xff, 0x31, 0x6a, 0x65, 0x48, 0xc8,
+	0xd0, 0xc2, 0x0d, 0x7a, 0x1a, 0x04, 0xcf, 0x1b, 0x46, 0xe0, 0x6a, 0xbe, 0x49, 0x62, 0xa9, 0xea,
+	0x34, 0xdf, 0x37, 0x6d, 0xec, 0xf9, 0x9a, 0x3d, 0x8a, 0x00, 0xb5, 0xdf, 0xa4, 0x21, 0xbf, 0x4b,
+	0x88, 0xa1, 0x98, 0x16, 0xda, 0x82, 0xb5, 0x21, 0x21, 0x86, 0xea, 0x9b, 0x96, 0x3a, 0xb0, 0x88,
+	0x7e, 0xa4, 0x1e, 0x62, 0x73, 0x78, 0xe8, 0xf3, 0xa9, 0xf7, 0x53, 0x0f, 0xb3, 0x32, 0x1a, 0x46,
+	0xb8, 0x66, 0xc8, 0xfa, 0x8c, 0x72, 0x50, 0x17, 0x56, 0xa7, 0x44, 0x42, 0x03, 0x7c, 0xfa, 0xfd,
+	0xd4, 0xc3, 0xd2, 0xe3, 0x8d, 0x7a, 0x64, 0xbd, 0x1e, 0x5b, 0xaf, 0x2b, 0xb1, 0xf5, 0x66, 0xf6,
+	0xeb, 0x7f, 0x55, 0x53, 0x32, 0x37, 0xa9, 0x32, 0x64, 0xa2, 0x0f, 0x61, 0xf9, 0xa2, 0x42, 0x8f,
+	0xcf, 0x50, 0xeb, 0xe5, 0x49, 0xa8, 0x87, 0xf6, 0x60, 0x25, 0xc1, 0xc5, 0x3e, 0xf3, 0x59, 0x6a,
+	0x76, 0x7d, 0xc6, 0xac, 0xc0, 0x00, 0xcd, 0xec, 0x9f, 0x42, 0xab, 0xcb, 0x4c, 0x55, 0x4c, 0xae,
+	0xf5, 0xa0, 0xdc, 0xd2, 0x1c, 0x1d, 0x5b, 0x71, 0x24, 0x78, 0xc8, 0xeb, 0x2e, 0xd6, 0x7c, 0xe2,
+	0x52, 0xdf, 0x8b, 0x72, 0x7c, 0x44, 0xf7, 0x61, 0x89, 0x26, 0x51, 0xf5, 0xf0, 0x8b, 0x00, 0x3b,
+	0x7a, 0xe4, 0x6b, 0x56, 0x2e, 0x53, 0x6a, 0x9f, 0x11, 0x6b, 0x5f, 0x41, 0x59, 0xc0, 0x9a, 0xb1,
+	0xaf, 0x39, 0xfd, 0x2f, 0x4d, 0x5f, 0x3f, 0xbc, 0x5c, 0x63, 0x18, 0x33, 0x12, 0xf8, 0xb1, 0xc3,
+	0x4c, 0x23, 0xa3, 0x32, 0x87, 0xff, 0x1f, 0x56, 0xf0, 0xc9, 0xc8, 0x8c, 0x6e, 0x1c, 0x27, 0x26,
+	0x0a, 0x0d, 0x37, 0x66, 0x44, 0x69, 0xa9, 0xfd, 0x25, 0x05, 0xeb, 0x91, 0x47, 0x17, 0x6e, 0xd1,
+	0x0d, 0xef, 0xe8, 0xbd, 0xb1, 0x77, 0xe8, 0x53, 0x58, 0x8e, 0x60, 0xa6, 0xa1, 0xea, 0x81, 0xeb,
+	0x11, 0x97, 0xde, 0xa4, 0xd8, 0x5c, 0x39, 0x3f, 0xab, 0x96, 0xa9, 0x15, 0x49, 0x68, 0x51, 0x06,
+	0x13, 0x95, 0x8c, 0xe8, 0x48, 0xbd, 0x75, 0xcd, 0xe1, 0x10, 0xbb, 0x2a, 0x65, 0x78, 0x34, 0x69,
+	0x05, 0xb9, 0xcc, 0xa8, 0xd1, 0x15, 0x6b, 0x36, 0xe4, 0x95, 0x88, 0x80, 0xee, 0xc1, 0xe2, 0xc8,
+	0x35, 0x75, 0x1c, 0xdd, 0xb5, 0x59, 0x7e, 0x79, 0x56, 0x5d, 0xf8, 0xe7, 0x59, 0x75, 0xb1, 0x17,
+	0x12, 0xe5, 0x88, 0x87, 0x7e, 0x0a, 0x45, 0x9d, 0x38, 0x86, 0x49, 0xcb, 0x20, 0xbc, 0xf3, 0xd2,
+	0xe3, 0x6a, 0xfd, 0x42, 0x83, 0xd5, 0x99, 0xbe, 0x56, 0x0c, 0x93, 0xc7, 0x12, 0xb5, 0x6f, 0x0a,
+	0xb0, 0x48, 0x2d, 0x5f, 0x12, 0x9b, 0x1f, 0x40, 0xd6, 0x3f, 0x1d, 0x61, 0xa6, 0x9d, 0x9f, 0xd2,
+	0x4e, 0xa5, 0x95, 0xd3, 0x11, 0x96, 0x29, 0x0a, 0xdd, 0x81, 0xb4, 0x69, 0xb0, 0xa8, 0xe4, 0xce,
+	0xcf, 0xaa, 0x69, 0x49, 0x90, 0xd3, 0xa6, 0x81, 0x36, 0xa0, 0x90, 0xc4, 0x36, 0x4b, 0x63, 0x9b,
+	0x9c, 0xd1, 0x5d, 0x80, 0xb0, 0xfb, 0x55, 0x03, 0x3b, 0xc4, 0xe6, 0x17, 0xa9, 0xf9, 0x62, 0x48,
+	0x11, 0x42, 0x02, 0xaa, 0x42, 0xe9, 0x45, 0x40, 0xfc, 0x98, 0x9f, 0xa3, 0x7c, 0xa0, 0xa4, 0x18,
+	0xc0, 0x22, 0x95, 0xa7, 0x66, 0x8b, 0x33, 0x51, 0xfa, 0x14, 0x0a, 0x2f, 0x02, 0xcd, 0xf1, 0x4d,
+	0xff, 0x94, 0x2f, 0x50, 0xcc, 0x5d, 0x16, 0xcd, 0xb5, 0x68, 0xfa, 0x78, 0xc6, 0x51, 0xdd, 0x24,
+	0x0d, 0x5b, 0xf3, 0x0f, 0xeb, 0x92, 0xe3, 0xcb, 0x09, 0x1c, 0x3d, 0x80, 0xac, 0x67, 0x1a, 0x98,
+	0x2f, 0x52, 0xef, 0x57, 0xa7, 0xbc, 0xef, 0x9b, 0x06, 0x96, 0x29, 0x00, 0x1d, 0xc0, 0x7b, 0x2e,
+	0xb6, 0x35, 0xd3, 0x31, 0x9d, 0xa1, 0x4a, 0xdd, 0x49, 0x4c, 0xc2, 0x75, 0x4c, 0xae, 0x25, 0xd2,
+	0x4d, 0xcd, 0xc3, 0x9f, 0xc7, 0xf6, 0x7f, 0x05, 0x9b, 0x63, 0xb5, 0xde, 0x08, 0x3b, 0x86, 0x36,
+	0xb0, 0xb0, 0x3a, 0xd0, 0xac, 0xb0, 0xcc, 0xf9, 0xd2, 0x75, 0x54, 0xaf, 0x27, 0x1a, 0xfa, 0xb1,
+	0x82, 0x66, 0x24, 0x8f, 0xb6, 0xa0, 0x10, 0x8f, 0x13, 0xfe, 0x16, 0x9d, 0x22, 0x77, 0xa6, 0x5c,
+	0x64, 0xa3, 0x41, 0xce, 0xb3, 0xe1, 0x81, 0x7e, 0x06, 0xb4, 0x43, 0x55, 0xd3, 0x51, 0x9f, 0x13,
+	0x57, 0xc7, 0x7c, 0x99, 0x86, 0x66, 0x63, 0xba, 0xec, 0x4c, 0x1b, 0x4b, 0xce, 0x4e, 0x88, 0x90,
+	0x4b, 0xfe, 0xf8, 0x80, 0x0c, 0xc8, 0xbb, 0xd8, 0xc3, 0xee, 0x31, 0xe6, 0x97, 0xd8, 0xdc, 0x8a,
+	0xae, 0x5d, 0x0f, 0xa3, 0x56, 0x67, 0x4f, 0x40, 0xbd, 0x45, 0x4c, 0xa7, 0xd9, 0x60, 0x8e, 0x3d,
+	0x18, 0x9a, 0xfe, 0x61, 0x30, 0xa8, 0xeb, 0xc4, 0x6e, 0xb0, 0xf7, 0x22, 0xfa, 0xf3, 0x91, 0x67,
+	0x1c, 0x35, 0xc2, 0xc2, 0xf3, 0xa8, 0x80, 0x1c, 0xab, 0x46, 0x1f, 0x43, 0x9e, 0x75, 0x16, 0xbf,
+	0x3c, 0xd7, 0x2f, 0xd6, 0x16, 0x72, 0x0c, 0x43, 0x4f, 0x61, 0xcd, 0xc3, 0xd6, 0x73, 0xd5, 0x77,
+	0x35, 0x03, 0xab, 0x23, 0x17, 0x1f, 0x63, 0x87, 0xb6, 0x15, 0x47, 0xfd, 0xab, 0x4d, 0xa7, 0x1e,
+	0x5b, 0xcf, 0x95, 0x10, 0xda, 0x4b, 0x90, 0xf2, 0xaa, 0x37, 0x4b, 0x44, 0x02, 0x70, 0x86, 0xe9,
+	0x8d, 0x2c, 0xed, 0x74, 0x5c, 0x11, 0x2b, 0x34, 0x6d, 0xeb, 0xaf, 0x4f, 0xd9, 0x32, 0x13, 0x49,
+	0xea, 0x60, 0x0f, 0x6e, 0x1f, 0x9a, 0x86, 0x81, 0x9d, 0xa9, 0xda, 0x42, 0x57, 0x69, 0x42, 0x91,
+	0xd8, 0x85, 0xa2, 0xaa, 0x40, 0x49, 0xb3, 0x2c, 0x95, 0xb8, 0xaa, 0x43, 0x1c, 0xcc, 0xaf, 0xd2,
+	0x49, 0x54, 0xd4, 0x2c, 0xab, 0xeb, 0x76, 0x88, 0x83, 0x6b, 0x7f, 0xcd, 0x42, 0x91, 0x36, 0xb6,
+	0xa0, 0xf9, 0x1a, 0xfa, 0x10, 0x0a, 0xf1, 0xd4, 0x63, 0xb3, 0xa8, 0x74, 0x7e, 0x56, 0xcd, 0xb3,
+	0x71, 0x27, 0xe7, 0xd9, 0xa0, 0x43, 0x4f, 0x20, 0x9a, 0x79, 0xea, 0x80, 0x90, 0xa3, 0x10, 0x1c,
+	0x4e, 0x8c, 0x72, 0x73, 0xf9, 0xfc, 0xac, 0x5a, 0xa2, 0xe0, 0x26, 0x21, 0x47, 0x92, 0x20, 0x97,
+	0x48, 0x72, 0x30, 0xc6, 0x53, 0x2e, 0x73, 0xc9, 0x94, 0x9b, 0xec, 0xdf, 0xec, 0xf7, 0xeb, 0xdf,
+	0xc5, 0xab, 0xfa, 0x77, 0xb2, 0x13, 0x72, 0xd7, 0xeb, 0x84, 0x89, 0x4a, 0xce, 0xbf, 0xbb, 0x4a,
+	0x9e, 0x57, 0x3f, 0x85, 0x1b, 0xd7, 0xcf, 0x4c, 0xd7, 0x16, 0x6f, 0xd6, 0xb5, 0x1f, 0x01, 0x1a,
+	0x59, 0x9a, 0x8e, 0x6d, 0xec, 0xf8, 0xe3, 0x57, 0x12, 0xe8, 0x24, 0x5f, 0x49, 0x38, 0xc9, 0x1e,
+	0xf0, 0x87, 0x0c, 0x94, 0x93, 0x9c, 0xd3, 0x2a, 0xba, 0x38, 0xe4, 0x53, 0x57, 0x0c, 0xf9, 0xf4,
+	0xcc, 0x90, 0xff, 0x04, 0x72, 0x9e, 0xaf, 0xf9, 0x41, 0xb4, 0x17, 0x2d, 0x3d, 0xae, 0xcc, 0x7b,
+	0x88, 0x42, 0x6b, 0x7d, 0x8a, 0x92, 0x19, 0x1a, 0x3d, 0x04, 0xa0, 0x45, 0xa4, 0xfa, 0xa6, 0x7e,
+	0xc4, 0x67, 0xa7, 0x5f, 0x88, 0x22, 0x65, 0x2a, 0xa6, 0x7e, 0x14, 0x86, 0x28, 0x0e, 0xb0, 0xea,
+	0xf9, 0x78, 0xc4, 0x2f, 0x5e, 0x15, 0xe5, 0x5b, 0x31, 0xbe, 0xef, 0xe3, 0x11, 0xfa, 0x09, 0xdc,
+	0xb2, 0x4d, 0x67, 0x9c, 0xa4, 0xdc, 0x55, 0xe2, 0x25, 0xdb, 0x74, 0x92, 0x04, 0xd5, 0x61, 0xf5,
+	0x50, 0xb3, 0x7c, 0x6c, 0xa8, 0x81, 0x13, 0x2e, 0x77, 0x6c, 0xd3, 0x09, 0x0b, 0x2b, 0x23, 0xaf,
+	0x44, 0xac, 0x83, 0x90, 0xc3, 0x36, 0xd0, 0x8f, 0xe1, 0xb6, 0x16, 0xe8, 0x74, 0x29, 0xba, 0x20,
+	0x50, 0xa0, 0x02, 0x88, 0xf1, 0x26, 0x24, 0x6a, 0xbf, 0xcb, 0xc0, 0x6a, 0x12, 0x25, 0x19, 0xeb,
+	0xc4, 0x35, 0x6e, 0xd4, 0xdf, 0xf7, 0x61, 0x49, 0xd3, 0x75, 0x12, 0x38, 0xbe, 0xea, 0x04, 0xf6,
+	0x00, 0xbb, 0xf1, 0x92, 0xc4, 0xa8, 0x1d, 0x4a, 0xbc, 0xec, 0x21, 0xcc, 0xbc, 0xbb, 0x87, 0x30,
+	0xfb, 0x86, 0x0f, 0xe1, 0xeb, 0xe6, 0xeb, 0xe2, 0x5b, 0x98, 0xaf, 0xb9, 0xe9, 0xf9, 0xfa, 0x6d,
+	0x0a, 0x50, 0x92, 0x89, 0xb6, 0xe6, 0xf9, 0xf4, 0xcd, 0x98, 0x1d, 0xa0, 0xa9, 0x9b, 0x0c, 0xd0,
+	0xf4, 0x25, 0x03, 0x74, 0x76, 0xbf, 0xcd, 0xcc, 0xdb, 0xde, 0xbf, 0x4d, 0x03, 0x47, 0xe5, 0xb6,
+	0x75, 0x3d, 0xb0, 0x03, 0x8b, 0xee, 0x7f, 0xdf, 0xeb, 0x56, 0x3f, 0x82, 0xec, 0x35, 0x3f, 0x88,
+	0x0a, 0xe1, 0x85, 0xe9, 0x47, 0x11, 0x95, 0x40, 0x4d, 0x00, 0x4b, 0xf3, 0x7c, 0x75, 0xf2, 0x55,
+	0xb8, 0xc7, 0x9c, 0xda, 0x9c, 0x4d, 0x41, 0x1b, 0x0f, 0x35, 0xfd, 0x54, 0xc0, 0xba, 0x5c, 0x0c,
+	0xc5, 0xe8, 0xed, 0x51, 0x07, 0x38, 0x76, 0x7f, 0xf3, 0x18, 0x33, 0x4d, 0xd9, 0xeb, 0x6b, 0x5a,
+	0x1e, 0x0b, 0x53, 0x7d, 0xb5, 0x13, 0x28, 0x87, 0x19, 0x32, 0x9d, 0xe1, 0x53, 0x62, 0x05, 0x36,
+	0x0e, 0xb7, 0x65, 0x56, 0xf4, 0xf1, 0xb6, 0xcc, 0x8e, 0x88, 0x83, 0x8c, 0xa1, 0x9d, 0xb2, 0xce,
+	0x08, 0xff, 0x45, 0x3f, 0x86, 0xdc, 0x31, 0x95, 0xba, 0x89, 0x33, 0x4c, 0xa4, 0xf6, 0xf7, 0x34,
+	0x94, 0x65, 0xfc, 0xa5, 0xe6, 0x1a, 0x3d, 0x97, 0x0c, 0x5d, 0xcd, 0x7e, 0xe3, 0x39, 0xfa, 0x6b,
+	0xc8, 0x8e, 0x08, 0xb1, 0xf8, 0xcc, 0x5b, 0x7f, 0xb1, 0xa8, 0x5e, 0xb4, 0x0b, 0x9c, 0x4b, 0x2f,
+	0xac, 0x8e, 0xb0, 0xab, 0xe2, 0x11, 0xd1, 0x0f, 0xaf, 0xd7, 0x9c, 0x4b, 0x91, 0x58, 0x0f, 0xbb,
+	0x62, 0x28, 0x84, 0x36, 0xa1, 0x68, 0x6b, 0x27, 0x74, 0x6c, 0x7b, 0xb4, 0x0d, 0xcb, 0x72, 0xc1,
+	0xd6, 0x4e, 0xc2, 0x51, 0xed, 0xa1, 0x5f, 0xcc, 0x9d, 0xb5, 0x57, 0x58, 0x98, 0x9c, 0xb7, 0xb5,
+	0xdf, 0xa6, 0xa1, 0xbc, 0x1d, 0x25, 0x2d, 0x0a, 0xf0, 0x25, 0x49, 0xbd, 0x18, 0xf3, 0xf4, 0x15,
+	0x31, 0xcf, 0xcc, 0xc4, 0xfc, 0x87, 0x90, 0x1b, 0x11, 0xd3, 0xf1, 0xbd, 0xeb, 0x45, 0x82, 0x81,
+	0xd1, 0x00, 0x72, 0x51, 0x4c, 0xf8, 0xc5, 0xb7, 0x9e, 0x2c, 0xa6, 0xb9, 0xf6, 0xb7, 0x14, 0x00,
+	0xed, 0xe2, 0xcf, 0x03, 0xe2, 0x6b, 0x97, 0xc4, 0xe0, 0x09, 0xdc, 0xc1, 0x27, 0xbe, 0xab, 0xb1,
+	0xcf, 0x57, 0x9a, 0xdd, 0x71, 0x3c, 0xb2, 0xf2, 0x2a, 0xe5, 0x52, 0x55, 0x5e, 0x0f, 0xbb, 0x91,
+	0xe3, 0x13, 0x1b, 0x52, 0xe6, 0x9d, 0x6d, 0x48, 0x8f, 0x7e, 0x0e, 0xd9, 0x70, 0x91, 0x43, 0xb7,
+	0x81, 0xeb, 0x4b, 0x82, 0xa8, 0x1e, 0x74, 0xfa, 0x3d, 0xb1, 0x25, 0xed, 0x48, 0xa2, 0xc0, 0x2d,
+	0xa0, 0x5b, 0x50, 0xa0, 0xd4, 0xe6, 0xc1, 0x33, 0x2e, 0x85, 0xca, 0x50, 0xa4, 0xa7, 0xbe, 0xd8,
+	0x6e, 0x73, 0xe9, 0x8d, 0xec, 0xef, 0xff, 0x5c, 0x59, 0x78, 0xf4, 0x05, 0x14, 0x93, 0xef, 0x58,
+	0xb4, 0x01, 0x77, 0xba, 0xb2, 0x20, 0xca, 0xaa, 0xf2, 0xac, 0x37, 0xad, 0xeb, 0x36, 0x70, 0x13,
+	0xbc, 0xb6, 0xb4, 0x2f, 0x29, 0x5c, 0x0a, 0xad, 0xc1, 0xca, 0x04, 0x75, 0x7f, 0x5b, 0xde, 0x13,
+	0x95, 0x44, 0xf7, 0x1f, 0x53, 0xb0, 0x3c, 0xb5, 0x9b, 0xa0, 0x0f, 0xe0, 0x6e, 0x24, 0xd0, 0xec,
+	0x76, 0xf7, 0xd4, 0xbe, 0xb2, 0xad, 0x1c, 0xf4, 0xa7, 0x2c, 0xfd, 0x1f, 0xf0, 0xb3, 0x90, 0xed,
+	0x96, 0x22, 0x3d, 0x15, 0xb9, 0xd4, 0x7c, 0x6e, 0x6f, 0xfb, 0xa0, 0x2f, 0x0a, 0x5c, 0x1a, 0x55,
+	0x60, 0x63, 0x96, 0x2b, 0x88, 0x6d, 0xa9, 0xaf, 0x88, 0x02, 0x97, 0x61, 0x17, 0xfb, 0x26, 0x05,
+	0xa5, 0x89, 0x75, 0x0f, 0xdd, 0x85, 0x75, 0x45, 0xda, 0x17, 0x55, 0xa9, 0xa3, 0xee, 0x74, 0xe5,
+	0xd6, 0xb4, 0xeb, 0x6b, 0xb0, 0x72, 0x91, 0xbd, 0xab, 0xb4, 0xb8, 0xd4, 0x2c, 0x59, 0xea, 0xb6,
+	0xb8, 0xf4, 0x2c, 0x79, 0xa7, 0xbb, 0xc7, 0x65, 0xd0, 0x26, 0xbc, 0x77, 0x91, 0xdc, 0xeb, 0xf6,
+	0x15, 0xb5, 0xdb, 0x69, 0x3f, 0xe3, 0xb2, 0xec, 0x5a, 0xff, 0x4d, 0xc1, 0xea, 0x9c, 0x6f, 0x2b,
+	0x74, 0x1f, 0x3e, 0xe8, 0x8b, 0xed, 0x1d, 0x55, 0x91, 0xb7, 0x05, 0x51, 0xed, 0xc9, 0xe2, 0x53,
+	0xb1, 0xa3, 0x48, 0xdd, 0xce, 0xd4, 0x35, 0x1f, 0xc0, 0xbd, 0xf9, 0xb0, 0xd6, 0x76, 0xa7, 0x25,
+	0xb6, 0xd5, 0x8e, 0xf8, 0x4b, 0xb1, 0x1f, 0x26, 0xed, 0x2a, 0x60, 0xb7, 0x2d, 0x84, 0xc0, 0xf4,
+	0xeb, 0x0d, 0x33, 0x60, 0xb3, 0xab, 0x7c, 0xc6, 0x65, 0x50, 0x1d, 0x1e, 0xcd, 0x87, 0x09, 0x62,
+	0x4b, 0x16, 0xf7, 0xc5, 0x8e, 0xa2, 0x6e, 0x77, 0x04, 0x26, 0x94, 0x78, 0xfb, 0x15, 0x70, 0xd3,
+	0xbf, 0xcf, 0x84, 0xd5, 0xa1, 0xc8, 0xd2, 0xee, 0xae, 0x28, 0xab, 0xad, 0x6e, 0x47, 0x90, 0xe6,
+	0x78, 0x59, 0x85, 0xcd, 0x59, 0x48, 0x4f, 0x96, 0x68, 0x5a, 0xc2, 0x02, 0xb9, 0x04, 0xd0, 0x56,
+	0xc4, 0xb8, 0x38, 0x9b, 0xdd, 0x97, 0xff, 0xa9, 0x2c, 0xbc, 0x3c, 0xaf, 0xa4, 0xbe, 0x3b, 0xaf,
+	0xa4, 0xfe, 0x7d, 0x5e, 0x49, 0x7d, 0xfd, 0xaa, 0xb2, 0xf0, 0xdd, 0xab, 0xca, 0xc2, 0x3f, 0x5e,
+	0x55, 0x16, 0xbe, 0xd8, 0x9a, 0xe8, 0xc4, 0x16, 0x5d, 0xb6, 0x77, 0x48, 0xe0, 0x18, 0xf4, 0x07,
+	0xb7, 0x06, 0xfb, 0x81, 0xf7, 0xf8, 0x93, 0xc6, 0x09, 0xfd, 0x95, 0x97, 0x36, 0xe6, 0x20, 0x47,
+	0x5f, 0xf8, 0x27, 0xff, 0x1b, 0x00, 0xc1, 0x95, 0x17, 0x08, 0x00, 0x16, 0x00, 0x00,
 }
 
 func (m *GoodTil) Marshal() (dAtA []byte, err error) {
@@ -515,14 +1152,29 @@ func (m *GoodTil) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.GoodTilBlockTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.GoodTilBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilBlockTime):])
+	if m.GoodTilDuration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.GoodTilDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.GoodTilDuration):])
 		if err1 != nil {
 			return 0, err1
 		}
 		i -= n1
 		i = encodeVarintOrder(dAtA, i, uint64(n1))
 		i--
+		dAtA[i] = 0x22
+	}
+	if m.GoodTilBlocks != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.GoodTilBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GoodTilBlockTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.GoodTilBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilBlockTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintOrder(dAtA, i, uint64(n2))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.GoodTilBlockHeight != 0 {
@@ -568,7 +1220,7 @@ func (m *CancelGoodTil) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
+func (m *DeadManSwitch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -578,19 +1230,208 @@ func (m *Order) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeadManSwitch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeadManSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Reserve.Size()
-		i -= size
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelDeadManSwitchOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelDeadManSwitchOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelDeadManSwitchOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggerOrders {
+		i--
+		if m.TriggerOrders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OrderIDCursor) > 0 {
+		i -= len(m.OrderIDCursor)
+		copy(dAtA[i:], m.OrderIDCursor)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.OrderIDCursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderSequence != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Trigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Condition != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Condition))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllOrNone {
+		i--
+		if m.AllOrNone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HiddenBaseQuantity != nil {
+		{
+			size := m.HiddenBaseQuantity.Size()
+			i -= size
+			if _, err := m.HiddenBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.DisplayQuantity != nil {
+		{
+			size := m.DisplayQuantity.Size()
+			i -= size
+			if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size := m.Reserve.Size()
+		i -= size
 		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
@@ -723,6 +1564,28 @@ func (m *OrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PlacementSequence != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.PlacementSequence))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DisplayQuantity != nil {
+		{
+			size := m.DisplayQuantity.Size()
+			i -= size
+			if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.Reserve.Size()
 		i -= size
@@ -805,6 +1668,57 @@ func (m *OrderBookData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AuctionUntilHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.AuctionUntilHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HaltedUntilHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.HaltedUntilHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinQuantity != nil {
+		{
+			size := m.MinQuantity.Size()
+			i -= size
+			if _, err := m.MinQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.QuantityStep != nil {
+		{
+			size := m.QuantityStep.Size()
+			i -= size
+			if _, err := m.QuantityStep.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PriceTick != nil {
+		{
+			size := m.PriceTick.Size()
+			i -= size
+			if _, err := m.PriceTick.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.QuoteDenom) > 0 {
 		i -= len(m.QuoteDenom)
 		copy(dAtA[i:], m.QuoteDenom)
@@ -842,6 +1756,28 @@ func (m *OrderBookRecordData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllOrNone {
+		i--
+		if m.AllOrNone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HiddenBaseQuantity != nil {
+		{
+			size := m.HiddenBaseQuantity.Size()
+			i -= size
+			if _, err := m.HiddenBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.RemainingSpendableBalance.Size()
 		i -= size
@@ -877,175 +1813,2317 @@ func (m *OrderBookRecordData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOrder(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OrderBookLastTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GoodTil) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GoodTilBlockHeight != 0 {
-		n += 1 + sovOrder(uint64(m.GoodTilBlockHeight))
-	}
-	if m.GoodTilBlockTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilBlockTime)
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	return n
+
+func (m *OrderBookLastTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelGoodTil) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OrderBookLastTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
-	}
 	if m.OrderSequence != 0 {
-		n += 1 + sovOrder(uint64(m.OrderSequence))
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderSequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OrderBookID != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	if m.Type != 0 {
-		n += 1 + sovOrder(uint64(m.Type))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LastPrice.Size()
+		i -= size
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovOrder(uint64(m.Sequence))
+	i -= n8
+	i = encodeVarintOrder(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.OrderBookID != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TradingVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Quantity.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	if m.Side != 0 {
-		n += 1 + sovOrder(uint64(m.Side))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.RemainingBaseQuantity.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	l = m.RemainingSpendableBalance.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	if m.GoodTil != nil {
-		l = m.GoodTil.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovOrder(uint64(m.TimeInForce))
+	return dAtA[:n], nil
+}
+
+func (m *RewardProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinQuantity.Size()
+		i -= size
+		if _, err := m.MinQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	l = m.Reserve.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x32
+	if m.MaxTicks != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.MaxTicks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RewardPerEpoch.Size()
+		i -= size
+		if _, err := m.RewardPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Pool.Size()
+		i -= size
+		if _, err := m.Pool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OrderData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderID)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	if m.OrderBookID != 0 {
-		n += 1 + sovOrder(uint64(m.OrderBookID))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Points.Size()
+		i -= size
+		if _, err := m.Points.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	if m.Side != 0 {
-		n += 1 + sovOrder(uint64(m.Side))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.GoodTil != nil {
-		l = m.GoodTil.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Reserve.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	return n
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OrderBookData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrderQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExtraOrdersPerDenom != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExtraOrdersPerDenom))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GoodTil) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GoodTilBlockHeight != 0 {
+		n += 1 + sovOrder(uint64(m.GoodTilBlockHeight))
+	}
+	if m.GoodTilBlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilBlockTime)
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.GoodTilBlocks != 0 {
+		n += 1 + sovOrder(uint64(m.GoodTilBlocks))
+	}
+	if m.GoodTilDuration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.GoodTilDuration)
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelGoodTil) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.OrderSequence != 0 {
+		n += 1 + sovOrder(uint64(m.OrderSequence))
+	}
+	return n
+}
+
+func (m *DeadManSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovOrder(uint64(m.TimeoutBlocks))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovOrder(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *CancelDeadManSwitchOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.OrderSequence != 0 {
+		n += 1 + sovOrder(uint64(m.OrderSequence))
+	}
+	l = len(m.OrderIDCursor)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.TriggerOrders {
+		n += 2
+	}
+	return n
+}
+
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Condition != 0 {
+		n += 1 + sovOrder(uint64(m.Condition))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovOrder(uint64(m.Sequence))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovOrder(uint64(m.Side))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.GoodTil != nil {
+		l = m.GoodTil.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovOrder(uint64(m.TimeInForce))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 2 + sovOrder(uint64(m.SelfTradePrevention))
+	}
+	if m.DisplayQuantity != nil {
+		l = m.DisplayQuantity.Size()
+		n += 2 + l + sovOrder(uint64(l))
+	}
+	if m.HiddenBaseQuantity != nil {
+		l = m.HiddenBaseQuantity.Size()
+		n += 2 + l + sovOrder(uint64(l))
+	}
+	if m.AllOrNone {
+		n += 3
+	}
+	return n
+}
+
+func (m *OrderData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderID)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.OrderBookID != 0 {
+		n += 1 + sovOrder(uint64(m.OrderBookID))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovOrder(uint64(m.Side))
+	}
+	if m.GoodTil != nil {
+		l = m.GoodTil.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.DisplayQuantity != nil {
+		l = m.DisplayQuantity.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovOrder(uint64(m.TimeInForce))
+	}
+	if m.PlacementSequence != 0 {
+		n += 1 + sovOrder(uint64(m.PlacementSequence))
+	}
+	return n
+}
+
+func (m *OrderBookData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
 		n += 1 + l + sovOrder(uint64(l))
 	}
 	l = len(m.QuoteDenom)
 	if l > 0 {
 		n += 1 + l + sovOrder(uint64(l))
 	}
-	return n
-}
+	if m.Status != 0 {
+		n += 1 + sovOrder(uint64(m.Status))
+	}
+	if m.PriceTick != nil {
+		l = m.PriceTick.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.QuantityStep != nil {
+		l = m.QuantityStep.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.MinQuantity != nil {
+		l = m.MinQuantity.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.HaltedUntilHeight != 0 {
+		n += 1 + sovOrder(uint64(m.HaltedUntilHeight))
+	}
+	if m.AuctionUntilHeight != 0 {
+		n += 1 + sovOrder(uint64(m.AuctionUntilHeight))
+	}
+	return n
+}
+
+func (m *OrderBookRecordData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderID)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovOrder(uint64(m.AccountNumber))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.HiddenBaseQuantity != nil {
+		l = m.HiddenBaseQuantity.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.AllOrNone {
+		n += 2
+	}
+	return n
+}
+
+func (m *OrderBookLastTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovOrder(uint64(m.OrderBookID))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.OrderSequence != 0 {
+		n += 1 + sovOrder(uint64(m.OrderSequence))
+	}
+	return n
+}
+
+func (m *PriceAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovOrder(uint64(m.OrderBookID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.LastPrice.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func (m *TradingVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovOrder(uint64(m.Day))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func (m *RewardProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.Pool.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.RewardPerEpoch.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.MaxTicks != 0 {
+		n += 1 + sovOrder(uint64(m.MaxTicks))
+	}
+	l = m.MinQuantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func (m *AccountReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.Points.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Reward.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func (m *OrderQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.ExtraOrdersPerDenom != 0 {
+		n += 1 + sovOrder(uint64(m.ExtraOrdersPerDenom))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func sovOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrder(x uint64) (n int) {
+	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GoodTil) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GoodTil: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GoodTil: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockHeight", wireType)
+			}
+			m.GoodTilBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTilBlockTime == nil {
+				m.GoodTilBlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.GoodTilBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlocks", wireType)
+			}
+			m.GoodTilBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTilDuration == nil {
+				m.GoodTilDuration = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.GoodTilDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelGoodTil: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelGoodTil: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSequence", wireType)
+			}
+			m.OrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadManSwitch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadManSwitch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadManSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelDeadManSwitchOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelDeadManSwitchOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelDeadManSwitchOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSequence", wireType)
+			}
+			m.OrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIDCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderIDCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrders", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TriggerOrders = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= TriggerCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTil == nil {
+				m.GoodTil = &GoodTil{}
+			}
+			if err := m.GoodTil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.DisplayQuantity = &v
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.HiddenBaseQuantity = &v
+			if err := m.HiddenBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOrNone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOrNone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTil == nil {
+				m.GoodTil = &GoodTil{}
+			}
+			if err := m.GoodTil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.DisplayQuantity = &v
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementSequence", wireType)
+			}
+			m.PlacementSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlacementSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *OrderBookRecordData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrderID)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovOrder(uint64(m.AccountNumber))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.RemainingBaseQuantity.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	l = m.RemainingSpendableBalance.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	return n
-}
-
-func sovOrder(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOrder(x uint64) (n int) {
-	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GoodTil) Unmarshal(dAtA []byte) error {
+func (m *OrderBookData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1068,17 +4146,136 @@ func (m *GoodTil) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GoodTil: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderBookData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GoodTil: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderBookData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderBookStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
 			}
-			m.GoodTilBlockHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.PriceTick = &v
+			if err := m.PriceTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStep", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1088,16 +4285,33 @@ func (m *GoodTil) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GoodTilBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.QuantityStep = &v
+			if err := m.QuantityStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1107,28 +4321,66 @@ func (m *GoodTil) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GoodTilBlockTime == nil {
-				m.GoodTilBlockTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.GoodTilBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.MinQuantity = &v
+			if err := m.MinQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedUntilHeight", wireType)
+			}
+			m.HaltedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionUntilHeight", wireType)
+			}
+			m.AuctionUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -1150,7 +4402,7 @@ func (m *GoodTil) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
+func (m *OrderBookRecordData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1173,15 +4425,15 @@ func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelGoodTil: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderBookRecordData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelGoodTil: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderBookRecordData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1209,13 +4461,13 @@ func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.OrderID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
 			}
-			m.OrderSequence = 0
+			m.AccountNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1225,64 +4477,82 @@ func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderSequence |= uint64(b&0x7F) << shift
+				m.AccountNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOrder(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Order) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOrder
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenBaseQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,13 +4580,87 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.HiddenBaseQuantity = &v
+			if err := m.HiddenBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOrNone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOrNone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookLastTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookLastTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookLastTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
 			}
-			m.Type = 0
+			m.OrderBookID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1326,14 +4670,14 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= OrderType(b&0x7F) << shift
+				m.OrderBookID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1361,13 +4705,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSequence", wireType)
 			}
-			m.Sequence = 0
+			m.OrderSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1377,48 +4723,66 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.OrderSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrder
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1428,29 +4792,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderBookID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOrder
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrder
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1460,31 +4811,28 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v Price
-			m.Price = &v
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1512,32 +4860,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
-			}
-			m.Side = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Side |= Side(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1565,49 +4894,65 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrder
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1617,33 +4962,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GoodTil == nil {
-				m.GoodTil = &GoodTil{}
-			}
-			if err := m.GoodTil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
 			}
-			m.TimeInForce = 0
+			m.Day = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1653,16 +4994,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				m.Day |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1672,22 +5013,23 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1712,7 +5054,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderData) Unmarshal(dAtA []byte) error {
+func (m *RewardProgram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1735,15 +5077,15 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderData: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardProgram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardProgram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1771,13 +5113,13 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderID = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
-			m.OrderBookID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1787,16 +5129,29 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderBookID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1806,29 +5161,28 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerEpoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1856,15 +5210,15 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTicks", wireType)
 			}
-			m.Side = 0
+			m.MaxTicks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1874,52 +5228,16 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Side |= Side(b&0x7F) << shift
+				m.MaxTicks |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTil", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrder
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrder
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GoodTil == nil {
-				m.GoodTil = &GoodTil{}
-			}
-			if err := m.GoodTil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1929,22 +5247,23 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1969,7 +5288,7 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderBookData) Unmarshal(dAtA []byte) error {
+func (m *AccountReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1992,13 +5311,45 @@ func (m *OrderBookData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderBookData: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderBookData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
@@ -2030,7 +5381,7 @@ func (m *OrderBookData) Unmarshal(dAtA []byte) error {
 			}
 			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
@@ -2062,6 +5413,73 @@ func (m *OrderBookData) Unmarshal(dAtA []byte) error {
 			}
 			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Points.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -2083,7 +5501,7 @@ func (m *OrderBookData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderBookRecordData) Unmarshal(dAtA []byte) error {
+func (m *OrderQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2106,15 +5524,15 @@ func (m *OrderBookRecordData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderBookRecordData: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderBookRecordData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2142,13 +5560,13 @@ func (m *OrderBookRecordData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderID = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOrdersPerDenom", wireType)
 			}
-			m.AccountNumber = 0
+			m.ExtraOrdersPerDenom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2158,50 +5576,16 @@ func (m *OrderBookRecordData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				m.ExtraOrdersPerDenom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOrder
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrder
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2211,23 +5595,22 @@ func (m *OrderBookRecordData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
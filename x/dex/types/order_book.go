@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the order book status set by the order book update.
+func (s OrderBookStatus) Validate() error {
+	switch s {
+	case ORDER_BOOK_STATUS_ACTIVE, ORDER_BOOK_STATUS_PAUSED, ORDER_BOOK_STATUS_DELISTED:
+		return nil
+	default:
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"only %s, %s and %s order book statuses are allowed",
+			ORDER_BOOK_STATUS_ACTIVE.String(), ORDER_BOOK_STATUS_PAUSED.String(), ORDER_BOOK_STATUS_DELISTED.String(),
+		)
+	}
+}
+
+// IsActive returns true if the orders can be placed to the order book.
+func (s OrderBookStatus) IsActive() bool {
+	return s == ORDER_BOOK_STATUS_UNSPECIFIED || s == ORDER_BOOK_STATUS_ACTIVE
+}
+
+// Validate validates the order book data.
+func (d OrderBookData) Validate() error {
+	if err := validateOrderBookDenoms(d.BaseDenom, d.QuoteDenom); err != nil {
+		return err
+	}
+	if _, exists := OrderBookStatus_name[int32(d.Status)]; !exists {
+		return sdkerrors.Wrapf(ErrInvalidInput, "non-existing order book status provided: %d", d.Status)
+	}
+	if d.HaltedUntilHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "halted until height must not be negative")
+	}
+	if d.AuctionUntilHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "auction until height must not be negative")
+	}
+
+	return validateOrderBookOverrides(d.PriceTick, d.QuantityStep, d.MinQuantity)
+}
+
+func validateOrderBookOverrides(priceTick *Price, quantityStep, minQuantity *sdkmath.Int) error {
+	if priceTick != nil && priceTick.Rat().Sign() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "price tick must be positive")
+	}
+	if quantityStep != nil && (quantityStep.IsNil() || !quantityStep.IsPositive()) {
+		return sdkerrors.Wrap(ErrInvalidInput, "quantity step must be positive")
+	}
+	if minQuantity != nil && (minQuantity.IsNil() || !minQuantity.IsPositive()) {
+		return sdkerrors.Wrap(ErrInvalidInput, "min quantity must be positive")
+	}
+
+	return nil
+}
+
+func validateOrderBookDenoms(baseDenom, quoteDenom string) error {
+	if err := sdk.ValidateDenom(baseDenom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid base denom: %s", baseDenom)
+	}
+	if err := sdk.ValidateDenom(quoteDenom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid quote denom: %s", quoteDenom)
+	}
+	if baseDenom == quoteDenom {
+		return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be different")
+	}
+
+	return nil
+}
@@ -26,6 +26,33 @@ type OrderBookRecord struct {
 	RemainingBaseQuantity cosmossdk_io_math.Int `json:"remaining_base_quantity"`
 	// remaining_spendable_balance - is balance up to which user wants to spend to execute the order.
 	RemainingSpendableBalance cosmossdk_io_math.Int `json:"remaining_spendable_balance"`
+	// hidden_base_quantity is the remaining quantity of the iceberg order which isn't visible in the order book.
+	HiddenBaseQuantity *cosmossdk_io_math.Int `json:"hidden_base_quantity,omitempty"`
+	// all_or_none defines that the record is matched only when it can be filled entirely.
+	AllOrNone bool `json:"all_or_none,omitempty"`
+}
+
+// TotalRemainingBaseQuantity returns the remaining base quantity including the hidden quantity of the iceberg order.
+func (o *OrderBookRecord) TotalRemainingBaseQuantity() cosmossdk_io_math.Int {
+	if o.HiddenBaseQuantity == nil {
+		return o.RemainingBaseQuantity
+	}
+
+	return o.RemainingBaseQuantity.Add(*o.HiddenBaseQuantity)
+}
+
+// HasHiddenBaseQuantity returns true if the iceberg order has the hidden quantity to refresh the visible quantity from.
+func (o *OrderBookRecord) HasHiddenBaseQuantity() bool {
+	return o.HiddenBaseQuantity != nil && o.HiddenBaseQuantity.IsPositive()
+}
+
+// SetDisplayQuantity splits the total remaining base quantity into the visible quantity limited by the display
+// quantity and the hidden quantity.
+func (o *OrderBookRecord) SetDisplayQuantity(displayQuantity cosmossdk_io_math.Int) {
+	total := o.TotalRemainingBaseQuantity()
+	o.RemainingBaseQuantity = cosmossdk_io_math.MinInt(displayQuantity, total)
+	hiddenBaseQuantity := total.Sub(o.RemainingBaseQuantity)
+	o.HiddenBaseQuantity = &hiddenBaseQuantity
 }
 
 func (o *OrderBookRecord) String() string {
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the order quota.
+func (q OrderQuota) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(q.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", q.Account)
+	}
+	if q.ExtraOrdersPerDenom == 0 {
+		return sdkerrors.Wrapf(ErrInvalidInput, "extra orders per denom of %s must be positive", q.Account)
+	}
+	if err := q.Reserve.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid reserve: %s", err)
+	}
+
+	return nil
+}
+
+// ComputeOrderQuotaReserve computes the reserve required for the additional orders per denom, the reserve of each
+// additional order is the order reserve scaled by the order quota reserve multiplier, rounded up.
+func (m Params) ComputeOrderQuotaReserve(extraOrdersPerDenom uint64) (sdk.Coin, error) {
+	if extraOrdersPerDenom == 0 {
+		return sdk.NewCoin(m.OrderReserve.Denom, sdkmath.ZeroInt()), nil
+	}
+	if !m.OrderQuotaReserveMultiplier.IsPositive() || !m.OrderReserve.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrap(ErrInvalidInput, "order quota extension is disabled")
+	}
+
+	amount := m.OrderReserve.Amount.ToLegacyDec().
+		Mul(m.OrderQuotaReserveMultiplier).
+		MulInt(sdkmath.NewIntFromUint64(extraOrdersPerDenom)).
+		Ceil().
+		TruncateInt()
+
+	return sdk.NewCoin(m.OrderReserve.Denom, amount), nil
+}
@@ -254,6 +254,52 @@ func TestOrder_Validate(t *testing.T) {
 				return order
 			}(),
 		},
+		{
+			name: "valid_good_til_blocks_and_duration",
+			order: func() types.Order {
+				order := validOrder()
+				order.GoodTil = &types.GoodTil{
+					GoodTilBlocks:   10,
+					GoodTilDuration: lo.ToPtr(time.Hour),
+				}
+				return order
+			}(),
+		},
+		{
+			name: "invalid_good_til_block_height_and_blocks",
+			order: func() types.Order {
+				order := validOrder()
+				order.GoodTil = &types.GoodTil{
+					GoodTilBlockHeight: 1,
+					GoodTilBlocks:      10,
+				}
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_good_til_block_time_and_duration",
+			order: func() types.Order {
+				order := validOrder()
+				order.GoodTil = &types.GoodTil{
+					GoodTilBlockTime: lo.ToPtr(time.Now()),
+					GoodTilDuration:  lo.ToPtr(time.Hour),
+				}
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_good_til_zero_duration",
+			order: func() types.Order {
+				order := validOrder()
+				order.GoodTil = &types.GoodTil{
+					GoodTilDuration: lo.ToPtr(time.Duration(0)),
+				}
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid_good_til_with_market",
 			order: func() types.Order {
@@ -297,6 +343,97 @@ func TestOrder_Validate(t *testing.T) {
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
+		{
+			name: "valid_self_trade_prevention",
+			order: func() types.Order {
+				order := validOrder()
+				order.SelfTradePrevention = types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL
+				return order
+			}(),
+		},
+		{
+			name: "invalid_self_trade_prevention",
+			order: func() types.Order {
+				order := validOrder()
+				order.SelfTradePrevention = types.SelfTradePrevention(100)
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_display_quantity",
+			order: func() types.Order {
+				order := validOrder()
+				order.DisplayQuantity = lo.ToPtr(sdkmath.NewInt(10))
+				return order
+			}(),
+		},
+		{
+			name: "invalid_display_quantity_not_less_than_quantity",
+			order: func() types.Order {
+				order := validOrder()
+				order.DisplayQuantity = lo.ToPtr(order.Quantity)
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_display_quantity_zero",
+			order: func() types.Order {
+				order := validOrder()
+				order.DisplayQuantity = lo.ToPtr(sdkmath.ZeroInt())
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_display_quantity_with_ioc_time_in_force",
+			order: func() types.Order {
+				order := validOrder()
+				order.TimeInForce = types.TIME_IN_FORCE_IOC
+				order.DisplayQuantity = lo.ToPtr(sdkmath.NewInt(10))
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_all_or_none",
+			order: func() types.Order {
+				order := validOrder()
+				order.AllOrNone = true
+				return order
+			}(),
+		},
+		{
+			name: "invalid_all_or_none_with_ioc_time_in_force",
+			order: func() types.Order {
+				order := validOrder()
+				order.TimeInForce = types.TIME_IN_FORCE_IOC
+				order.AllOrNone = true
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_all_or_none_with_display_quantity",
+			order: func() types.Order {
+				order := validOrder()
+				order.DisplayQuantity = lo.ToPtr(sdkmath.NewInt(10))
+				order.AllOrNone = true
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_hidden_base_quantity",
+			order: func() types.Order {
+				order := validOrder()
+				order.DisplayQuantity = lo.ToPtr(sdkmath.NewInt(10))
+				order.HiddenBaseQuantity = lo.ToPtr(sdkmath.NewInt(90))
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid_gtc_time_in_force_for_market_order",
 			order: func() types.Order {
@@ -333,6 +470,39 @@ func TestOrder_Validate(t *testing.T) {
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
+		{
+			name: "valid_trigger",
+			order: func() types.Order {
+				order := validOrder()
+				order.Trigger = &types.Trigger{
+					Price:     types.MustNewPriceFromString("2e-1"),
+					Condition: types.TRIGGER_CONDITION_PRICE_GTE,
+				}
+				return order
+			}(),
+		},
+		{
+			name: "invalid_trigger_unspecified_condition",
+			order: func() types.Order {
+				order := validOrder()
+				order.Trigger = &types.Trigger{
+					Price: types.MustNewPriceFromString("2e-1"),
+				}
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_trigger_zero_price",
+			order: func() types.Order {
+				order := validOrder()
+				order.Trigger = &types.Trigger{
+					Condition: types.TRIGGER_CONDITION_PRICE_LTE,
+				}
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
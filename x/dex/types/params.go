@@ -1,6 +1,10 @@
 package types
 
 import (
+	"sort"
+	"strings"
+	"time"
+
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +26,69 @@ var (
 
 	// KeyOrderReserve represents the order reserve param key.
 	KeyOrderReserve = []byte("OrderReserve")
+
+	// KeyMakerFeeRate represents the maker fee rate param key.
+	KeyMakerFeeRate = []byte("MakerFeeRate")
+
+	// KeyTakerFeeRate represents the taker fee rate param key.
+	KeyTakerFeeRate = []byte("TakerFeeRate")
+
+	// KeyFeeCollector represents the fee collector param key.
+	KeyFeeCollector = []byte("FeeCollector")
+
+	// KeyOrderBookFeeRates represents the order book fee rates param key.
+	KeyOrderBookFeeRates = []byte("OrderBookFeeRates")
+
+	// KeyCircuitBreakerMaxPriceDeviation represents the circuit breaker max price deviation param key.
+	KeyCircuitBreakerMaxPriceDeviation = []byte("CircuitBreakerMaxPriceDeviation")
+
+	// KeyCircuitBreakerCooldownBlocks represents the circuit breaker cooldown blocks param key.
+	KeyCircuitBreakerCooldownBlocks = []byte("CircuitBreakerCooldownBlocks")
+
+	// KeyTWAPRetentionPeriod represents the TWAP retention period param key.
+	KeyTWAPRetentionPeriod = []byte("TWAPRetentionPeriod")
+
+	// KeyMaxOrderLifetime represents the max order lifetime param key.
+	KeyMaxOrderLifetime = []byte("MaxOrderLifetime")
+
+	// KeyOrderExpirationSweepGasLimit represents the order expiration sweep gas limit param key.
+	KeyOrderExpirationSweepGasLimit = []byte("OrderExpirationSweepGasLimit")
+	// KeyTradingVolumeWindowDays represents the trading volume window days param key.
+	KeyTradingVolumeWindowDays = []byte("TradingVolumeWindowDays")
+	// KeyVolumeTiers represents the volume tiers param key.
+	KeyVolumeTiers = []byte("VolumeTiers")
+	// KeySwapRouteDenoms represents the swap route denoms param key.
+	KeySwapRouteDenoms = []byte("SwapRouteDenoms")
+	// KeyRewardEpochBlocks represents the reward epoch blocks param key.
+	KeyRewardEpochBlocks = []byte("RewardEpochBlocks")
+	// KeyOrderQuotaReserveMultiplier represents the order quota reserve multiplier param key.
+	KeyOrderQuotaReserveMultiplier = []byte("OrderQuotaReserveMultiplier")
+	// KeyOpeningAuctionBlocks represents the opening auction blocks param key.
+	KeyOpeningAuctionBlocks = []byte("OpeningAuctionBlocks")
+	// KeyRewardDistributionGasLimit represents the reward distribution gas limit param key.
+	KeyRewardDistributionGasLimit = []byte("RewardDistributionGasLimit")
+	// KeyOpeningAuctionMaxRecords represents the opening auction max records param key.
+	KeyOpeningAuctionMaxRecords = []byte("OpeningAuctionMaxRecords")
+)
+
+const (
+	// DefaultTWAPRetentionPeriod is the default period the price accumulators are kept for.
+	DefaultTWAPRetentionPeriod = 48 * time.Hour
+	// DefaultOrderExpirationSweepGasLimit is the default gas limit of the expired orders removal per block.
+	DefaultOrderExpirationSweepGasLimit = 20_000_000
+	// DefaultTradingVolumeWindowDays is the default number of days the accounts trading volume is tracked for.
+	DefaultTradingVolumeWindowDays = 30
+	// MaxTradingVolumeWindowDays is the max number of days the accounts trading volume can be tracked for.
+	MaxTradingVolumeWindowDays = 365
+	// MaxSwapRouteDenoms is the max number of the intermediate denoms of the automatically computed swap routes.
+	MaxSwapRouteDenoms = 10
+	// DefaultRewardEpochBlocks is the default number of blocks between the reward programs samplings.
+	DefaultRewardEpochBlocks = 100
+	// DefaultRewardDistributionGasLimit is the default gas limit of the reward programs distribution per block.
+	DefaultRewardDistributionGasLimit = 20_000_000
+	// DefaultOpeningAuctionMaxRecords is the default maximum number of the crossing records of each side of the order
+	// book pair executed by the opening auction uncross per block.
+	DefaultOpeningAuctionMaxRecords = 1_000
 )
 
 // DefaultParams returns params with default values.
@@ -32,6 +99,20 @@ func DefaultParams() Params {
 		QuantityStepExponent:    -2,
 		MaxOrdersPerDenom:       100,
 		OrderReserve:            sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000),
+		MakerFeeRate:            sdkmath.LegacyZeroDec(),
+		TakerFeeRate:            sdkmath.LegacyZeroDec(),
+		// the circuit breaker is disabled by default
+		CircuitBreakerMaxPriceDeviation: sdkmath.LegacyZeroDec(),
+		TWAPRetentionPeriod:             DefaultTWAPRetentionPeriod,
+		OrderExpirationSweepGasLimit:    DefaultOrderExpirationSweepGasLimit,
+		TradingVolumeWindowDays:         DefaultTradingVolumeWindowDays,
+		RewardEpochBlocks:               DefaultRewardEpochBlocks,
+		// the reserve of each additional order is equal to the order reserve by default
+		OrderQuotaReserveMultiplier: sdkmath.LegacyOneDec(),
+		// the opening auction is disabled by default
+		OpeningAuctionBlocks:       0,
+		RewardDistributionGasLimit: DefaultRewardDistributionGasLimit,
+		OpeningAuctionMaxRecords:   DefaultOpeningAuctionMaxRecords,
 	}
 }
 
@@ -64,6 +145,91 @@ func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&m.OrderReserve,
 			validateOrderReserve,
 		),
+		paramtypes.NewParamSetPair(
+			KeyMakerFeeRate,
+			&m.MakerFeeRate,
+			validateFeeRate,
+		),
+		paramtypes.NewParamSetPair(
+			KeyTakerFeeRate,
+			&m.TakerFeeRate,
+			validateFeeRate,
+		),
+		paramtypes.NewParamSetPair(
+			KeyFeeCollector,
+			&m.FeeCollector,
+			validateFeeCollector,
+		),
+		paramtypes.NewParamSetPair(
+			KeyOrderBookFeeRates,
+			&m.OrderBookFeeRates,
+			validateOrderBookFeeRates,
+		),
+		paramtypes.NewParamSetPair(
+			KeyCircuitBreakerMaxPriceDeviation,
+			&m.CircuitBreakerMaxPriceDeviation,
+			validateCircuitBreakerMaxPriceDeviation,
+		),
+		paramtypes.NewParamSetPair(
+			KeyCircuitBreakerCooldownBlocks,
+			&m.CircuitBreakerCooldownBlocks,
+			validateCircuitBreakerCooldownBlocks,
+		),
+		paramtypes.NewParamSetPair(
+			KeyTWAPRetentionPeriod,
+			&m.TWAPRetentionPeriod,
+			validateTWAPRetentionPeriod,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMaxOrderLifetime,
+			&m.MaxOrderLifetime,
+			validateMaxOrderLifetime,
+		),
+		paramtypes.NewParamSetPair(
+			KeyOrderExpirationSweepGasLimit,
+			&m.OrderExpirationSweepGasLimit,
+			validateOrderExpirationSweepGasLimit,
+		),
+		paramtypes.NewParamSetPair(
+			KeyTradingVolumeWindowDays,
+			&m.TradingVolumeWindowDays,
+			validateTradingVolumeWindowDays,
+		),
+		paramtypes.NewParamSetPair(
+			KeyVolumeTiers,
+			&m.VolumeTiers,
+			validateVolumeTiers,
+		),
+		paramtypes.NewParamSetPair(
+			KeySwapRouteDenoms,
+			&m.SwapRouteDenoms,
+			validateSwapRouteDenoms,
+		),
+		paramtypes.NewParamSetPair(
+			KeyRewardEpochBlocks,
+			&m.RewardEpochBlocks,
+			validateRewardEpochBlocks,
+		),
+		paramtypes.NewParamSetPair(
+			KeyOrderQuotaReserveMultiplier,
+			&m.OrderQuotaReserveMultiplier,
+			validateOrderQuotaReserveMultiplier,
+		),
+		paramtypes.NewParamSetPair(
+			KeyOpeningAuctionBlocks,
+			&m.OpeningAuctionBlocks,
+			validateOpeningAuctionBlocks,
+		),
+		paramtypes.NewParamSetPair(
+			KeyRewardDistributionGasLimit,
+			&m.RewardDistributionGasLimit,
+			validateRewardDistributionGasLimit,
+		),
+		paramtypes.NewParamSetPair(
+			KeyOpeningAuctionMaxRecords,
+			&m.OpeningAuctionMaxRecords,
+			validateOpeningAuctionMaxRecords,
+		),
 	}
 }
 
@@ -85,7 +251,117 @@ func (m Params) ValidateBasic() error {
 		return err
 	}
 
-	return validateOrderReserve(m.OrderReserve)
+	if err := validateOrderReserve(m.OrderReserve); err != nil {
+		return err
+	}
+
+	if err := validateFeeRate(m.MakerFeeRate); err != nil {
+		return sdkerrors.Wrap(err, "invalid maker fee rate")
+	}
+
+	if err := validateFeeRate(m.TakerFeeRate); err != nil {
+		return sdkerrors.Wrap(err, "invalid taker fee rate")
+	}
+
+	if err := validateFeeCollector(m.FeeCollector); err != nil {
+		return err
+	}
+
+	if err := validateOrderBookFeeRates(m.OrderBookFeeRates); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerMaxPriceDeviation(m.CircuitBreakerMaxPriceDeviation); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerCooldownBlocks(m.CircuitBreakerCooldownBlocks); err != nil {
+		return err
+	}
+
+	if m.CircuitBreakerMaxPriceDeviation.IsPositive() && m.CircuitBreakerCooldownBlocks == 0 {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"circuit breaker cooldown blocks must be positive if the circuit breaker is enabled",
+		)
+	}
+
+	if err := validateTWAPRetentionPeriod(m.TWAPRetentionPeriod); err != nil {
+		return err
+	}
+
+	if err := validateMaxOrderLifetime(m.MaxOrderLifetime); err != nil {
+		return err
+	}
+
+	if err := validateOrderExpirationSweepGasLimit(m.OrderExpirationSweepGasLimit); err != nil {
+		return err
+	}
+
+	if err := validateTradingVolumeWindowDays(m.TradingVolumeWindowDays); err != nil {
+		return err
+	}
+
+	if err := validateVolumeTiers(m.VolumeTiers); err != nil {
+		return err
+	}
+
+	if err := validateSwapRouteDenoms(m.SwapRouteDenoms); err != nil {
+		return err
+	}
+
+	if err := validateRewardEpochBlocks(m.RewardEpochBlocks); err != nil {
+		return err
+	}
+
+	if err := validateOrderQuotaReserveMultiplier(m.OrderQuotaReserveMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateOpeningAuctionBlocks(m.OpeningAuctionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateRewardDistributionGasLimit(m.RewardDistributionGasLimit); err != nil {
+		return err
+	}
+
+	return validateOpeningAuctionMaxRecords(m.OpeningAuctionMaxRecords)
+}
+
+// IsCircuitBreakerEnabled returns true if the circuit breaker is enabled.
+func (m Params) IsCircuitBreakerEnabled() bool {
+	return !m.CircuitBreakerMaxPriceDeviation.IsNil() && m.CircuitBreakerMaxPriceDeviation.IsPositive()
+}
+
+// GetFeeRates returns the maker and taker fee rates of the order book.
+func (m Params) GetFeeRates(baseDenom, quoteDenom string) (sdkmath.LegacyDec, sdkmath.LegacyDec) {
+	for _, rates := range m.OrderBookFeeRates {
+		if (rates.BaseDenom == baseDenom && rates.QuoteDenom == quoteDenom) ||
+			(rates.BaseDenom == quoteDenom && rates.QuoteDenom == baseDenom) {
+			return rates.MakerFeeRate, rates.TakerFeeRate
+		}
+	}
+
+	return m.MakerFeeRate, m.TakerFeeRate
+}
+
+// GetVolumeTier returns the number of the volume tier of the trading volume starting from 1 and the tier, the zero
+// number is returned if the volume doesn't reach any tier.
+func (m Params) GetVolumeTier(volume sdkmath.LegacyDec) (uint32, VolumeTier) {
+	var (
+		tierNumber uint32
+		tier       VolumeTier
+	)
+	for i, t := range m.VolumeTiers {
+		if volume.LT(t.MinVolume) {
+			break
+		}
+		tierNumber = uint32(i + 1)
+		tier = t
+	}
+
+	return tierNumber, tier
 }
 
 func validateDefaultUnifiedRefAmount(i interface{}) error {
@@ -158,3 +434,254 @@ func validateOrderReserve(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeRate(i interface{}) error {
+	rate, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if rate.IsNil() || rate.IsNegative() || rate.GTE(sdkmath.LegacyOneDec()) {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"fee rate must be greater than or equal to 0 and less than 1",
+		)
+	}
+
+	return nil
+}
+
+func validateFeeCollector(i interface{}) error {
+	feeCollector, ok := i.(string)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if feeCollector != strings.TrimSpace(feeCollector) {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"fee collector must not contain leading or trailing spaces",
+		)
+	}
+
+	return nil
+}
+
+func validateOrderBookFeeRates(i interface{}) error {
+	orderBookFeeRates, ok := i.([]OrderBookFeeRates)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+
+	orderBooks := make(map[string]struct{}, len(orderBookFeeRates))
+	for _, rates := range orderBookFeeRates {
+		if err := sdk.ValidateDenom(rates.BaseDenom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid order book fee rates base denom: %s", err)
+		}
+		if err := sdk.ValidateDenom(rates.QuoteDenom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid order book fee rates quote denom: %s", err)
+		}
+		if rates.BaseDenom == rates.QuoteDenom {
+			return sdkerrors.Wrap(ErrInvalidInput, "order book fee rates base and quote denoms must be different")
+		}
+		if err := validateFeeRate(rates.MakerFeeRate); err != nil {
+			return sdkerrors.Wrapf(err, "invalid maker fee rate of %s/%s", rates.BaseDenom, rates.QuoteDenom)
+		}
+		if err := validateFeeRate(rates.TakerFeeRate); err != nil {
+			return sdkerrors.Wrapf(err, "invalid taker fee rate of %s/%s", rates.BaseDenom, rates.QuoteDenom)
+		}
+
+		// the rates are shared by the order book and its inverted order book
+		denoms := []string{rates.BaseDenom, rates.QuoteDenom}
+		sort.Strings(denoms)
+		key := strings.Join(denoms, "/")
+		if _, found := orderBooks[key]; found {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicated order book fee rates of %s/%s", rates.BaseDenom, rates.QuoteDenom,
+			)
+		}
+		orderBooks[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerMaxPriceDeviation(i interface{}) error {
+	deviation, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if deviation.IsNil() || deviation.IsNegative() {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"circuit breaker max price deviation must be greater than or equal to 0",
+		)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerCooldownBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateTWAPRetentionPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if period <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "TWAP retention period must be positive")
+	}
+
+	return nil
+}
+
+func validateMaxOrderLifetime(i interface{}) error {
+	lifetime, ok := i.(time.Duration)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if lifetime < 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "max order lifetime must not be negative")
+	}
+
+	return nil
+}
+
+func validateOrderExpirationSweepGasLimit(i interface{}) error {
+	gasLimit, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if gasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "order expiration sweep gas limit must be positive")
+	}
+
+	return nil
+}
+
+func validateTradingVolumeWindowDays(i interface{}) error {
+	days, ok := i.(uint32)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if days == 0 || days > MaxTradingVolumeWindowDays {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "trading volume window days must be positive and not greater than %d",
+			MaxTradingVolumeWindowDays,
+		)
+	}
+
+	return nil
+}
+
+func validateVolumeTiers(i interface{}) error {
+	tiers, ok := i.([]VolumeTier)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+
+	for i, tier := range tiers {
+		if tier.MinVolume.IsNil() || !tier.MinVolume.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "min volume of volume tier %d must be positive", i+1)
+		}
+		if i > 0 && !tier.MinVolume.GT(tiers[i-1].MinVolume) {
+			return sdkerrors.Wrap(ErrInvalidInput, "volume tiers must be sorted by min volume in ascending order")
+		}
+		if err := validateMaxOrdersPerDenom(tier.MaxOrdersPerDenom); err != nil {
+			return sdkerrors.Wrapf(err, "invalid max orders per denom of volume tier %d", i+1)
+		}
+		if tier.FeeDiscount.IsNil() || tier.FeeDiscount.IsNegative() || tier.FeeDiscount.GT(sdkmath.LegacyOneDec()) {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "fee discount of volume tier %d must be greater than or equal to 0 and less than or "+
+					"equal to 1", i+1,
+			)
+		}
+	}
+
+	return nil
+}
+
+func validateSwapRouteDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+
+	if len(denoms) > MaxSwapRouteDenoms {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "number of swap route denoms %d exceeds the max %d", len(denoms), MaxSwapRouteDenoms,
+		)
+	}
+	uniqueDenoms := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid swap route denom: %s", denom)
+		}
+		if _, ok := uniqueDenoms[denom]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated swap route denom: %s", denom)
+		}
+		uniqueDenoms[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateRewardEpochBlocks(i interface{}) error {
+	blocks, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if blocks == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "reward epoch blocks must be positive")
+	}
+
+	return nil
+}
+
+func validateRewardDistributionGasLimit(i interface{}) error {
+	gasLimit, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if gasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "reward distribution gas limit must be positive")
+	}
+
+	return nil
+}
+
+func validateOpeningAuctionMaxRecords(i interface{}) error {
+	maxRecords, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if maxRecords == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "opening auction max records must be positive")
+	}
+
+	return nil
+}
+
+func validateOrderQuotaReserveMultiplier(i interface{}) error {
+	multiplier, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if multiplier.IsNil() || multiplier.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "order quota reserve multiplier must be non-negative")
+	}
+
+	return nil
+}
+
+func validateOpeningAuctionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+
+	return nil
+}
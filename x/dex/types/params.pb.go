@@ -10,15 +10,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +42,51 @@ type Params struct {
 	MaxOrdersPerDenom uint64 `protobuf:"varint,3,opt,name=max_orders_per_denom,json=maxOrdersPerDenom,proto3" json:"max_orders_per_denom,omitempty"`
 	// order_reserve is the reserve required to save the order in the order book
 	OrderReserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=order_reserve,json=orderReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"order_reserve"`
+	// maker_fee_rate is the rate of the fee charged from the amount received by the maker order
+	MakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maker_fee_rate"`
+	// taker_fee_rate is the rate of the fee charged from the amount received by the taker order
+	TakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee_rate"`
+	// fee_collector is the name of the module account receiving the trading fees,
+	// the fees are sent to the community pool if it is empty
+	FeeCollector string `protobuf:"bytes,8,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	// order_book_fee_rates overrides the maker and taker fee rates for the specific order books
+	OrderBookFeeRates []OrderBookFeeRates `protobuf:"bytes,9,rep,name=order_book_fee_rates,json=orderBookFeeRates,proto3" json:"order_book_fee_rates"`
+	// circuit_breaker_max_price_deviation is the max relative deviation of the trade price from the last traded price of
+	// the order book, the order book is halted if the deviation is exceeded, zero disables the circuit breaker
+	CircuitBreakerMaxPriceDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=circuit_breaker_max_price_deviation,json=circuitBreakerMaxPriceDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"circuit_breaker_max_price_deviation"`
+	// circuit_breaker_cooldown_blocks is the number of blocks the order book is halted for by the circuit breaker
+	CircuitBreakerCooldownBlocks uint64 `protobuf:"varint,11,opt,name=circuit_breaker_cooldown_blocks,json=circuitBreakerCooldownBlocks,proto3" json:"circuit_breaker_cooldown_blocks,omitempty"`
+	// twap_retention_period is the period the price accumulators are kept for, it limits the window of the TWAP query
+	TWAPRetentionPeriod time.Duration `protobuf:"bytes,12,opt,name=twap_retention_period,json=twapRetentionPeriod,proto3,stdduration" json:"twap_retention_period"`
+	// max_order_lifetime is the maximum time the order remains in the order book, the good til block time of the saved
+	// orders is limited by it, the zero value means that the lifetime is unlimited
+	MaxOrderLifetime time.Duration `protobuf:"bytes,13,opt,name=max_order_lifetime,json=maxOrderLifetime,proto3,stdduration" json:"max_order_lifetime"`
+	// order_expiration_sweep_gas_limit is the gas limit of the expired orders removal executed at the end of the block,
+	// the expired orders exceeding the limit are removed in the next blocks
+	OrderExpirationSweepGasLimit uint64 `protobuf:"varint,14,opt,name=order_expiration_sweep_gas_limit,json=orderExpirationSweepGasLimit,proto3" json:"order_expiration_sweep_gas_limit,omitempty"`
+	// trading_volume_window_days is the number of days of the rolling window the accounts trading volume is tracked for
+	TradingVolumeWindowDays uint32 `protobuf:"varint,15,opt,name=trading_volume_window_days,json=tradingVolumeWindowDays,proto3" json:"trading_volume_window_days,omitempty"`
+	// volume_tiers is the list of the account tiers sorted by the min trading volume, the account gets the tier with the
+	// highest min trading volume not greater than its trading volume within the window
+	VolumeTiers []VolumeTier `protobuf:"bytes,16,rep,name=volume_tiers,json=volumeTiers,proto3" json:"volume_tiers"`
+	// swap_route_denoms is the list of the intermediate denoms the automatically computed swap routes might go through
+	SwapRouteDenoms []string `protobuf:"bytes,17,rep,name=swap_route_denoms,json=swapRouteDenoms,proto3" json:"swap_route_denoms,omitempty"`
+	// reward_epoch_blocks is the number of blocks between the samplings of the orders qualifying for the reward programs
+	RewardEpochBlocks uint64 `protobuf:"varint,18,opt,name=reward_epoch_blocks,json=rewardEpochBlocks,proto3" json:"reward_epoch_blocks,omitempty"`
+	// order_quota_reserve_multiplier is the multiplier of the order_reserve defining the reserve the account locks for each
+	// additional order per denom of its order quota, zero disables the order quota extension
+	OrderQuotaReserveMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,19,opt,name=order_quota_reserve_multiplier,json=orderQuotaReserveMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"order_quota_reserve_multiplier"`
+	// opening_auction_blocks is the number of blocks the orders are collected without matching when the order book is
+	// registered or resumed, the collected orders are executed at the single clearing price at the end of the auction,
+	// zero disables the opening auction
+	OpeningAuctionBlocks uint64 `protobuf:"varint,20,opt,name=opening_auction_blocks,json=openingAuctionBlocks,proto3" json:"opening_auction_blocks,omitempty"`
+	// reward_distribution_gas_limit is the gas limit of the reward programs distribution executed at the end of the
+	// block, the programs exceeding the limit are distributed in the next blocks
+	RewardDistributionGasLimit uint64 `protobuf:"varint,21,opt,name=reward_distribution_gas_limit,json=rewardDistributionGasLimit,proto3" json:"reward_distribution_gas_limit,omitempty"`
+	// opening_auction_max_records is the maximum number of the crossing records of each side of the order book pair
+	// executed by the opening auction uncross at the end of the block, the rest of the crossing records are executed in
+	// the next blocks
+	OpeningAuctionMaxRecords uint64 `protobuf:"varint,22,opt,name=opening_auction_max_records,json=openingAuctionMaxRecords,proto3" json:"opening_auction_max_records,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -94,40 +143,282 @@ func (m *Params) GetMaxOrdersPerDenom() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+func (m *Params) GetOrderBookFeeRates() []OrderBookFeeRates {
+	if m != nil {
+		return m.OrderBookFeeRates
+	}
+	return nil
+}
+
+func (m *Params) GetCircuitBreakerCooldownBlocks() uint64 {
+	if m != nil {
+		return m.CircuitBreakerCooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetTWAPRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.TWAPRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrderLifetime() time.Duration {
+	if m != nil {
+		return m.MaxOrderLifetime
+	}
+	return 0
+}
+
+func (m *Params) GetOrderExpirationSweepGasLimit() uint64 {
+	if m != nil {
+		return m.OrderExpirationSweepGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetTradingVolumeWindowDays() uint32 {
+	if m != nil {
+		return m.TradingVolumeWindowDays
+	}
+	return 0
+}
+
+func (m *Params) GetVolumeTiers() []VolumeTier {
+	if m != nil {
+		return m.VolumeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetSwapRouteDenoms() []string {
+	if m != nil {
+		return m.SwapRouteDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetRewardEpochBlocks() uint64 {
+	if m != nil {
+		return m.RewardEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetOpeningAuctionBlocks() uint64 {
+	if m != nil {
+		return m.OpeningAuctionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRewardDistributionGasLimit() uint64 {
+	if m != nil {
+		return m.RewardDistributionGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetOpeningAuctionMaxRecords() uint64 {
+	if m != nil {
+		return m.OpeningAuctionMaxRecords
+	}
+	return 0
+}
+
+// OrderBookFeeRates defines the maker and taker fee rates of the order book.
+type OrderBookFeeRates struct {
+	// base_denom is the base denom of the order book, the rates are applied to the inverted order book as well
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the quote denom of the order book
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// maker_fee_rate is the rate of the fee charged from the amount received by the maker order
+	MakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maker_fee_rate"`
+	// taker_fee_rate is the rate of the fee charged from the amount received by the taker order
+	TakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee_rate"`
+}
+
+func (m *OrderBookFeeRates) Reset()         { *m = OrderBookFeeRates{} }
+func (m *OrderBookFeeRates) String() string { return proto.CompactTextString(m) }
+func (*OrderBookFeeRates) ProtoMessage()    {}
+func (*OrderBookFeeRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f339dad46d471ea, []int{1}
+}
+func (m *OrderBookFeeRates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookFeeRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookFeeRates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookFeeRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookFeeRates.Merge(m, src)
+}
+func (m *OrderBookFeeRates) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookFeeRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookFeeRates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookFeeRates proto.InternalMessageInfo
+
+func (m *OrderBookFeeRates) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *OrderBookFeeRates) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+// VolumeTier defines the benefits of the accounts with the trading volume within the window greater than or equal to the
+// min volume.
+type VolumeTier struct {
+	// min_volume is the min trading volume of the account normalized by the unified ref amount
+	MinVolume cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_volume"`
+	// max_orders_per_denom overrides the max_orders_per_denom param for the accounts of the tier
+	MaxOrdersPerDenom uint64 `protobuf:"varint,2,opt,name=max_orders_per_denom,json=maxOrdersPerDenom,proto3" json:"max_orders_per_denom,omitempty"`
+	// fee_discount is the share of the maker and taker fees the accounts of the tier are exempted from
+	FeeDiscount cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_discount,json=feeDiscount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_discount"`
+}
+
+func (m *VolumeTier) Reset()         { *m = VolumeTier{} }
+func (m *VolumeTier) String() string { return proto.CompactTextString(m) }
+func (*VolumeTier) ProtoMessage()    {}
+func (*VolumeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f339dad46d471ea, []int{2}
+}
+func (m *VolumeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeTier.Merge(m, src)
+}
+func (m *VolumeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeTier proto.InternalMessageInfo
+
+func (m *VolumeTier) GetMaxOrdersPerDenom() uint64 {
+	if m != nil {
+		return m.MaxOrdersPerDenom
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "coreum.dex.v1.Params")
+	proto.RegisterType((*OrderBookFeeRates)(nil), "coreum.dex.v1.OrderBookFeeRates")
+	proto.RegisterType((*VolumeTier)(nil), "coreum.dex.v1.VolumeTier")
 }
 
 func init() { proto.RegisterFile("coreum/dex/v1/params.proto", fileDescriptor_4f339dad46d471ea) }
 
 var fileDescriptor_4f339dad46d471ea = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0xb3, 0xa5, 0xad, 0xc4, 0x42, 0x0f, 0x5d, 0x22, 0x08, 0x41, 0xda, 0x46, 0x70, 0x20,
-	0x17, 0x6c, 0x2d, 0x20, 0xee, 0xa4, 0x85, 0x0b, 0x48, 0x54, 0x0b, 0x5c, 0xb8, 0x18, 0xc7, 0x9e,
-	0xa4, 0x56, 0xb0, 0xc7, 0xd8, 0xde, 0xd5, 0xe6, 0x2d, 0x78, 0xac, 0x1e, 0x7b, 0x44, 0x1c, 0x2a,
-	0x94, 0xbc, 0x01, 0x4f, 0x80, 0x62, 0x2f, 0x7f, 0x4e, 0x3b, 0xda, 0x6f, 0xbe, 0xdf, 0xc8, 0xdf,
-	0x4c, 0x3e, 0x16, 0xe8, 0xa0, 0xd1, 0x54, 0x42, 0x47, 0xdb, 0x8a, 0x5a, 0xee, 0xb8, 0xf6, 0xc4,
-	0x3a, 0x0c, 0x58, 0x1c, 0x25, 0x8d, 0x48, 0xe8, 0x48, 0x5b, 0x8d, 0x4b, 0x81, 0x5e, 0xa3, 0xa7,
-	0x73, 0xee, 0x81, 0xb6, 0xd5, 0x1c, 0x02, 0xaf, 0xa8, 0x40, 0x65, 0x52, 0xfb, 0x78, 0xb8, 0xc4,
-	0x25, 0xc6, 0x92, 0xee, 0xaa, 0xf4, 0xf7, 0xe1, 0xaf, 0xbd, 0xfc, 0xf0, 0x3c, 0x52, 0x8b, 0xcf,
-	0xf9, 0x58, 0xc2, 0x82, 0x37, 0x5f, 0x02, 0x6b, 0x8c, 0x5a, 0x28, 0x90, 0xcc, 0xc1, 0x82, 0x71,
-	0x8d, 0x8d, 0x09, 0xa3, 0x6c, 0x92, 0x4d, 0x6f, 0xce, 0x1e, 0x5d, 0x5e, 0x9f, 0x0c, 0x7e, 0x5c,
-	0x9f, 0x3c, 0x48, 0xc3, 0xbc, 0x5c, 0x11, 0x85, 0x54, 0xf3, 0x70, 0x41, 0xde, 0xc2, 0x92, 0x8b,
-	0xf5, 0x19, 0x88, 0xfa, 0x5e, 0x8f, 0xf9, 0x98, 0x28, 0x35, 0x2c, 0x5e, 0x46, 0x46, 0x41, 0xf2,
-	0x3b, 0xd6, 0x29, 0x01, 0x2c, 0x28, 0xb1, 0x62, 0xd0, 0x59, 0x34, 0x60, 0xc2, 0x68, 0x6f, 0x92,
-	0x4d, 0x0f, 0xea, 0xe3, 0x28, 0x7d, 0x50, 0x62, 0xf5, 0xaa, 0x17, 0x8a, 0xe7, 0xf9, 0xdd, 0xaf,
-	0x0d, 0x37, 0x41, 0x85, 0x35, 0xf3, 0x01, 0xec, 0x3f, 0xcb, 0x41, 0xb4, 0x0c, 0xff, 0xa8, 0xef,
-	0x03, 0xd8, 0xbf, 0x2e, 0x9a, 0x0f, 0x35, 0xef, 0x18, 0x3a, 0x09, 0xce, 0x33, 0x0b, 0x8e, 0x49,
-	0x30, 0xa8, 0x47, 0x37, 0x26, 0xd9, 0x74, 0xbf, 0x3e, 0xd6, 0xbc, 0x7b, 0x17, 0xa5, 0x73, 0x70,
-	0x67, 0x3b, 0xa1, 0xc0, 0xfc, 0x28, 0x36, 0x33, 0x07, 0x1e, 0x5c, 0x0b, 0xa3, 0xfd, 0x49, 0x36,
-	0xbd, 0xf5, 0xf4, 0x3e, 0x49, 0x8f, 0x24, 0xbb, 0x44, 0x49, 0x9f, 0x28, 0x39, 0x45, 0x65, 0x66,
-	0xb4, 0x8f, 0xe1, 0xf1, 0x52, 0x85, 0x8b, 0x66, 0x4e, 0x04, 0x6a, 0xda, 0xc7, 0x9f, 0x3e, 0x4f,
-	0xbc, 0x5c, 0xd1, 0xb0, 0xb6, 0xe0, 0xa3, 0xa1, 0xbe, 0x1d, 0x07, 0xd4, 0x89, 0x3f, 0x7b, 0x73,
-	0xb9, 0x29, 0xb3, 0xab, 0x4d, 0x99, 0xfd, 0xdc, 0x94, 0xd9, 0xb7, 0x6d, 0x39, 0xb8, 0xda, 0x96,
-	0x83, 0xef, 0xdb, 0x72, 0xf0, 0xa9, 0xfa, 0x0f, 0x78, 0x1a, 0xd7, 0xfb, 0x1a, 0x1b, 0x23, 0x79,
-	0x50, 0x68, 0x68, 0x7f, 0x0b, 0xed, 0x0b, 0xda, 0xc5, 0x83, 0x88, 0xfc, 0xf9, 0x61, 0x5c, 0xe4,
-	0xb3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xed, 0x0a, 0x11, 0x24, 0x2b, 0x02, 0x00, 0x00,
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x26, 0x69, 0x68, 0xc6, 0x4e, 0x5b, 0x6f, 0xdc, 0x76, 0x71, 0xa8, 0x6d, 0x25, 0x07,
+	0x2c, 0x24, 0x76, 0x95, 0x82, 0xb8, 0x20, 0x0e, 0xd9, 0x38, 0x41, 0x88, 0x44, 0xa4, 0xdb, 0x40,
+	0x24, 0x2e, 0xc3, 0x78, 0xf7, 0xb5, 0x33, 0xf2, 0xee, 0xce, 0x66, 0x66, 0xd6, 0x1f, 0xff, 0x82,
+	0x23, 0x3f, 0xa9, 0x70, 0xea, 0x11, 0x71, 0x08, 0x28, 0x39, 0xf1, 0x2f, 0xd0, 0x7c, 0xd8, 0xf9,
+	0xa8, 0x2a, 0x12, 0xc4, 0x29, 0xce, 0x3c, 0xef, 0xf3, 0xbc, 0x33, 0xef, 0xe7, 0xa2, 0x46, 0xcc,
+	0x38, 0x94, 0x59, 0x90, 0xc0, 0x24, 0x18, 0x6d, 0x07, 0x05, 0xe1, 0x24, 0x13, 0x7e, 0xc1, 0x99,
+	0x64, 0xee, 0x9a, 0xc1, 0xfc, 0x04, 0x26, 0xfe, 0x68, 0xbb, 0xd1, 0x8c, 0x99, 0xc8, 0x98, 0x08,
+	0x7a, 0x44, 0x40, 0x30, 0xda, 0xee, 0x81, 0x24, 0xdb, 0x41, 0xcc, 0x68, 0x6e, 0xcc, 0x1b, 0xf5,
+	0x01, 0x1b, 0x30, 0xfd, 0x33, 0x50, 0xbf, 0xec, 0x69, 0x73, 0xc0, 0xd8, 0x20, 0x85, 0x40, 0xff,
+	0xd7, 0x2b, 0xfb, 0x41, 0x52, 0x72, 0x22, 0x29, 0xb3, 0xac, 0xcd, 0xdf, 0xaa, 0x68, 0xe5, 0x48,
+	0x7b, 0x75, 0x7f, 0x42, 0x8d, 0x04, 0xfa, 0xa4, 0x4c, 0x25, 0x2e, 0x73, 0xda, 0xa7, 0x90, 0x60,
+	0x0e, 0x7d, 0x4c, 0x32, 0x56, 0xe6, 0xd2, 0x73, 0xda, 0x4e, 0x67, 0x35, 0xdc, 0x7a, 0x73, 0xde,
+	0x5a, 0xf8, 0xe3, 0xbc, 0xb5, 0x61, 0x2e, 0x23, 0x92, 0xa1, 0x4f, 0x59, 0x90, 0x11, 0x79, 0xea,
+	0x1f, 0xc0, 0x80, 0xc4, 0xd3, 0x2e, 0xc4, 0xd1, 0x73, 0x2b, 0xf3, 0xbd, 0x51, 0x89, 0xa0, 0xbf,
+	0xa3, 0x35, 0x5c, 0x1f, 0xad, 0x17, 0x9c, 0xc6, 0x80, 0x25, 0x8d, 0x87, 0x18, 0x26, 0x05, 0xcb,
+	0x21, 0x97, 0xde, 0x62, 0xdb, 0xe9, 0x3c, 0x88, 0x6a, 0x1a, 0x3a, 0xa6, 0xf1, 0x70, 0xcf, 0x02,
+	0xee, 0xe7, 0xe8, 0xd9, 0x59, 0x49, 0x72, 0x49, 0xe5, 0x14, 0x0b, 0x09, 0xc5, 0x15, 0xe5, 0x81,
+	0xa6, 0xd4, 0x67, 0xe8, 0x6b, 0x09, 0xc5, 0x9c, 0x15, 0xa0, 0x7a, 0x46, 0x26, 0x98, 0xf1, 0x04,
+	0xb8, 0xc0, 0x05, 0x70, 0x9c, 0x40, 0xce, 0x32, 0x6f, 0xa9, 0xed, 0x74, 0x96, 0xa3, 0x5a, 0x46,
+	0x26, 0xdf, 0x69, 0xe8, 0x08, 0x78, 0x57, 0x01, 0x2e, 0x43, 0x6b, 0xda, 0x18, 0x73, 0x10, 0xc0,
+	0x47, 0xe0, 0x2d, 0xb7, 0x9d, 0x4e, 0xe5, 0xe5, 0x87, 0xbe, 0x79, 0xa4, 0xaf, 0x22, 0xee, 0xdb,
+	0x88, 0xfb, 0xbb, 0x8c, 0xe6, 0x61, 0x60, 0xc3, 0xf0, 0xf1, 0x80, 0xca, 0xd3, 0xb2, 0xe7, 0xc7,
+	0x2c, 0x0b, 0x6c, 0x7a, 0xcc, 0x9f, 0x4f, 0x45, 0x32, 0x0c, 0xe4, 0xb4, 0x00, 0xa1, 0x09, 0x51,
+	0x55, 0x3b, 0x88, 0x8c, 0xbe, 0xfb, 0x0d, 0x7a, 0x94, 0x91, 0x21, 0x70, 0xdc, 0x07, 0xc0, 0x9c,
+	0x48, 0xf0, 0x56, 0xee, 0x1e, 0xdd, 0xaa, 0xa6, 0xee, 0x03, 0x44, 0x44, 0x6a, 0x29, 0x79, 0x53,
+	0xea, 0x83, 0x7b, 0x48, 0xc9, 0xeb, 0x52, 0x5b, 0x68, 0x4d, 0x89, 0xc4, 0x2c, 0x4d, 0x21, 0x96,
+	0x8c, 0x7b, 0x0f, 0x95, 0x52, 0x54, 0xed, 0x03, 0xec, 0xce, 0xce, 0xdc, 0x13, 0x54, 0x37, 0xb1,
+	0xea, 0x31, 0x36, 0x9c, 0x3b, 0x15, 0xde, 0x6a, 0x7b, 0xa9, 0x53, 0x79, 0xd9, 0xf6, 0x6f, 0xd4,
+	0xac, 0xaf, 0x03, 0x1d, 0x32, 0x36, 0xb4, 0x3e, 0x44, 0xb8, 0xac, 0xee, 0x15, 0xd5, 0xd8, 0x6d,
+	0xc0, 0x3d, 0x43, 0x5b, 0x31, 0xe5, 0x71, 0x49, 0x25, 0xee, 0x71, 0xd0, 0x4f, 0x52, 0x59, 0x34,
+	0xf5, 0x92, 0xc0, 0x88, 0xea, 0xaa, 0xf5, 0xd0, 0xdd, 0x5f, 0xd7, 0xb2, 0x7a, 0xa1, 0x91, 0x3b,
+	0x24, 0x93, 0x23, 0x25, 0xd6, 0x9d, 0x69, 0xb9, 0x7b, 0xa8, 0x75, 0xdb, 0x65, 0xcc, 0x58, 0x9a,
+	0xb0, 0x71, 0x8e, 0x7b, 0x29, 0x8b, 0x87, 0xc2, 0xab, 0xe8, 0x9a, 0xf9, 0xe8, 0xa6, 0xd2, 0xae,
+	0x35, 0x0a, 0xb5, 0x8d, 0x9b, 0xa3, 0xa7, 0x72, 0x4c, 0x0a, 0xcc, 0x41, 0x42, 0xae, 0x84, 0x55,
+	0xcd, 0x51, 0x96, 0x78, 0x55, 0x5b, 0x46, 0xa6, 0x05, 0xfd, 0x59, 0x0b, 0xfa, 0x5d, 0xdb, 0x82,
+	0x61, 0x4b, 0x3d, 0xe3, 0xe2, 0xbc, 0xb5, 0x7e, 0x7c, 0xb2, 0x73, 0x14, 0xcd, 0xe8, 0x47, 0x9a,
+	0xfd, 0xcb, 0x9f, 0x2d, 0x27, 0x5a, 0x57, 0xc2, 0xb7, 0x00, 0xf7, 0x15, 0x72, 0xe7, 0xf5, 0x8d,
+	0x53, 0xda, 0x07, 0x49, 0x33, 0xf0, 0xd6, 0xfe, 0xcd, 0xd9, 0x43, 0xe5, 0x4c, 0xab, 0x3e, 0x99,
+	0xb5, 0xc0, 0x81, 0x25, 0xbb, 0xfb, 0xa8, 0x6d, 0xe4, 0x60, 0x52, 0x50, 0x63, 0x8f, 0xc5, 0x18,
+	0xa0, 0xc0, 0x03, 0x22, 0x70, 0x4a, 0x33, 0x2a, 0xbd, 0x47, 0x26, 0x14, 0xda, 0x6e, 0x6f, 0x6e,
+	0xf6, 0x5a, 0x59, 0x7d, 0x4d, 0xc4, 0x81, 0xb2, 0x71, 0xbf, 0x44, 0x0d, 0xc9, 0x49, 0x42, 0xf3,
+	0x01, 0x1e, 0xb1, 0xb4, 0xcc, 0x00, 0x8f, 0x69, 0x9e, 0xb0, 0x31, 0x4e, 0xc8, 0x54, 0x78, 0x8f,
+	0xdb, 0x4e, 0x67, 0x2d, 0x7a, 0x6e, 0x2d, 0x7e, 0xd0, 0x06, 0x27, 0x1a, 0xef, 0x92, 0xa9, 0x70,
+	0x43, 0x54, 0xb5, 0x24, 0x49, 0x81, 0x0b, 0xef, 0x49, 0x7b, 0xc9, 0x76, 0xe1, 0xf5, 0x92, 0x32,
+	0xb4, 0x63, 0x0a, 0xdc, 0xd6, 0x52, 0x65, 0x34, 0x3f, 0x11, 0xee, 0x27, 0xa8, 0x26, 0x74, 0x2e,
+	0x58, 0x29, 0xc1, 0xf4, 0xbd, 0xf0, 0x6a, 0xed, 0xa5, 0xce, 0x6a, 0xf4, 0x58, 0x01, 0x91, 0x3a,
+	0xd7, 0x5d, 0x2f, 0xd4, 0x34, 0xe2, 0x30, 0x26, 0x3c, 0xc1, 0x50, 0xb0, 0xf8, 0x74, 0x96, 0x72,
+	0xd7, 0x8c, 0x09, 0x03, 0xed, 0x29, 0xc4, 0xe6, 0xf9, 0x14, 0x35, 0x4d, 0x90, 0xce, 0x4a, 0x26,
+	0xc9, 0x6c, 0x58, 0xe0, 0xac, 0x4c, 0x25, 0x2d, 0x52, 0x0a, 0xdc, 0x5b, 0xbf, 0x7b, 0x71, 0x6e,
+	0x68, 0xa9, 0x57, 0x4a, 0xc9, 0x4e, 0x85, 0xc3, 0xb9, 0x8e, 0x9a, 0x7b, 0xac, 0x80, 0x5c, 0x85,
+	0x91, 0x94, 0xb1, 0xce, 0x86, 0xbd, 0x5c, 0x5d, 0x5f, 0xae, 0x6e, 0xd1, 0x1d, 0x03, 0xda, 0xfb,
+	0xed, 0xa0, 0x17, 0xf6, 0x3d, 0x09, 0x15, 0x92, 0xd3, 0x5e, 0xa9, 0x99, 0x57, 0x19, 0x7c, 0xaa,
+	0xc9, 0x0d, 0x63, 0xd4, 0xbd, 0x66, 0x33, 0xcf, 0xdf, 0x57, 0x68, 0xe3, 0xb6, 0x63, 0x55, 0x6a,
+	0x1c, 0x62, 0xc6, 0x13, 0xe1, 0x3d, 0xd3, 0x02, 0xde, 0x4d, 0xef, 0x87, 0x64, 0x12, 0x19, 0x7c,
+	0xf3, 0x6f, 0x07, 0xd5, 0xde, 0x69, 0x79, 0xf7, 0x05, 0x42, 0x6a, 0x82, 0xda, 0x29, 0xac, 0xf7,
+	0x48, 0xb4, 0xaa, 0x4e, 0xcc, 0xf4, 0x6d, 0xa1, 0x8a, 0x0a, 0xe8, 0x0c, 0x5f, 0xd4, 0x38, 0xd2,
+	0x47, 0xc6, 0xe0, 0xdd, 0x69, 0xb9, 0xf4, 0xff, 0x4d, 0xcb, 0xe5, 0xff, 0x38, 0x2d, 0x37, 0x7f,
+	0x75, 0x10, 0xba, 0xaa, 0x45, 0x37, 0x44, 0x28, 0xa3, 0xb9, 0xad, 0xfa, 0xfb, 0x2c, 0xcb, 0xd5,
+	0x8c, 0xe6, 0x46, 0xe7, 0xbd, 0x8b, 0x6b, 0xf1, 0x7d, 0x8b, 0x6b, 0x1f, 0xa9, 0xe1, 0xac, 0xd2,
+	0x1d, 0xeb, 0x1d, 0x7d, 0x8f, 0xb8, 0x54, 0xfa, 0x00, 0x5d, 0xcb, 0x0b, 0xbf, 0x7d, 0x73, 0xd1,
+	0x74, 0xde, 0x5e, 0x34, 0x9d, 0xbf, 0x2e, 0x9a, 0xce, 0xcf, 0x97, 0xcd, 0x85, 0xb7, 0x97, 0xcd,
+	0x85, 0xdf, 0x2f, 0x9b, 0x0b, 0x3f, 0x6e, 0x5f, 0x5b, 0x70, 0xbb, 0xba, 0x0f, 0xf7, 0x59, 0x99,
+	0x27, 0xba, 0xf5, 0x03, 0xfb, 0xed, 0x32, 0xfa, 0x22, 0x98, 0xe8, 0x0f, 0x18, 0xbd, 0xef, 0x7a,
+	0x2b, 0x7a, 0xf4, 0x7c, 0xf6, 0xcf, 0x00, 0xd7, 0x88, 0x6f, 0xb5, 0xdb, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -150,6 +441,155 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OpeningAuctionMaxRecords != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OpeningAuctionMaxRecords))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.RewardDistributionGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardDistributionGasLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.OpeningAuctionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OpeningAuctionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.OrderQuotaReserveMultiplier.Size()
+		i -= size
+		if _, err := m.OrderQuotaReserveMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.RewardEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.SwapRouteDenoms) > 0 {
+		for iNdEx := len(m.SwapRouteDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SwapRouteDenoms[iNdEx])
+			copy(dAtA[i:], m.SwapRouteDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.SwapRouteDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.VolumeTiers) > 0 {
+		for iNdEx := len(m.VolumeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.TradingVolumeWindowDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TradingVolumeWindowDays))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OrderExpirationSweepGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderExpirationSweepGasLimit))
+		i--
+		dAtA[i] = 0x70
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxOrderLifetime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxOrderLifetime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TWAPRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TWAPRetentionPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	if m.CircuitBreakerCooldownBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerCooldownBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.CircuitBreakerMaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.CircuitBreakerMaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.OrderBookFeeRates) > 0 {
+		for iNdEx := len(m.OrderBookFeeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBookFeeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.QuantityStepExponent != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.QuantityStepExponent))
 		i--
@@ -181,53 +621,891 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.DefaultUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookFeeRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookFeeRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookFeeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeDiscount.Size()
+		i -= size
+		if _, err := m.FeeDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxOrdersPerDenom != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOrdersPerDenom))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DefaultUnifiedRefAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.PriceTickExponent != 0 {
+		n += 1 + sovParams(uint64(m.PriceTickExponent))
+	}
+	if m.MaxOrdersPerDenom != 0 {
+		n += 1 + sovParams(uint64(m.MaxOrdersPerDenom))
+	}
+	l = m.OrderReserve.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.QuantityStepExponent != 0 {
+		n += 1 + sovParams(uint64(m.QuantityStepExponent))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.OrderBookFeeRates) > 0 {
+		for _, e := range m.OrderBookFeeRates {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.CircuitBreakerMaxPriceDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.CircuitBreakerCooldownBlocks != 0 {
+		n += 1 + sovParams(uint64(m.CircuitBreakerCooldownBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TWAPRetentionPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxOrderLifetime)
+	n += 1 + l + sovParams(uint64(l))
+	if m.OrderExpirationSweepGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.OrderExpirationSweepGasLimit))
+	}
+	if m.TradingVolumeWindowDays != 0 {
+		n += 1 + sovParams(uint64(m.TradingVolumeWindowDays))
+	}
+	if len(m.VolumeTiers) > 0 {
+		for _, e := range m.VolumeTiers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.SwapRouteDenoms) > 0 {
+		for _, s := range m.SwapRouteDenoms {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RewardEpochBlocks != 0 {
+		n += 2 + sovParams(uint64(m.RewardEpochBlocks))
+	}
+	l = m.OrderQuotaReserveMultiplier.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.OpeningAuctionBlocks != 0 {
+		n += 2 + sovParams(uint64(m.OpeningAuctionBlocks))
+	}
+	if m.RewardDistributionGasLimit != 0 {
+		n += 2 + sovParams(uint64(m.RewardDistributionGasLimit))
+	}
+	if m.OpeningAuctionMaxRecords != 0 {
+		n += 2 + sovParams(uint64(m.OpeningAuctionMaxRecords))
+	}
+	return n
+}
+
+func (m *OrderBookFeeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *VolumeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxOrdersPerDenom != 0 {
+		n += 1 + sovParams(uint64(m.MaxOrdersPerDenom))
+	}
+	l = m.FeeDiscount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultUnifiedRefAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTickExponent", wireType)
+			}
+			m.PriceTickExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceTickExponent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrdersPerDenom", wireType)
+			}
+			m.MaxOrdersPerDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrdersPerDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderReserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStepExponent", wireType)
+			}
+			m.QuantityStepExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuantityStepExponent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookFeeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookFeeRates = append(m.OrderBookFeeRates, OrderBookFeeRates{})
+			if err := m.OrderBookFeeRates[len(m.OrderBookFeeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerMaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerCooldownBlocks", wireType)
+			}
+			m.CircuitBreakerCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TWAPRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TWAPRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxOrderLifetime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExpirationSweepGasLimit", wireType)
+			}
+			m.OrderExpirationSweepGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderExpirationSweepGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingVolumeWindowDays", wireType)
+			}
+			m.TradingVolumeWindowDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradingVolumeWindowDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeTiers = append(m.VolumeTiers, VolumeTier{})
+			if err := m.VolumeTiers[len(m.VolumeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRouteDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRouteDenoms = append(m.SwapRouteDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochBlocks", wireType)
+			}
+			m.RewardEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderQuotaReserveMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderQuotaReserveMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpeningAuctionBlocks", wireType)
+			}
+			m.OpeningAuctionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpeningAuctionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionGasLimit", wireType)
+			}
+			m.RewardDistributionGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpeningAuctionMaxRecords", wireType)
+			}
+			m.OpeningAuctionMaxRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpeningAuctionMaxRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.DefaultUnifiedRefAmount.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.PriceTickExponent != 0 {
-		n += 1 + sovParams(uint64(m.PriceTickExponent))
-	}
-	if m.MaxOrdersPerDenom != 0 {
-		n += 1 + sovParams(uint64(m.MaxOrdersPerDenom))
-	}
-	l = m.OrderReserve.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.QuantityStepExponent != 0 {
-		n += 1 + sovParams(uint64(m.QuantityStepExponent))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *OrderBookFeeRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -250,15 +1528,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderBookFeeRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderBookFeeRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultUnifiedRefAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -286,15 +1564,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceTickExponent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
-			m.PriceTickExponent = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -304,16 +1580,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceTickExponent |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrdersPerDenom", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
 			}
-			m.MaxOrdersPerDenom = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -323,16 +1612,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxOrdersPerDenom |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -342,30 +1646,115 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStepExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrdersPerDenom", wireType)
 			}
-			m.QuantityStepExponent = 0
+			m.MaxOrdersPerDenom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -375,11 +1764,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QuantityStepExponent |= int32(b&0x7F) << shift
+				m.MaxOrdersPerDenom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -117,6 +117,42 @@ func MustNewPriceFromString(str string) Price {
 	return price
 }
 
+// NewPriceFromRat returns new instance of the Price from the positive rat rounded down to the max num part length.
+func NewPriceFromRat(rat *big.Rat) (Price, error) {
+	if rat.Sign() <= 0 {
+		return Price{}, errors.Errorf("price must be positive, got %s", rat.String())
+	}
+
+	num, denom := rat.Num(), rat.Denom()
+	// select the exponent to get the num part with the max allowed length
+	exp := len(num.String()) - len(denom.String()) - MaxNumLen
+	intNum := new(big.Int)
+	if exp >= 0 {
+		intNum.Quo(num, cbig.IntMul(denom, cbig.IntTenToThePower(big.NewInt(int64(exp)))))
+	} else {
+		intNum.Quo(cbig.IntMul(num, cbig.IntTenToThePower(big.NewInt(int64(-exp)))), denom)
+	}
+
+	ten := big.NewInt(10)
+	for len(intNum.String()) > MaxNumLen {
+		intNum.Quo(intNum, ten)
+		exp++
+	}
+	// trim trailing zeros to keep the canonical string representation
+	for intNum.Sign() > 0 && new(big.Int).Rem(intNum, ten).Sign() == 0 {
+		intNum.Quo(intNum, ten)
+		exp++
+	}
+
+	if intNum.Sign() == 0 || exp < int(MinExp) || exp > int(MaxExp) {
+		return Price{}, errors.Errorf(
+			"price %s is out of the range, exponent must be in the rage %d:%d", rat.String(), MinExp, MaxExp,
+		)
+	}
+
+	return NewPrice(intNum.Uint64(), int8(exp))
+}
+
 // Rat creates Rat type from Price.
 func (p Price) Rat() *big.Rat {
 	if p.exp > 0 {
@@ -267,6 +267,70 @@ func TestPrice_Rat(t *testing.T) {
 	}
 }
 
+func TestNewPriceFromRat(t *testing.T) {
+	tests := []struct {
+		rat     *big.Rat
+		want    string
+		wantErr bool
+	}{
+		{
+			rat:  big.NewRat(1, 1),
+			want: "1",
+		},
+		{
+			rat:  big.NewRat(1200, 1),
+			want: "12e2",
+		},
+		{
+			rat:  big.NewRat(1, 8),
+			want: "125e-3",
+		},
+		{
+			rat:  big.NewRat(1, 3),
+			want: "3333333333333333333e-19",
+		},
+		{
+			rat:  big.NewRat(2, 3),
+			want: "6666666666666666666e-19",
+		},
+		{
+			rat:  types.MustNewPriceFromString("9999999999999999999e100").Rat(),
+			want: "9999999999999999999e100",
+		},
+		{
+			rat:  types.MustNewPriceFromString("1e-100").Rat(),
+			want: "1e-100",
+		},
+		{
+			rat:     new(big.Rat).Mul(types.MustNewPriceFromString("1e100").Rat(), big.NewRat(10, 1)),
+			wantErr: true,
+		},
+		{
+			rat:     new(big.Rat).Mul(types.MustNewPriceFromString("1e-100").Rat(), big.NewRat(1, 10)),
+			wantErr: true,
+		},
+		{
+			rat:     big.NewRat(0, 1),
+			wantErr: true,
+		},
+		{
+			rat:     big.NewRat(-1, 1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rat.String(), func(t *testing.T) {
+			p, err := types.NewPriceFromRat(tt.rat)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, p.String())
+		})
+	}
+}
+
 func TestPrice_Marshalling(t *testing.T) {
 	t.Parallel()
 
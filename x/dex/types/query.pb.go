@@ -7,23 +7,29 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -485,6 +491,19 @@ type QueryOrderBookParamsResponse struct {
 	BaseDenomUnifiedRefAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=base_denom_unified_ref_amount,json=baseDenomUnifiedRefAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_denom_unified_ref_amount"`
 	// quote_denom_unified_ref_amount is needed to define price tick & quantity step of quote denom
 	QuoteDenomUnifiedRefAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=quote_denom_unified_ref_amount,json=quoteDenomUnifiedRefAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quote_denom_unified_ref_amount"`
+	// maker_fee_rate is the fee rate charged from the amount received by the maker order
+	MakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maker_fee_rate"`
+	// taker_fee_rate is the fee rate charged from the amount received by the taker order
+	TakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee_rate"`
+	// status is the order book status.
+	Status OrderBookStatus `protobuf:"varint,7,opt,name=status,proto3,enum=coreum.dex.v1.OrderBookStatus" json:"status,omitempty"`
+	// min_quantity is the min quantity of the order placed to the order book, empty if not limited.
+	MinQuantity *cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=min_quantity,json=minQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"min_quantity,omitempty"`
+	// halted_until_height is the height until which the order book is halted by the circuit breaker, zero if not halted.
+	HaltedUntilHeight int64 `protobuf:"varint,9,opt,name=halted_until_height,json=haltedUntilHeight,proto3" json:"halted_until_height,omitempty"`
+	// auction_until_height is the height at the end of which the opening auction of the order book is uncrossed, zero if
+	// the order book isn't in the auction.
+	AuctionUntilHeight int64 `protobuf:"varint,10,opt,name=auction_until_height,json=auctionUntilHeight,proto3" json:"auction_until_height,omitempty"`
 }
 
 func (m *QueryOrderBookParamsResponse) Reset()         { *m = QueryOrderBookParamsResponse{} }
@@ -520,6 +539,27 @@ func (m *QueryOrderBookParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOrderBookParamsResponse proto.InternalMessageInfo
 
+func (m *QueryOrderBookParamsResponse) GetStatus() OrderBookStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ORDER_BOOK_STATUS_UNSPECIFIED
+}
+
+func (m *QueryOrderBookParamsResponse) GetHaltedUntilHeight() int64 {
+	if m != nil {
+		return m.HaltedUntilHeight
+	}
+	return 0
+}
+
+func (m *QueryOrderBookParamsResponse) GetAuctionUntilHeight() int64 {
+	if m != nil {
+		return m.AuctionUntilHeight
+	}
+	return 0
+}
+
 // QueryOrderBookOrdersRequest defines the request type for the `OrderBookOrders` query.
 type QueryOrderBookOrdersRequest struct {
 	// base_denom is base order denom.
@@ -646,6 +686,351 @@ func (m *QueryOrderBookOrdersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryOrderBookDepthRequest defines the request type for the `OrderBookDepth` query.
+type QueryOrderBookDepthRequest struct {
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// limit is the max number of price levels returned for each side, the default limit is used if not set.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryOrderBookDepthRequest) Reset()         { *m = QueryOrderBookDepthRequest{} }
+func (m *QueryOrderBookDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookDepthRequest) ProtoMessage()    {}
+func (*QueryOrderBookDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{12}
+}
+func (m *QueryOrderBookDepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookDepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrderBookDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookDepthRequest.Merge(m, src)
+}
+func (m *QueryOrderBookDepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookDepthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrderBookDepthRequest proto.InternalMessageInfo
+
+func (m *QueryOrderBookDepthRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *QueryOrderBookDepthRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryOrderBookDepthRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// PriceLevel is the aggregated order book price level.
+type PriceLevel struct {
+	// price is the price of the level.
+	Price Price `protobuf:"bytes,1,opt,name=price,proto3,customtype=Price" json:"price"`
+	// remaining_base_quantity is the total remaining base quantity of the orders with the level price.
+	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
+	// orders_count is the number of orders with the level price.
+	OrdersCount uint64 `protobuf:"varint,3,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+}
+
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{13}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+func (m *PriceLevel) GetOrdersCount() uint64 {
+	if m != nil {
+		return m.OrdersCount
+	}
+	return 0
+}
+
+// QueryOrderBookDepthResponse defines the response type for the `OrderBookDepth` query.
+type QueryOrderBookDepthResponse struct {
+	// bids are the buy side price levels sorted by price descending.
+	Bids []PriceLevel `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids"`
+	// asks are the sell side price levels sorted by price ascending.
+	Asks []PriceLevel `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks"`
+	// best_bid is the highest buy price, empty if there are no buy orders.
+	BestBid *Price `protobuf:"bytes,3,opt,name=best_bid,json=bestBid,proto3,customtype=Price" json:"best_bid,omitempty"`
+	// best_ask is the lowest sell price, empty if there are no sell orders.
+	BestAsk *Price `protobuf:"bytes,4,opt,name=best_ask,json=bestAsk,proto3,customtype=Price" json:"best_ask,omitempty"`
+	// spread is the difference between the best ask and best bid rounded down to the max price precision, empty if
+	// any of them is empty or the best ask isn't greater than the best bid.
+	Spread *Price `protobuf:"bytes,5,opt,name=spread,proto3,customtype=Price" json:"spread,omitempty"`
+}
+
+func (m *QueryOrderBookDepthResponse) Reset()         { *m = QueryOrderBookDepthResponse{} }
+func (m *QueryOrderBookDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookDepthResponse) ProtoMessage()    {}
+func (*QueryOrderBookDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{14}
+}
+func (m *QueryOrderBookDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrderBookDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookDepthResponse.Merge(m, src)
+}
+func (m *QueryOrderBookDepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookDepthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrderBookDepthResponse proto.InternalMessageInfo
+
+func (m *QueryOrderBookDepthResponse) GetBids() []PriceLevel {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *QueryOrderBookDepthResponse) GetAsks() []PriceLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+// QuerySimulateOrderRequest defines the request type for the `SimulateOrder` query.
+type QuerySimulateOrderRequest struct {
+	// creator is the order creator address. It's optional, if it's empty, the market order spendable balance isn't
+	// limited.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// type is order type.
+	Type OrderType `protobuf:"varint,2,opt,name=type,proto3,enum=coreum.dex.v1.OrderType" json:"type,omitempty"`
+	// base_denom is base order denom.
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order denom
+	QuoteDenom string `protobuf:"bytes,4,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price is value of one unit of the base_denom expressed in terms of the quote_denom.
+	Price *Price `protobuf:"bytes,5,opt,name=price,proto3,customtype=Price" json:"price,omitempty"`
+	// quantity is amount of the base base_denom being traded.
+	Quantity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+	// side is order side.
+	Side Side `protobuf:"varint,7,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// time_in_force is order time in force
+	TimeInForce TimeInForce `protobuf:"varint,8,opt,name=time_in_force,json=timeInForce,proto3,enum=coreum.dex.v1.TimeInForce" json:"time_in_force,omitempty"`
+}
+
+func (m *QuerySimulateOrderRequest) Reset()         { *m = QuerySimulateOrderRequest{} }
+func (m *QuerySimulateOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateOrderRequest) ProtoMessage()    {}
+func (*QuerySimulateOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{15}
+}
+func (m *QuerySimulateOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateOrderRequest.Merge(m, src)
+}
+func (m *QuerySimulateOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateOrderRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateOrderRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QuerySimulateOrderRequest) GetType() OrderType {
+	if m != nil {
+		return m.Type
+	}
+	return ORDER_TYPE_UNSPECIFIED
+}
+
+func (m *QuerySimulateOrderRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *QuerySimulateOrderRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QuerySimulateOrderRequest) GetSide() Side {
+	if m != nil {
+		return m.Side
+	}
+	return SIDE_UNSPECIFIED
+}
+
+func (m *QuerySimulateOrderRequest) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TIME_IN_FORCE_UNSPECIFIED
+}
+
+// QuerySimulateOrderResponse defines the response type for the `SimulateOrder` query.
+type QuerySimulateOrderResponse struct {
+	// executed_base_quantity is the executed quantity of the base denom.
+	ExecutedBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=executed_base_quantity,json=executedBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"executed_base_quantity"`
+	// executed_quote_quantity is the executed quantity of the quote denom.
+	ExecutedQuoteQuantity cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=executed_quote_quantity,json=executedQuoteQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"executed_quote_quantity"`
+	// remaining_base_quantity is the not executed quantity of the base denom.
+	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
+	// filled is true if the order is filled fully.
+	Filled bool `protobuf:"varint,4,opt,name=filled,proto3" json:"filled,omitempty"`
+	// average_price is the average execution price rounded down to the max price precision, empty if nothing is
+	// executed.
+	AveragePrice *Price `protobuf:"bytes,5,opt,name=average_price,json=averagePrice,proto3,customtype=Price" json:"average_price,omitempty"`
+	// best_price is the price of the first trade expressed in the order denoms rounded down to the max price precision,
+	// empty if nothing is executed.
+	BestPrice *Price `protobuf:"bytes,6,opt,name=best_price,json=bestPrice,proto3,customtype=Price" json:"best_price,omitempty"`
+	// slippage is the relative difference between the average price and the best price.
+	Slippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=slippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slippage"`
+	// trades are the trades expressed in the maker order books.
+	Trades []EventTrade `protobuf:"bytes,8,rep,name=trades,proto3" json:"trades"`
+	// taker_fee is the fee deducted from the coin received by the order.
+	TakerFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,9,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_fee"`
+	// maker_fee is the fee deducted from the coins received by the matched maker orders.
+	MakerFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,10,opt,name=maker_fee,json=makerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"maker_fee"`
+}
+
+func (m *QuerySimulateOrderResponse) Reset()         { *m = QuerySimulateOrderResponse{} }
+func (m *QuerySimulateOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateOrderResponse) ProtoMessage()    {}
+func (*QuerySimulateOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{16}
+}
+func (m *QuerySimulateOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateOrderResponse.Merge(m, src)
+}
+func (m *QuerySimulateOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateOrderResponse) GetFilled() bool {
+	if m != nil {
+		return m.Filled
+	}
+	return false
+}
+
+func (m *QuerySimulateOrderResponse) GetTrades() []EventTrade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
 // QueryAccountDenomOrdersCountRequest defines the request type for the `AccountDenomOrdersCount` query.
 type QueryAccountDenomOrdersCountRequest struct {
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -656,7 +1041,7 @@ func (m *QueryAccountDenomOrdersCountRequest) Reset()         { *m = QueryAccoun
 func (m *QueryAccountDenomOrdersCountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDenomOrdersCountRequest) ProtoMessage()    {}
 func (*QueryAccountDenomOrdersCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a17d94653a2124, []int{12}
+	return fileDescriptor_23a17d94653a2124, []int{17}
 }
 func (m *QueryAccountDenomOrdersCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +1093,7 @@ func (m *QueryAccountDenomOrdersCountResponse) Reset()         { *m = QueryAccou
 func (m *QueryAccountDenomOrdersCountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDenomOrdersCountResponse) ProtoMessage()    {}
 func (*QueryAccountDenomOrdersCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a17d94653a2124, []int{13}
+	return fileDescriptor_23a17d94653a2124, []int{18}
 }
 func (m *QueryAccountDenomOrdersCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,1211 +1129,6413 @@ func (m *QueryAccountDenomOrdersCountResponse) GetCount() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.dex.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.dex.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryOrderRequest)(nil), "coreum.dex.v1.QueryOrderRequest")
-	proto.RegisterType((*QueryOrderResponse)(nil), "coreum.dex.v1.QueryOrderResponse")
-	proto.RegisterType((*QueryOrdersRequest)(nil), "coreum.dex.v1.QueryOrdersRequest")
-	proto.RegisterType((*QueryOrdersResponse)(nil), "coreum.dex.v1.QueryOrdersResponse")
-	proto.RegisterType((*QueryOrderBooksRequest)(nil), "coreum.dex.v1.QueryOrderBooksRequest")
-	proto.RegisterType((*QueryOrderBooksResponse)(nil), "coreum.dex.v1.QueryOrderBooksResponse")
-	proto.RegisterType((*QueryOrderBookParamsRequest)(nil), "coreum.dex.v1.QueryOrderBookParamsRequest")
-	proto.RegisterType((*QueryOrderBookParamsResponse)(nil), "coreum.dex.v1.QueryOrderBookParamsResponse")
-	proto.RegisterType((*QueryOrderBookOrdersRequest)(nil), "coreum.dex.v1.QueryOrderBookOrdersRequest")
-	proto.RegisterType((*QueryOrderBookOrdersResponse)(nil), "coreum.dex.v1.QueryOrderBookOrdersResponse")
-	proto.RegisterType((*QueryAccountDenomOrdersCountRequest)(nil), "coreum.dex.v1.QueryAccountDenomOrdersCountRequest")
-	proto.RegisterType((*QueryAccountDenomOrdersCountResponse)(nil), "coreum.dex.v1.QueryAccountDenomOrdersCountResponse")
+// QueryTriggerOrdersRequest defines the request type for the `TriggerOrders` query.
+type QueryTriggerOrdersRequest struct {
+	// creator is order creator's account.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("coreum/dex/v1/query.proto", fileDescriptor_23a17d94653a2124) }
+func (m *QueryTriggerOrdersRequest) Reset()         { *m = QueryTriggerOrdersRequest{} }
+func (m *QueryTriggerOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTriggerOrdersRequest) ProtoMessage()    {}
+func (*QueryTriggerOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{19}
+}
+func (m *QueryTriggerOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTriggerOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTriggerOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTriggerOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTriggerOrdersRequest.Merge(m, src)
+}
+func (m *QueryTriggerOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTriggerOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTriggerOrdersRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_23a17d94653a2124 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x18, 0xcd, 0xba, 0xb6, 0x21, 0x5f, 0x48, 0x11, 0x53, 0x87, 0xa4, 0x9b, 0xc4, 0x49, 0xb6, 0x25,
-	0x41, 0x69, 0xb3, 0x83, 0x1d, 0x89, 0x53, 0x0b, 0xaa, 0x13, 0x05, 0xb5, 0x20, 0x11, 0xb6, 0xf4,
-	0x82, 0x84, 0xcc, 0x7a, 0x77, 0xe2, 0xae, 0x8c, 0x77, 0x9c, 0xdd, 0xb5, 0x95, 0xc8, 0xb2, 0x90,
-	0x10, 0x07, 0x8e, 0x08, 0x4e, 0x88, 0x3f, 0x00, 0x8e, 0x5c, 0xf8, 0x1f, 0x7a, 0xaa, 0x2a, 0x71,
-	0x41, 0x1c, 0xaa, 0x2a, 0x41, 0xe2, 0xdf, 0x40, 0x3b, 0xf3, 0x39, 0xfb, 0x23, 0x6b, 0x3b, 0x04,
-	0x0e, 0xbd, 0x79, 0x67, 0xde, 0xbc, 0xf7, 0xbe, 0x37, 0x33, 0xdf, 0x18, 0xae, 0x5b, 0xdc, 0x63,
-	0xdd, 0x36, 0xb5, 0xd9, 0x11, 0xed, 0x55, 0xe8, 0x61, 0x97, 0x79, 0xc7, 0x7a, 0xc7, 0xe3, 0x01,
-	0x27, 0xb3, 0x72, 0x4a, 0xb7, 0xd9, 0x91, 0xde, 0xab, 0xa8, 0x29, 0x24, 0xf7, 0x6c, 0xe6, 0x49,
-	0xa4, 0xaa, 0x26, 0xa7, 0x3a, 0xa6, 0x67, 0xb6, 0x7d, 0x9c, 0xdb, 0xb4, 0xb8, 0xdf, 0xe6, 0x3e,
-	0x6d, 0x98, 0x3e, 0x93, 0xf4, 0xb4, 0x57, 0x69, 0xb0, 0xc0, 0x0c, 0x71, 0x4d, 0xc7, 0x35, 0x03,
-	0x87, 0xbb, 0x88, 0x5d, 0x44, 0xec, 0x10, 0x16, 0xb7, 0xa3, 0x96, 0x9a, 0xbc, 0xc9, 0xc5, 0x4f,
-	0x1a, 0xfe, 0xc2, 0xd1, 0xa5, 0x26, 0xe7, 0xcd, 0x2f, 0x19, 0x35, 0x3b, 0x0e, 0x35, 0x5d, 0x97,
-	0x07, 0x82, 0x0f, 0xc5, 0xb5, 0x12, 0x90, 0x4f, 0x42, 0x8a, 0x7d, 0xe1, 0xc8, 0x60, 0x87, 0x5d,
-	0xe6, 0x07, 0xda, 0x03, 0xb8, 0x96, 0x18, 0xf5, 0x3b, 0xdc, 0xf5, 0x19, 0xd9, 0x86, 0xa2, 0x74,
-	0xbe, 0xa0, 0xac, 0x2a, 0x6f, 0xcf, 0x54, 0xe7, 0xf4, 0x44, 0x00, 0xba, 0x84, 0xd7, 0xf2, 0x4f,
-	0x9e, 0xaf, 0x4c, 0x19, 0x08, 0xd5, 0xee, 0xc2, 0x1b, 0x82, 0xeb, 0xe3, 0x30, 0x0e, 0x14, 0x20,
-	0x0b, 0xf0, 0x8a, 0xe5, 0x31, 0x33, 0xe0, 0x9e, 0xa0, 0x9a, 0x36, 0x86, 0x9f, 0xe4, 0x2a, 0xe4,
-	0x1c, 0x7b, 0x21, 0x27, 0x06, 0x73, 0x8e, 0xad, 0xed, 0xa1, 0x41, 0x5c, 0x8e, 0x4e, 0xde, 0x81,
-	0x82, 0x88, 0x17, 0x8d, 0x94, 0x52, 0x46, 0x04, 0x18, 0x7d, 0x48, 0xa0, 0xd6, 0x8b, 0xf3, 0xf8,
-	0x93, 0x7d, 0xec, 0x01, 0x44, 0xe9, 0x0b, 0x3f, 0x33, 0xd5, 0x75, 0x5d, 0xc6, 0xaf, 0x87, 0x5b,
-	0xa5, 0xcb, 0xe8, 0x71, 0xab, 0xf4, 0x7d, 0xb3, 0xc9, 0x90, 0xd5, 0x88, 0xad, 0xd4, 0xbe, 0x57,
-	0x30, 0xcb, 0xa1, 0x30, 0x56, 0x50, 0x85, 0xa2, 0x30, 0x16, 0x66, 0x79, 0x65, 0x42, 0x09, 0x88,
-	0x24, 0x1f, 0x64, 0x78, 0xda, 0x98, 0xe8, 0x49, 0x0a, 0x26, 0x4c, 0x7d, 0x01, 0x6f, 0x46, 0x9e,
-	0x6a, 0x9c, 0xb7, 0xce, 0x02, 0x49, 0x96, 0xad, 0x5c, 0xba, 0xec, 0x9f, 0x15, 0x98, 0x3f, 0x27,
-	0x81, 0xa5, 0xef, 0xc0, 0x8c, 0x28, 0xa8, 0xde, 0x08, 0x87, 0xb1, 0xfe, 0xa5, 0xcc, 0xfa, 0x39,
-	0x6f, 0xed, 0x9a, 0x81, 0x89, 0x39, 0x00, 0x3f, 0x23, 0xfb, 0xff, 0xb2, 0xf8, 0x1c, 0x16, 0x93,
-	0x46, 0x13, 0x57, 0x81, 0x2c, 0x03, 0x84, 0x6c, 0x75, 0x9b, 0xb9, 0xbc, 0x8d, 0x87, 0x64, 0x3a,
-	0x1c, 0xd9, 0x0d, 0x07, 0xc8, 0x0a, 0xcc, 0x1c, 0x76, 0x79, 0x30, 0x9c, 0x97, 0xe7, 0x16, 0xc4,
-	0x90, 0x00, 0x68, 0x2f, 0x72, 0xb0, 0x94, 0xcd, 0x8f, 0x69, 0xdc, 0x06, 0xe8, 0x78, 0x8e, 0xc5,
-	0xea, 0x81, 0x63, 0xb5, 0xa4, 0x40, 0x6d, 0x36, 0x2c, 0xf7, 0xcf, 0xe7, 0x2b, 0x85, 0xfd, 0x70,
-	0xc6, 0x98, 0x16, 0x80, 0x4f, 0x1d, 0xab, 0x45, 0x6a, 0x30, 0x7b, 0xd8, 0x35, 0xdd, 0xc0, 0x09,
-	0x8e, 0xeb, 0x7e, 0xc0, 0x3a, 0x52, 0xb1, 0xb6, 0x8c, 0x0b, 0xe6, 0x64, 0x00, 0xbe, 0xdd, 0xd2,
-	0x1d, 0x4e, 0xdb, 0x66, 0xf0, 0x58, 0xbf, 0xef, 0x06, 0xc6, 0x6b, 0xc3, 0x35, 0x0f, 0x03, 0xd6,
-	0x21, 0x0c, 0x96, 0xa3, 0x92, 0xea, 0x5d, 0xd7, 0x39, 0x70, 0x98, 0x5d, 0xf7, 0xd8, 0x41, 0xdd,
-	0x6c, 0xf3, 0xae, 0x1b, 0x2c, 0x5c, 0x11, 0x9c, 0x37, 0x90, 0x73, 0xf1, 0x3c, 0xe7, 0x47, 0xac,
-	0x69, 0x5a, 0xc7, 0xbb, 0xcc, 0x32, 0xae, 0x9f, 0x45, 0xf1, 0x48, 0xf2, 0x18, 0xec, 0xe0, 0x9e,
-	0x60, 0x21, 0x4d, 0x28, 0xc7, 0xa2, 0xc9, 0xd2, 0xc9, 0x5f, 0x5c, 0x47, 0x8d, 0x22, 0x4d, 0x0b,
-	0x69, 0x4f, 0x95, 0xf4, 0x16, 0x26, 0x2f, 0xf9, 0x7f, 0xdc, 0x42, 0xb2, 0x01, 0x79, 0xdf, 0xb1,
-	0x99, 0x88, 0xe5, 0x6a, 0xf5, 0x5a, 0xea, 0xa0, 0x3e, 0x74, 0x6c, 0x66, 0x08, 0x40, 0xea, 0xf2,
-	0xe4, 0x2f, 0x7d, 0x79, 0x7e, 0x52, 0xd2, 0x67, 0xe6, 0x65, 0x6a, 0x1e, 0x8f, 0xe0, 0x86, 0x30,
-	0x77, 0xcf, 0xb2, 0xc2, 0xf8, 0x45, 0x46, 0xd2, 0xdf, 0x4e, 0xf8, 0x1d, 0x6b, 0xad, 0xa6, 0x44,
-	0x0c, 0x5b, 0x2b, 0x7e, 0x92, 0x12, 0x14, 0xe2, 0x51, 0xcb, 0x0f, 0xed, 0x0e, 0xdc, 0x1c, 0x4f,
-	0x8b, 0xb5, 0x97, 0xa0, 0x10, 0xb1, 0xe6, 0x0d, 0xf9, 0x51, 0xfd, 0xe5, 0x55, 0x28, 0x88, 0xe5,
-	0xc4, 0x87, 0xa2, 0xbc, 0x61, 0x64, 0x2d, 0x95, 0xca, 0xf9, 0x87, 0x4e, 0xd5, 0xc6, 0x41, 0xa4,
-	0xa0, 0xa6, 0x7d, 0xfb, 0xf7, 0xaf, 0x9b, 0xca, 0xd7, 0xbf, 0xff, 0xf5, 0x43, 0x6e, 0x9e, 0xcc,
-	0xd1, 0xac, 0x97, 0x9c, 0x7c, 0x05, 0x05, 0xe1, 0x95, 0xac, 0x66, 0x11, 0xc6, 0x9f, 0x3e, 0x75,
-	0x6d, 0x0c, 0x02, 0x15, 0x2b, 0x91, 0xe2, 0x3a, 0xb9, 0x49, 0x33, 0xfe, 0x56, 0xf8, 0xb4, 0x8f,
-	0x0f, 0xd5, 0x80, 0xf6, 0x1d, 0x7b, 0x40, 0x06, 0x50, 0x94, 0x61, 0x91, 0xd1, 0xfc, 0xe3, 0xab,
-	0x4e, 0x1e, 0x31, 0xed, 0x76, 0xe4, 0x61, 0x8d, 0xac, 0x4c, 0xf0, 0x40, 0xbe, 0x51, 0x00, 0xa2,
-	0x4e, 0x4f, 0xde, 0x1a, 0x29, 0x10, 0x7f, 0x6c, 0xd4, 0xf5, 0x49, 0x30, 0xf4, 0xb2, 0x11, 0x79,
-	0x59, 0x22, 0x6a, 0x96, 0x97, 0x2d, 0xf1, 0x94, 0x90, 0x1f, 0x15, 0x78, 0x3d, 0xd5, 0x67, 0xc9,
-	0xe6, 0x58, 0x91, 0xe4, 0x71, 0xb8, 0x75, 0x21, 0x2c, 0xba, 0xda, 0x8a, 0x5c, 0x69, 0x64, 0x75,
-	0xa4, 0xab, 0x2d, 0x3c, 0x22, 0xbf, 0xc5, 0xbd, 0xe1, 0x5e, 0x8d, 0xf7, 0x96, 0xdc, 0xb4, 0x5b,
-	0x17, 0xc2, 0xa2, 0xb7, 0xfb, 0x91, 0xb7, 0xf7, 0xc8, 0x9d, 0xd1, 0x89, 0xd1, 0x7e, 0xd4, 0x19,
-	0x07, 0xb4, 0x1f, 0xeb, 0x83, 0x03, 0xdc, 0x64, 0xf2, 0x54, 0x81, 0xf9, 0x11, 0x77, 0x92, 0x54,
-	0xb3, 0x3c, 0x8d, 0xef, 0x0b, 0xea, 0xf6, 0xbf, 0x5a, 0x83, 0xf5, 0x3c, 0x88, 0xea, 0x79, 0x9f,
-	0xdc, 0x4d, 0xd5, 0x83, 0x7d, 0xc5, 0xa7, 0x7d, 0xfc, 0x35, 0xa0, 0xa2, 0x04, 0x9f, 0xf6, 0x13,
-	0xa5, 0x6c, 0x89, 0xc9, 0xda, 0x87, 0x4f, 0x4e, 0xca, 0xca, 0xb3, 0x93, 0xb2, 0xf2, 0xe2, 0xa4,
-	0xac, 0x7c, 0x77, 0x5a, 0x9e, 0x7a, 0x76, 0x5a, 0x9e, 0xfa, 0xe3, 0xb4, 0x3c, 0xf5, 0x59, 0xa5,
-	0xe9, 0x04, 0x8f, 0xbb, 0x0d, 0xdd, 0xe2, 0x6d, 0xba, 0x23, 0x24, 0xf6, 0x78, 0xd7, 0xb5, 0x45,
-	0xdb, 0x1b, 0x6a, 0xf6, 0xde, 0xa5, 0x47, 0x42, 0x38, 0x38, 0xee, 0x30, 0xbf, 0x51, 0x14, 0x7f,
-	0xa3, 0xb7, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x35, 0xdd, 0x21, 0x1c, 0x26, 0x0c, 0x00, 0x00,
+var xxx_messageInfo_QueryTriggerOrdersRequest proto.InternalMessageInfo
+
+func (m *QueryTriggerOrdersRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryTriggerOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryTriggerOrdersResponse defines the response type for the `TriggerOrders` query.
+type QueryTriggerOrdersResponse struct {
+	Orders     []Order             `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/dex module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Order queries order by creator and ID.
-	Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error)
-	// Orders queries creator orders.
-	Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
-	// OrderBooks queries order books.
-	OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error)
-	// OrderBookParams queries order book params.
-	OrderBookParams(ctx context.Context, in *QueryOrderBookParamsRequest, opts ...grpc.CallOption) (*QueryOrderBookParamsResponse, error)
-	// OrderBookOrders queries order book orders.
-	OrderBookOrders(ctx context.Context, in *QueryOrderBookOrdersRequest, opts ...grpc.CallOption) (*QueryOrderBookOrdersResponse, error)
-	// AccountDenomOrdersCount queries account denom orders count.
-	AccountDenomOrdersCount(ctx context.Context, in *QueryAccountDenomOrdersCountRequest, opts ...grpc.CallOption) (*QueryAccountDenomOrdersCountResponse, error)
+func (m *QueryTriggerOrdersResponse) Reset()         { *m = QueryTriggerOrdersResponse{} }
+func (m *QueryTriggerOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTriggerOrdersResponse) ProtoMessage()    {}
+func (*QueryTriggerOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{20}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryTriggerOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTriggerOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTriggerOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTriggerOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTriggerOrdersResponse.Merge(m, src)
+}
+func (m *QueryTriggerOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTriggerOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTriggerOrdersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTriggerOrdersResponse proto.InternalMessageInfo
+
+func (m *QueryTriggerOrdersResponse) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error) {
-	out := new(QueryOrderResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Order", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTriggerOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
-	out := new(QueryOrdersResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Orders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryTWAPRequest defines the request type for the `TWAP` query.
+type QueryTWAPRequest struct {
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// start_time is the start of the window, it must be within the TWAP retention period.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the end of the window, the current block time is used if it is empty.
+	EndTime *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
 }
 
-func (c *queryClient) OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error) {
-	out := new(QueryOrderBooksResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBooks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{21}
+}
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
+}
+func (m *QueryTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) OrderBookParams(ctx context.Context, in *QueryOrderBookParamsRequest, opts ...grpc.CallOption) (*QueryOrderBookParamsResponse, error) {
-	out := new(QueryOrderBookParamsResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
+
+func (m *QueryTWAPRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OrderBookOrders(ctx context.Context, in *QueryOrderBookOrdersRequest, opts ...grpc.CallOption) (*QueryOrderBookOrdersResponse, error) {
-	out := new(QueryOrderBookOrdersResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTWAPRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AccountDenomOrdersCount(ctx context.Context, in *QueryAccountDenomOrdersCountRequest, opts ...grpc.CallOption) (*QueryAccountDenomOrdersCountResponse, error) {
-	out := new(QueryAccountDenomOrdersCountResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/AccountDenomOrdersCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTWAPRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/dex module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Order queries order by creator and ID.
-	Order(context.Context, *QueryOrderRequest) (*QueryOrderResponse, error)
-	// Orders queries creator orders.
-	Orders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error)
-	// OrderBooks queries order books.
-	OrderBooks(context.Context, *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error)
-	// OrderBookParams queries order book params.
-	OrderBookParams(context.Context, *QueryOrderBookParamsRequest) (*QueryOrderBookParamsResponse, error)
-	// OrderBookOrders queries order book orders.
-	OrderBookOrders(context.Context, *QueryOrderBookOrdersRequest) (*QueryOrderBookOrdersResponse, error)
-	// AccountDenomOrdersCount queries account denom orders count.
-	AccountDenomOrdersCount(context.Context, *QueryAccountDenomOrdersCountRequest) (*QueryAccountDenomOrdersCountResponse, error)
+func (m *QueryTWAPRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryTWAPResponse defines the response type for the `TWAP` query.
+type QueryTWAPResponse struct {
+	// twap is the time-weighted average price of the order book in the window.
+	TWAP cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"twap"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{22}
 }
-func (*UnimplementedQueryServer) Order(ctx context.Context, req *QueryOrderRequest) (*QueryOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Order not implemented")
-}
-func (*UnimplementedQueryServer) Orders(ctx context.Context, req *QueryOrdersRequest) (*QueryOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Orders not implemented")
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OrderBooks(ctx context.Context, req *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderBooks not implemented")
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OrderBookParams(ctx context.Context, req *QueryOrderBookParamsRequest) (*QueryOrderBookParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderBookParams not implemented")
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OrderBookOrders(ctx context.Context, req *QueryOrderBookOrdersRequest) (*QueryOrderBookOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderBookOrders not implemented")
+func (m *QueryTWAPResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AccountDenomOrdersCount(ctx context.Context, req *QueryAccountDenomOrdersCountRequest) (*QueryAccountDenomOrdersCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountDenomOrdersCount not implemented")
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
+
+// QueryDeadManSwitchRequest defines the request type for the `DeadManSwitch` query.
+type QueryDeadManSwitchRequest struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryDeadManSwitchRequest) Reset()         { *m = QueryDeadManSwitchRequest{} }
+func (m *QueryDeadManSwitchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadManSwitchRequest) ProtoMessage()    {}
+func (*QueryDeadManSwitchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{23}
+}
+func (m *QueryDeadManSwitchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadManSwitchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadManSwitchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDeadManSwitchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadManSwitchRequest.Merge(m, src)
+}
+func (m *QueryDeadManSwitchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadManSwitchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadManSwitchRequest.DiscardUnknown(m)
 }
 
-func _Query_Order_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Order(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/Order",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Order(ctx, req.(*QueryOrderRequest))
+var xxx_messageInfo_QueryDeadManSwitchRequest proto.InternalMessageInfo
+
+func (m *QueryDeadManSwitchRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Orders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Orders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/Orders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Orders(ctx, req.(*QueryOrdersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDeadManSwitchResponse defines the response type for the `DeadManSwitch` query.
+type QueryDeadManSwitchResponse struct {
+	DeadManSwitch DeadManSwitch `protobuf:"bytes,1,opt,name=dead_man_switch,json=deadManSwitch,proto3" json:"dead_man_switch"`
 }
 
-func _Query_OrderBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderBooksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderBooks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/OrderBooks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderBooks(ctx, req.(*QueryOrderBooksRequest))
+func (m *QueryDeadManSwitchResponse) Reset()         { *m = QueryDeadManSwitchResponse{} }
+func (m *QueryDeadManSwitchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadManSwitchResponse) ProtoMessage()    {}
+func (*QueryDeadManSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{24}
+}
+func (m *QueryDeadManSwitchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadManSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadManSwitchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDeadManSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadManSwitchResponse.Merge(m, src)
+}
+func (m *QueryDeadManSwitchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadManSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadManSwitchResponse.DiscardUnknown(m)
 }
 
-func _Query_OrderBookParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderBookParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderBookParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/OrderBookParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderBookParams(ctx, req.(*QueryOrderBookParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryDeadManSwitchResponse proto.InternalMessageInfo
 
-func _Query_OrderBookOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderBookOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderBookOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/OrderBookOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderBookOrders(ctx, req.(*QueryOrderBookOrdersRequest))
+func (m *QueryDeadManSwitchResponse) GetDeadManSwitch() DeadManSwitch {
+	if m != nil {
+		return m.DeadManSwitch
 	}
-	return interceptor(ctx, in, info, handler)
+	return DeadManSwitch{}
 }
 
-func _Query_AccountDenomOrdersCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountDenomOrdersCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountDenomOrdersCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/AccountDenomOrdersCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountDenomOrdersCount(ctx, req.(*QueryAccountDenomOrdersCountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAccountTradingVolumeRequest defines the request type for the `AccountTradingVolume` query.
+type QueryAccountTradingVolumeRequest struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.dex.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Order",
-			Handler:    _Query_Order_Handler,
-		},
-		{
-			MethodName: "Orders",
-			Handler:    _Query_Orders_Handler,
-		},
-		{
-			MethodName: "OrderBooks",
-			Handler:    _Query_OrderBooks_Handler,
-		},
-		{
-			MethodName: "OrderBookParams",
-			Handler:    _Query_OrderBookParams_Handler,
-		},
-		{
-			MethodName: "OrderBookOrders",
-			Handler:    _Query_OrderBookOrders_Handler,
-		},
-		{
-			MethodName: "AccountDenomOrdersCount",
-			Handler:    _Query_AccountDenomOrdersCount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "coreum/dex/v1/query.proto",
+func (m *QueryAccountTradingVolumeRequest) Reset()         { *m = QueryAccountTradingVolumeRequest{} }
+func (m *QueryAccountTradingVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountTradingVolumeRequest) ProtoMessage()    {}
+func (*QueryAccountTradingVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{25}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountTradingVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountTradingVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountTradingVolumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountTradingVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountTradingVolumeRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAccountTradingVolumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountTradingVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountTradingVolumeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountTradingVolumeRequest proto.InternalMessageInfo
+
+func (m *QueryAccountTradingVolumeRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccountTradingVolumeResponse defines the response type for the `AccountTradingVolume` query.
+type QueryAccountTradingVolumeResponse struct {
+	// volume is the trading volume of the account within the window normalized by the unified ref amount.
+	Volume cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=volume,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volume"`
+	// tier is the number of the volume tier of the account starting from 1, 0 if the account has no tier.
+	Tier uint32 `protobuf:"varint,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	// max_orders_per_denom is the maximum number of orders per denom the account can have.
+	MaxOrdersPerDenom uint64 `protobuf:"varint,3,opt,name=max_orders_per_denom,json=maxOrdersPerDenom,proto3" json:"max_orders_per_denom,omitempty"`
+	// fee_discount is the share of the maker and taker fees the account is exempted from.
+	FeeDiscount cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee_discount,json=feeDiscount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_discount"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAccountTradingVolumeResponse) Reset()         { *m = QueryAccountTradingVolumeResponse{} }
+func (m *QueryAccountTradingVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountTradingVolumeResponse) ProtoMessage()    {}
+func (*QueryAccountTradingVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{26}
+}
+func (m *QueryAccountTradingVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountTradingVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountTradingVolumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAccountTradingVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountTradingVolumeResponse.Merge(m, src)
 }
-
-func (m *QueryOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountTradingVolumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountTradingVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountTradingVolumeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAccountTradingVolumeResponse proto.InternalMessageInfo
+
+func (m *QueryAccountTradingVolumeResponse) GetTier() uint32 {
+	if m != nil {
+		return m.Tier
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountTradingVolumeResponse) GetMaxOrdersPerDenom() uint64 {
+	if m != nil {
+		return m.MaxOrdersPerDenom
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardProgramsRequest defines the request type for the `RewardPrograms` query.
+type QueryRewardProgramsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardProgramsRequest) Reset()         { *m = QueryRewardProgramsRequest{} }
+func (m *QueryRewardProgramsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardProgramsRequest) ProtoMessage()    {}
+func (*QueryRewardProgramsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{27}
+}
+func (m *QueryRewardProgramsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardProgramsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardProgramsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardProgramsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardProgramsRequest.Merge(m, src)
+}
+func (m *QueryRewardProgramsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardProgramsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardProgramsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardProgramsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardProgramsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardProgramsResponse defines the response type for the `RewardPrograms` query.
+type QueryRewardProgramsResponse struct {
+	RewardPrograms []RewardProgram     `protobuf:"bytes,1,rep,name=reward_programs,json=rewardPrograms,proto3" json:"reward_programs"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryRewardProgramsResponse) Reset()         { *m = QueryRewardProgramsResponse{} }
+func (m *QueryRewardProgramsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardProgramsResponse) ProtoMessage()    {}
+func (*QueryRewardProgramsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{28}
+}
+func (m *QueryRewardProgramsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardProgramsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardProgramsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryRewardProgramsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardProgramsResponse.Merge(m, src)
+}
+func (m *QueryRewardProgramsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardProgramsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardProgramsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardProgramsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardProgramsResponse) GetRewardPrograms() []RewardProgram {
+	if m != nil {
+		return m.RewardPrograms
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardProgramsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccountRewardsRequest defines the request type for the `AccountRewards` query.
+type QueryAccountRewardsRequest struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAccountRewardsRequest) Reset()         { *m = QueryAccountRewardsRequest{} }
+func (m *QueryAccountRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRewardsRequest) ProtoMessage()    {}
+func (*QueryAccountRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{29}
+}
+func (m *QueryAccountRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccountRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRewardsRequest.Merge(m, src)
+}
+func (m *QueryAccountRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOrderBooksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountRewardsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryOrderBooksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccountRewardsResponse defines the response type for the `AccountRewards` query.
+type QueryAccountRewardsResponse struct {
+	AccountRewards []AccountReward `protobuf:"bytes,1,rep,name=account_rewards,json=accountRewards,proto3" json:"account_rewards"`
 }
 
-func (m *QueryOrderBooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAccountRewardsResponse) Reset()         { *m = QueryAccountRewardsResponse{} }
+func (m *QueryAccountRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRewardsResponse) ProtoMessage()    {}
+func (*QueryAccountRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{30}
+}
+func (m *QueryAccountRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccountRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRewardsResponse.Merge(m, src)
+}
+func (m *QueryAccountRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOrderBooksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountRewardsResponse) GetAccountRewards() []AccountReward {
+	if m != nil {
+		return m.AccountRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOrderBooksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderQuotaRequest defines the request type for the `OrderQuota` query.
+type QueryOrderQuotaRequest struct {
+	// account is the account address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryOrderBooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrderBooks) > 0 {
-		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOrderQuotaRequest) Reset()         { *m = QueryOrderQuotaRequest{} }
+func (m *QueryOrderQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderQuotaRequest) ProtoMessage()    {}
+func (*QueryOrderQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{31}
+}
+func (m *QueryOrderQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOrderQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderQuotaRequest.Merge(m, src)
+}
+func (m *QueryOrderQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderQuotaRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOrderBookParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrderQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryOrderQuotaRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryOrderBookParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderQuotaResponse defines the response type for the `OrderQuota` query.
+type QueryOrderQuotaResponse struct {
+	// max_orders_per_denom is the maximum number of orders per denom the account can have, including the additional orders.
+	MaxOrdersPerDenom uint64 `protobuf:"varint,1,opt,name=max_orders_per_denom,json=maxOrdersPerDenom,proto3" json:"max_orders_per_denom,omitempty"`
+	// extra_orders_per_denom is the additional number of orders per denom set by the account.
+	ExtraOrdersPerDenom uint64 `protobuf:"varint,2,opt,name=extra_orders_per_denom,json=extraOrdersPerDenom,proto3" json:"extra_orders_per_denom,omitempty"`
+	// reserve is the reserve locked for the additional orders.
+	Reserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=reserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reserve"`
+	// used_orders_per_denom is the highest number of the account orders per denom.
+	UsedOrdersPerDenom uint64 `protobuf:"varint,4,opt,name=used_orders_per_denom,json=usedOrdersPerDenom,proto3" json:"used_orders_per_denom,omitempty"`
 }
 
-func (m *QueryOrderBookParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryOrderQuotaResponse) Reset()         { *m = QueryOrderQuotaResponse{} }
+func (m *QueryOrderQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderQuotaResponse) ProtoMessage()    {}
+func (*QueryOrderQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{32}
+}
+func (m *QueryOrderQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryOrderQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderQuotaResponse.Merge(m, src)
+}
+func (m *QueryOrderQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrderQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryOrderQuotaResponse) GetMaxOrdersPerDenom() uint64 {
+	if m != nil {
+		return m.MaxOrdersPerDenom
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryOrderBookParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOrderQuotaResponse) GetExtraOrdersPerDenom() uint64 {
+	if m != nil {
+		return m.ExtraOrdersPerDenom
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOrderBookParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOrderQuotaResponse) GetUsedOrdersPerDenom() uint64 {
+	if m != nil {
+		return m.UsedOrdersPerDenom
+	}
+	return 0
 }
 
-func (m *QueryOrderBookParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.QuoteDenomUnifiedRefAmount.Size()
-		i -= size
-		if _, err := m.QuoteDenomUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.BaseDenomUnifiedRefAmount.Size()
-		i -= size
-		if _, err := m.BaseDenomUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.QuantityStep.Size()
-		i -= size
-		if _, err := m.QuantityStep.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.PriceTick.Size()
-		i -= size
-		if _, err := m.PriceTick.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.dex.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.dex.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryOrderRequest)(nil), "coreum.dex.v1.QueryOrderRequest")
+	proto.RegisterType((*QueryOrderResponse)(nil), "coreum.dex.v1.QueryOrderResponse")
+	proto.RegisterType((*QueryOrdersRequest)(nil), "coreum.dex.v1.QueryOrdersRequest")
+	proto.RegisterType((*QueryOrdersResponse)(nil), "coreum.dex.v1.QueryOrdersResponse")
+	proto.RegisterType((*QueryOrderBooksRequest)(nil), "coreum.dex.v1.QueryOrderBooksRequest")
+	proto.RegisterType((*QueryOrderBooksResponse)(nil), "coreum.dex.v1.QueryOrderBooksResponse")
+	proto.RegisterType((*QueryOrderBookParamsRequest)(nil), "coreum.dex.v1.QueryOrderBookParamsRequest")
+	proto.RegisterType((*QueryOrderBookParamsResponse)(nil), "coreum.dex.v1.QueryOrderBookParamsResponse")
+	proto.RegisterType((*QueryOrderBookOrdersRequest)(nil), "coreum.dex.v1.QueryOrderBookOrdersRequest")
+	proto.RegisterType((*QueryOrderBookOrdersResponse)(nil), "coreum.dex.v1.QueryOrderBookOrdersResponse")
+	proto.RegisterType((*QueryOrderBookDepthRequest)(nil), "coreum.dex.v1.QueryOrderBookDepthRequest")
+	proto.RegisterType((*PriceLevel)(nil), "coreum.dex.v1.PriceLevel")
+	proto.RegisterType((*QueryOrderBookDepthResponse)(nil), "coreum.dex.v1.QueryOrderBookDepthResponse")
+	proto.RegisterType((*QuerySimulateOrderRequest)(nil), "coreum.dex.v1.QuerySimulateOrderRequest")
+	proto.RegisterType((*QuerySimulateOrderResponse)(nil), "coreum.dex.v1.QuerySimulateOrderResponse")
+	proto.RegisterType((*QueryAccountDenomOrdersCountRequest)(nil), "coreum.dex.v1.QueryAccountDenomOrdersCountRequest")
+	proto.RegisterType((*QueryAccountDenomOrdersCountResponse)(nil), "coreum.dex.v1.QueryAccountDenomOrdersCountResponse")
+	proto.RegisterType((*QueryTriggerOrdersRequest)(nil), "coreum.dex.v1.QueryTriggerOrdersRequest")
+	proto.RegisterType((*QueryTriggerOrdersResponse)(nil), "coreum.dex.v1.QueryTriggerOrdersResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "coreum.dex.v1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "coreum.dex.v1.QueryTWAPResponse")
+	proto.RegisterType((*QueryDeadManSwitchRequest)(nil), "coreum.dex.v1.QueryDeadManSwitchRequest")
+	proto.RegisterType((*QueryDeadManSwitchResponse)(nil), "coreum.dex.v1.QueryDeadManSwitchResponse")
+	proto.RegisterType((*QueryAccountTradingVolumeRequest)(nil), "coreum.dex.v1.QueryAccountTradingVolumeRequest")
+	proto.RegisterType((*QueryAccountTradingVolumeResponse)(nil), "coreum.dex.v1.QueryAccountTradingVolumeResponse")
+	proto.RegisterType((*QueryRewardProgramsRequest)(nil), "coreum.dex.v1.QueryRewardProgramsRequest")
+	proto.RegisterType((*QueryRewardProgramsResponse)(nil), "coreum.dex.v1.QueryRewardProgramsResponse")
+	proto.RegisterType((*QueryAccountRewardsRequest)(nil), "coreum.dex.v1.QueryAccountRewardsRequest")
+	proto.RegisterType((*QueryAccountRewardsResponse)(nil), "coreum.dex.v1.QueryAccountRewardsResponse")
+	proto.RegisterType((*QueryOrderQuotaRequest)(nil), "coreum.dex.v1.QueryOrderQuotaRequest")
+	proto.RegisterType((*QueryOrderQuotaResponse)(nil), "coreum.dex.v1.QueryOrderQuotaResponse")
 }
 
-func (m *QueryOrderBookOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("coreum/dex/v1/query.proto", fileDescriptor_23a17d94653a2124) }
+
+var fileDescriptor_23a17d94653a2124 = []byte{
+	// 2328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1c, 0x59,
+	0x11, 0x4f, 0x3b, 0x33, 0x63, 0xbb, 0xec, 0x71, 0xc8, 0xb3, 0x13, 0x4f, 0x3a, 0x89, 0x3f, 0x3a,
+	0x21, 0x89, 0x9d, 0xcc, 0x74, 0x3c, 0x81, 0xac, 0xd8, 0x24, 0x1b, 0xc5, 0x31, 0xde, 0x4d, 0x76,
+	0xd1, 0x3a, 0x6d, 0x07, 0x24, 0x24, 0xd4, 0x3c, 0x4f, 0x3f, 0x8f, 0x1b, 0xbb, 0xbb, 0x27, 0xdd,
+	0x6f, 0x26, 0xb6, 0x2c, 0x0b, 0x09, 0x71, 0xe0, 0x18, 0x2d, 0x48, 0xb0, 0x20, 0xc4, 0x91, 0x03,
+	0x17, 0x10, 0x42, 0xe2, 0x4f, 0xd8, 0x53, 0x58, 0x09, 0x0e, 0x08, 0x89, 0x80, 0x12, 0x24, 0xb8,
+	0x70, 0xe5, 0x8c, 0xde, 0x47, 0x4f, 0x7f, 0x4c, 0xcf, 0x47, 0xb2, 0xd6, 0x6a, 0x4f, 0x33, 0xdd,
+	0xf5, 0xab, 0xaa, 0x5f, 0xd5, 0xab, 0x57, 0x5d, 0xef, 0xc1, 0x99, 0x9a, 0xe7, 0x93, 0xa6, 0xa3,
+	0x5b, 0x64, 0x4f, 0x6f, 0x2d, 0xe9, 0x4f, 0x9a, 0xc4, 0xdf, 0xaf, 0x34, 0x7c, 0x8f, 0x7a, 0xa8,
+	0x28, 0x44, 0x15, 0x8b, 0xec, 0x55, 0x5a, 0x4b, 0x6a, 0x0a, 0x49, 0x5a, 0xc4, 0xa5, 0x02, 0x99,
+	0x16, 0x79, 0xbe, 0x45, 0x7c, 0x29, 0x52, 0x93, 0xa2, 0x06, 0xf6, 0xb1, 0x13, 0x48, 0xd9, 0x62,
+	0xcd, 0x0b, 0x1c, 0x2f, 0xd0, 0x37, 0x71, 0x40, 0x84, 0x67, 0xbd, 0xb5, 0xb4, 0x49, 0x28, 0x66,
+	0xb8, 0xba, 0xed, 0x62, 0x6a, 0x7b, 0xae, 0xc4, 0xce, 0xc4, 0xb1, 0x21, 0xaa, 0xe6, 0xd9, 0xa1,
+	0xfc, 0xac, 0x94, 0x87, 0x66, 0xe2, 0x91, 0xa8, 0x53, 0x75, 0xaf, 0xee, 0xf1, 0xbf, 0x3a, 0xfb,
+	0x27, 0xdf, 0x9e, 0xab, 0x7b, 0x5e, 0x7d, 0x97, 0xe8, 0xb8, 0x61, 0xeb, 0xd8, 0x75, 0x3d, 0xca,
+	0xfd, 0x85, 0xe4, 0x66, 0xa5, 0x94, 0x3f, 0x6d, 0x36, 0xb7, 0x74, 0x6a, 0x3b, 0x24, 0xa0, 0xd8,
+	0x69, 0x08, 0x80, 0x36, 0x05, 0xe8, 0x11, 0xf3, 0xb1, 0xc6, 0x43, 0x32, 0xc8, 0x93, 0x26, 0x09,
+	0xa8, 0xf6, 0x10, 0x26, 0x13, 0x6f, 0x83, 0x86, 0xe7, 0x06, 0x04, 0xdd, 0x80, 0x82, 0x08, 0xbd,
+	0xa4, 0xcc, 0x29, 0x57, 0xc6, 0xaa, 0xa7, 0x2a, 0x89, 0xe4, 0x56, 0x04, 0x7c, 0x39, 0xf7, 0xc9,
+	0x8b, 0xd9, 0x63, 0x86, 0x84, 0x6a, 0x77, 0xe0, 0x24, 0xb7, 0xf5, 0x21, 0xcb, 0xa7, 0x74, 0x80,
+	0x4a, 0x30, 0x5c, 0xf3, 0x09, 0xa6, 0x9e, 0xcf, 0x4d, 0x8d, 0x1a, 0xe1, 0x23, 0x9a, 0x80, 0x21,
+	0xdb, 0x2a, 0x0d, 0xf1, 0x97, 0x43, 0xb6, 0xa5, 0xad, 0x02, 0x8a, 0xab, 0x4b, 0x26, 0xd7, 0x21,
+	0xcf, 0xd7, 0x47, 0x12, 0x99, 0x4a, 0x11, 0xe1, 0x60, 0xc9, 0x43, 0x00, 0xb5, 0x56, 0xdc, 0x4e,
+	0xd0, 0x9f, 0xc7, 0x2a, 0x40, 0xb4, 0x7c, 0x9c, 0xcf, 0x58, 0xf5, 0x52, 0x45, 0xac, 0x4f, 0x85,
+	0xad, 0x5f, 0x45, 0xac, 0x8d, 0x5c, 0xc5, 0xca, 0x1a, 0xae, 0x13, 0x69, 0xd5, 0x88, 0x69, 0x6a,
+	0x1f, 0x29, 0x30, 0x99, 0x70, 0x2c, 0x23, 0xa8, 0x42, 0x81, 0x13, 0x63, 0xb9, 0x3c, 0xde, 0x27,
+	0x04, 0x89, 0x44, 0xef, 0x66, 0x70, 0xba, 0xdc, 0x97, 0x93, 0x70, 0x98, 0x20, 0xf5, 0x5d, 0x38,
+	0x1d, 0x71, 0x5a, 0xf6, 0xbc, 0x9d, 0x76, 0x42, 0x92, 0x61, 0x2b, 0x6f, 0x1c, 0xf6, 0xaf, 0x15,
+	0x98, 0xee, 0x70, 0x21, 0x43, 0xbf, 0x0f, 0x63, 0x3c, 0x20, 0x73, 0x93, 0xbd, 0x96, 0xf1, 0x9f,
+	0xcb, 0x8c, 0xdf, 0xf3, 0x76, 0x56, 0x30, 0xc5, 0x32, 0x0f, 0xe0, 0xb5, 0x8d, 0x1d, 0x5d, 0x2e,
+	0xbe, 0x03, 0x67, 0x93, 0x44, 0x13, 0x5b, 0x01, 0x9d, 0x07, 0x60, 0xd6, 0x4c, 0x8b, 0xb8, 0x9e,
+	0x23, 0x8b, 0x64, 0x94, 0xbd, 0x59, 0x61, 0x2f, 0xd0, 0x2c, 0x8c, 0x3d, 0x69, 0x7a, 0x34, 0x94,
+	0x8b, 0xba, 0x05, 0xfe, 0x8a, 0x03, 0xb4, 0xe7, 0x79, 0x38, 0x97, 0x6d, 0x5f, 0x66, 0xe3, 0x1a,
+	0x40, 0xc3, 0xb7, 0x6b, 0xc4, 0xa4, 0x76, 0x6d, 0x47, 0x38, 0x58, 0x2e, 0xb2, 0x70, 0xff, 0xf6,
+	0x62, 0x36, 0xbf, 0xc6, 0x24, 0xc6, 0x28, 0x07, 0x6c, 0xd8, 0xb5, 0x1d, 0xb4, 0x0c, 0xc5, 0x27,
+	0x4d, 0xec, 0x52, 0x9b, 0xee, 0x9b, 0x01, 0x25, 0x0d, 0xe1, 0x71, 0xf9, 0xbc, 0x54, 0x38, 0x25,
+	0x12, 0x10, 0x58, 0x3b, 0x15, 0xdb, 0xd3, 0x1d, 0x4c, 0xb7, 0x2b, 0x0f, 0x5c, 0x6a, 0x8c, 0x87,
+	0x3a, 0xeb, 0x94, 0x34, 0x10, 0x81, 0xf3, 0x51, 0x48, 0x66, 0xd3, 0xb5, 0xb7, 0x6c, 0x62, 0x99,
+	0x3e, 0xd9, 0x32, 0xb1, 0xe3, 0x35, 0x5d, 0x5a, 0x3a, 0xce, 0x6d, 0x5e, 0x90, 0x36, 0xcf, 0x76,
+	0xda, 0xfc, 0x80, 0xd4, 0x71, 0x6d, 0x7f, 0x85, 0xd4, 0x8c, 0x33, 0xed, 0x54, 0x3c, 0x16, 0x76,
+	0x0c, 0xb2, 0x75, 0x8f, 0x5b, 0x41, 0x75, 0x98, 0x89, 0xa5, 0x26, 0xcb, 0x4f, 0x6e, 0x70, 0x3f,
+	0x6a, 0x94, 0xd2, 0x0e, 0x47, 0x0f, 0x60, 0xc2, 0xc1, 0x3b, 0xc4, 0x37, 0xb7, 0x08, 0x31, 0x7d,
+	0x4c, 0x49, 0x29, 0x3f, 0xb8, 0xe1, 0x71, 0xae, 0xba, 0x4a, 0x88, 0x81, 0x29, 0x61, 0xa6, 0x68,
+	0xd2, 0x54, 0xe1, 0x35, 0x4c, 0xd1, 0xb8, 0xa9, 0x9b, 0x50, 0x08, 0x28, 0xa6, 0xcd, 0xa0, 0x34,
+	0x3c, 0xa7, 0x5c, 0x99, 0xa8, 0xce, 0x74, 0x2b, 0xf0, 0x75, 0x8e, 0x32, 0x24, 0x1a, 0xdd, 0x86,
+	0x71, 0xc7, 0x76, 0xcd, 0x70, 0xc5, 0x4a, 0x23, 0x9c, 0xc0, 0x99, 0xee, 0x8b, 0x3b, 0xe6, 0xd8,
+	0xee, 0x23, 0x89, 0x46, 0x15, 0x98, 0xdc, 0xc6, 0xbb, 0x94, 0x58, 0x66, 0xd3, 0xa5, 0xf6, 0xae,
+	0xb9, 0x4d, 0xec, 0xfa, 0x36, 0x2d, 0x8d, 0xce, 0x29, 0x57, 0x8e, 0x1b, 0x27, 0x85, 0xe8, 0x31,
+	0x93, 0xbc, 0xc7, 0x05, 0xe8, 0x3a, 0x4c, 0xe1, 0x66, 0x8d, 0x6d, 0x84, 0xa4, 0x02, 0x70, 0x05,
+	0x24, 0x65, 0x31, 0x0d, 0xed, 0xb9, 0x92, 0xde, 0x30, 0xc9, 0x96, 0xfa, 0x19, 0x37, 0x0c, 0xba,
+	0x0c, 0xb9, 0xc0, 0xb6, 0x08, 0x2f, 0xc2, 0x89, 0xea, 0x64, 0x2a, 0x6b, 0xeb, 0xb6, 0x45, 0x0c,
+	0x0e, 0x48, 0xb5, 0xaa, 0xdc, 0x1b, 0xb7, 0xaa, 0x5f, 0x28, 0x70, 0x2e, 0x3b, 0xa0, 0x2f, 0x42,
+	0xab, 0xf6, 0x41, 0x4d, 0x92, 0x5b, 0x21, 0x0d, 0xba, 0x7d, 0x54, 0xc9, 0x9e, 0x82, 0xfc, 0xae,
+	0xed, 0xd8, 0x62, 0xcb, 0x17, 0x0d, 0xf1, 0xa0, 0xfd, 0x46, 0x01, 0xe0, 0x9d, 0xe7, 0x03, 0xd2,
+	0x22, 0xbb, 0xe8, 0x02, 0xe4, 0x79, 0x03, 0xca, 0x6e, 0x4e, 0x42, 0x86, 0x1e, 0xc3, 0xb4, 0x4f,
+	0x1c, 0x6c, 0xbb, 0xb6, 0x5b, 0x37, 0x39, 0xa7, 0x76, 0x05, 0x0f, 0xd4, 0xa2, 0x4e, 0xb5, 0xb5,
+	0x97, 0x71, 0x40, 0xda, 0xf5, 0x3c, 0x0f, 0xe3, 0x22, 0xa3, 0x66, 0xad, 0xdd, 0x9a, 0x72, 0x86,
+	0xf8, 0x7e, 0x04, 0xf7, 0xd9, 0x2b, 0xed, 0x7f, 0x1d, 0x05, 0x29, 0x53, 0xd4, 0x9e, 0x5a, 0x72,
+	0x9b, 0xb6, 0x15, 0x2e, 0xde, 0x99, 0xf4, 0xcc, 0xd2, 0x8e, 0x53, 0xae, 0x20, 0x07, 0x33, 0x25,
+	0x1c, 0xec, 0x04, 0xa5, 0xa1, 0x01, 0x95, 0x18, 0x18, 0x5d, 0x84, 0x91, 0x4d, 0x12, 0x50, 0x73,
+	0xd3, 0xb6, 0x64, 0x0f, 0x1d, 0x8d, 0xf2, 0x34, 0xcc, 0x44, 0xcb, 0xb6, 0xd5, 0x46, 0xe1, 0x60,
+	0xa7, 0x94, 0xcb, 0x44, 0xdd, 0x0b, 0x76, 0xd0, 0x3c, 0x14, 0x82, 0x86, 0x4f, 0xb0, 0x55, 0xca,
+	0xa7, 0x31, 0x52, 0xa0, 0xfd, 0x67, 0x08, 0xce, 0xf0, 0xc0, 0xd7, 0x6d, 0xa7, 0xb9, 0x8b, 0x29,
+	0x19, 0x70, 0xc4, 0xba, 0x06, 0x39, 0xba, 0xdf, 0x20, 0x7c, 0x5d, 0x26, 0xaa, 0xa5, 0xac, 0x6a,
+	0xde, 0xd8, 0x6f, 0x10, 0x83, 0xa3, 0x52, 0x25, 0x76, 0xbc, 0x4f, 0x89, 0xe5, 0x3a, 0x4a, 0x6c,
+	0x36, 0xac, 0x9e, 0x8e, 0x38, 0x64, 0xe5, 0x7c, 0x0d, 0x46, 0xda, 0xa5, 0x52, 0x18, 0xa4, 0x54,
+	0xda, 0xf0, 0x76, 0xaf, 0x18, 0xee, 0xd7, 0x2b, 0xde, 0x81, 0x22, 0x9b, 0x7c, 0x4d, 0xdb, 0x35,
+	0xb7, 0x3c, 0xbf, 0x46, 0x78, 0x57, 0x9d, 0xa8, 0xaa, 0x29, 0x8d, 0x0d, 0xdb, 0x21, 0x0f, 0xdc,
+	0x55, 0x86, 0x30, 0xc6, 0x68, 0xf4, 0xa0, 0xfd, 0x3d, 0x0f, 0x6a, 0x56, 0xaa, 0x65, 0x89, 0xad,
+	0xc3, 0x69, 0xb2, 0x47, 0x6a, 0x4d, 0xd6, 0x77, 0x93, 0xb5, 0xaf, 0x0c, 0x12, 0xd0, 0x54, 0xa8,
+	0x9c, 0x28, 0xfd, 0xc7, 0x30, 0xdd, 0x36, 0x2a, 0x52, 0xfc, 0x9a, 0x3b, 0x2a, 0xd4, 0x7e, 0xc4,
+	0x94, 0xe3, 0x66, 0xbb, 0x6d, 0xd4, 0xe3, 0x9f, 0x61, 0xa3, 0x9e, 0x86, 0xc2, 0x96, 0xbd, 0xbb,
+	0x4b, 0x2c, 0x5e, 0x02, 0x23, 0x86, 0x7c, 0x42, 0x15, 0x28, 0xe2, 0x16, 0xf1, 0x71, 0x9d, 0x98,
+	0x5d, 0xca, 0x60, 0x5c, 0xca, 0xf9, 0x13, 0xba, 0x02, 0xc0, 0x77, 0x87, 0x00, 0x17, 0xd2, 0xe0,
+	0x51, 0x26, 0x14, 0xc8, 0xbb, 0x30, 0x12, 0xec, 0xda, 0x8d, 0x06, 0xae, 0x8b, 0x02, 0x18, 0xf0,
+	0x2b, 0xdd, 0x56, 0x42, 0x6f, 0x41, 0x81, 0xfa, 0xd8, 0x22, 0x41, 0x69, 0x24, 0x73, 0x97, 0x7f,
+	0x9d, 0x1d, 0x0e, 0x37, 0x18, 0x22, 0x6c, 0xee, 0x02, 0x8e, 0xea, 0x30, 0xda, 0x9e, 0x12, 0xf8,
+	0xa7, 0x55, 0xe8, 0x46, 0xbd, 0x3d, 0xec, 0xea, 0xf7, 0x3d, 0xdb, 0x5d, 0xd6, 0x25, 0xab, 0xcb,
+	0x75, 0x9b, 0x6e, 0x37, 0x37, 0x2b, 0x35, 0xcf, 0xd1, 0x05, 0x58, 0xfe, 0x94, 0x03, 0x6b, 0x47,
+	0x67, 0x7b, 0x2d, 0xe0, 0x0a, 0xc6, 0x48, 0x38, 0x47, 0x30, 0x47, 0xed, 0xc9, 0xa6, 0x04, 0x47,
+	0xef, 0x28, 0x9c, 0x7d, 0xb4, 0xc7, 0x70, 0x81, 0x97, 0xf7, 0xbd, 0x1a, 0x6f, 0xb3, 0x7c, 0xe7,
+	0x7e, 0x18, 0xf5, 0xd8, 0x58, 0x4f, 0xc1, 0x02, 0x11, 0xf6, 0x14, 0xf9, 0xc8, 0x3e, 0x24, 0xf1,
+	0x6f, 0x8c, 0x78, 0xd0, 0x6e, 0xc3, 0xc5, 0xde, 0x66, 0xe5, 0xfe, 0x99, 0x82, 0x7c, 0x64, 0x35,
+	0x67, 0x88, 0x07, 0xed, 0x50, 0xb6, 0xb7, 0x0d, 0xdf, 0xae, 0xd7, 0x89, 0xff, 0x79, 0x9f, 0xdc,
+	0x3e, 0x56, 0x40, 0xcd, 0xf2, 0xff, 0x45, 0x98, 0x0a, 0xfe, 0xa2, 0xc0, 0x97, 0x04, 0xb7, 0x6f,
+	0xdd, 0x5b, 0x3b, 0xaa, 0x61, 0xe0, 0x3e, 0x40, 0x40, 0xb1, 0x4f, 0x4d, 0xd6, 0xf9, 0x78, 0x33,
+	0x18, 0xab, 0xaa, 0x15, 0x71, 0x83, 0x50, 0x09, 0x6f, 0x10, 0x2a, 0x1b, 0xe1, 0x0d, 0xc2, 0xf2,
+	0x08, 0x8b, 0xed, 0xd9, 0x3f, 0x66, 0x15, 0x63, 0x94, 0xeb, 0x31, 0x09, 0xba, 0x05, 0x23, 0xc4,
+	0xb5, 0x84, 0x89, 0x5c, 0x5f, 0x13, 0x39, 0xae, 0x3e, 0x4c, 0x5c, 0x8b, 0xbd, 0xd3, 0x36, 0xe0,
+	0x64, 0x2c, 0x2a, 0x99, 0xe8, 0xbb, 0x90, 0xa3, 0x4f, 0x71, 0x43, 0xb6, 0xd2, 0xab, 0x03, 0xec,
+	0xf1, 0x97, 0x2f, 0x66, 0x73, 0xdc, 0x04, 0x57, 0xd4, 0xbe, 0x2a, 0xeb, 0x68, 0x85, 0x60, 0xeb,
+	0x1b, 0xd8, 0x5d, 0x7f, 0x6a, 0xd3, 0xda, 0x76, 0xdf, 0x92, 0xd6, 0xb6, 0x41, 0xcd, 0x52, 0x93,
+	0xac, 0x1e, 0xc2, 0x09, 0x8b, 0x60, 0xcb, 0x74, 0xb0, 0x6b, 0x06, 0x5c, 0x24, 0x4f, 0xcb, 0xe9,
+	0x83, 0x6c, 0x42, 0x5d, 0xd6, 0x43, 0xd1, 0x8a, 0xbf, 0xd4, 0x6e, 0xc3, 0x5c, 0x7c, 0x9b, 0xb0,
+	0x96, 0x63, 0xbb, 0xf5, 0x6f, 0x7a, 0xbb, 0x4d, 0x87, 0xf4, 0xe7, 0xf9, 0x5f, 0x05, 0xe6, 0x7b,
+	0xa8, 0x4b, 0xbe, 0xb7, 0xa0, 0xd0, 0xe2, 0x6f, 0x4a, 0xca, 0xe0, 0xbd, 0x52, 0xaa, 0x20, 0x04,
+	0x39, 0x6a, 0x13, 0x9f, 0xd7, 0x4c, 0xd1, 0xe0, 0xff, 0x91, 0x0e, 0x53, 0x0e, 0xde, 0x33, 0xe5,
+	0x74, 0xd6, 0x20, 0x7e, 0x6c, 0x42, 0xc8, 0x19, 0x27, 0x1d, 0xbc, 0x27, 0x36, 0xcc, 0x1a, 0xf1,
+	0x45, 0x79, 0xad, 0xc2, 0x38, 0x3b, 0x55, 0x59, 0x76, 0x50, 0x7b, 0xdd, 0xd3, 0xdf, 0xd8, 0x16,
+	0x21, 0x2b, 0x52, 0x4f, 0xb3, 0xe4, 0xba, 0x18, 0xe4, 0x29, 0xf6, 0xad, 0x35, 0xdf, 0xab, 0xc7,
+	0xcf, 0xeb, 0x47, 0x75, 0x81, 0xf1, 0xfb, 0x70, 0xaa, 0x4c, 0xbb, 0x91, 0xf9, 0x7c, 0x1f, 0x4e,
+	0xf8, 0x5c, 0x62, 0x36, 0xa4, 0xa8, 0xcb, 0x45, 0x46, 0x42, 0x5f, 0xae, 0xff, 0x84, 0x9f, 0x30,
+	0x7a, 0x74, 0x7d, 0xe1, 0x26, 0xa8, 0xf1, 0x52, 0x10, 0xbe, 0x83, 0xfe, 0x35, 0xf4, 0x3d, 0x38,
+	0x9b, 0xa9, 0x17, 0x05, 0x2b, 0x91, 0xa6, 0x60, 0xde, 0x2d, 0xd8, 0x84, 0x7e, 0x18, 0x2c, 0x4e,
+	0x18, 0xd5, 0xaa, 0xf1, 0xcb, 0x27, 0x36, 0x9b, 0xe0, 0xfe, 0xfc, 0x7e, 0x36, 0x04, 0xd3, 0x1d,
+	0x4a, 0x92, 0x5c, 0xb7, 0x42, 0x54, 0xba, 0x15, 0xe2, 0x0d, 0x36, 0xad, 0x51, 0x1f, 0x77, 0xaa,
+	0x0c, 0x71, 0x95, 0x49, 0x2e, 0x4d, 0x29, 0x59, 0x30, 0xec, 0x93, 0x80, 0xf8, 0xad, 0xb0, 0x33,
+	0x1e, 0xe5, 0x87, 0x38, 0x34, 0x8d, 0x96, 0xe0, 0x54, 0x33, 0x20, 0x56, 0x27, 0xb3, 0x1c, 0x67,
+	0x86, 0x98, 0x30, 0x49, 0xac, 0xfa, 0xa7, 0x49, 0xc8, 0xf3, 0xd4, 0xa0, 0x00, 0x0a, 0xe2, 0x6e,
+	0x09, 0xcd, 0xa7, 0x96, 0xa5, 0xf3, 0x8a, 0x57, 0xd5, 0x7a, 0x41, 0x44, 0x66, 0x35, 0xed, 0x47,
+	0xff, 0xfe, 0xed, 0xa2, 0xf2, 0x83, 0x3f, 0xff, 0xeb, 0xc7, 0x43, 0xd3, 0xe8, 0x94, 0x9e, 0x75,
+	0x09, 0x8e, 0xbe, 0x0f, 0x79, 0x4e, 0x08, 0xcd, 0x65, 0x19, 0x8c, 0x9f, 0x48, 0xd4, 0xf9, 0x1e,
+	0x08, 0xe9, 0x71, 0x29, 0xf2, 0x78, 0x09, 0x5d, 0xd4, 0x33, 0x6e, 0xe4, 0x03, 0xfd, 0x40, 0x7e,
+	0xe8, 0x0f, 0xf5, 0x03, 0xdb, 0x3a, 0x44, 0x87, 0x50, 0x10, 0x19, 0x41, 0xdd, 0xed, 0xf7, 0x8e,
+	0x3a, 0xf9, 0x61, 0xd7, 0xae, 0x45, 0x1c, 0xe6, 0xd1, 0x6c, 0x1f, 0x0e, 0xe8, 0x87, 0x0a, 0x40,
+	0x74, 0xc7, 0x89, 0xbe, 0xdc, 0xd5, 0x41, 0xfc, 0x9a, 0x55, 0xbd, 0xd4, 0x0f, 0x26, 0xb9, 0x5c,
+	0x8e, 0xb8, 0x9c, 0x43, 0x6a, 0x16, 0x97, 0x32, 0xbf, 0x44, 0x45, 0x1f, 0x2b, 0x70, 0x22, 0x75,
+	0xc3, 0x88, 0x16, 0x7b, 0x3a, 0x49, 0x96, 0xc3, 0xd5, 0x81, 0xb0, 0x92, 0x55, 0x39, 0x62, 0xa5,
+	0xa1, 0xb9, 0xae, 0xac, 0xca, 0xb2, 0x44, 0xfe, 0x10, 0xe7, 0x26, 0xd7, 0xaa, 0x37, 0xb7, 0xe4,
+	0xa2, 0x5d, 0x1d, 0x08, 0x2b, 0xb9, 0x3d, 0x88, 0xb8, 0xbd, 0x83, 0x6e, 0x77, 0xcf, 0x98, 0x7e,
+	0x10, 0xcd, 0x4a, 0x87, 0xfa, 0x41, 0x6c, 0x32, 0x3a, 0x94, 0x8b, 0x8c, 0x7e, 0xa7, 0xc0, 0x44,
+	0xf2, 0x4e, 0x01, 0x2d, 0xf4, 0xa4, 0x12, 0xbf, 0x9a, 0x51, 0x17, 0x07, 0x81, 0x4a, 0xd2, 0xef,
+	0x45, 0xa4, 0xef, 0xa0, 0x5b, 0x6f, 0x46, 0xda, 0xe2, 0x04, 0x9f, 0x29, 0x50, 0x4c, 0x9c, 0x51,
+	0xd1, 0x95, 0x2c, 0x1e, 0x59, 0x37, 0x06, 0xea, 0xc2, 0x00, 0x48, 0x49, 0x78, 0x31, 0x22, 0x3c,
+	0x8b, 0xce, 0xa7, 0x08, 0x07, 0x52, 0xa5, 0xcc, 0x99, 0xa3, 0xe7, 0x0a, 0x4c, 0x77, 0x39, 0x00,
+	0xa0, 0x6a, 0x96, 0xcb, 0xde, 0x87, 0x10, 0xf5, 0xc6, 0x6b, 0xe9, 0x48, 0xc2, 0x0f, 0x23, 0xc2,
+	0x77, 0xd1, 0x9d, 0x14, 0x61, 0xf9, 0x95, 0x09, 0xf4, 0x03, 0xf9, 0x8f, 0x65, 0xd3, 0xf5, 0x9c,
+	0x40, 0x3f, 0x48, 0x54, 0x44, 0x99, 0x0b, 0xd1, 0xcf, 0x15, 0x28, 0x26, 0xce, 0x04, 0xd9, 0x39,
+	0xce, 0x3a, 0xb6, 0xa8, 0x0b, 0x03, 0x20, 0x25, 0xe5, 0xaf, 0x44, 0x94, 0x17, 0xd0, 0xe5, 0x14,
+	0x65, 0x2a, 0x54, 0xca, 0x1d, 0xfd, 0xe8, 0x23, 0x05, 0xf8, 0xec, 0x8b, 0x66, 0x33, 0x3d, 0x45,
+	0xc7, 0x05, 0x75, 0xae, 0x3b, 0x40, 0x32, 0x78, 0x37, 0x62, 0x70, 0x1b, 0xbd, 0xfd, 0x66, 0x65,
+	0xc9, 0x26, 0x70, 0xf4, 0x4b, 0x05, 0x8a, 0x89, 0x39, 0x38, 0x3b, 0x63, 0x59, 0x03, 0xba, 0xba,
+	0x30, 0x00, 0x52, 0xf2, 0x7d, 0x2b, 0xe2, 0x7b, 0x0d, 0x2d, 0xa6, 0xf8, 0xb2, 0x91, 0xbb, 0xec,
+	0x60, 0xb7, 0x2c, 0xa6, 0x75, 0x12, 0x5b, 0x6d, 0xf4, 0x47, 0x05, 0xa6, 0xb2, 0xa6, 0x67, 0xa4,
+	0xf7, 0xa8, 0xb5, 0xac, 0x31, 0x5d, 0xbd, 0x3e, 0xb8, 0x82, 0x24, 0x7d, 0x27, 0x22, 0x5d, 0x45,
+	0xd7, 0xfb, 0x57, 0x26, 0x15, 0x56, 0xca, 0x72, 0x34, 0xff, 0x89, 0x02, 0x13, 0xc9, 0x11, 0x35,
+	0xbb, 0x49, 0x65, 0x4e, 0xcb, 0xea, 0xe2, 0x20, 0x50, 0x49, 0xf4, 0x6a, 0x44, 0x74, 0x0e, 0xcd,
+	0xa4, 0x88, 0x8a, 0xb1, 0xb0, 0x1c, 0xce, 0xc2, 0xe8, 0x57, 0x0a, 0x4c, 0x24, 0x87, 0xc9, 0x6c,
+	0x5a, 0x99, 0x83, 0xaa, 0xba, 0x38, 0x08, 0x54, 0xd2, 0xba, 0x19, 0xd1, 0xba, 0x8a, 0x16, 0xfa,
+	0xe7, 0x4f, 0x0e, 0xb0, 0xe8, 0xa7, 0xe1, 0x87, 0x9b, 0x4f, 0x93, 0x3d, 0x3e, 0xdc, 0xf1, 0x11,
+	0x55, 0xbd, 0xd4, 0x0f, 0x26, 0x59, 0xbd, 0x1d, 0xb1, 0xd2, 0x51, 0xb9, 0x3f, 0x2b, 0xb1, 0x9b,
+	0xd8, 0xce, 0xc1, 0xcb, 0xef, 0x7f, 0xf2, 0x72, 0x46, 0xf9, 0xf4, 0xe5, 0x8c, 0xf2, 0xcf, 0x97,
+	0x33, 0xca, 0xb3, 0x57, 0x33, 0xc7, 0x3e, 0x7d, 0x35, 0x73, 0xec, 0xaf, 0xaf, 0x66, 0x8e, 0x7d,
+	0x7b, 0x29, 0x36, 0x50, 0xde, 0xe7, 0x26, 0x57, 0xbd, 0xa6, 0x6b, 0xf1, 0xd9, 0x3f, 0xf4, 0xd1,
+	0xba, 0xa9, 0xef, 0x71, 0x47, 0x7c, 0xbe, 0xdc, 0x2c, 0xf0, 0x53, 0xf7, 0x8d, 0xff, 0x0f, 0x00,
+	0x43, 0xfd, 0xef, 0xdd, 0x23, 0x21, 0x00, 0x00,
 }
 
-func (m *QueryOrderBookOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/dex module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Order queries order by creator and ID.
+	Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error)
+	// Orders queries creator orders.
+	Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
+	// OrderBooks queries order books.
+	OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error)
+	// OrderBookParams queries order book params.
+	OrderBookParams(ctx context.Context, in *QueryOrderBookParamsRequest, opts ...grpc.CallOption) (*QueryOrderBookParamsResponse, error)
+	// OrderBookOrders queries order book orders.
+	OrderBookOrders(ctx context.Context, in *QueryOrderBookOrdersRequest, opts ...grpc.CallOption) (*QueryOrderBookOrdersResponse, error)
+	// OrderBookDepth queries order book price levels aggregated by price.
+	OrderBookDepth(ctx context.Context, in *QueryOrderBookDepthRequest, opts ...grpc.CallOption) (*QueryOrderBookDepthResponse, error)
+	// SimulateOrder simulates the matching of the order without placing it.
+	SimulateOrder(ctx context.Context, in *QuerySimulateOrderRequest, opts ...grpc.CallOption) (*QuerySimulateOrderResponse, error)
+	// AccountDenomOrdersCount queries account denom orders count.
+	AccountDenomOrdersCount(ctx context.Context, in *QueryAccountDenomOrdersCountRequest, opts ...grpc.CallOption) (*QueryAccountDenomOrdersCountResponse, error)
+	// TriggerOrders queries creator trigger orders which are not activated yet.
+	TriggerOrders(ctx context.Context, in *QueryTriggerOrdersRequest, opts ...grpc.CallOption) (*QueryTriggerOrdersResponse, error)
+	// TWAP queries the time-weighted average price of the order book.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// DeadManSwitch queries the dead man's switch of the account.
+	DeadManSwitch(ctx context.Context, in *QueryDeadManSwitchRequest, opts ...grpc.CallOption) (*QueryDeadManSwitchResponse, error)
+	// AccountTradingVolume queries the trading volume of the account within the window and its volume tier.
+	AccountTradingVolume(ctx context.Context, in *QueryAccountTradingVolumeRequest, opts ...grpc.CallOption) (*QueryAccountTradingVolumeResponse, error)
+	// RewardPrograms queries the reward programs of the order books.
+	RewardPrograms(ctx context.Context, in *QueryRewardProgramsRequest, opts ...grpc.CallOption) (*QueryRewardProgramsResponse, error)
+	// AccountRewards queries the rewards accrued by the account in the reward programs.
+	AccountRewards(ctx context.Context, in *QueryAccountRewardsRequest, opts ...grpc.CallOption) (*QueryAccountRewardsResponse, error)
+	// OrderQuota queries the order quota of the account and its usage.
+	OrderQuota(ctx context.Context, in *QueryOrderQuotaRequest, opts ...grpc.CallOption) (*QueryOrderQuotaResponse, error)
 }
 
-func (m *QueryOrderBookOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Side != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryOrderBookOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOrderBookOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error) {
+	out := new(QueryOrderResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Order", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOrderBookOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
+	out := new(QueryOrdersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Orders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAccountDenomOrdersCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error) {
+	out := new(QueryOrderBooksResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBooks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAccountDenomOrdersCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OrderBookParams(ctx context.Context, in *QueryOrderBookParamsRequest, opts ...grpc.CallOption) (*QueryOrderBookParamsResponse, error) {
+	out := new(QueryOrderBookParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAccountDenomOrdersCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) OrderBookOrders(ctx context.Context, in *QueryOrderBookOrdersRequest, opts ...grpc.CallOption) (*QueryOrderBookOrdersResponse, error) {
+	out := new(QueryOrderBookOrdersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) OrderBookDepth(ctx context.Context, in *QueryOrderBookDepthRequest, opts ...grpc.CallOption) (*QueryOrderBookDepthResponse, error) {
+	out := new(QueryOrderBookDepthResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAccountDenomOrdersCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateOrder(ctx context.Context, in *QuerySimulateOrderRequest, opts ...grpc.CallOption) (*QuerySimulateOrderResponse, error) {
+	out := new(QuerySimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/SimulateOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAccountDenomOrdersCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountDenomOrdersCount(ctx context.Context, in *QueryAccountDenomOrdersCountRequest, opts ...grpc.CallOption) (*QueryAccountDenomOrdersCountResponse, error) {
+	out := new(QueryAccountDenomOrdersCountResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/AccountDenomOrdersCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAccountDenomOrdersCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) TriggerOrders(ctx context.Context, in *QueryTriggerOrdersRequest, opts ...grpc.CallOption) (*QueryTriggerOrdersResponse, error) {
+	out := new(QueryTriggerOrdersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/TriggerOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) DeadManSwitch(ctx context.Context, in *QueryDeadManSwitchRequest, opts ...grpc.CallOption) (*QueryDeadManSwitchResponse, error) {
+	out := new(QueryDeadManSwitchResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/DeadManSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AccountTradingVolume(ctx context.Context, in *QueryAccountTradingVolumeRequest, opts ...grpc.CallOption) (*QueryAccountTradingVolumeResponse, error) {
+	out := new(QueryAccountTradingVolumeResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/AccountTradingVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RewardPrograms(ctx context.Context, in *QueryRewardProgramsRequest, opts ...grpc.CallOption) (*QueryRewardProgramsResponse, error) {
+	out := new(QueryRewardProgramsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/RewardPrograms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AccountRewards(ctx context.Context, in *QueryAccountRewardsRequest, opts ...grpc.CallOption) (*QueryAccountRewardsResponse, error) {
+	out := new(QueryAccountRewardsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/AccountRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) OrderQuota(ctx context.Context, in *QueryOrderQuotaRequest, opts ...grpc.CallOption) (*QueryOrderQuotaResponse, error) {
+	out := new(QueryOrderQuotaResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Order.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/dex module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Order queries order by creator and ID.
+	Order(context.Context, *QueryOrderRequest) (*QueryOrderResponse, error)
+	// Orders queries creator orders.
+	Orders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error)
+	// OrderBooks queries order books.
+	OrderBooks(context.Context, *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error)
+	// OrderBookParams queries order book params.
+	OrderBookParams(context.Context, *QueryOrderBookParamsRequest) (*QueryOrderBookParamsResponse, error)
+	// OrderBookOrders queries order book orders.
+	OrderBookOrders(context.Context, *QueryOrderBookOrdersRequest) (*QueryOrderBookOrdersResponse, error)
+	// OrderBookDepth queries order book price levels aggregated by price.
+	OrderBookDepth(context.Context, *QueryOrderBookDepthRequest) (*QueryOrderBookDepthResponse, error)
+	// SimulateOrder simulates the matching of the order without placing it.
+	SimulateOrder(context.Context, *QuerySimulateOrderRequest) (*QuerySimulateOrderResponse, error)
+	// AccountDenomOrdersCount queries account denom orders count.
+	AccountDenomOrdersCount(context.Context, *QueryAccountDenomOrdersCountRequest) (*QueryAccountDenomOrdersCountResponse, error)
+	// TriggerOrders queries creator trigger orders which are not activated yet.
+	TriggerOrders(context.Context, *QueryTriggerOrdersRequest) (*QueryTriggerOrdersResponse, error)
+	// TWAP queries the time-weighted average price of the order book.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// DeadManSwitch queries the dead man's switch of the account.
+	DeadManSwitch(context.Context, *QueryDeadManSwitchRequest) (*QueryDeadManSwitchResponse, error)
+	// AccountTradingVolume queries the trading volume of the account within the window and its volume tier.
+	AccountTradingVolume(context.Context, *QueryAccountTradingVolumeRequest) (*QueryAccountTradingVolumeResponse, error)
+	// RewardPrograms queries the reward programs of the order books.
+	RewardPrograms(context.Context, *QueryRewardProgramsRequest) (*QueryRewardProgramsResponse, error)
+	// AccountRewards queries the rewards accrued by the account in the reward programs.
+	AccountRewards(context.Context, *QueryAccountRewardsRequest) (*QueryAccountRewardsResponse, error)
+	// OrderQuota queries the order quota of the account and its usage.
+	OrderQuota(context.Context, *QueryOrderQuotaRequest) (*QueryOrderQuotaResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Order(ctx context.Context, req *QueryOrderRequest) (*QueryOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Order not implemented")
+}
+func (*UnimplementedQueryServer) Orders(ctx context.Context, req *QueryOrdersRequest) (*QueryOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Orders not implemented")
+}
+func (*UnimplementedQueryServer) OrderBooks(ctx context.Context, req *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBooks not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookParams(ctx context.Context, req *QueryOrderBookParamsRequest) (*QueryOrderBookParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookParams not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookOrders(ctx context.Context, req *QueryOrderBookOrdersRequest) (*QueryOrderBookOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookOrders not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookDepth(ctx context.Context, req *QueryOrderBookDepthRequest) (*QueryOrderBookDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookDepth not implemented")
+}
+func (*UnimplementedQueryServer) SimulateOrder(ctx context.Context, req *QuerySimulateOrderRequest) (*QuerySimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+}
+func (*UnimplementedQueryServer) AccountDenomOrdersCount(ctx context.Context, req *QueryAccountDenomOrdersCountRequest) (*QueryAccountDenomOrdersCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountDenomOrdersCount not implemented")
+}
+func (*UnimplementedQueryServer) TriggerOrders(ctx context.Context, req *QueryTriggerOrdersRequest) (*QueryTriggerOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerOrders not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (*UnimplementedQueryServer) DeadManSwitch(ctx context.Context, req *QueryDeadManSwitchRequest) (*QueryDeadManSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeadManSwitch not implemented")
+}
+func (*UnimplementedQueryServer) AccountTradingVolume(ctx context.Context, req *QueryAccountTradingVolumeRequest) (*QueryAccountTradingVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountTradingVolume not implemented")
+}
+func (*UnimplementedQueryServer) RewardPrograms(ctx context.Context, req *QueryRewardProgramsRequest) (*QueryRewardProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPrograms not implemented")
+}
+func (*UnimplementedQueryServer) AccountRewards(ctx context.Context, req *QueryAccountRewardsRequest) (*QueryAccountRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRewards not implemented")
+}
+func (*UnimplementedQueryServer) OrderQuota(ctx context.Context, req *QueryOrderQuotaRequest) (*QueryOrderQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderQuota not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Order_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Order(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/Order",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Order(ctx, req.(*QueryOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderBooksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Orders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Orders(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/Orders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Orders(ctx, req.(*QueryOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderBooksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.OrderBooks) > 0 {
-		for _, e := range m.OrderBooks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBooks(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBooks",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBooks(ctx, req.(*QueryOrderBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderBookParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderBookParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookParams(ctx, in)
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBookParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookParams(ctx, req.(*QueryOrderBookParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderBookParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderBookOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PriceTick.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QuantityStep.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BaseDenomUnifiedRefAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QuoteDenomUnifiedRefAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBookOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookOrders(ctx, req.(*QueryOrderBookOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderBookOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderBookDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookDepth(ctx, in)
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBookDepth",
 	}
-	if m.Side != 0 {
-		n += 1 + sovQuery(uint64(m.Side))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookDepth(ctx, req.(*QueryOrderBookDepthRequest))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/SimulateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateOrder(ctx, req.(*QuerySimulateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderBookOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AccountDenomOrdersCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDenomOrdersCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountDenomOrdersCount(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/AccountDenomOrdersCount",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountDenomOrdersCount(ctx, req.(*QueryAccountDenomOrdersCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDenomOrdersCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TriggerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTriggerOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).TriggerOrders(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/TriggerOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TriggerOrders(ctx, req.(*QueryTriggerOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeadManSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeadManSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeadManSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/DeadManSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeadManSwitch(ctx, req.(*QueryDeadManSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountTradingVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountTradingVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountTradingVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/AccountTradingVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountTradingVolume(ctx, req.(*QueryAccountTradingVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardProgramsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPrograms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/RewardPrograms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPrograms(ctx, req.(*QueryRewardProgramsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/AccountRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRewards(ctx, req.(*QueryAccountRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderQuota(ctx, req.(*QueryOrderQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.dex.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Order",
+			Handler:    _Query_Order_Handler,
+		},
+		{
+			MethodName: "Orders",
+			Handler:    _Query_Orders_Handler,
+		},
+		{
+			MethodName: "OrderBooks",
+			Handler:    _Query_OrderBooks_Handler,
+		},
+		{
+			MethodName: "OrderBookParams",
+			Handler:    _Query_OrderBookParams_Handler,
+		},
+		{
+			MethodName: "OrderBookOrders",
+			Handler:    _Query_OrderBookOrders_Handler,
+		},
+		{
+			MethodName: "OrderBookDepth",
+			Handler:    _Query_OrderBookDepth_Handler,
+		},
+		{
+			MethodName: "SimulateOrder",
+			Handler:    _Query_SimulateOrder_Handler,
+		},
+		{
+			MethodName: "AccountDenomOrdersCount",
+			Handler:    _Query_AccountDenomOrdersCount_Handler,
+		},
+		{
+			MethodName: "TriggerOrders",
+			Handler:    _Query_TriggerOrders_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "DeadManSwitch",
+			Handler:    _Query_DeadManSwitch_Handler,
+		},
+		{
+			MethodName: "AccountTradingVolume",
+			Handler:    _Query_AccountTradingVolume_Handler,
+		},
+		{
+			MethodName: "RewardPrograms",
+			Handler:    _Query_RewardPrograms_Handler,
+		},
+		{
+			MethodName: "AccountRewards",
+			Handler:    _Query_AccountRewards_Handler,
+		},
+		{
+			MethodName: "OrderQuota",
+			Handler:    _Query_OrderQuota_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/dex/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderBooks) > 0 {
+		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionUntilHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionUntilHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HaltedUntilHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HaltedUntilHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinQuantity != nil {
+		{
+			size := m.MinQuantity.Size()
+			i -= size
+			if _, err := m.MinQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QuoteDenomUnifiedRefAmount.Size()
+		i -= size
+		if _, err := m.QuoteDenomUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseDenomUnifiedRefAmount.Size()
+		i -= size
+		if _, err := m.BaseDenomUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.QuantityStep.Size()
+		i -= size
+		if _, err := m.QuantityStep.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PriceTick.Size()
+		i -= size
+		if _, err := m.PriceTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrdersCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrdersCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spread != nil {
+		{
+			size := m.Spread.Size()
+			i -= size
+			if _, err := m.Spread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BestAsk != nil {
+		{
+			size := m.BestAsk.Size()
+			i -= size
+			if _, err := m.BestAsk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BestBid != nil {
+		{
+			size := m.BestBid.Size()
+			i -= size
+			if _, err := m.BestBid.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeInForce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MakerFee.Size()
+		i -= size
+		if _, err := m.MakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BestPrice != nil {
+		{
+			size := m.BestPrice.Size()
+			i -= size
+			if _, err := m.BestPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AveragePrice != nil {
+		{
+			size := m.AveragePrice.Size()
+			i -= size
+			if _, err := m.AveragePrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Filled {
+		i--
+		if m.Filled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExecutedQuoteQuantity.Size()
+		i -= size
+		if _, err := m.ExecutedQuoteQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExecutedBaseQuantity.Size()
+		i -= size
+		if _, err := m.ExecutedBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDenomOrdersCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDenomOrdersCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDenomOrdersCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDenomOrdersCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDenomOrdersCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDenomOrdersCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTriggerOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTriggerOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTriggerOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTriggerOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTriggerOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTriggerOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x22
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TWAP.Size()
+		i -= size
+		if _, err := m.TWAP.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadManSwitchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadManSwitchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadManSwitchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadManSwitchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadManSwitchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadManSwitchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DeadManSwitch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountTradingVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountTradingVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountTradingVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountTradingVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountTradingVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountTradingVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeDiscount.Size()
+		i -= size
+		if _, err := m.FeeDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxOrdersPerDenom != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxOrdersPerDenom))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Tier != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardProgramsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardProgramsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardProgramsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardProgramsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardProgramsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardProgramsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardPrograms) > 0 {
+		for iNdEx := len(m.RewardPrograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPrograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountRewards) > 0 {
+		for iNdEx := len(m.AccountRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsedOrdersPerDenom != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UsedOrdersPerDenom))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExtraOrdersPerDenom != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExtraOrdersPerDenom))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxOrdersPerDenom != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxOrdersPerDenom))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderBooks) > 0 {
+		for _, e := range m.OrderBooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuantityStep.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseDenomUnifiedRefAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuoteDenomUnifiedRefAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.MinQuantity != nil {
+		l = m.MinQuantity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HaltedUntilHeight != 0 {
+		n += 1 + sovQuery(uint64(m.HaltedUntilHeight))
+	}
+	if m.AuctionUntilHeight != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionUntilHeight))
+	}
+	return n
+}
+
+func (m *QueryOrderBookOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OrdersCount != 0 {
+		n += 1 + sovQuery(uint64(m.OrdersCount))
+	}
+	return n
+}
+
+func (m *QueryOrderBookDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BestBid != nil {
+		l = m.BestBid.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BestAsk != nil {
+		l = m.BestAsk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Spread != nil {
+		l = m.Spread.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovQuery(uint64(m.TimeInForce))
+	}
+	return n
+}
+
+func (m *QuerySimulateOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExecutedBaseQuantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExecutedQuoteQuantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Filled {
+		n += 2
+	}
+	if m.AveragePrice != nil {
+		l = m.AveragePrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BestPrice != nil {
+		l = m.BestPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Slippage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MakerFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountDenomOrdersCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDenomOrdersCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryTriggerOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTriggerOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TWAP.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeadManSwitchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeadManSwitchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeadManSwitch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountTradingVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountTradingVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Tier != 0 {
+		n += 1 + sovQuery(uint64(m.Tier))
+	}
+	if m.MaxOrdersPerDenom != 0 {
+		n += 1 + sovQuery(uint64(m.MaxOrdersPerDenom))
+	}
+	l = m.FeeDiscount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardProgramsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardProgramsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPrograms) > 0 {
+		for _, e := range m.RewardPrograms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountRewards) > 0 {
+		for _, e := range m.AccountRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOrderQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxOrdersPerDenom != 0 {
+		n += 1 + sovQuery(uint64(m.MaxOrdersPerDenom))
+	}
+	if m.ExtraOrdersPerDenom != 0 {
+		n += 1 + sovQuery(uint64(m.ExtraOrdersPerDenom))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UsedOrdersPerDenom != 0 {
+		n += 1 + sovQuery(uint64(m.UsedOrdersPerDenom))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBooks = append(m.OrderBooks, OrderBookData{})
+			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuantityStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenomUnifiedRefAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseDenomUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenomUnifiedRefAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteDenomUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderBookStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinQuantity = &v
+			if err := m.MinQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedUntilHeight", wireType)
+			}
+			m.HaltedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionUntilHeight", wireType)
+			}
+			m.AuctionUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookDepthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookDepthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersCount", wireType)
+			}
+			m.OrdersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookDepthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookDepthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, PriceLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, PriceLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.BestBid = &v
+			if err := m.BestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAsk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.BestAsk = &v
+			if err := m.BestAsk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.Spread = &v
+			if err := m.Spread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutedBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuoteQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutedQuoteQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.AveragePrice = &v
+			if err := m.AveragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.BestPrice = &v
+			if err := m.BestPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, EventTrade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAccountDenomOrdersCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1971,12 +7558,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDenomOrdersCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDenomOrdersCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1998,7 +7649,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDenomOrdersCountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2021,17 +7672,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDenomOrdersCountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDenomOrdersCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2041,25 +7692,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2081,7 +7718,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTriggerOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,10 +7741,10 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTriggerOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTriggerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2144,9 +7781,9 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2156,23 +7793,27 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2195,7 +7836,7 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTriggerOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,15 +7859,15 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTriggerOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTriggerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2253,7 +7894,44 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2278,7 +7956,7 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2301,15 +7979,47 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2337,11 +8047,44 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2368,10 +8111,10 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2396,7 +8139,7 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2419,51 +8162,17 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orders = append(m.Orders, Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TWAP", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2473,25 +8182,23 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TWAP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2516,7 +8223,7 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadManSwitchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2539,17 +8246,17 @@ func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBooksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadManSwitchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadManSwitchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2559,27 +8266,23 @@ func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2602,7 +8305,7 @@ func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadManSwitchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2625,49 +8328,15 @@ func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBooksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadManSwitchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadManSwitchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderBooks = append(m.OrderBooks, OrderBookData{})
-			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadManSwitch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2694,10 +8363,7 @@ func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeadManSwitch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2722,7 +8388,7 @@ func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountTradingVolumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2745,47 +8411,15 @@ func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountTradingVolumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountTradingVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2813,7 +8447,7 @@ func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2836,7 +8470,7 @@ func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountTradingVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2859,15 +8493,15 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountTradingVolumeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountTradingVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2895,15 +8529,15 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStep", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
 			}
-			var stringLen uint64
+			m.Tier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2913,29 +8547,33 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Tier |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrdersPerDenom", wireType)
 			}
-			if err := m.QuantityStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxOrdersPerDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrdersPerDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenomUnifiedRefAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2963,15 +8601,65 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseDenomUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardProgramsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardProgramsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardProgramsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenomUnifiedRefAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2981,23 +8669,25 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteDenomUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3022,7 +8712,7 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardProgramsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3045,17 +8735,17 @@ func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardProgramsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardProgramsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPrograms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3065,76 +8755,27 @@ func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RewardPrograms = append(m.RewardPrograms, RewardProgram{})
+			if err := m.RewardPrograms[len(m.RewardPrograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
-			}
-			m.Side = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Side |= Side(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3164,7 +8805,7 @@ func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3191,7 +8832,7 @@ func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3214,17 +8855,17 @@ func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3234,29 +8875,77 @@ func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3283,10 +8972,8 @@ func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccountRewards = append(m.AccountRewards, AccountReward{})
+			if err := m.AccountRewards[len(m.AccountRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3311,7 +8998,7 @@ func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3334,10 +9021,10 @@ func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDenomOrdersCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDenomOrdersCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3372,38 +9059,6 @@ func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3425,7 +9080,7 @@ func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDenomOrdersCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderQuotaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3448,17 +9103,17 @@ func (m *QueryAccountDenomOrdersCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDenomOrdersCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderQuotaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDenomOrdersCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrdersPerDenom", wireType)
 			}
-			m.Count = 0
+			m.MaxOrdersPerDenom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3468,7 +9123,78 @@ func (m *QueryAccountDenomOrdersCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				m.MaxOrdersPerDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOrdersPerDenom", wireType)
+			}
+			m.ExtraOrdersPerDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtraOrdersPerDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedOrdersPerDenom", wireType)
+			}
+			m.UsedOrdersPerDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedOrdersPerDenom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
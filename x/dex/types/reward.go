@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardPoolModuleName is the name of the module account holding the pools of the reward programs.
+const RewardPoolModuleName = "dex_reward_pool"
+
+// Validate validates the reward program.
+func (p RewardProgram) Validate() error {
+	if err := validateOrderBookDenoms(p.BaseDenom, p.QuoteDenom); err != nil {
+		return err
+	}
+	if err := p.Pool.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid pool: %s", err)
+	}
+
+	return ValidateRewardProgramSettings(p.RewardPerEpoch, p.MaxTicks, p.MinQuantity)
+}
+
+// Validate validates the account reward.
+func (r AccountReward) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", r.Account)
+	}
+	if err := validateOrderBookDenoms(r.BaseDenom, r.QuoteDenom); err != nil {
+		return err
+	}
+	if r.Points.IsNil() || r.Points.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "points of %s must be non-negative", r.Account)
+	}
+	if err := r.Reward.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid reward: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateRewardProgramSettings validates the settings of the reward program.
+func ValidateRewardProgramSettings(rewardPerEpoch sdkmath.Int, maxTicks uint32, minQuantity sdkmath.Int) error {
+	if rewardPerEpoch.IsNil() || !rewardPerEpoch.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "reward per epoch must be positive")
+	}
+	if maxTicks == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "max ticks must be positive")
+	}
+	if minQuantity.IsNil() || minQuantity.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "min quantity must be non-negative")
+	}
+
+	return nil
+}
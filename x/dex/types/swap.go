@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSwapRouteLength is the maximum number of denoms in the swap route including the input and output denoms.
+const MaxSwapRouteLength = 5
+
+// ValidateSwapRoute validates the swap route, the empty route is valid and means the route is computed automatically.
+func ValidateSwapRoute(route []string, denomIn, denomOut string) error {
+	if len(route) == 0 {
+		return nil
+	}
+	if len(route) < 2 {
+		return sdkerrors.Wrap(ErrInvalidInput, "swap route must contain at least the input and output denoms")
+	}
+	if len(route) > MaxSwapRouteLength {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "swap route length %d exceeds the max length %d", len(route), MaxSwapRouteLength,
+		)
+	}
+	if route[0] != denomIn {
+		return sdkerrors.Wrapf(ErrInvalidInput, "swap route must start with the input denom %s", denomIn)
+	}
+	if route[len(route)-1] != denomOut {
+		return sdkerrors.Wrapf(ErrInvalidInput, "swap route must end with the output denom %s", denomOut)
+	}
+
+	denoms := make(map[string]struct{}, len(route))
+	for _, denom := range route {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid swap route denom: %s", denom)
+		}
+		if _, ok := denoms[denom]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated swap route denom: %s", denom)
+		}
+		denoms[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateSwapDenoms(denomIn, denomOut string) error {
+	if err := sdk.ValidateDenom(denomIn); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid input denom: %s", denomIn)
+	}
+	if err := sdk.ValidateDenom(denomOut); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid output denom: %s", denomOut)
+	}
+	if denomIn == denomOut {
+		return sdkerrors.Wrap(ErrInvalidInput, "input and output denoms must be different")
+	}
+
+	return nil
+}
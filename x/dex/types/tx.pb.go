@@ -8,6 +8,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -32,6 +34,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BatchMode defines how the batch message handles the failure of a single item.
+type BatchMode int32
+
+const (
+	// batch_mode_unspecified reserves the default value, to protect against unexpected settings.
+	BATCH_MODE_UNSPECIFIED BatchMode = 0
+	// batch_mode_all_or_nothing means that the whole batch fails if any item fails.
+	BATCH_MODE_ALL_OR_NOTHING BatchMode = 1
+	// batch_mode_best_effort means that the failed items are skipped and reported in the response, while the
+	// successful items are applied.
+	BATCH_MODE_BEST_EFFORT BatchMode = 2
+)
+
+var BatchMode_name = map[int32]string{
+	0: "BATCH_MODE_UNSPECIFIED",
+	1: "BATCH_MODE_ALL_OR_NOTHING",
+	2: "BATCH_MODE_BEST_EFFORT",
+}
+
+var BatchMode_value = map[string]int32{
+	"BATCH_MODE_UNSPECIFIED":    0,
+	"BATCH_MODE_ALL_OR_NOTHING": 1,
+	"BATCH_MODE_BEST_EFFORT":    2,
+}
+
+func (x BatchMode) String() string {
+	return proto.EnumName(BatchMode_name, int32(x))
+}
+
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{0}
+}
+
 type MsgUpdateParams struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
@@ -92,6 +127,15 @@ type MsgPlaceOrder struct {
 	GoodTil *GoodTil `protobuf:"bytes,9,opt,name=good_til,json=goodTil,proto3" json:"good_til,omitempty"`
 	// time_in_force is order time in force
 	TimeInForce TimeInForce `protobuf:"varint,10,opt,name=time_in_force,json=timeInForce,proto3,enum=coreum.dex.v1.TimeInForce" json:"time_in_force,omitempty"`
+	// trigger is order trigger, the order is placed to the order book only when the trigger is activated.
+	Trigger *Trigger `protobuf:"bytes,11,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// self_trade_prevention defines what happens when the order is matched as a taker against the order of the same
+	// creator.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,12,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=coreum.dex.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// display_quantity is the visible quantity of the iceberg order, the rest of the quantity is hidden.
+	DisplayQuantity *cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=display_quantity,json=displayQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"display_quantity,omitempty"`
+	// all_or_none defines that the order resting in the order book is matched only when it can be filled entirely.
+	AllOrNone bool `protobuf:"varint,14,opt,name=all_or_none,json=allOrNone,proto3" json:"all_or_none,omitempty"`
 }
 
 func (m *MsgPlaceOrder) Reset()         { *m = MsgPlaceOrder{} }
@@ -127,6 +171,53 @@ func (m *MsgPlaceOrder) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPlaceOrder proto.InternalMessageInfo
 
+// MsgReplaceOrder defines message to change the price and/or the quantity of the order in the orderbook.
+// The order keeps its time priority if only its quantity is reduced, otherwise it's canceled and placed again with the
+// same ID.
+type MsgReplaceOrder struct {
+	// sender is order creator address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// price is the new order price, the current price is kept if empty.
+	Price *Price `protobuf:"bytes,3,opt,name=price,proto3,customtype=Price" json:"price,omitempty"`
+	// quantity is the new remaining quantity of the order, the current remaining quantity is kept if empty.
+	Quantity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+}
+
+func (m *MsgReplaceOrder) Reset()         { *m = MsgReplaceOrder{} }
+func (m *MsgReplaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrder) ProtoMessage()    {}
+func (*MsgReplaceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{2}
+}
+func (m *MsgReplaceOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrder.Merge(m, src)
+}
+func (m *MsgReplaceOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceOrder proto.InternalMessageInfo
+
 // MsgCancelOrder defines message to cancel the order in the orderbook.
 type MsgCancelOrder struct {
 	// sender is order creator address.
@@ -139,7 +230,7 @@ func (m *MsgCancelOrder) Reset()         { *m = MsgCancelOrder{} }
 func (m *MsgCancelOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelOrder) ProtoMessage()    {}
 func (*MsgCancelOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b3181ef84525da2, []int{2}
+	return fileDescriptor_6b3181ef84525da2, []int{3}
 }
 func (m *MsgCancelOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +273,7 @@ func (m *MsgCancelOrdersByDenom) Reset()         { *m = MsgCancelOrdersByDenom{}
 func (m *MsgCancelOrdersByDenom) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelOrdersByDenom) ProtoMessage()    {}
 func (*MsgCancelOrdersByDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b3181ef84525da2, []int{3}
+	return fileDescriptor_6b3181ef84525da2, []int{4}
 }
 func (m *MsgCancelOrdersByDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,21 +302,49 @@ func (m *MsgCancelOrdersByDenom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrdersByDenom proto.InternalMessageInfo
 
-type EmptyResponse struct {
+// BatchOrder defines an order placed by the MsgBatchPlaceOrders.
+type BatchOrder struct {
+	// type is order type.
+	Type OrderType `protobuf:"varint,1,opt,name=type,proto3,enum=coreum.dex.v1.OrderType" json:"type,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// base_denom is base order denom.
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order denom
+	QuoteDenom string `protobuf:"bytes,4,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price is value of one unit of the base_denom expressed in terms of the quote_denom.
+	Price *Price `protobuf:"bytes,5,opt,name=price,proto3,customtype=Price" json:"price,omitempty"`
+	// quantity is amount of the base base_denom being traded.
+	Quantity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+	// side is order side.
+	Side Side `protobuf:"varint,7,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// good_til is order good til
+	GoodTil *GoodTil `protobuf:"bytes,8,opt,name=good_til,json=goodTil,proto3" json:"good_til,omitempty"`
+	// time_in_force is order time in force
+	TimeInForce TimeInForce `protobuf:"varint,9,opt,name=time_in_force,json=timeInForce,proto3,enum=coreum.dex.v1.TimeInForce" json:"time_in_force,omitempty"`
+	// trigger is order trigger, the order is placed to the order book only when the trigger is activated.
+	Trigger *Trigger `protobuf:"bytes,10,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// self_trade_prevention defines what happens when the order is matched as a taker against the order of the same
+	// creator.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,11,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=coreum.dex.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// display_quantity is the visible quantity of the iceberg order, the rest of the quantity is hidden.
+	DisplayQuantity *cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=display_quantity,json=displayQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"display_quantity,omitempty"`
+	// all_or_none defines that the order resting in the order book is matched only when it can be filled entirely.
+	AllOrNone bool `protobuf:"varint,13,opt,name=all_or_none,json=allOrNone,proto3" json:"all_or_none,omitempty"`
 }
 
-func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
-func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
-func (*EmptyResponse) ProtoMessage()    {}
-func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b3181ef84525da2, []int{4}
+func (m *BatchOrder) Reset()         { *m = BatchOrder{} }
+func (m *BatchOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchOrder) ProtoMessage()    {}
+func (*BatchOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{5}
 }
-func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
+func (m *BatchOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EmptyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -235,645 +354,7436 @@ func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EmptyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EmptyResponse.Merge(m, src)
+func (m *BatchOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOrder.Merge(m, src)
 }
-func (m *EmptyResponse) XXX_Size() int {
+func (m *BatchOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *EmptyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EmptyResponse.DiscardUnknown(m)
+func (m *BatchOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
+var xxx_messageInfo_BatchOrder proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "coreum.dex.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgPlaceOrder)(nil), "coreum.dex.v1.MsgPlaceOrder")
-	proto.RegisterType((*MsgCancelOrder)(nil), "coreum.dex.v1.MsgCancelOrder")
-	proto.RegisterType((*MsgCancelOrdersByDenom)(nil), "coreum.dex.v1.MsgCancelOrdersByDenom")
-	proto.RegisterType((*EmptyResponse)(nil), "coreum.dex.v1.EmptyResponse")
+// MsgBatchPlaceOrders defines message to place multiple orders on orderbook.
+type MsgBatchPlaceOrders struct {
+	// sender is orders creator address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// orders are the orders to place, they are placed in the provided order.
+	Orders []BatchOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
+	// mode is the batch failure handling mode.
+	Mode BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=coreum.dex.v1.BatchMode" json:"mode,omitempty"`
 }
 
-func init() { proto.RegisterFile("coreum/dex/v1/tx.proto", fileDescriptor_6b3181ef84525da2) }
-
-var fileDescriptor_6b3181ef84525da2 = []byte{
-	// 744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0x1a, 0x6c, 0xf0, 0xb8, 0x06, 0x31, 0x80, 0xbb, 0xb5, 0x60, 0x4d, 0x2d, 0xb5, 0x45,
-	0x56, 0xbb, 0x5b, 0x53, 0x09, 0xb5, 0x3e, 0x54, 0xaa, 0xa1, 0xb4, 0x44, 0xb1, 0x40, 0x0b, 0xb9,
-	0x70, 0xb1, 0x96, 0x9d, 0xc9, 0x32, 0x8a, 0x77, 0x67, 0xd9, 0x19, 0x23, 0xfb, 0x16, 0xe5, 0x18,
-	0xe5, 0x90, 0x9f, 0x11, 0x29, 0x17, 0x0e, 0xf9, 0x11, 0x1c, 0x72, 0x40, 0x39, 0x45, 0x1c, 0xac,
-	0xc4, 0x1c, 0xf8, 0x1b, 0xd1, 0xcc, 0xac, 0xb1, 0xbd, 0x24, 0x70, 0xb1, 0xf6, 0xbd, 0xef, 0x9b,
-	0xef, 0xbd, 0x37, 0x9f, 0xe7, 0x81, 0xa2, 0x4b, 0x23, 0xdc, 0xf1, 0x2d, 0x84, 0xbb, 0xd6, 0x59,
-	0xcd, 0xe2, 0x5d, 0x33, 0x8c, 0x28, 0xa7, 0xb0, 0xa0, 0xf2, 0x26, 0xc2, 0x5d, 0xf3, 0xac, 0x56,
-	0x5a, 0x70, 0x7c, 0x12, 0x50, 0x4b, 0xfe, 0x2a, 0x46, 0xe9, 0x87, 0xc9, 0x93, 0x34, 0x42, 0x38,
-	0x8a, 0xa1, 0xd2, 0x24, 0x14, 0x3a, 0x91, 0xe3, 0xb3, 0x18, 0xfb, 0xde, 0xa5, 0xcc, 0xa7, 0xcc,
-	0xf2, 0x99, 0x27, 0x30, 0x9f, 0x79, 0x23, 0x3d, 0x01, 0xb4, 0x64, 0x64, 0xa9, 0x20, 0x86, 0x96,
-	0x3c, 0xea, 0x51, 0x95, 0x17, 0x5f, 0x2a, 0x5b, 0x79, 0xab, 0x81, 0xf9, 0x26, 0xf3, 0x9e, 0x84,
-	0xc8, 0xe1, 0x78, 0x5f, 0xd6, 0x80, 0x9b, 0x20, 0xe7, 0x74, 0xf8, 0x09, 0x8d, 0x08, 0xef, 0xe9,
-	0xda, 0x9a, 0xb6, 0x9e, 0x6b, 0xe8, 0x1f, 0xde, 0xfd, 0xb6, 0x14, 0xcb, 0xfd, 0x83, 0x50, 0x84,
-	0x19, 0x3b, 0xe0, 0x11, 0x09, 0x3c, 0x7b, 0x44, 0x85, 0x7f, 0x82, 0xac, 0xea, 0x52, 0x4f, 0xaf,
-	0x69, 0xeb, 0xf9, 0x8d, 0x65, 0x73, 0x62, 0x7e, 0x53, 0xc9, 0x37, 0x72, 0x17, 0xfd, 0x72, 0xea,
-	0xcd, 0xcd, 0x79, 0x55, 0xb3, 0x63, 0x7e, 0xfd, 0xe7, 0x17, 0x37, 0xe7, 0xd5, 0x91, 0xd2, 0xcb,
-	0x9b, 0xf3, 0xea, 0xa2, 0x98, 0x3b, 0xd1, 0x59, 0x65, 0x30, 0x05, 0x0a, 0x4d, 0xe6, 0xed, 0xb7,
-	0x1d, 0x17, 0xef, 0x89, 0xbb, 0x82, 0xbf, 0x83, 0x2c, 0xc3, 0x01, 0xc2, 0xd1, 0x83, 0x8d, 0xc6,
-	0x3c, 0xf8, 0x2b, 0x98, 0xe6, 0xbd, 0x10, 0xcb, 0x1e, 0xe7, 0x36, 0xf4, 0x44, 0x8f, 0x52, 0xf5,
-	0xb0, 0x17, 0x62, 0x5b, 0xb2, 0x60, 0x11, 0xa4, 0x09, 0xd2, 0xa7, 0xa4, 0x76, 0x76, 0xd0, 0x2f,
-	0xa7, 0x77, 0xb7, 0xed, 0x34, 0x41, 0x70, 0x15, 0x80, 0x63, 0x87, 0xe1, 0x16, 0xc2, 0x01, 0xf5,
-	0xf5, 0x69, 0x81, 0xdb, 0x39, 0x91, 0xd9, 0x16, 0x09, 0x58, 0x06, 0xf9, 0xd3, 0x0e, 0xe5, 0x43,
-	0x3c, 0x23, 0x71, 0x20, 0x53, 0x43, 0x42, 0x26, 0x8c, 0x88, 0x8b, 0xf5, 0xac, 0x94, 0xce, 0x5d,
-	0xf5, 0xcb, 0x99, 0x7d, 0x91, 0xb0, 0x55, 0x1e, 0xfe, 0x05, 0x66, 0x4f, 0x3b, 0x4e, 0xc0, 0x85,
-	0x07, 0x33, 0x92, 0xb3, 0x2a, 0xee, 0xed, 0xaa, 0x5f, 0x5e, 0x56, 0xe3, 0x31, 0xf4, 0xcc, 0x24,
-	0xd4, 0xf2, 0x1d, 0x7e, 0x62, 0xee, 0x06, 0xdc, 0xbe, 0xa5, 0xc3, 0x5f, 0xc0, 0x34, 0x23, 0x08,
-	0xeb, 0xb3, 0x72, 0xc2, 0xc5, 0xc4, 0x84, 0x07, 0x04, 0x61, 0x5b, 0x12, 0x60, 0x0d, 0xcc, 0x7a,
-	0x94, 0xa2, 0x16, 0x27, 0x6d, 0x3d, 0x27, 0x2d, 0x2b, 0x26, 0xc8, 0xff, 0x51, 0x8a, 0x0e, 0x49,
-	0xdb, 0x9e, 0xf1, 0xd4, 0x07, 0xfc, 0x1b, 0x14, 0x38, 0xf1, 0x71, 0x8b, 0x04, 0xad, 0xa7, 0x34,
-	0x72, 0xb1, 0x0e, 0x64, 0x91, 0x52, 0xe2, 0xdc, 0x21, 0xf1, 0xf1, 0x6e, 0xb0, 0x23, 0x18, 0x76,
-	0x9e, 0x8f, 0x82, 0xfa, 0x8f, 0xc2, 0xe9, 0xd8, 0x0a, 0x61, 0xf3, 0x42, 0x6c, 0xf3, 0xc8, 0xd2,
-	0x0a, 0x02, 0x73, 0x4d, 0xe6, 0x6d, 0x39, 0x81, 0x8b, 0xdb, 0xca, 0xe4, 0xe2, 0xa4, 0xc9, 0xb7,
-	0x56, 0x2a, 0x73, 0xd2, 0x49, 0x73, 0xea, 0x95, 0x44, 0x11, 0x18, 0x17, 0x19, 0xd3, 0xac, 0xbc,
-	0xd2, 0x40, 0x71, 0x32, 0xc5, 0x1a, 0x3d, 0xe5, 0xcd, 0xb7, 0xca, 0xe9, 0x60, 0xc6, 0x71, 0x5d,
-	0xda, 0x09, 0xb8, 0xaa, 0x69, 0x0f, 0x43, 0xb8, 0x04, 0x32, 0xca, 0x68, 0xf9, 0x47, 0xb1, 0x55,
-	0x50, 0xaf, 0x26, 0xda, 0x28, 0xdd, 0x6d, 0x63, 0x58, 0xb3, 0x32, 0x0f, 0x0a, 0xff, 0xfa, 0x21,
-	0xef, 0xd9, 0x98, 0x85, 0x34, 0x60, 0x78, 0xe3, 0x7d, 0x1a, 0x4c, 0x35, 0x99, 0x07, 0x1f, 0x83,
-	0xef, 0x26, 0x1e, 0xa7, 0x91, 0xb8, 0xe9, 0xc4, 0x13, 0x29, 0xad, 0x24, 0xf0, 0x09, 0x55, 0xf8,
-	0x3f, 0x00, 0x63, 0x8f, 0x67, 0xe5, 0xae, 0xd6, 0x08, 0x7d, 0x40, 0xe9, 0x11, 0xc8, 0x8f, 0x5b,
-	0xb4, 0x7a, 0x57, 0x6a, 0x0c, 0x7e, 0x40, 0xeb, 0x08, 0x2c, 0x7e, 0xcd, 0x87, 0x9f, 0xee, 0xd5,
-	0x1c, 0xd2, 0xee, 0xd7, 0x2e, 0x65, 0x9e, 0x8b, 0x4d, 0xd3, 0xd8, 0xbb, 0xf8, 0x6c, 0xa4, 0x2e,
-	0x06, 0x86, 0x76, 0x39, 0x30, 0xb4, 0x4f, 0x03, 0x43, 0x7b, 0x7d, 0x6d, 0xa4, 0x2e, 0xaf, 0x8d,
-	0xd4, 0xc7, 0x6b, 0x23, 0x75, 0x54, 0xf3, 0x08, 0x3f, 0xe9, 0x1c, 0x9b, 0x2e, 0xf5, 0xad, 0x2d,
-	0x29, 0xb6, 0x43, 0x3b, 0x01, 0x72, 0x38, 0xa1, 0x81, 0x15, 0xef, 0xe1, 0xb3, 0x4d, 0xab, 0x2b,
-	0x97, 0xb1, 0xd8, 0x0b, 0xec, 0x38, 0x2b, 0xf7, 0xe7, 0x1f, 0x5f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x3d, 0xec, 0xf0, 0x41, 0xfc, 0x05, 0x00, 0x00,
+func (m *MsgBatchPlaceOrders) Reset()         { *m = MsgBatchPlaceOrders{} }
+func (m *MsgBatchPlaceOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchPlaceOrders) ProtoMessage()    {}
+func (*MsgBatchPlaceOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{6}
+}
+func (m *MsgBatchPlaceOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchPlaceOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchPlaceOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchPlaceOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchPlaceOrders.Merge(m, src)
+}
+func (m *MsgBatchPlaceOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchPlaceOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchPlaceOrders.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgBatchPlaceOrders proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams is a governance operation to modify the parameters of the module.
-	// NOTE: all parameters must be provided.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// PlaceOrder place an order on orderbook.
-	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// CancelOrder cancels an order in the orderbook.
-	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// CancelOrdersByDenom cancels all orders by denom and account.
-	CancelOrdersByDenom(ctx context.Context, in *MsgCancelOrdersByDenom, opts ...grpc.CallOption) (*EmptyResponse, error)
+// MsgBatchCancelOrders defines message to cancel multiple orders in the orderbook.
+type MsgBatchCancelOrders struct {
+	// sender is orders creator address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ids are unique IDs of the orders to cancel.
+	IDs []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	// mode is the batch failure handling mode.
+	Mode BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=coreum.dex.v1.BatchMode" json:"mode,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgBatchCancelOrders) Reset()         { *m = MsgBatchCancelOrders{} }
+func (m *MsgBatchCancelOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelOrders) ProtoMessage()    {}
+func (*MsgBatchCancelOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{7}
+}
+func (m *MsgBatchCancelOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchCancelOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCancelOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchCancelOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelOrders.Merge(m, src)
+}
+func (m *MsgBatchCancelOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCancelOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelOrders.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgBatchCancelOrders proto.InternalMessageInfo
+
+// MsgCancelAllOrders defines message to cancel the orders and the trigger orders of the sender matching the filters.
+type MsgCancelAllOrders struct {
+	// sender is orders creator address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// base_denom is base denom of the order book to cancel the orders of, must be set together with the quote_denom.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote denom of the order book to cancel the orders of, must be set together with the base_denom.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// side is the side of the orders to cancel, the orders of both sides are canceled if unspecified.
+	Side Side `protobuf:"varint,4,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// min_price is the min price (inclusive) of the orders to cancel, requires the order book.
+	MinPrice *Price `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3,customtype=Price" json:"min_price,omitempty"`
+	// max_price is the max price (inclusive) of the orders to cancel, requires the order book.
+	MaxPrice *Price `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3,customtype=Price" json:"max_price,omitempty"`
+	// limit is the max number of orders to cancel, the gas is charged for the limit.
+	Limit uint32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelAllOrders) Reset()         { *m = MsgCancelAllOrders{} }
+func (m *MsgCancelAllOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrders) ProtoMessage()    {}
+func (*MsgCancelAllOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{8}
+}
+func (m *MsgCancelAllOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAllOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAllOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelAllOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrders.Merge(m, src)
+}
+func (m *MsgCancelAllOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAllOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrders.DiscardUnknown(m)
 }
 
-func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/PlaceOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgCancelAllOrders proto.InternalMessageInfo
+
+// MsgSetDeadManSwitch defines message to arm, update or disarm the dead man's switch canceling all orders of the sender
+// if the switch isn't refreshed within the timeout.
+type MsgSetDeadManSwitch struct {
+	// sender is the account address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// timeout_blocks is the number of blocks the switch is extended by on each refresh, zero disarms the switch.
+	TimeoutBlocks uint64 `protobuf:"varint,2,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
 }
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetDeadManSwitch) Reset()         { *m = MsgSetDeadManSwitch{} }
+func (m *MsgSetDeadManSwitch) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDeadManSwitch) ProtoMessage()    {}
+func (*MsgSetDeadManSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{9}
+}
+func (m *MsgSetDeadManSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDeadManSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDeadManSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetDeadManSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDeadManSwitch.Merge(m, src)
+}
+func (m *MsgSetDeadManSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDeadManSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDeadManSwitch.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelOrdersByDenom(ctx context.Context, in *MsgCancelOrdersByDenom, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/CancelOrdersByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetDeadManSwitch proto.InternalMessageInfo
+
+// MsgHeartbeat defines message to refresh the dead man's switch of the sender.
+type MsgHeartbeat struct {
+	// sender is the account address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams is a governance operation to modify the parameters of the module.
-	// NOTE: all parameters must be provided.
-	UpdateParams(context.Context, *MsgUpdateParams) (*EmptyResponse, error)
-	// PlaceOrder place an order on orderbook.
-	PlaceOrder(context.Context, *MsgPlaceOrder) (*EmptyResponse, error)
-	// CancelOrder cancels an order in the orderbook.
-	CancelOrder(context.Context, *MsgCancelOrder) (*EmptyResponse, error)
-	// CancelOrdersByDenom cancels all orders by denom and account.
-	CancelOrdersByDenom(context.Context, *MsgCancelOrdersByDenom) (*EmptyResponse, error)
+func (m *MsgHeartbeat) Reset()         { *m = MsgHeartbeat{} }
+func (m *MsgHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*MsgHeartbeat) ProtoMessage()    {}
+func (*MsgHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{10}
+}
+func (m *MsgHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHeartbeat.Merge(m, src)
+}
+func (m *MsgHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHeartbeat.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgHeartbeat proto.InternalMessageInfo
+
+// MsgCreateOrderBook defines message to register the order book pair.
+type MsgCreateOrderBook struct {
+	// sender is the governance account or the base denom admin address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price_tick overrides the price tick computed from the unified ref amounts, if set.
+	PriceTick *Price `protobuf:"bytes,4,opt,name=price_tick,json=priceTick,proto3,customtype=Price" json:"price_tick,omitempty"`
+	// quantity_step overrides the quantity step computed from the base denom unified ref amount, if set.
+	QuantityStep *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=quantity_step,json=quantityStep,proto3,customtype=cosmossdk.io/math.Int" json:"quantity_step,omitempty"`
+	// min_quantity is the min quantity of the order placed to the order book, if set.
+	MinQuantity *cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=min_quantity,json=minQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"min_quantity,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCreateOrderBook) Reset()         { *m = MsgCreateOrderBook{} }
+func (m *MsgCreateOrderBook) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOrderBook) ProtoMessage()    {}
+func (*MsgCreateOrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{11}
 }
-func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+func (m *MsgCreateOrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+func (m *MsgCreateOrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelOrdersByDenom(ctx context.Context, req *MsgCancelOrdersByDenom) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrdersByDenom not implemented")
+func (m *MsgCreateOrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOrderBook.Merge(m, src)
+}
+func (m *MsgCreateOrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOrderBook.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgCreateOrderBook proto.InternalMessageInfo
+
+// MsgUpdateOrderBook defines message to update the price tick, quantity step and min quantity of the order book.
+// The empty value removes the corresponding override.
+type MsgUpdateOrderBook struct {
+	// sender is the governance account or the base denom admin address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price_tick overrides the price tick computed from the unified ref amounts, if set.
+	PriceTick *Price `protobuf:"bytes,4,opt,name=price_tick,json=priceTick,proto3,customtype=Price" json:"price_tick,omitempty"`
+	// quantity_step overrides the quantity step computed from the base denom unified ref amount, if set.
+	QuantityStep *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=quantity_step,json=quantityStep,proto3,customtype=cosmossdk.io/math.Int" json:"quantity_step,omitempty"`
+	// min_quantity is the min quantity of the order placed to the order book, if set.
+	MinQuantity *cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=min_quantity,json=minQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"min_quantity,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgUpdateOrderBook) Reset()         { *m = MsgUpdateOrderBook{} }
+func (m *MsgUpdateOrderBook) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOrderBook) ProtoMessage()    {}
+func (*MsgUpdateOrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{12}
+}
+func (m *MsgUpdateOrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateOrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOrderBook.Merge(m, src)
+}
+func (m *MsgUpdateOrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOrderBook.DiscardUnknown(m)
 }
 
-func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Msg/PlaceOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateOrderBook proto.InternalMessageInfo
+
+// MsgUpdateOrderBookStatus defines message to pause, resume or delist the order book pair. The status is applied to
+// both the order book and the inverted order book.
+type MsgUpdateOrderBookStatus struct {
+	// sender is the governance account or the base denom admin address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// status is the new order book status.
+	Status OrderBookStatus `protobuf:"varint,4,opt,name=status,proto3,enum=coreum.dex.v1.OrderBookStatus" json:"status,omitempty"`
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Msg/CancelOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+func (m *MsgUpdateOrderBookStatus) Reset()         { *m = MsgUpdateOrderBookStatus{} }
+func (m *MsgUpdateOrderBookStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOrderBookStatus) ProtoMessage()    {}
+func (*MsgUpdateOrderBookStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{13}
+}
+func (m *MsgUpdateOrderBookStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOrderBookStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOrderBookStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateOrderBookStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOrderBookStatus.Merge(m, src)
+}
+func (m *MsgUpdateOrderBookStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOrderBookStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOrderBookStatus.DiscardUnknown(m)
 }
 
-func _Msg_CancelOrdersByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrdersByDenom)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrdersByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Msg/CancelOrdersByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrdersByDenom(ctx, req.(*MsgCancelOrdersByDenom))
+var xxx_messageInfo_MsgUpdateOrderBookStatus proto.InternalMessageInfo
+
+// MsgSwapExactIn defines message to swap the exact input coin to the output denom by the market orders executed
+// atomically in the order books of the route.
+type MsgSwapExactIn struct {
+	// sender is the swap creator address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// coin_in is the coin to swap.
+	CoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in"`
+	// denom_out is the denom to receive.
+	DenomOut string `protobuf:"bytes,3,opt,name=denom_out,json=denomOut,proto3" json:"denom_out,omitempty"`
+	// route is the list of the denoms the coin is swapped through, starting with the input denom and ending with the
+	// output denom, the route is computed automatically if it's empty.
+	Route []string `protobuf:"bytes,4,rep,name=route,proto3" json:"route,omitempty"`
+	// min_amount_out is the min amount of the output denom to receive, the swap fails if less is received.
+	MinAmountOut cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_amount_out,json=minAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount_out"`
+}
+
+func (m *MsgSwapExactIn) Reset()         { *m = MsgSwapExactIn{} }
+func (m *MsgSwapExactIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactIn) ProtoMessage()    {}
+func (*MsgSwapExactIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{14}
+}
+func (m *MsgSwapExactIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSwapExactIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactIn.Merge(m, src)
+}
+func (m *MsgSwapExactIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactIn.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.dex.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "PlaceOrder",
-			Handler:    _Msg_PlaceOrder_Handler,
-		},
-		{
-			MethodName: "CancelOrder",
-			Handler:    _Msg_CancelOrder_Handler,
-		},
-		{
-			MethodName: "CancelOrdersByDenom",
-			Handler:    _Msg_CancelOrdersByDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "coreum/dex/v1/tx.proto",
+var xxx_messageInfo_MsgSwapExactIn proto.InternalMessageInfo
+
+// MsgSwapExactOut defines message to swap the input denom to the exact output coin by the market orders executed
+// atomically in the order books of the route.
+type MsgSwapExactOut struct {
+	// sender is the swap creator address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom_in is the denom to swap.
+	DenomIn string `protobuf:"bytes,2,opt,name=denom_in,json=denomIn,proto3" json:"denom_in,omitempty"`
+	// coin_out is the coin to receive.
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out"`
+	// route is the list of the denoms the coin is swapped through, starting with the input denom and ending with the
+	// output denom, the route is computed automatically if it's empty.
+	Route []string `protobuf:"bytes,4,rep,name=route,proto3" json:"route,omitempty"`
+	// max_amount_in is the max amount of the input denom to spend, the swap fails if more is required.
+	MaxAmountIn cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_amount_in,json=maxAmountIn,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_in"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSwapExactOut) Reset()         { *m = MsgSwapExactOut{} }
+func (m *MsgSwapExactOut) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactOut) ProtoMessage()    {}
+func (*MsgSwapExactOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{15}
+}
+func (m *MsgSwapExactOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSwapExactOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactOut.Merge(m, src)
+}
+func (m *MsgSwapExactOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactOut.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSwapExactOut proto.InternalMessageInfo
+
+// MsgFundRewardProgram defines message to create the reward program of the order book or to fund the existing one
+// and update its settings.
+type MsgFundRewardProgram struct {
+	// sender is the governance account or the base denom admin address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// amount is the amount transferred from the sender to the program pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// reward_per_epoch is the amount of the pool denom distributed each epoch.
+	RewardPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=reward_per_epoch,json=rewardPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"reward_per_epoch"`
+	// max_ticks is the max distance of the qualifying order price from the mid price in price ticks.
+	MaxTicks uint32 `protobuf:"varint,6,opt,name=max_ticks,json=maxTicks,proto3" json:"max_ticks,omitempty"`
+	// min_quantity is the min remaining quantity of the qualifying order.
+	MinQuantity cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=min_quantity,json=minQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"min_quantity"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgFundRewardProgram) Reset()         { *m = MsgFundRewardProgram{} }
+func (m *MsgFundRewardProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardProgram) ProtoMessage()    {}
+func (*MsgFundRewardProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{16}
+}
+func (m *MsgFundRewardProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFundRewardProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardProgram.Merge(m, src)
+}
+func (m *MsgFundRewardProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardProgram.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFundRewardProgram proto.InternalMessageInfo
+
+// MsgClaimRewards defines message to claim the rewards accrued by the sender in all reward programs.
+type MsgClaimRewards struct {
+	// sender is the rewards claimer address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{17}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+// MsgSetOrderQuota defines message to set the additional number of orders per denom the sender can have.
+type MsgSetOrderQuota struct {
+	// sender is the account address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// extra_orders_per_denom is the additional number of orders per denom, zero releases the whole reserve.
+	ExtraOrdersPerDenom uint64 `protobuf:"varint,2,opt,name=extra_orders_per_denom,json=extraOrdersPerDenom,proto3" json:"extra_orders_per_denom,omitempty"`
 }
 
-func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.GoodTil != nil {
-		{
-			size, err := m.GoodTil.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgSetOrderQuota) Reset()         { *m = MsgSetOrderQuota{} }
+func (m *MsgSetOrderQuota) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOrderQuota) ProtoMessage()    {}
+func (*MsgSetOrderQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{18}
+}
+func (m *MsgSetOrderQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOrderQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOrderQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x4a
+		return b[:n], nil
 	}
-	if m.Side != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Side))
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size := m.Quantity.Size()
-		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.Price != nil {
-		{
-			size := m.Price.Size()
-			i -= size
-			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Type != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetOrderQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOrderQuota.Merge(m, src)
+}
+func (m *MsgSetOrderQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOrderQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOrderQuota.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetOrderQuota proto.InternalMessageInfo
+
+// BatchOrderResult is the result of a single item of the batch message.
+type BatchOrderResult struct {
+	// id is unique order ID.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// success is true if the item is applied.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the failure reason of the item, empty if the item is applied.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *BatchOrderResult) Reset()         { *m = BatchOrderResult{} }
+func (m *BatchOrderResult) String() string { return proto.CompactTextString(m) }
+func (*BatchOrderResult) ProtoMessage()    {}
+func (*BatchOrderResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{19}
+}
+func (m *BatchOrderResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOrderResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOrderResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchOrderResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOrderResult.Merge(m, src)
+}
+func (m *BatchOrderResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOrderResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOrderResult.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrdersByDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchOrderResult proto.InternalMessageInfo
+
+// MsgBatchPlaceOrdersResponse defines the response of the MsgBatchPlaceOrders.
+type MsgBatchPlaceOrdersResponse struct {
+	// results are the per-order results in the order of the message orders.
+	Results []BatchOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgBatchPlaceOrdersResponse) Reset()         { *m = MsgBatchPlaceOrdersResponse{} }
+func (m *MsgBatchPlaceOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchPlaceOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchPlaceOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{20}
+}
+func (m *MsgBatchPlaceOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchPlaceOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchPlaceOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgBatchPlaceOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchPlaceOrdersResponse.Merge(m, src)
+}
+func (m *MsgBatchPlaceOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchPlaceOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchPlaceOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrdersByDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBatchPlaceOrdersResponse proto.InternalMessageInfo
+
+// MsgBatchCancelOrdersResponse defines the response of the MsgBatchCancelOrders.
+type MsgBatchCancelOrdersResponse struct {
+	// results are the per-order results in the order of the message IDs.
+	Results []BatchOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgCancelOrdersByDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBatchCancelOrdersResponse) Reset()         { *m = MsgBatchCancelOrdersResponse{} }
+func (m *MsgBatchCancelOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchCancelOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{21}
+}
+func (m *MsgBatchCancelOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchCancelOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCancelOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBatchCancelOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelOrdersResponse.Merge(m, src)
+}
+func (m *MsgBatchCancelOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCancelOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgBatchCancelOrdersResponse proto.InternalMessageInfo
+
+// MsgCancelAllOrdersResponse defines the response of the MsgCancelAllOrders.
+type MsgCancelAllOrdersResponse struct {
+	// ids are unique IDs of the canceled orders.
+	IDs []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelAllOrdersResponse) Reset()         { *m = MsgCancelAllOrdersResponse{} }
+func (m *MsgCancelAllOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrdersResponse) ProtoMessage()    {}
+func (*MsgCancelAllOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{22}
+}
+func (m *MsgCancelAllOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAllOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAllOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAllOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrdersResponse.Merge(m, src)
+}
+func (m *MsgCancelAllOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAllOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgCancelAllOrdersResponse proto.InternalMessageInfo
+
+// MsgSwapExactInResponse defines the response of the MsgSwapExactIn.
+type MsgSwapExactInResponse struct {
+	// coin_in is the spent coin, the remainder of the input coin not fitting the quantity step is kept by the sender.
+	CoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in"`
+	// coin_out is the received coin.
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSwapExactInResponse) Reset()         { *m = MsgSwapExactInResponse{} }
+func (m *MsgSwapExactInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactInResponse) ProtoMessage()    {}
+func (*MsgSwapExactInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{23}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSwapExactInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgSwapExactInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactInResponse.Merge(m, src)
+}
+func (m *MsgSwapExactInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactInResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgSwapExactInResponse proto.InternalMessageInfo
+
+// MsgSwapExactOutResponse defines the response of the MsgSwapExactOut.
+type MsgSwapExactOutResponse struct {
+	// coin_in is the spent coin.
+	CoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in"`
+	// coin_out is the received coin, it might exceed the requested coin because of the rounding to the quantity step.
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out"`
+}
+
+func (m *MsgSwapExactOutResponse) Reset()         { *m = MsgSwapExactOutResponse{} }
+func (m *MsgSwapExactOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactOutResponse) ProtoMessage()    {}
+func (*MsgSwapExactOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{24}
+}
+func (m *MsgSwapExactOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Type != 0 {
-		n += 1 + sovTx(uint64(m.Type))
+}
+func (m *MsgSwapExactOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactOutResponse.Merge(m, src)
+}
+func (m *MsgSwapExactOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactOutResponse proto.InternalMessageInfo
+
+// MsgClaimRewardsResponse defines the response of the MsgClaimRewards.
+type MsgClaimRewardsResponse struct {
+	// amount is the claimed rewards.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{25}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+type EmptyResponse struct {
+}
+
+func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
+func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
+func (*EmptyResponse) ProtoMessage()    {}
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3181ef84525da2, []int{26}
+}
+func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmptyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *EmptyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyResponse.Merge(m, src)
+}
+func (m *EmptyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmptyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("coreum.dex.v1.BatchMode", BatchMode_name, BatchMode_value)
+	proto.RegisterType((*MsgUpdateParams)(nil), "coreum.dex.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgPlaceOrder)(nil), "coreum.dex.v1.MsgPlaceOrder")
+	proto.RegisterType((*MsgReplaceOrder)(nil), "coreum.dex.v1.MsgReplaceOrder")
+	proto.RegisterType((*MsgCancelOrder)(nil), "coreum.dex.v1.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrdersByDenom)(nil), "coreum.dex.v1.MsgCancelOrdersByDenom")
+	proto.RegisterType((*BatchOrder)(nil), "coreum.dex.v1.BatchOrder")
+	proto.RegisterType((*MsgBatchPlaceOrders)(nil), "coreum.dex.v1.MsgBatchPlaceOrders")
+	proto.RegisterType((*MsgBatchCancelOrders)(nil), "coreum.dex.v1.MsgBatchCancelOrders")
+	proto.RegisterType((*MsgCancelAllOrders)(nil), "coreum.dex.v1.MsgCancelAllOrders")
+	proto.RegisterType((*MsgSetDeadManSwitch)(nil), "coreum.dex.v1.MsgSetDeadManSwitch")
+	proto.RegisterType((*MsgHeartbeat)(nil), "coreum.dex.v1.MsgHeartbeat")
+	proto.RegisterType((*MsgCreateOrderBook)(nil), "coreum.dex.v1.MsgCreateOrderBook")
+	proto.RegisterType((*MsgUpdateOrderBook)(nil), "coreum.dex.v1.MsgUpdateOrderBook")
+	proto.RegisterType((*MsgUpdateOrderBookStatus)(nil), "coreum.dex.v1.MsgUpdateOrderBookStatus")
+	proto.RegisterType((*MsgSwapExactIn)(nil), "coreum.dex.v1.MsgSwapExactIn")
+	proto.RegisterType((*MsgSwapExactOut)(nil), "coreum.dex.v1.MsgSwapExactOut")
+	proto.RegisterType((*MsgFundRewardProgram)(nil), "coreum.dex.v1.MsgFundRewardProgram")
+	proto.RegisterType((*MsgClaimRewards)(nil), "coreum.dex.v1.MsgClaimRewards")
+	proto.RegisterType((*MsgSetOrderQuota)(nil), "coreum.dex.v1.MsgSetOrderQuota")
+	proto.RegisterType((*BatchOrderResult)(nil), "coreum.dex.v1.BatchOrderResult")
+	proto.RegisterType((*MsgBatchPlaceOrdersResponse)(nil), "coreum.dex.v1.MsgBatchPlaceOrdersResponse")
+	proto.RegisterType((*MsgBatchCancelOrdersResponse)(nil), "coreum.dex.v1.MsgBatchCancelOrdersResponse")
+	proto.RegisterType((*MsgCancelAllOrdersResponse)(nil), "coreum.dex.v1.MsgCancelAllOrdersResponse")
+	proto.RegisterType((*MsgSwapExactInResponse)(nil), "coreum.dex.v1.MsgSwapExactInResponse")
+	proto.RegisterType((*MsgSwapExactOutResponse)(nil), "coreum.dex.v1.MsgSwapExactOutResponse")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "coreum.dex.v1.MsgClaimRewardsResponse")
+	proto.RegisterType((*EmptyResponse)(nil), "coreum.dex.v1.EmptyResponse")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/tx.proto", fileDescriptor_6b3181ef84525da2) }
+
+var fileDescriptor_6b3181ef84525da2 = []byte{
+	// 2118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x3d, 0x6c, 0x1b, 0xc9,
+	0x15, 0xd6, 0xf2, 0x9f, 0x8f, 0xa4, 0x24, 0xaf, 0x64, 0x79, 0x45, 0xdb, 0x94, 0xbd, 0x17, 0xdb,
+	0x8a, 0x72, 0x47, 0x5a, 0x3e, 0xc0, 0x49, 0x84, 0x43, 0x12, 0x51, 0x3f, 0x36, 0x03, 0xcb, 0xd4,
+	0x2d, 0x79, 0x2e, 0x5c, 0xdc, 0x62, 0xb5, 0x3b, 0xa6, 0x16, 0xe2, 0xee, 0xf0, 0x76, 0x87, 0xb2,
+	0xd4, 0x04, 0x41, 0xaa, 0x20, 0x48, 0x91, 0x2e, 0x48, 0x95, 0x22, 0x4d, 0x90, 0x34, 0x2e, 0xd2,
+	0x05, 0x49, 0x93, 0xc6, 0xe5, 0xe1, 0x8a, 0x20, 0x38, 0x04, 0x4a, 0x22, 0x27, 0x70, 0x9f, 0x32,
+	0x55, 0x30, 0x33, 0xbb, 0xe4, 0x72, 0x49, 0x6a, 0x25, 0xda, 0x07, 0x03, 0x41, 0x1a, 0x89, 0x33,
+	0xef, 0xcd, 0xfb, 0x9b, 0x37, 0xdf, 0xbc, 0x37, 0x0b, 0x0b, 0x3a, 0x76, 0x50, 0xd7, 0xaa, 0x18,
+	0xe8, 0xa8, 0x72, 0xb8, 0x5a, 0x21, 0x47, 0xe5, 0x8e, 0x83, 0x09, 0x16, 0x0b, 0x7c, 0xbe, 0x6c,
+	0xa0, 0xa3, 0xf2, 0xe1, 0x6a, 0xf1, 0x92, 0x66, 0x99, 0x36, 0xae, 0xb0, 0xbf, 0x9c, 0xa3, 0xb8,
+	0x38, 0xb8, 0x12, 0x3b, 0x06, 0x72, 0x3c, 0x52, 0x71, 0x90, 0xd4, 0xd1, 0x1c, 0xcd, 0x72, 0x3d,
+	0x5a, 0x49, 0xc7, 0xae, 0x85, 0xdd, 0xca, 0x9e, 0xe6, 0xa2, 0xca, 0xe1, 0xea, 0x1e, 0x22, 0xda,
+	0x6a, 0x45, 0xc7, 0xa6, 0xed, 0xd1, 0xaf, 0x78, 0x74, 0xcb, 0x6d, 0xd1, 0xb5, 0x96, 0xdb, 0xea,
+	0xeb, 0xa3, 0x04, 0x95, 0x8d, 0x2a, 0x7c, 0xe0, 0x91, 0xe6, 0x5b, 0xb8, 0x85, 0xf9, 0x3c, 0xfd,
+	0xc5, 0x67, 0xe5, 0xdf, 0x0a, 0x30, 0xb3, 0xe3, 0xb6, 0x3e, 0xe9, 0x18, 0x1a, 0x41, 0xbb, 0xcc,
+	0x06, 0xf1, 0x3e, 0x64, 0xb5, 0x2e, 0xd9, 0xc7, 0x8e, 0x49, 0x8e, 0x25, 0xe1, 0x86, 0xb0, 0x9c,
+	0xad, 0x4a, 0x5f, 0xfc, 0xee, 0x83, 0x79, 0x4f, 0xdc, 0xba, 0x61, 0x38, 0xc8, 0x75, 0x1b, 0xc4,
+	0x31, 0xed, 0x96, 0xd2, 0x67, 0x15, 0xbf, 0x05, 0x29, 0xee, 0x85, 0x14, 0xbb, 0x21, 0x2c, 0xe7,
+	0xee, 0x5d, 0x2e, 0x0f, 0xc4, 0xa7, 0xcc, 0xc5, 0x57, 0xb3, 0x2f, 0x4f, 0x96, 0xa6, 0x7e, 0xfd,
+	0xfa, 0xc5, 0x8a, 0xa0, 0x78, 0xfc, 0x6b, 0xb7, 0x7f, 0xf4, 0xfa, 0xc5, 0x4a, 0x5f, 0xd2, 0x4f,
+	0x5e, 0xbf, 0x58, 0x99, 0xa3, 0x71, 0x09, 0x59, 0x26, 0x7f, 0x91, 0x84, 0xc2, 0x8e, 0xdb, 0xda,
+	0x6d, 0x6b, 0x3a, 0xaa, 0xd3, 0x58, 0x8a, 0x77, 0x21, 0xe5, 0x22, 0xdb, 0x40, 0x4e, 0xa4, 0xa1,
+	0x1e, 0x9f, 0xf8, 0x3e, 0x24, 0xc8, 0x71, 0x07, 0x31, 0x1b, 0xa7, 0xef, 0x49, 0x21, 0x1b, 0x99,
+	0xd4, 0xe6, 0x71, 0x07, 0x29, 0x8c, 0x4b, 0x5c, 0x80, 0x98, 0x69, 0x48, 0x71, 0x26, 0x3b, 0x75,
+	0x7a, 0xb2, 0x14, 0xab, 0x6d, 0x2a, 0x31, 0xd3, 0x10, 0xaf, 0x03, 0xd0, 0xcd, 0x51, 0x0d, 0x64,
+	0x63, 0x4b, 0x4a, 0x50, 0xba, 0x92, 0xa5, 0x33, 0x9b, 0x74, 0x42, 0x5c, 0x82, 0xdc, 0x67, 0x5d,
+	0x4c, 0x7c, 0x7a, 0x92, 0xd1, 0x81, 0x4d, 0xf9, 0x0c, 0xc9, 0x8e, 0x63, 0xea, 0x48, 0x4a, 0x31,
+	0xd1, 0xd9, 0x2f, 0x4f, 0x96, 0x92, 0xbb, 0x74, 0x42, 0xe1, 0xf3, 0xe2, 0xb7, 0x21, 0xf3, 0x59,
+	0x57, 0xb3, 0x09, 0xdd, 0x83, 0x34, 0xe3, 0xb9, 0x4e, 0xe3, 0xf6, 0xe5, 0xc9, 0xd2, 0x65, 0xee,
+	0x9e, 0x6b, 0x1c, 0x94, 0x4d, 0x5c, 0xb1, 0x34, 0xb2, 0x5f, 0xae, 0xd9, 0x44, 0xe9, 0xb1, 0x8b,
+	0x77, 0x20, 0xe1, 0x9a, 0x06, 0x92, 0x32, 0xcc, 0xc3, 0xb9, 0x90, 0x87, 0x0d, 0xd3, 0x40, 0x0a,
+	0x63, 0x10, 0x57, 0x21, 0xd3, 0xc2, 0xd8, 0x50, 0x89, 0xd9, 0x96, 0xb2, 0x6c, 0xcb, 0x16, 0x42,
+	0xcc, 0x0f, 0x30, 0x36, 0x9a, 0x66, 0x5b, 0x49, 0xb7, 0xf8, 0x0f, 0xf1, 0x3b, 0x50, 0x20, 0xa6,
+	0x85, 0x54, 0xd3, 0x56, 0x9f, 0x61, 0x47, 0x47, 0x12, 0x30, 0x25, 0xc5, 0xd0, 0xba, 0xa6, 0x69,
+	0xa1, 0x9a, 0xbd, 0x4d, 0x39, 0x94, 0x1c, 0xe9, 0x0f, 0xc4, 0xbb, 0x90, 0x26, 0x8e, 0xd9, 0x6a,
+	0x21, 0x47, 0xca, 0x8d, 0xd4, 0xd8, 0xe4, 0x54, 0xc5, 0x67, 0x13, 0x9f, 0xc0, 0x65, 0x17, 0xb5,
+	0x9f, 0xa9, 0xc4, 0xd1, 0x0c, 0xa4, 0x76, 0x1c, 0x74, 0x88, 0x6c, 0x62, 0x62, 0x5b, 0xca, 0x33,
+	0xcd, 0x72, 0xd8, 0x3d, 0xd4, 0x7e, 0xd6, 0xa4, 0xac, 0xbb, 0x3d, 0x4e, 0x65, 0xce, 0x1d, 0x9e,
+	0x14, 0x37, 0x61, 0xd6, 0x30, 0xdd, 0x4e, 0x5b, 0x3b, 0x56, 0x7b, 0x81, 0x2e, 0xb0, 0x40, 0x2f,
+	0x8e, 0x0f, 0xf2, 0x8c, 0xb7, 0xe4, 0x63, 0x3f, 0xd6, 0x25, 0xc8, 0x69, 0xed, 0xb6, 0x8a, 0x1d,
+	0xd5, 0xc6, 0x36, 0x92, 0xa6, 0x6f, 0x08, 0xcb, 0x19, 0x25, 0xab, 0xb5, 0xdb, 0x75, 0xe7, 0x31,
+	0xb6, 0xd1, 0xda, 0x4d, 0x9a, 0xd9, 0x5e, 0xea, 0xd1, 0xb4, 0xbe, 0xe4, 0xa5, 0x75, 0x3f, 0x85,
+	0xe5, 0xbf, 0xf2, 0x23, 0xa8, 0xa0, 0xce, 0x9b, 0xa4, 0x35, 0x4f, 0xd4, 0xd8, 0x50, 0xa2, 0xf6,
+	0x12, 0x2d, 0x7e, 0x8e, 0x44, 0x4b, 0x5c, 0x28, 0xd1, 0xd6, 0xde, 0x0b, 0x39, 0xe7, 0x9f, 0xd9,
+	0xa0, 0x2b, 0xb2, 0x01, 0xd3, 0x3b, 0x6e, 0x6b, 0x43, 0xb3, 0x75, 0xd4, 0xe6, 0xce, 0x2d, 0x0c,
+	0x3a, 0x17, 0xe5, 0xc2, 0x9a, 0x1c, 0x52, 0x23, 0x7a, 0x6a, 0x02, 0x32, 0xe5, 0x9f, 0x0a, 0xb0,
+	0x30, 0x38, 0xe5, 0x56, 0x8f, 0xf9, 0x51, 0x1b, 0xa7, 0x4e, 0x82, 0xb4, 0xa6, 0xeb, 0xb8, 0x6b,
+	0x13, 0xae, 0x53, 0xf1, 0x87, 0xe2, 0x3c, 0x24, 0xf9, 0xb9, 0x65, 0x31, 0x53, 0xf8, 0x60, 0x6d,
+	0x25, 0x64, 0x46, 0x71, 0xd8, 0x0c, 0x5f, 0xa7, 0xfc, 0x9f, 0x04, 0x40, 0x55, 0x23, 0xfa, 0x7e,
+	0xdd, 0x09, 0x62, 0x8e, 0x70, 0x01, 0xcc, 0x89, 0x45, 0x60, 0x4e, 0x3c, 0x02, 0x73, 0x12, 0xe3,
+	0x31, 0x27, 0x79, 0x8e, 0x54, 0x48, 0x4d, 0x86, 0x39, 0xe9, 0x8b, 0x60, 0x4e, 0x66, 0x42, 0xcc,
+	0xc9, 0x4e, 0x8c, 0x39, 0xf0, 0x86, 0x98, 0x93, 0x7b, 0xfb, 0x98, 0x93, 0x7f, 0x53, 0xcc, 0x29,
+	0x84, 0x30, 0x87, 0x02, 0xca, 0xdc, 0x8e, 0xdb, 0x62, 0xf9, 0xd7, 0xc7, 0x19, 0x77, 0x02, 0x50,
+	0xf9, 0x08, 0x52, 0xac, 0x64, 0xa1, 0x37, 0x7a, 0x7c, 0x39, 0x77, 0x6f, 0x31, 0xe4, 0x78, 0x3f,
+	0xc5, 0x07, 0x6e, 0x75, 0xbe, 0x86, 0x66, 0xbd, 0x85, 0x0d, 0x8e, 0x3c, 0xc3, 0x59, 0xcf, 0xd6,
+	0xee, 0x60, 0x9a, 0x18, 0x94, 0x6b, 0xed, 0x4e, 0xe8, 0x78, 0x5d, 0xf1, 0x8e, 0x57, 0xd8, 0x0d,
+	0xf9, 0xf7, 0x02, 0xcc, 0xfb, 0xf3, 0xc1, 0xb3, 0x37, 0x81, 0x7f, 0x8b, 0x10, 0x37, 0x0d, 0xee,
+	0x5c, 0xb6, 0x9a, 0x3e, 0x3d, 0x59, 0x8a, 0xd7, 0x36, 0x5d, 0x85, 0xce, 0x5d, 0xd0, 0xf8, 0xe5,
+	0x90, 0xf1, 0x52, 0xd0, 0xf8, 0xa0, 0x91, 0xf2, 0x1f, 0x62, 0x20, 0xf6, 0x40, 0x63, 0xbd, 0x3d,
+	0xb9, 0xed, 0x83, 0x68, 0x10, 0x8b, 0x40, 0x83, 0xf8, 0x10, 0x1a, 0xf8, 0x27, 0x36, 0x11, 0x75,
+	0x62, 0x6f, 0x43, 0xd6, 0x32, 0x6d, 0x75, 0x0c, 0x74, 0x64, 0x2c, 0xd3, 0x66, 0xbf, 0x18, 0x9f,
+	0x76, 0xa4, 0x8e, 0x29, 0x6b, 0x32, 0x96, 0x76, 0xc4, 0xf9, 0xe6, 0x21, 0xd9, 0x36, 0x2d, 0x93,
+	0x30, 0xac, 0x28, 0x28, 0x7c, 0xc0, 0x4b, 0xc0, 0x40, 0x04, 0x17, 0x06, 0xd0, 0xb5, 0x17, 0x28,
+	0xf9, 0xe7, 0x3c, 0xb9, 0x1b, 0x88, 0x6c, 0x22, 0xcd, 0xd8, 0xd1, 0xec, 0xc6, 0x73, 0x93, 0xe8,
+	0xfb, 0x13, 0x04, 0xf0, 0x16, 0x4c, 0x53, 0x94, 0xc0, 0x5d, 0xa2, 0xee, 0xb5, 0xb1, 0x7e, 0xc0,
+	0xcb, 0xd6, 0x84, 0x52, 0xf0, 0x66, 0xab, 0x6c, 0x72, 0x6c, 0x5e, 0x86, 0x2d, 0x90, 0xf7, 0x20,
+	0xbf, 0xe3, 0xb6, 0x1e, 0x22, 0xcd, 0x21, 0x7b, 0x48, 0x23, 0x17, 0xb7, 0x68, 0xed, 0x46, 0x48,
+	0xd5, 0xac, 0xa7, 0xaa, 0x27, 0x53, 0xfe, 0xb3, 0x97, 0x3d, 0x0e, 0xd2, 0x08, 0x3f, 0x0f, 0x55,
+	0x8c, 0x0f, 0xde, 0x41, 0xf6, 0x2c, 0x03, 0xb0, 0x8d, 0x56, 0x89, 0xa9, 0x1f, 0x48, 0x89, 0xf0,
+	0x6e, 0x67, 0x19, 0xb1, 0x69, 0xea, 0x07, 0x14, 0xbd, 0x7d, 0xac, 0x53, 0x5d, 0x82, 0x3a, 0x52,
+	0x32, 0x0a, 0xf0, 0xf2, 0x3e, 0x7f, 0x83, 0xa0, 0x8e, 0xf8, 0x11, 0xe4, 0x69, 0xfa, 0x85, 0x2e,
+	0xa6, 0x33, 0x96, 0xe7, 0x2c, 0xd3, 0xf6, 0xb1, 0x72, 0x7c, 0x5a, 0x0d, 0x46, 0xd0, 0x0f, 0x2c,
+	0xef, 0x36, 0xfe, 0x1f, 0xd8, 0xc9, 0x03, 0x1b, 0x8a, 0xa0, 0xfc, 0x6f, 0x01, 0xa4, 0xe1, 0xe9,
+	0x06, 0xd1, 0x48, 0xf7, 0x5d, 0xa0, 0xde, 0x7d, 0x48, 0xb9, 0x4c, 0xb7, 0x87, 0x7b, 0xa5, 0x51,
+	0xb5, 0x58, 0xdf, 0x42, 0xc5, 0xe3, 0x5e, 0x7b, 0x3f, 0xe4, 0xee, 0xb5, 0xd1, 0xee, 0xf2, 0x55,
+	0xf2, 0x9f, 0x62, 0xac, 0xe8, 0x6d, 0x3c, 0xd7, 0x3a, 0x5b, 0x47, 0x9a, 0x4e, 0x6a, 0xf6, 0x04,
+	0xae, 0xea, 0x90, 0xa6, 0x2d, 0xbf, 0x6a, 0xda, 0x5e, 0x3f, 0xbd, 0x58, 0xf6, 0xf8, 0xa9, 0xbf,
+	0x65, 0xef, 0x59, 0xa0, 0xbc, 0x81, 0x4d, 0xbb, 0x5a, 0xf1, 0xea, 0xb4, 0x3b, 0x2d, 0x93, 0xec,
+	0x77, 0xf7, 0xca, 0x3a, 0xb6, 0xbc, 0xee, 0xdf, 0xfb, 0xf7, 0x81, 0x6b, 0x1c, 0x54, 0x68, 0x75,
+	0xe9, 0xb2, 0x05, 0x4a, 0x8a, 0x8a, 0xae, 0xd9, 0xe2, 0x55, 0xc8, 0xb2, 0x50, 0xa9, 0xb8, 0x4b,
+	0xbc, 0x70, 0x65, 0xd8, 0x44, 0xbd, 0xcb, 0xea, 0x60, 0x07, 0x77, 0x09, 0xbd, 0x23, 0xe2, 0xb4,
+	0x0e, 0x66, 0x03, 0x71, 0x03, 0xa6, 0x69, 0xde, 0x68, 0x16, 0xad, 0x95, 0xd9, 0xba, 0xe4, 0x79,
+	0x6a, 0x45, 0x9a, 0x6c, 0xeb, 0x6c, 0x4d, 0xbd, 0x4b, 0xc6, 0xd6, 0xf4, 0x81, 0x90, 0xd1, 0x28,
+	0xce, 0x04, 0xa7, 0xa8, 0x49, 0x93, 0xdc, 0xf1, 0xdc, 0x21, 0x3f, 0x8e, 0x59, 0x25, 0xcd, 0xc6,
+	0x35, 0x5b, 0x44, 0x90, 0x61, 0x11, 0xf6, 0x7d, 0x7f, 0xbb, 0x21, 0x66, 0xbb, 0x37, 0x3e, 0x8c,
+	0xeb, 0x50, 0xa0, 0xd7, 0xa5, 0x17, 0x46, 0xd3, 0x3e, 0x5f, 0x14, 0x73, 0x96, 0x76, 0xc4, 0xa3,
+	0x58, 0xb3, 0xc7, 0xf6, 0x5f, 0xc1, 0x88, 0xc9, 0x7f, 0x8c, 0xb3, 0x72, 0x69, 0xbb, 0x6b, 0x1b,
+	0x0a, 0x7a, 0xae, 0x39, 0xc6, 0xae, 0x83, 0x5b, 0x8e, 0x66, 0xbd, 0x83, 0xc3, 0xb7, 0x07, 0x29,
+	0xee, 0xae, 0x94, 0x78, 0xeb, 0xd1, 0xf6, 0x24, 0x8b, 0x0f, 0x60, 0xd6, 0x61, 0x6e, 0xaa, 0x1d,
+	0xe4, 0xa8, 0xa8, 0x83, 0xf5, 0xfd, 0xf3, 0x45, 0x76, 0x9a, 0x2f, 0xdb, 0x45, 0xce, 0x16, 0x5d,
+	0x44, 0x4f, 0x06, 0xdd, 0x1f, 0x0a, 0xc3, 0x2e, 0xc3, 0xc6, 0x02, 0xab, 0x61, 0x28, 0xf4, 0xba,
+	0xe2, 0xf7, 0x42, 0xd8, 0x99, 0x3e, 0xdf, 0xde, 0x05, 0xf0, 0x73, 0x5c, 0xc5, 0x38, 0xb4, 0x4f,
+	0xf2, 0x3e, 0x3b, 0x05, 0x1b, 0x6d, 0xcd, 0xb4, 0x38, 0x61, 0x02, 0xdc, 0x1c, 0x9b, 0x2a, 0x41,
+	0xb1, 0xf2, 0x2f, 0x05, 0x98, 0xe5, 0x95, 0x0d, 0x03, 0xb4, 0x8f, 0xbb, 0x98, 0x68, 0x13, 0xa4,
+	0xc9, 0x87, 0xb0, 0x80, 0x8e, 0x88, 0xa3, 0xa9, 0xbc, 0x0f, 0x60, 0x1b, 0xd1, 0x4f, 0x99, 0x84,
+	0x32, 0xc7, 0xa8, 0x4c, 0x85, 0xbb, 0x8b, 0x1c, 0x96, 0x1b, 0x6b, 0x5f, 0x0b, 0x19, 0x38, 0xdf,
+	0x2f, 0xb3, 0xfa, 0xc6, 0xc8, 0x4f, 0x61, 0xb6, 0xdf, 0x73, 0x28, 0xc8, 0xed, 0xb6, 0x89, 0xd7,
+	0x2e, 0x0b, 0x43, 0xed, 0xb2, 0x04, 0x69, 0xb7, 0xab, 0xeb, 0xc8, 0xe5, 0x85, 0x5d, 0x46, 0xf1,
+	0x87, 0xf4, 0x40, 0x22, 0xc7, 0xc1, 0x8e, 0xdf, 0xdf, 0xb3, 0x81, 0xfc, 0x29, 0x5c, 0x1d, 0xd1,
+	0x6e, 0x28, 0xc8, 0xed, 0x60, 0xdb, 0x45, 0xe2, 0x77, 0x21, 0xed, 0x30, 0x85, 0xae, 0x24, 0xb0,
+	0x66, 0x68, 0x69, 0x6c, 0x33, 0xc4, 0x0d, 0xab, 0x26, 0x68, 0x3a, 0x28, 0xfe, 0x2a, 0x59, 0x85,
+	0x6b, 0xa3, 0x3a, 0x82, 0xb7, 0xa7, 0xe0, 0x9b, 0x50, 0x1c, 0x2e, 0x98, 0x7b, 0xe2, 0xbd, 0x5e,
+	0x47, 0x18, 0xee, 0x75, 0xe4, 0x7f, 0xf2, 0xc7, 0x93, 0x00, 0xf6, 0xf6, 0x56, 0x05, 0x2e, 0x21,
+	0xe1, 0x2b, 0xbb, 0x84, 0x82, 0x38, 0x1c, 0xfb, 0xca, 0x70, 0x58, 0xfe, 0x97, 0x00, 0x57, 0x42,
+	0xe8, 0xf8, 0x3f, 0xe9, 0xe7, 0x0f, 0xe0, 0x4a, 0xe8, 0x64, 0x07, 0xdc, 0xf4, 0x11, 0x58, 0xe8,
+	0x35, 0xf4, 0x63, 0xf4, 0xdf, 0xa5, 0xfa, 0x7f, 0xf3, 0xb7, 0xa5, 0xe5, 0x73, 0xea, 0x77, 0x7d,
+	0x08, 0x96, 0x67, 0xa0, 0xb0, 0x65, 0x75, 0xc8, 0xb1, 0xaf, 0x75, 0x65, 0x1f, 0xb2, 0xbd, 0x86,
+	0x59, 0x2c, 0xc2, 0x42, 0x75, 0xbd, 0xb9, 0xf1, 0x50, 0xdd, 0xa9, 0x6f, 0x6e, 0xa9, 0x9f, 0x3c,
+	0x6e, 0xec, 0x6e, 0x6d, 0xd4, 0xb6, 0x6b, 0x5b, 0x9b, 0xb3, 0x53, 0xe2, 0x75, 0x58, 0x0c, 0xd0,
+	0xd6, 0x1f, 0x3d, 0x52, 0xeb, 0x8a, 0xfa, 0xb8, 0xde, 0x7c, 0x58, 0x7b, 0xfc, 0x60, 0x56, 0x08,
+	0x2d, 0xad, 0x6e, 0x35, 0x9a, 0xea, 0xd6, 0xf6, 0x76, 0x5d, 0x69, 0xce, 0xc6, 0x8a, 0x89, 0x1f,
+	0xff, 0xaa, 0x34, 0x75, 0xef, 0x17, 0x79, 0x88, 0xef, 0xb8, 0x2d, 0xf1, 0x11, 0xe4, 0x07, 0x3e,
+	0x69, 0x84, 0xcb, 0xbc, 0xd0, 0x87, 0x85, 0xe2, 0xb5, 0x10, 0x7d, 0xc0, 0x7e, 0xf1, 0x21, 0x40,
+	0xe0, 0x93, 0xc3, 0xb5, 0x61, 0x59, 0x7d, 0x6a, 0x84, 0xa4, 0x47, 0x90, 0x1f, 0x78, 0xe7, 0x1d,
+	0x61, 0x57, 0x90, 0x1e, 0x21, 0xed, 0xfb, 0x90, 0x0b, 0xbe, 0xab, 0x5e, 0x1f, 0x16, 0x16, 0x20,
+	0x47, 0xc8, 0x7a, 0x0a, 0x73, 0xa3, 0x1e, 0x4f, 0x6f, 0x9d, 0x29, 0xd3, 0x67, 0x8b, 0x90, 0xbd,
+	0xe7, 0xa1, 0x76, 0xf0, 0x31, 0x4a, 0x1e, 0x16, 0x1c, 0xe6, 0x29, 0xae, 0x44, 0xf3, 0xf4, 0x74,
+	0x20, 0xb8, 0x34, 0xfc, 0x22, 0xf4, 0xde, 0x18, 0x01, 0x41, 0xa6, 0xe2, 0x37, 0xce, 0xc1, 0xd4,
+	0x53, 0xa3, 0xc2, 0x4c, 0xf8, 0xe9, 0xe6, 0xe6, 0xb8, 0x10, 0xf5, 0x58, 0x8a, 0x5f, 0x8f, 0x64,
+	0xe9, 0x29, 0x68, 0xc2, 0xec, 0xd0, 0xdb, 0xc6, 0x88, 0x58, 0x85, 0x79, 0x22, 0x76, 0x60, 0x1b,
+	0xb2, 0xfd, 0x87, 0x89, 0xab, 0xc3, 0xe2, 0x7a, 0xc4, 0x08, 0x39, 0x0a, 0xcc, 0x84, 0xdf, 0x1e,
+	0x46, 0xb9, 0x3f, 0xc8, 0x12, 0x2d, 0x33, 0xdc, 0x76, 0xdf, 0x1c, 0x77, 0x5c, 0xcf, 0x2b, 0xf3,
+	0x53, 0xb8, 0x3c, 0xba, 0xe3, 0xbc, 0x13, 0x29, 0x99, 0x33, 0x46, 0xc8, 0x6f, 0x40, 0x2e, 0xd8,
+	0xdc, 0x8d, 0x38, 0x79, 0x01, 0x72, 0xf1, 0xd6, 0x99, 0xe4, 0x9e, 0xd0, 0x27, 0x90, 0x1f, 0xe8,
+	0x75, 0x4a, 0x67, 0x2c, 0xab, 0x77, 0x49, 0xf1, 0xf6, 0xd9, 0xf4, 0x80, 0xdc, 0x4b, 0xc3, 0xd5,
+	0xff, 0x88, 0xa3, 0x31, 0xc4, 0x14, 0x11, 0x84, 0x27, 0x90, 0x1f, 0xa8, 0x4a, 0x47, 0xd8, 0x1b,
+	0xa4, 0x17, 0x6f, 0x9f, 0x4d, 0xef, 0xc9, 0x7d, 0x0c, 0x85, 0xc1, 0x12, 0x74, 0x69, 0x64, 0xfe,
+	0xf7, 0x19, 0xce, 0xb6, 0xb3, 0x98, 0xfc, 0x21, 0x7d, 0x96, 0xae, 0xd6, 0x5f, 0xfe, 0xa3, 0x34,
+	0xf5, 0xf2, 0xb4, 0x24, 0x7c, 0x7e, 0x5a, 0x12, 0xfe, 0x7e, 0x5a, 0x12, 0x7e, 0xf6, 0xaa, 0x34,
+	0xf5, 0xf9, 0xab, 0xd2, 0xd4, 0x5f, 0x5e, 0x95, 0xa6, 0x9e, 0xae, 0x06, 0xae, 0xb9, 0x0d, 0x26,
+	0x6c, 0x1b, 0x77, 0x6d, 0x43, 0xa3, 0xef, 0xf6, 0x15, 0x2e, 0xbd, 0x72, 0x78, 0xbf, 0x72, 0xc4,
+	0xbe, 0xd7, 0xb3, 0x5b, 0x6f, 0x2f, 0xc5, 0x3e, 0xa1, 0x7f, 0xf8, 0xdf, 0x01, 0x00, 0x25, 0x68,
+	0x29, 0x9a, 0x1f, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams is a governance operation to modify the parameters of the module.
+	// NOTE: all parameters must be provided.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// PlaceOrder place an order on orderbook.
+	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ReplaceOrder changes the price and/or the quantity of an order in the orderbook.
+	ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// CancelOrder cancels an order in the orderbook.
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// CancelOrdersByDenom cancels all orders by denom and account.
+	CancelOrdersByDenom(ctx context.Context, in *MsgCancelOrdersByDenom, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// BatchPlaceOrders places multiple orders on orderbook.
+	BatchPlaceOrders(ctx context.Context, in *MsgBatchPlaceOrders, opts ...grpc.CallOption) (*MsgBatchPlaceOrdersResponse, error)
+	// BatchCancelOrders cancels multiple orders in the orderbook.
+	BatchCancelOrders(ctx context.Context, in *MsgBatchCancelOrders, opts ...grpc.CallOption) (*MsgBatchCancelOrdersResponse, error)
+	// CancelAllOrders cancels the orders of the sender matching the filters.
+	CancelAllOrders(ctx context.Context, in *MsgCancelAllOrders, opts ...grpc.CallOption) (*MsgCancelAllOrdersResponse, error)
+	// SetDeadManSwitch arms, updates or disarms the dead man's switch of the sender.
+	SetDeadManSwitch(ctx context.Context, in *MsgSetDeadManSwitch, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// Heartbeat refreshes the dead man's switch of the sender.
+	Heartbeat(ctx context.Context, in *MsgHeartbeat, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// CreateOrderBook registers the order book pair.
+	CreateOrderBook(ctx context.Context, in *MsgCreateOrderBook, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateOrderBook updates the price tick, quantity step and min quantity of the order book.
+	UpdateOrderBook(ctx context.Context, in *MsgUpdateOrderBook, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateOrderBookStatus pauses, resumes or delists the order book pair.
+	UpdateOrderBookStatus(ctx context.Context, in *MsgUpdateOrderBookStatus, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SwapExactIn swaps the exact input coin to the output denom through the route of the order books.
+	SwapExactIn(ctx context.Context, in *MsgSwapExactIn, opts ...grpc.CallOption) (*MsgSwapExactInResponse, error)
+	// SwapExactOut swaps the input denom to the exact output coin through the route of the order books.
+	SwapExactOut(ctx context.Context, in *MsgSwapExactOut, opts ...grpc.CallOption) (*MsgSwapExactOutResponse, error)
+	// FundRewardProgram creates or funds the reward program of the order book.
+	FundRewardProgram(ctx context.Context, in *MsgFundRewardProgram, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ClaimRewards claims the rewards accrued by the sender in all reward programs.
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// SetOrderQuota sets the additional number of orders per denom the sender can have, the reserve is locked or
+	// released according to the change.
+	SetOrderQuota(ctx context.Context, in *MsgSetOrderQuota, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Quantity.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Side != 0 {
-		n += 1 + sovTx(uint64(m.Side))
+	return out, nil
+}
+
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GoodTil != nil {
-		l = m.GoodTil.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelOrdersByDenom(ctx context.Context, in *MsgCancelOrdersByDenom, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/CancelOrdersByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
+	return out, nil
+}
+
+func (c *msgClient) BatchPlaceOrders(ctx context.Context, in *MsgBatchPlaceOrders, opts ...grpc.CallOption) (*MsgBatchPlaceOrdersResponse, error) {
+	out := new(MsgBatchPlaceOrdersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/BatchPlaceOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) BatchCancelOrders(ctx context.Context, in *MsgBatchCancelOrders, opts ...grpc.CallOption) (*MsgBatchCancelOrdersResponse, error) {
+	out := new(MsgBatchCancelOrdersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/BatchCancelOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelAllOrders(ctx context.Context, in *MsgCancelAllOrders, opts ...grpc.CallOption) (*MsgCancelAllOrdersResponse, error) {
+	out := new(MsgCancelAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetDeadManSwitch(ctx context.Context, in *MsgSetDeadManSwitch, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/SetDeadManSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelOrdersByDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Heartbeat(ctx context.Context, in *MsgHeartbeat, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateOrderBook(ctx context.Context, in *MsgCreateOrderBook, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/CreateOrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateOrderBook(ctx context.Context, in *MsgUpdateOrderBook, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/UpdateOrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateOrderBookStatus(ctx context.Context, in *MsgUpdateOrderBookStatus, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/UpdateOrderBookStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactIn(ctx context.Context, in *MsgSwapExactIn, opts ...grpc.CallOption) (*MsgSwapExactInResponse, error) {
+	out := new(MsgSwapExactInResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/SwapExactIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactOut(ctx context.Context, in *MsgSwapExactOut, opts ...grpc.CallOption) (*MsgSwapExactOutResponse, error) {
+	out := new(MsgSwapExactOutResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/SwapExactOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardProgram(ctx context.Context, in *MsgFundRewardProgram, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/FundRewardProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOrderQuota(ctx context.Context, in *MsgSetOrderQuota, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Msg/SetOrderQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams is a governance operation to modify the parameters of the module.
+	// NOTE: all parameters must be provided.
+	UpdateParams(context.Context, *MsgUpdateParams) (*EmptyResponse, error)
+	// PlaceOrder place an order on orderbook.
+	PlaceOrder(context.Context, *MsgPlaceOrder) (*EmptyResponse, error)
+	// ReplaceOrder changes the price and/or the quantity of an order in the orderbook.
+	ReplaceOrder(context.Context, *MsgReplaceOrder) (*EmptyResponse, error)
+	// CancelOrder cancels an order in the orderbook.
+	CancelOrder(context.Context, *MsgCancelOrder) (*EmptyResponse, error)
+	// CancelOrdersByDenom cancels all orders by denom and account.
+	CancelOrdersByDenom(context.Context, *MsgCancelOrdersByDenom) (*EmptyResponse, error)
+	// BatchPlaceOrders places multiple orders on orderbook.
+	BatchPlaceOrders(context.Context, *MsgBatchPlaceOrders) (*MsgBatchPlaceOrdersResponse, error)
+	// BatchCancelOrders cancels multiple orders in the orderbook.
+	BatchCancelOrders(context.Context, *MsgBatchCancelOrders) (*MsgBatchCancelOrdersResponse, error)
+	// CancelAllOrders cancels the orders of the sender matching the filters.
+	CancelAllOrders(context.Context, *MsgCancelAllOrders) (*MsgCancelAllOrdersResponse, error)
+	// SetDeadManSwitch arms, updates or disarms the dead man's switch of the sender.
+	SetDeadManSwitch(context.Context, *MsgSetDeadManSwitch) (*EmptyResponse, error)
+	// Heartbeat refreshes the dead man's switch of the sender.
+	Heartbeat(context.Context, *MsgHeartbeat) (*EmptyResponse, error)
+	// CreateOrderBook registers the order book pair.
+	CreateOrderBook(context.Context, *MsgCreateOrderBook) (*EmptyResponse, error)
+	// UpdateOrderBook updates the price tick, quantity step and min quantity of the order book.
+	UpdateOrderBook(context.Context, *MsgUpdateOrderBook) (*EmptyResponse, error)
+	// UpdateOrderBookStatus pauses, resumes or delists the order book pair.
+	UpdateOrderBookStatus(context.Context, *MsgUpdateOrderBookStatus) (*EmptyResponse, error)
+	// SwapExactIn swaps the exact input coin to the output denom through the route of the order books.
+	SwapExactIn(context.Context, *MsgSwapExactIn) (*MsgSwapExactInResponse, error)
+	// SwapExactOut swaps the input denom to the exact output coin through the route of the order books.
+	SwapExactOut(context.Context, *MsgSwapExactOut) (*MsgSwapExactOutResponse, error)
+	// FundRewardProgram creates or funds the reward program of the order book.
+	FundRewardProgram(context.Context, *MsgFundRewardProgram) (*EmptyResponse, error)
+	// ClaimRewards claims the rewards accrued by the sender in all reward programs.
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// SetOrderQuota sets the additional number of orders per denom the sender can have, the reserve is locked or
+	// released according to the change.
+	SetOrderQuota(context.Context, *MsgSetOrderQuota) (*EmptyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceOrder(ctx context.Context, req *MsgReplaceOrder) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrdersByDenom(ctx context.Context, req *MsgCancelOrdersByDenom) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrdersByDenom not implemented")
+}
+func (*UnimplementedMsgServer) BatchPlaceOrders(ctx context.Context, req *MsgBatchPlaceOrders) (*MsgBatchPlaceOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPlaceOrders not implemented")
+}
+func (*UnimplementedMsgServer) BatchCancelOrders(ctx context.Context, req *MsgBatchCancelOrders) (*MsgBatchCancelOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelOrders not implemented")
+}
+func (*UnimplementedMsgServer) CancelAllOrders(ctx context.Context, req *MsgCancelAllOrders) (*MsgCancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (*UnimplementedMsgServer) SetDeadManSwitch(ctx context.Context, req *MsgSetDeadManSwitch) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeadManSwitch not implemented")
+}
+func (*UnimplementedMsgServer) Heartbeat(ctx context.Context, req *MsgHeartbeat) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedMsgServer) CreateOrderBook(ctx context.Context, req *MsgCreateOrderBook) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrderBook not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOrderBook(ctx context.Context, req *MsgUpdateOrderBook) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderBook not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOrderBookStatus(ctx context.Context, req *MsgUpdateOrderBookStatus) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderBookStatus not implemented")
+}
+func (*UnimplementedMsgServer) SwapExactIn(ctx context.Context, req *MsgSwapExactIn) (*MsgSwapExactInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactIn not implemented")
+}
+func (*UnimplementedMsgServer) SwapExactOut(ctx context.Context, req *MsgSwapExactOut) (*MsgSwapExactOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactOut not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardProgram(ctx context.Context, req *MsgFundRewardProgram) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardProgram not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetOrderQuota(ctx context.Context, req *MsgSetOrderQuota) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrderQuota not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceOrder(ctx, req.(*MsgReplaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOrdersByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrdersByDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrdersByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/CancelOrdersByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrdersByDenom(ctx, req.(*MsgCancelOrdersByDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchPlaceOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchPlaceOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchPlaceOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/BatchPlaceOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchPlaceOrders(ctx, req.(*MsgBatchPlaceOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchCancelOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancelOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancelOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/BatchCancelOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancelOrders(ctx, req.(*MsgBatchCancelOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAllOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/CancelAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAllOrders(ctx, req.(*MsgCancelAllOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDeadManSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDeadManSwitch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDeadManSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/SetDeadManSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDeadManSwitch(ctx, req.(*MsgSetDeadManSwitch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Heartbeat(ctx, req.(*MsgHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOrderBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/CreateOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOrderBook(ctx, req.(*MsgCreateOrderBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOrderBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/UpdateOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOrderBook(ctx, req.(*MsgUpdateOrderBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateOrderBookStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOrderBookStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOrderBookStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/UpdateOrderBookStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOrderBookStatus(ctx, req.(*MsgUpdateOrderBookStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/SwapExactIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactIn(ctx, req.(*MsgSwapExactIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/SwapExactOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactOut(ctx, req.(*MsgSwapExactOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/FundRewardProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardProgram(ctx, req.(*MsgFundRewardProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOrderQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOrderQuota)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOrderQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Msg/SetOrderQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOrderQuota(ctx, req.(*MsgSetOrderQuota))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.dex.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Msg_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Msg_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrdersByDenom",
+			Handler:    _Msg_CancelOrdersByDenom_Handler,
+		},
+		{
+			MethodName: "BatchPlaceOrders",
+			Handler:    _Msg_BatchPlaceOrders_Handler,
+		},
+		{
+			MethodName: "BatchCancelOrders",
+			Handler:    _Msg_BatchCancelOrders_Handler,
+		},
+		{
+			MethodName: "CancelAllOrders",
+			Handler:    _Msg_CancelAllOrders_Handler,
+		},
+		{
+			MethodName: "SetDeadManSwitch",
+			Handler:    _Msg_SetDeadManSwitch_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Msg_Heartbeat_Handler,
+		},
+		{
+			MethodName: "CreateOrderBook",
+			Handler:    _Msg_CreateOrderBook_Handler,
+		},
+		{
+			MethodName: "UpdateOrderBook",
+			Handler:    _Msg_UpdateOrderBook_Handler,
+		},
+		{
+			MethodName: "UpdateOrderBookStatus",
+			Handler:    _Msg_UpdateOrderBookStatus_Handler,
+		},
+		{
+			MethodName: "SwapExactIn",
+			Handler:    _Msg_SwapExactIn_Handler,
+		},
+		{
+			MethodName: "SwapExactOut",
+			Handler:    _Msg_SwapExactOut_Handler,
+		},
+		{
+			MethodName: "FundRewardProgram",
+			Handler:    _Msg_FundRewardProgram_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "SetOrderQuota",
+			Handler:    _Msg_SetOrderQuota_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/dex/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllOrNone {
+		i--
+		if m.AllOrNone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DisplayQuantity != nil {
+		{
+			size := m.DisplayQuantity.Size()
+			i -= size
+			if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GoodTil != nil {
+		{
+			size, err := m.GoodTil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrdersByDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrdersByDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrdersByDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllOrNone {
+		i--
+		if m.AllOrNone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DisplayQuantity != nil {
+		{
+			size := m.DisplayQuantity.Size()
+			i -= size
+			if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GoodTil != nil {
+		{
+			size, err := m.GoodTil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchPlaceOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchPlaceOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchPlaceOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancelOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancelOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancelOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IDs) > 0 {
+		for iNdEx := len(m.IDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IDs[iNdEx])
+			copy(dAtA[i:], m.IDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.IDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPrice != nil {
+		{
+			size := m.MaxPrice.Size()
+			i -= size
+			if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinPrice != nil {
+		{
+			size := m.MinPrice.Size()
+			i -= size
+			if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDeadManSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDeadManSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDeadManSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinQuantity != nil {
+		{
+			size := m.MinQuantity.Size()
+			i -= size
+			if _, err := m.MinQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.QuantityStep != nil {
+		{
+			size := m.QuantityStep.Size()
+			i -= size
+			if _, err := m.QuantityStep.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PriceTick != nil {
+		{
+			size := m.PriceTick.Size()
+			i -= size
+			if _, err := m.PriceTick.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinQuantity != nil {
+		{
+			size := m.MinQuantity.Size()
+			i -= size
+			if _, err := m.MinQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.QuantityStep != nil {
+		{
+			size := m.QuantityStep.Size()
+			i -= size
+			if _, err := m.QuantityStep.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PriceTick != nil {
+		{
+			size := m.PriceTick.Size()
+			i -= size
+			if _, err := m.PriceTick.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOrderBookStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOrderBookStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOrderBookStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Route[iNdEx])
+			copy(dAtA[i:], m.Route[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Route[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenomOut) > 0 {
+		i -= len(m.DenomOut)
+		copy(dAtA[i:], m.DenomOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DenomOut)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmountIn.Size()
+		i -= size
+		if _, err := m.MaxAmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Route[iNdEx])
+			copy(dAtA[i:], m.Route[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Route[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DenomIn) > 0 {
+		i -= len(m.DenomIn)
+		copy(dAtA[i:], m.DenomIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DenomIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinQuantity.Size()
+		i -= size
+		if _, err := m.MinQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxTicks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTicks))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RewardPerEpoch.Size()
+		i -= size
+		if _, err := m.RewardPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOrderQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOrderQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOrderQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtraOrdersPerDenom != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtraOrdersPerDenom))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOrderResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOrderResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOrderResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchPlaceOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchPlaceOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchPlaceOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancelOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancelOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancelOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		for iNdEx := len(m.IDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IDs[iNdEx])
+			copy(dAtA[i:], m.IDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.IDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTx(uint64(m.Type))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	if m.GoodTil != nil {
+		l = m.GoodTil.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	if m.DisplayQuantity != nil {
+		l = m.DisplayQuantity.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllOrNone {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgReplaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOrdersByDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTx(uint64(m.Type))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	if m.GoodTil != nil {
+		l = m.GoodTil.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	if m.DisplayQuantity != nil {
+		l = m.DisplayQuantity.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllOrNone {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBatchPlaceOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MsgBatchCancelOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.IDs) > 0 {
+		for _, s := range m.IDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MsgCancelAllOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	if m.MinPrice != nil {
+		l = m.MinPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPrice != nil {
+		l = m.MaxPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgSetDeadManSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutBlocks))
+	}
+	return n
+}
+
+func (m *MsgHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateOrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PriceTick != nil {
+		l = m.PriceTick.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.QuantityStep != nil {
+		l = m.QuantityStep.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinQuantity != nil {
+		l = m.MinQuantity.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateOrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PriceTick != nil {
+		l = m.PriceTick.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.QuantityStep != nil {
+		l = m.QuantityStep.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinQuantity != nil {
+		l = m.MinQuantity.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateOrderBookStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgSwapExactIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DenomOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, s := range m.Route {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DenomIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Route) > 0 {
+		for _, s := range m.Route {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MaxAmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundRewardProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RewardPerEpoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxTicks != 0 {
+		n += 1 + sovTx(uint64(m.MaxTicks))
+	}
+	l = m.MinQuantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOrderQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExtraOrdersPerDenom != 0 {
+		n += 1 + sovTx(uint64(m.ExtraOrdersPerDenom))
+	}
+	return n
+}
+
+func (m *BatchOrderResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchPlaceOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCancelOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelAllOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		for _, s := range m.IDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSwapExactInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTil == nil {
+				m.GoodTil = &GoodTil{}
+			}
+			if err := m.GoodTil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.DisplayQuantity = &v
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOrNone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOrNone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrdersByDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrdersByDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrdersByDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTil == nil {
+				m.GoodTil = &GoodTil{}
+			}
+			if err := m.GoodTil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.DisplayQuantity = &v
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOrNone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOrNone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchPlaceOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchPlaceOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchPlaceOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, BatchOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BatchMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancelOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancelOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancelOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IDs = append(m.IDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BatchMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAllOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAllOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAllOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.MinPrice = &v
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.MaxPrice = &v
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDeadManSwitch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDeadManSwitch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDeadManSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.PriceTick = &v
+			if err := m.PriceTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.QuantityStep = &v
+			if err := m.QuantityStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinQuantity = &v
+			if err := m.MinQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.PriceTick = &v
+			if err := m.PriceTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.QuantityStep = &v
+			if err := m.QuantityStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinQuantity = &v
+			if err := m.MinQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOrderBookStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOrderBookStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOrderBookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderBookStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTicks", wireType)
+			}
+			m.MaxTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTicks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EmptyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -896,15 +7806,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -932,40 +7842,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -988,7 +7865,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOrderQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1011,10 +7888,10 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOrderQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOrderQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1051,9 +7928,9 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOrdersPerDenom", wireType)
 			}
-			m.Type = 0
+			m.ExtraOrdersPerDenom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1063,12 +7940,62 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= OrderType(b&0x7F) << shift
+				m.ExtraOrdersPerDenom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOrderResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOrderResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOrderResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
@@ -1100,9 +8027,29 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1130,13 +8077,63 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchPlaceOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchPlaceOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchPlaceOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1146,29 +8143,81 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, BatchOrderResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancelOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancelOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancelOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1178,86 +8227,81 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v Price
-			m.Price = &v
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, BatchOrderResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAllOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Side = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Side |= Side(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAllOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1267,47 +8311,24 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GoodTil == nil {
-				m.GoodTil = &GoodTil{}
-			}
-			if err := m.GoodTil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IDs = append(m.IDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
-			}
-			m.TimeInForce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1329,7 +8350,7 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1352,17 +8373,17 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1372,29 +8393,30 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1404,23 +8426,24 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1443,7 +8466,7 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrdersByDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1466,17 +8489,17 @@ func (m *MsgCancelOrdersByDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrdersByDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrdersByDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1486,29 +8509,30 @@ func (m *MsgCancelOrdersByDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1518,29 +8542,80 @@ func (m *MsgCancelOrdersByDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1550,23 +8625,25 @@ func (m *MsgCancelOrdersByDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
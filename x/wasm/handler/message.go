@@ -13,6 +13,7 @@ import (
 
 	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/nft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
 	"github.com/CoreumFoundation/coreum/v6/x/wasm/types"
 )
 
@@ -81,6 +82,15 @@ type nftMsg struct {
 	Send *nfttypes.MsgSend `json:"Send"`
 }
 
+// dexMsg represents dex module messages integrated with the wasm handler.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type dexMsg struct {
+	PlaceOrder          *dextypes.MsgPlaceOrder          `json:"PlaceOrder"`
+	CancelOrder         *dextypes.MsgCancelOrder         `json:"CancelOrder"`
+	CancelOrdersByDenom *dextypes.MsgCancelOrdersByDenom `json:"CancelOrdersByDenom"`
+}
+
 // coreumMsg represents all supported custom messages integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
@@ -88,6 +98,7 @@ type coreumMsg struct {
 	AssetFT  *assetFTMsg  `json:"AssetFT"`
 	AssetNFT *assetNFTMsg `json:"AssetNFT"`
 	NFT      *nftMsg      `json:"nft"`
+	DEX      *dexMsg      `json:"DEX"`
 }
 
 // NewCoreumMsgHandler returns coreum handler that handles messages received from smart contracts.
@@ -131,6 +142,9 @@ func decodeCoreumMessage(coreumMessages coreumMsg, sender sdk.AccAddress) (sdk.M
 	if coreumMessages.NFT != nil {
 		return decodeNFTMessage(coreumMessages.NFT, sender.String())
 	}
+	if coreumMessages.DEX != nil {
+		return decodeDEXMessage(coreumMessages.DEX, sender.String())
+	}
 
 	//nolint:nilnil // we are ok with this.
 	return nil, nil
@@ -274,6 +288,24 @@ func decodeNFTMessage(nftMsg *nftMsg, sender string) (sdk.Msg, error) {
 	return nil, nil
 }
 
+func decodeDEXMessage(dexMsg *dexMsg, sender string) (sdk.Msg, error) {
+	if dexMsg.PlaceOrder != nil {
+		dexMsg.PlaceOrder.Sender = sender
+		return dexMsg.PlaceOrder, nil
+	}
+	if dexMsg.CancelOrder != nil {
+		dexMsg.CancelOrder.Sender = sender
+		return dexMsg.CancelOrder, nil
+	}
+	if dexMsg.CancelOrdersByDenom != nil {
+		dexMsg.CancelOrdersByDenom.Sender = sender
+		return dexMsg.CancelOrdersByDenom, nil
+	}
+
+	//nolint:nilnil // we are ok with this.
+	return nil, nil
+}
+
 var _ wasmkeeper.Messenger = &MessengerWrapper{}
 
 // MessengerWrapper wraps WASM messenger and sets information about smart contract.
@@ -0,0 +1,76 @@
+package handler_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
+	"github.com/CoreumFoundation/coreum/v6/x/wasm/handler"
+)
+
+func TestCoreumMsgHandler_DEX(t *testing.T) {
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	encoder := handler.NewCoreumMsgHandler().Custom
+
+	tests := []struct {
+		name    string
+		msg     string
+		wantMsg sdk.Msg
+		wantErr bool
+	}{
+		{
+			name: "place_order",
+			msg: `{"DEX":{"PlaceOrder":{"type":1,"id":"id1","base_denom":"denom1","quote_denom":"denom2",` +
+				`"price":"12e-1","quantity":"1000","side":2,"time_in_force":1}}}`,
+			wantMsg: &dextypes.MsgPlaceOrder{
+				Sender:      contract.String(),
+				Type:        dextypes.ORDER_TYPE_LIMIT,
+				ID:          "id1",
+				BaseDenom:   "denom1",
+				QuoteDenom:  "denom2",
+				Price:       lo.ToPtr(dextypes.MustNewPriceFromString("12e-1")),
+				Quantity:    sdkmath.NewInt(1000),
+				Side:        dextypes.SIDE_SELL,
+				TimeInForce: dextypes.TIME_IN_FORCE_GTC,
+			},
+		},
+		{
+			name:    "place_invalid_order",
+			msg:     `{"DEX":{"PlaceOrder":{"type":1,"id":"id1","quantity":"1000"}}}`,
+			wantErr: true,
+		},
+		{
+			name: "cancel_order",
+			msg:  `{"DEX":{"CancelOrder":{"id":"id1"}}}`,
+			wantMsg: &dextypes.MsgCancelOrder{
+				Sender: contract.String(),
+				ID:     "id1",
+			},
+		},
+		{
+			name: "cancel_orders_by_denom",
+			msg:  `{"DEX":{"CancelOrdersByDenom":{"account":"` + contract.String() + `","denom":"denom1"}}}`,
+			wantMsg: &dextypes.MsgCancelOrdersByDenom{
+				Sender:  contract.String(),
+				Account: contract.String(),
+				Denom:   "denom1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, err := encoder(contract, []byte(tt.msg))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Msg{tt.wantMsg}, msgs)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/nft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
 // assetFTQuery represents asset ft module queries integrated with the wasm handler.
@@ -144,6 +145,16 @@ type nftQuery struct {
 	Classes *nfttypes.QueryClassesRequest `json:"Classes"`
 }
 
+// dexQuery represents dex module queries integrated with the wasm handler.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type dexQuery struct {
+	Params          *dextypes.QueryParamsRequest          `json:"Params"`
+	Order           *dextypes.QueryOrderRequest           `json:"Order"`
+	Orders          *dextypes.QueryOrdersRequest          `json:"Orders"`
+	OrderBookOrders *dextypes.QueryOrderBookOrdersRequest `json:"OrderBookOrders"`
+}
+
 // coreumQuery represents all coreum module queries integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
@@ -151,12 +162,14 @@ type coreumQuery struct {
 	AssetFT  *assetFTQuery  `json:"AssetFT"`
 	AssetNFT *assetNFTQuery `json:"AssetNFT"`
 	NFT      *nftQuery      `json:"nft"`
+	DEX      *dexQuery      `json:"DEX"`
 }
 
 // NewCoreumQueryHandler returns the coreum handler which handles queries from smart contracts.
 func NewCoreumQueryHandler(
 	assetFTQueryServer assetfttypes.QueryServer, assetNFTQueryServer assetnfttypes.QueryServer,
-	nftQueryServer nfttypes.QueryServer, gRPCQueryRouter *baseapp.GRPCQueryRouter, codec codec.Codec,
+	nftQueryServer nfttypes.QueryServer, dexQueryServer dextypes.QueryServer,
+	gRPCQueryRouter *baseapp.GRPCQueryRouter, codec codec.Codec,
 ) *wasmkeeper.QueryPlugins {
 	return &wasmkeeper.QueryPlugins{
 		Grpc: NewGRPCQuerier(gRPCQueryRouter, codec).Query,
@@ -166,7 +179,9 @@ func NewCoreumQueryHandler(
 				return nil, errors.WithStack(err)
 			}
 
-			return processCoreumQuery(ctx, coreumQuery, assetFTQueryServer, assetNFTQueryServer, nftQueryServer)
+			return processCoreumQuery(
+				ctx, coreumQuery, assetFTQueryServer, assetNFTQueryServer, nftQueryServer, dexQueryServer,
+			)
 		},
 	}
 }
@@ -190,6 +205,7 @@ func processCoreumQuery(
 	assetFTQueryServer assetfttypes.QueryServer,
 	assetNFTQueryServer assetnfttypes.QueryServer,
 	nftQueryServer nfttypes.QueryServer,
+	dexQueryServer dextypes.QueryServer,
 ) ([]byte, error) {
 	if queries.AssetFT != nil {
 		return processAssetFTQuery(ctx, queries.AssetFT, assetFTQueryServer)
@@ -200,6 +216,9 @@ func processCoreumQuery(
 	if queries.NFT != nil {
 		return processNFTQuery(ctx, queries.NFT, nftQueryServer)
 	}
+	if queries.DEX != nil {
+		return processDEXQuery(ctx, queries.DEX, dexQueryServer)
+	}
 
 	return nil, nil
 }
@@ -652,6 +671,51 @@ func processNFTQuery(ctx sdk.Context, nftQuery *nftQuery, nftQueryServer nfttype
 	return nil, nil
 }
 
+func processDEXQuery(
+	ctx sdk.Context, dexQuery *dexQuery, dexQueryServer dextypes.QueryServer,
+) ([]byte, error) {
+	if dexQuery.Params != nil {
+		return executeQuery(
+			ctx,
+			dexQuery.Params,
+			func(ctx context.Context, req *dextypes.QueryParamsRequest) (*dextypes.QueryParamsResponse, error) {
+				return dexQueryServer.Params(ctx, req)
+			},
+		)
+	}
+	if dexQuery.Order != nil {
+		return executeQuery(
+			ctx,
+			dexQuery.Order,
+			func(ctx context.Context, req *dextypes.QueryOrderRequest) (*dextypes.QueryOrderResponse, error) {
+				return dexQueryServer.Order(ctx, req)
+			},
+		)
+	}
+	if dexQuery.Orders != nil {
+		return executeQuery(
+			ctx,
+			dexQuery.Orders,
+			func(ctx context.Context, req *dextypes.QueryOrdersRequest) (*dextypes.QueryOrdersResponse, error) {
+				return dexQueryServer.Orders(ctx, req)
+			},
+		)
+	}
+	if dexQuery.OrderBookOrders != nil {
+		return executeQuery(
+			ctx,
+			dexQuery.OrderBookOrders,
+			func(
+				ctx context.Context, req *dextypes.QueryOrderBookOrdersRequest,
+			) (*dextypes.QueryOrderBookOrdersResponse, error) {
+				return dexQueryServer.OrderBookOrders(ctx, req)
+			},
+		)
+	}
+
+	return nil, nil
+}
+
 func executeQuery[T, K any](
 	ctx sdk.Context,
 	reqStruct T,
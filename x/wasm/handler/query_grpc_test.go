@@ -16,6 +16,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
 	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
 	"github.com/CoreumFoundation/coreum/v6/x/wasm/handler"
 )
 
@@ -92,3 +93,26 @@ func TestGRPCQuerier(t *testing.T) {
 
 	require.NoError(t, eg.Wait())
 }
+
+func TestGRPCQuerier_DEXTWAP(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:    time.Now(),
+		AppHash: []byte("some-hash"),
+	})
+
+	q := handler.NewGRPCQuerier(testApp.GRPCQueryRouter(), testApp.AppCodec())
+	wasmGrpcData, err := testApp.AppCodec().Marshal(&dextypes.QueryTWAPRequest{
+		BaseDenom:  "denom1",
+		QuoteDenom: "denom2",
+		StartTime:  sdkCtx.BlockTime().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	// the query is allowed, but the order book doesn't exist
+	_, err = q.Query(sdkCtx, &wasmvmtypes.GrpcQuery{
+		Data: wasmGrpcData,
+		Path: "/coreum.dex.v1.Query/TWAP",
+	})
+	require.ErrorIs(t, err, dextypes.ErrRecordNotFound)
+}
@@ -0,0 +1,96 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	assetftkeeper "github.com/CoreumFoundation/coreum/v6/x/asset/ft/keeper"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	assetnftkeeper "github.com/CoreumFoundation/coreum/v6/x/asset/nft/keeper"
+	dexkeeper "github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
+	"github.com/CoreumFoundation/coreum/v6/x/wasm/handler"
+)
+
+func TestCoreumQueryHandler_DEX(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+
+	issuer, _ := testApp.GenAccount(sdkCtx)
+	denoms := make([]string, 0, 2)
+	for _, subunit := range []string{"denom1", "denom2"} {
+		denom, err := testApp.AssetFTKeeper.Issue(sdkCtx, assetfttypes.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdkmath.NewIntWithDecimal(1, 10),
+		})
+		require.NoError(t, err)
+		denoms = append(denoms, denom)
+	}
+
+	params, err := testApp.DEXKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, testApp.FundAccount(sdkCtx, issuer, sdk.NewCoins(params.OrderReserve)))
+	order := dextypes.Order{
+		Creator:     issuer.String(),
+		Type:        dextypes.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   denoms[0],
+		QuoteDenom:  denoms[1],
+		Price:       lo.ToPtr(dextypes.MustNewPriceFromString("12e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        dextypes.SIDE_SELL,
+		TimeInForce: dextypes.TIME_IN_FORCE_GTC,
+	}
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, order))
+
+	querier := handler.NewCoreumQueryHandler(
+		assetftkeeper.NewQueryService(testApp.AssetFTKeeper, testApp.BankKeeper),
+		assetnftkeeper.NewQueryService(testApp.AssetNFTKeeper),
+		testApp.NFTKeeper,
+		dexkeeper.NewQueryService(testApp.DEXKeeper),
+		testApp.GRPCQueryRouter(),
+		testApp.AppCodec(),
+	).Custom
+
+	res, err := querier(sdkCtx, []byte(`{"DEX":{"Params":{}}}`))
+	require.NoError(t, err)
+	var paramsRes dextypes.QueryParamsResponse
+	require.NoError(t, json.Unmarshal(res, &paramsRes))
+	require.Equal(t, params.MaxOrdersPerDenom, paramsRes.Params.MaxOrdersPerDenom)
+
+	res, err = querier(sdkCtx, []byte(`{"DEX":{"Order":{"creator":"`+issuer.String()+`","id":"id1"}}}`))
+	require.NoError(t, err)
+	var orderRes dextypes.QueryOrderResponse
+	require.NoError(t, json.Unmarshal(res, &orderRes))
+	require.Equal(t, order.ID, orderRes.Order.ID)
+	require.Equal(t, order.Price.String(), orderRes.Order.Price.String())
+	require.Equal(t, order.Quantity.String(), orderRes.Order.RemainingBaseQuantity.String())
+
+	res, err = querier(sdkCtx, []byte(`{"DEX":{"Orders":{"creator":"`+issuer.String()+`"}}}`))
+	require.NoError(t, err)
+	var ordersRes dextypes.QueryOrdersResponse
+	require.NoError(t, json.Unmarshal(res, &ordersRes))
+	require.Len(t, ordersRes.Orders, 1)
+
+	res, err = querier(sdkCtx, []byte(
+		`{"DEX":{"OrderBookOrders":{"base_denom":"`+denoms[0]+`","quote_denom":"`+denoms[1]+`","side":2}}}`,
+	))
+	require.NoError(t, err)
+	var orderBookOrdersRes dextypes.QueryOrderBookOrdersResponse
+	require.NoError(t, json.Unmarshal(res, &orderBookOrdersRes))
+	require.Len(t, orderBookOrdersRes.Orders, 1)
+	require.Equal(t, order.ID, orderBookOrdersRes.Orders[0].ID)
+
+	// the missing order
+	_, err = querier(sdkCtx, []byte(`{"DEX":{"Order":{"creator":"`+issuer.String()+`","id":"id2"}}}`))
+	require.ErrorIs(t, err, dextypes.ErrRecordNotFound)
+}
@@ -0,0 +1,164 @@
+package wibctransfer
+
+import (
+	"encoding/json"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/CoreumFoundation/coreum/v6/x/wibctransfer/types"
+)
+
+var _ porttypes.IBCModule = DEXMiddleware{}
+
+// DEXMiddleware places the DEX order described in the memo of the incoming transfer.
+// The transferred funds are received by the account derived from the channel and the sender, which places the
+// order. The proceeds and the unspent funds are sent to the receiver of the transfer, and the proceeds are
+// optionally forwarded from the receiver over IBC. If anything fails the error acknowledgement is returned, so the
+// transfer is refunded on the sender chain.
+type DEXMiddleware struct {
+	porttypes.IBCModule
+	dexKeeper      types.DEXKeeper
+	bankKeeper     types.BankKeeper
+	transferKeeper types.TransferKeeper
+}
+
+// NewDEXMiddleware returns middleware placing the DEX orders of the incoming transfers.
+func NewDEXMiddleware(
+	module porttypes.IBCModule,
+	dexKeeper types.DEXKeeper,
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+) DEXMiddleware {
+	return DEXMiddleware{
+		IBCModule:      module,
+		dexKeeper:      dexKeeper,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+	}
+}
+
+// OnRecvPacket places the DEX order if the memo of the transfer contains it, otherwise calls the upper
+// implementation.
+func (im DEXMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	dexMemo, err := types.ParseDEXMemo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if dexMemo == nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid receiver address: %s", data.Receiver),
+		)
+	}
+	// the transfer module checks the order account instead of the receiver, and the proceeds are sent to the receiver
+	// by the bank keeper, so the blocked receiver is rejected here
+	if im.bankKeeper.BlockedAddr(receiver) {
+		return channeltypes.NewErrorAcknowledgement(
+			sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "%s is not allowed to receive funds", data.Receiver),
+		)
+	}
+
+	orderAccount := types.DeriveDEXOrderAccount(packet.GetDestChannel(), data.Sender)
+	data.Receiver = orderAccount.String()
+	packet.Data = data.GetBytes()
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if err := im.placeOrder(ctx, packet, data, *dexMemo, orderAccount, receiver); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+func (im DEXMiddleware) placeOrder(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	dexMemo types.DEXMemo,
+	orderAccount, receiver sdk.AccAddress,
+) error {
+	order, err := dexMemo.Order.ToOrder(orderAccount.String(), packet.GetDestChannel(), packet.GetSequence())
+	if err != nil {
+		return err
+	}
+
+	receivedDenom := ibchooks.MustExtractDenomFromPacketOnRecv(packet)
+	if order.GetSpendDenom() != receivedDenom {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrInvalidRequest,
+			"the order spends %s but the transfer denom is %s", order.GetSpendDenom(), receivedDenom,
+		)
+	}
+	receivedAmount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidCoins, "invalid transfer amount: %s", data.Amount)
+	}
+
+	// the order account might hold the funds sent directly, so only the funds of the transfer are moved
+	spendBalance := im.bankKeeper.GetBalance(ctx, orderAccount, receivedDenom)
+	receiveBalance := im.bankKeeper.GetBalance(ctx, orderAccount, order.GetReceiveDenom())
+	if err := im.dexKeeper.PlaceOrder(ctx, order); err != nil {
+		return err
+	}
+	spent := spendBalance.Sub(im.bankKeeper.GetBalance(ctx, orderAccount, receivedDenom))
+	if spent.Amount.GT(receivedAmount) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrInsufficientFunds,
+			"the order spends %s which is more than the transfer amount %s", spent.String(), receivedAmount.String(),
+		)
+	}
+	proceeds := im.bankKeeper.GetBalance(ctx, orderAccount, order.GetReceiveDenom()).Sub(receiveBalance)
+	if !proceeds.IsPositive() {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidRequest, "the order %s isn't filled", order.ID)
+	}
+	unspent := sdk.NewCoin(receivedDenom, receivedAmount.Sub(spent.Amount))
+	if err := im.bankKeeper.SendCoins(ctx, orderAccount, receiver, sdk.NewCoins(proceeds, unspent)); err != nil {
+		return err
+	}
+
+	if dexMemo.Forward == nil {
+		return nil
+	}
+
+	timeoutTimestamp := dexMemo.Forward.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + ibctransfertypes.DefaultRelativePacketTimeoutTimestamp
+	}
+	// the proceeds are forwarded from the receiver, so if the forwarding fails later they are refunded to it
+	_, err = im.transferKeeper.Transfer(ctx, &ibctransfertypes.MsgTransfer{
+		SourcePort:       ibctransfertypes.PortID,
+		SourceChannel:    dexMemo.Forward.Channel,
+		Token:            proceeds,
+		Sender:           receiver.String(),
+		Receiver:         dexMemo.Forward.Receiver,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: timeoutTimestamp,
+	})
+	return err
+}
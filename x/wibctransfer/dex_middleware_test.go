@@ -0,0 +1,265 @@
+package wibctransfer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
+	"github.com/CoreumFoundation/coreum/v6/x/wibctransfer"
+	"github.com/CoreumFoundation/coreum/v6/x/wibctransfer/types"
+)
+
+const (
+	testSourceChannel = "channel-7"
+	testDestChannel   = "channel-0"
+	testSender        = "sender"
+)
+
+type transferModuleMock struct {
+	porttypes.IBCModule
+	testApp  *simapp.App
+	funder   sdk.AccAddress
+	received []ibctransfertypes.FungibleTokenPacketData
+}
+
+// OnRecvPacket sends the funds of the transfer to the receiver instead of unescrowing them.
+func (m *transferModuleMock) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	m.received = append(m.received, data)
+
+	amount, _ := sdkmath.NewIntFromString(data.Amount)
+	denom := data.Denom[len(ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+	if err := m.testApp.BankKeeper.SendCoins(
+		ctx, m.funder, sdk.MustAccAddressFromBech32(data.Receiver), sdk.NewCoins(sdk.NewCoin(denom, amount)),
+	); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+type transferKeeperMock struct {
+	transfers []*ibctransfertypes.MsgTransfer
+}
+
+func (k *transferKeeperMock) Transfer(
+	_ context.Context, msg *ibctransfertypes.MsgTransfer,
+) (*ibctransfertypes.MsgTransferResponse, error) {
+	k.transfers = append(k.transfers, msg)
+	return &ibctransfertypes.MsgTransferResponse{}, nil
+}
+
+func TestDEXMiddleware_OnRecvPacket(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContext(false)
+
+	issuer, _ := testApp.GenAccount(sdkCtx)
+	denoms := make([]string, 0)
+	for _, subunit := range []string{"denom1", "denom2"} {
+		denom, err := testApp.AssetFTKeeper.Issue(sdkCtx, assetfttypes.IssueSettings{
+			Issuer:        issuer,
+			Subunit:       subunit,
+			Symbol:        subunit,
+			Precision:     6,
+			InitialAmount: sdkmath.NewIntWithDecimal(1, 20),
+		})
+		require.NoError(t, err)
+		denoms = append(denoms, denom)
+	}
+	baseDenom, quoteDenom := denoms[0], denoms[1]
+
+	// 1denom1 = 2denom2
+	maker, _ := testApp.GenAccount(sdkCtx)
+	params, err := testApp.DEXKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, testApp.FundAccount(sdkCtx, maker, sdk.NewCoins(params.OrderReserve)))
+	require.NoError(t, testApp.BankKeeper.SendCoins(
+		sdkCtx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1_000_000)),
+	))
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, dextypes.Order{
+		Creator:     maker.String(),
+		Type:        dextypes.ORDER_TYPE_LIMIT,
+		ID:          "maker-order",
+		BaseDenom:   baseDenom,
+		QuoteDenom:  quoteDenom,
+		Price:       lo.ToPtr(dextypes.MustNewPriceFromString("2")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        dextypes.SIDE_SELL,
+		TimeInForce: dextypes.TIME_IN_FORCE_GTC,
+	}))
+
+	transferModule := &transferModuleMock{
+		testApp: testApp,
+		funder:  issuer,
+	}
+	transferKeeper := &transferKeeperMock{}
+	middleware := wibctransfer.NewDEXMiddleware(transferModule, testApp.DEXKeeper, testApp.BankKeeper, transferKeeper)
+
+	receiver, _ := testApp.GenAccount(sdkCtx)
+	orderAccount := types.DeriveDEXOrderAccount(testDestChannel, testSender)
+	dexMemo := func(price, timeInForce string, forward *types.DEXMemoForward) string {
+		memo, err := json.Marshal(map[string]types.DEXMemo{
+			types.DEXMemoKey: {
+				Order: types.DEXMemoOrder{
+					Type:        dextypes.ORDER_TYPE_LIMIT.String(),
+					BaseDenom:   baseDenom,
+					QuoteDenom:  quoteDenom,
+					Price:       price,
+					Quantity:    sdkmath.NewInt(200_000),
+					Side:        dextypes.SIDE_BUY.String(),
+					TimeInForce: timeInForce,
+				},
+				Forward: forward,
+			},
+		})
+		require.NoError(t, err)
+		return string(memo)
+	}
+
+	// the transfer without the DEX memo is passed as is
+	cacheCtx, _ := sdkCtx.CacheContext()
+	ack := middleware.OnRecvPacket(cacheCtx, newTransferPacket(t, 1, quoteDenom, 500_000, receiver, ""), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, receiver.String(), transferModule.received[0].Receiver)
+	require.Equal(t, sdk.NewInt64Coin(quoteDenom, 500_000).String(),
+		testApp.BankKeeper.GetAllBalances(cacheCtx, receiver).String())
+
+	// the invalid memo is rejected without receiving the transfer
+	ack = middleware.OnRecvPacket(
+		sdkCtx,
+		newTransferPacket(
+			t, 2, quoteDenom, 500_000, receiver, dexMemo("2", dextypes.TIME_IN_FORCE_GTC.String(), nil),
+		),
+		nil,
+	)
+	require.False(t, ack.Success())
+	require.Len(t, transferModule.received, 1)
+
+	// the memo can't be combined with the memos of the other middlewares
+	ack = middleware.OnRecvPacket(
+		sdkCtx,
+		newTransferPacket(t, 2, quoteDenom, 500_000, receiver, `{"dex":{},"forward":{}}`),
+		nil,
+	)
+	require.False(t, ack.Success())
+	require.Len(t, transferModule.received, 1)
+
+	// the module account can't receive the proceeds
+	ack = middleware.OnRecvPacket(
+		sdkCtx,
+		newTransferPacket(
+			t, 2, quoteDenom, 500_000, authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+			dexMemo("2", dextypes.TIME_IN_FORCE_IOC.String(), nil),
+		),
+		nil,
+	)
+	require.False(t, ack.Success())
+	require.Len(t, transferModule.received, 1)
+
+	// the order which isn't filled is rejected
+	cacheCtx, _ = sdkCtx.CacheContext()
+	ack = middleware.OnRecvPacket(
+		cacheCtx,
+		newTransferPacket(
+			t, 2, quoteDenom, 500_000, receiver, dexMemo("1", dextypes.TIME_IN_FORCE_IOC.String(), nil),
+		),
+		nil,
+	)
+	require.False(t, ack.Success())
+
+	// the order spending the denom other than the transfer denom is rejected
+	cacheCtx, _ = sdkCtx.CacheContext()
+	ack = middleware.OnRecvPacket(
+		cacheCtx,
+		newTransferPacket(
+			t, 2, baseDenom, 500_000, receiver, dexMemo("2", dextypes.TIME_IN_FORCE_IOC.String(), nil),
+		),
+		nil,
+	)
+	require.False(t, ack.Success())
+
+	// the order is placed and the proceeds are forwarded
+	forward := &types.DEXMemoForward{
+		Channel:  testDestChannel,
+		Receiver: testSender,
+	}
+	ack = middleware.OnRecvPacket(
+		sdkCtx,
+		newTransferPacket(
+			t, 2, quoteDenom, 500_000, receiver, dexMemo("2", dextypes.TIME_IN_FORCE_IOC.String(), forward),
+		),
+		nil,
+	)
+	require.True(t, ack.Success())
+	require.Equal(t, orderAccount.String(), transferModule.received[len(transferModule.received)-1].Receiver)
+
+	require.True(t, testApp.BankKeeper.GetAllBalances(sdkCtx, orderAccount).IsZero())
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(baseDenom, 200_000),
+		sdk.NewInt64Coin(quoteDenom, 100_000),
+	).String(), testApp.BankKeeper.GetAllBalances(sdkCtx, receiver).String())
+
+	_, err = testApp.DEXKeeper.GetOrderByAddressAndID(sdkCtx, orderAccount, fmt.Sprintf("ibc-%s-2", testDestChannel))
+	require.ErrorIs(t, err, dextypes.ErrRecordNotFound)
+
+	require.Len(t, transferKeeper.transfers, 1)
+	require.Equal(t, ibctransfertypes.PortID, transferKeeper.transfers[0].SourcePort)
+	require.Equal(t, testDestChannel, transferKeeper.transfers[0].SourceChannel)
+	require.Equal(t, sdk.NewInt64Coin(baseDenom, 200_000).String(), transferKeeper.transfers[0].Token.String())
+	require.Equal(t, receiver.String(), transferKeeper.transfers[0].Sender)
+	require.Equal(t, testSender, transferKeeper.transfers[0].Receiver)
+	require.Positive(t, transferKeeper.transfers[0].TimeoutTimestamp)
+}
+
+func newTransferPacket(
+	t *testing.T,
+	sequence uint64,
+	denom string,
+	amount int64,
+	receiver sdk.AccAddress,
+	memo string,
+) channeltypes.Packet {
+	t.Helper()
+
+	// the denom is prefixed by the source channel, so it's received as the native denom
+	data := ibctransfertypes.NewFungibleTokenPacketData(
+		ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, testSourceChannel, denom),
+		sdkmath.NewInt(amount).String(),
+		testSender,
+		receiver.String(),
+		memo,
+	)
+
+	return channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		ibctransfertypes.PortID,
+		testSourceChannel,
+		ibctransfertypes.PortID,
+		testDestChannel,
+		clienttypes.ZeroHeight(),
+		0,
+	)
+}
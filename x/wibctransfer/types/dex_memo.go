@@ -0,0 +1,184 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+const (
+	// DEXMemoKey is the key of the memo object describing the DEX order placed by the incoming transfer.
+	DEXMemoKey = "dex"
+	// dexOrderAccountPrefix is the prefix used to derive the account placing the DEX order of the incoming transfer.
+	dexOrderAccountPrefix = "ibc-dex-order"
+	// dexOrderIDPrefix is the prefix of the generated IDs of the DEX orders placed by the incoming transfers.
+	dexOrderIDPrefix = "ibc-"
+)
+
+// conflictingMemoKeys are the memo keys handled by the other middlewares of the transfer stack, which can't be
+// combined with the DEX memo.
+var conflictingMemoKeys = []string{"wasm", "forward"}
+
+// DEXMemo is the memo object describing the DEX order placed by the incoming transfer.
+type DEXMemo struct {
+	Order   DEXMemoOrder    `json:"order"`
+	Forward *DEXMemoForward `json:"forward,omitempty"`
+}
+
+// DEXMemoOrder is the DEX order placed by the incoming transfer.
+type DEXMemoOrder struct {
+	ID          string      `json:"id,omitempty"`
+	Type        string      `json:"type"`
+	BaseDenom   string      `json:"base_denom"`
+	QuoteDenom  string      `json:"quote_denom"`
+	Price       string      `json:"price,omitempty"`
+	Quantity    sdkmath.Int `json:"quantity"`
+	Side        string      `json:"side"`
+	TimeInForce string      `json:"time_in_force"`
+}
+
+// DEXMemoForward defines where the proceeds of the DEX order are forwarded over IBC.
+type DEXMemoForward struct {
+	Channel  string `json:"channel"`
+	Receiver string `json:"receiver"`
+	// TimeoutTimestamp is the absolute timeout timestamp in nanoseconds, if it is zero the default relative timeout
+	// is used.
+	TimeoutTimestamp uint64 `json:"timeout_timestamp,omitempty"`
+}
+
+// ParseDEXMemo returns the DEX memo if the memo contains it.
+func ParseDEXMemo(memo string) (*DEXMemo, error) {
+	if memo == "" {
+		return nil, nil //nolint:nilnil // nil memo means that the DEX memo isn't set
+	}
+
+	var memoObject map[string]json.RawMessage
+	// the memo might be not a json object, in this case it's not the DEX memo
+	if err := json.Unmarshal([]byte(memo), &memoObject); err != nil {
+		return nil, nil //nolint:nilnil,nilerr // nil memo means that the DEX memo isn't set
+	}
+
+	dexMemoRaw, ok := memoObject[DEXMemoKey]
+	if !ok {
+		return nil, nil //nolint:nilnil // nil memo means that the DEX memo isn't set
+	}
+
+	for _, key := range conflictingMemoKeys {
+		if _, ok := memoObject[key]; ok {
+			return nil, sdkerrors.Wrapf(
+				cosmoserrors.ErrInvalidRequest, "the %s memo can't be combined with the %s memo", DEXMemoKey, key,
+			)
+		}
+	}
+
+	var dexMemo DEXMemo
+	if err := json.Unmarshal(dexMemoRaw, &dexMemo); err != nil {
+		return nil, sdkerrors.Wrapf(cosmoserrors.ErrInvalidRequest, "invalid %s memo: %s", DEXMemoKey, err)
+	}
+
+	if err := dexMemo.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &dexMemo, nil
+}
+
+// Validate validates the DEX memo.
+func (m DEXMemo) Validate() error {
+	// the creator and the generated ID don't depend on the memo, so the placeholders are used to validate it
+	order, err := m.Order.ToOrder(
+		DeriveDEXOrderAccount(channeltypes.FormatChannelIdentifier(0), "").String(),
+		channeltypes.FormatChannelIdentifier(0),
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	if err := order.Validate(); err != nil {
+		return err
+	}
+
+	// the order account isn't controlled by anyone, so only the market orders and the limit orders which don't rest in
+	// the order book are allowed
+	if order.Type != dextypes.ORDER_TYPE_MARKET &&
+		order.TimeInForce != dextypes.TIME_IN_FORCE_IOC &&
+		order.TimeInForce != dextypes.TIME_IN_FORCE_FOK {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrInvalidRequest,
+			"the %s time in force isn't allowed for the order placed by the transfer", m.Order.TimeInForce,
+		)
+	}
+
+	if m.Forward != nil {
+		if err := host.ChannelIdentifierValidator(m.Forward.Channel); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidRequest, "invalid forward channel: %s", err)
+		}
+		if m.Forward.Receiver == "" {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidRequest, "forward receiver can't be empty")
+		}
+	}
+
+	return nil
+}
+
+// ToOrder converts the memo order to the DEX order of the creator. If the memo order ID is empty the ID is
+// generated from the channel and the sequence of the packet. The returned order isn't validated.
+func (o DEXMemoOrder) ToOrder(creator, channel string, sequence uint64) (dextypes.Order, error) {
+	orderType, ok := dextypes.OrderType_value[o.Type]
+	if !ok {
+		return dextypes.Order{}, sdkerrors.Wrapf(cosmoserrors.ErrInvalidRequest, "invalid order type: %s", o.Type)
+	}
+	side, ok := dextypes.Side_value[o.Side]
+	if !ok {
+		return dextypes.Order{}, sdkerrors.Wrapf(cosmoserrors.ErrInvalidRequest, "invalid side: %s", o.Side)
+	}
+	timeInForce, ok := dextypes.TimeInForce_value[o.TimeInForce]
+	if !ok {
+		return dextypes.Order{}, sdkerrors.Wrapf(
+			cosmoserrors.ErrInvalidRequest, "invalid time in force: %s", o.TimeInForce,
+		)
+	}
+
+	id := o.ID
+	if id == "" {
+		id = fmt.Sprintf("%s%s-%d", dexOrderIDPrefix, channel, sequence)
+	}
+
+	order := dextypes.Order{
+		Creator:     creator,
+		Type:        dextypes.OrderType(orderType),
+		ID:          id,
+		BaseDenom:   o.BaseDenom,
+		QuoteDenom:  o.QuoteDenom,
+		Quantity:    o.Quantity,
+		Side:        dextypes.Side(side),
+		TimeInForce: dextypes.TimeInForce(timeInForce),
+	}
+	if o.Price != "" {
+		price, err := dextypes.NewPriceFromString(o.Price)
+		if err != nil {
+			return dextypes.Order{}, err
+		}
+		order.Price = &price
+	}
+	if order.Quantity.IsNil() {
+		order.Quantity = sdkmath.ZeroInt()
+	}
+
+	return order, nil
+}
+
+// DeriveDEXOrderAccount returns the account placing the DEX orders of the incoming transfers of the sender
+// received on the channel.
+func DeriveDEXOrderAccount(channel, sender string) sdk.AccAddress {
+	return address.Hash(dexOrderAccountPrefix, []byte(fmt.Sprintf("%s/%s", channel, sender)))
+}
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
+	"github.com/CoreumFoundation/coreum/v6/x/wibctransfer/types"
+)
+
+func TestDEXMemo_Validate(t *testing.T) {
+	validMemo := func() types.DEXMemo {
+		return types.DEXMemo{
+			Order: types.DEXMemoOrder{
+				Type:        dextypes.ORDER_TYPE_LIMIT.String(),
+				BaseDenom:   "denom1",
+				QuoteDenom:  "denom2",
+				Price:       "2",
+				Quantity:    sdkmath.NewInt(200_000),
+				Side:        dextypes.SIDE_BUY.String(),
+				TimeInForce: dextypes.TIME_IN_FORCE_IOC.String(),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		memo    types.DEXMemo
+		wantErr error
+	}{
+		{
+			name: "valid_limit_ioc",
+			memo: validMemo(),
+		},
+		{
+			name: "valid_limit_fok",
+			memo: func() types.DEXMemo {
+				memo := validMemo()
+				memo.Order.TimeInForce = dextypes.TIME_IN_FORCE_FOK.String()
+				return memo
+			}(),
+		},
+		{
+			name: "valid_market",
+			memo: func() types.DEXMemo {
+				memo := validMemo()
+				memo.Order.Type = dextypes.ORDER_TYPE_MARKET.String()
+				memo.Order.Price = ""
+				return memo
+			}(),
+		},
+		{
+			name: "valid_forward",
+			memo: func() types.DEXMemo {
+				memo := validMemo()
+				memo.Forward = &types.DEXMemoForward{
+					Channel:  "channel-1",
+					Receiver: "receiver",
+				}
+				return memo
+			}(),
+		},
+		{
+			name: "invalid_limit_gtc",
+			memo: func() types.DEXMemo {
+				memo := validMemo()
+				memo.Order.TimeInForce = dextypes.TIME_IN_FORCE_GTC.String()
+				return memo
+			}(),
+			wantErr: cosmoserrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid_limit_post_only",
+			memo: func() types.DEXMemo {
+				memo := validMemo()
+				memo.Order.TimeInForce = dextypes.TIME_IN_FORCE_POST_ONLY.String()
+				return memo
+			}(),
+			wantErr: cosmoserrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid_time_in_force",
+			memo: func() types.DEXMemo {
+				memo := validMemo()
+				memo.Order.TimeInForce = "unknown"
+				return memo
+			}(),
+			wantErr: cosmoserrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid_forward_channel",
+			memo: func() types.DEXMemo {
+				memo := validMemo()
+				memo.Forward = &types.DEXMemoForward{
+					Channel:  "invalid",
+					Receiver: "receiver",
+				}
+				return memo
+			}(),
+			wantErr: cosmoserrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid_empty_forward_receiver",
+			memo: func() types.DEXMemo {
+				memo := validMemo()
+				memo.Forward = &types.DEXMemoForward{
+					Channel: "channel-1",
+				}
+				return memo
+			}(),
+			wantErr: cosmoserrors.ErrInvalidRequest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.memo.Validate()
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wantErr)
+			}
+
+			// the memo is validated when it's parsed
+			memo, err := json.Marshal(map[string]types.DEXMemo{types.DEXMemoKey: tc.memo})
+			require.NoError(t, err)
+			_, err = types.ParseDEXMemo(string(memo))
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// DEXKeeper represents required methods of the DEX keeper.
+type DEXKeeper interface {
+	PlaceOrder(ctx sdk.Context, order dextypes.Order) error
+}
+
+// BankKeeper represents required methods of the bank keeper.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// TransferKeeper represents required methods of the IBC transfer keeper.
+type TransferKeeper interface {
+	Transfer(
+		ctx context.Context, msg *ibctransfertypes.MsgTransfer,
+	) (*ibctransfertypes.MsgTransferResponse, error)
+}